@@ -34,6 +34,9 @@ import (
 // swagger:model addBucketLifecycle
 type AddBucketLifecycle struct {
 
+	// Non required, days after which incomplete multipart uploads are aborted, it can't be set along with tags
+	AbortIncompleteMultipartDays int32 `json:"abort_incomplete_multipart_days,omitempty"`
+
 	// Non required, toggle to disable or enable rule
 	Disable bool `json:"disable,omitempty"`
 
@@ -61,7 +64,7 @@ type AddBucketLifecycle struct {
 	// Required only in case of transition is set. it refers to a tier
 	StorageClass string `json:"storage_class,omitempty"`
 
-	// Non required field, tags to match ILM files
+	// Non required field, tags to match ILM files, several tags are separated by & (e.g. key1=value1&key2=value2)
 	Tags string `json:"tags,omitempty"`
 
 	// Required in case of transition_days or expiry fields are not set. it defines a transition date for ILM
@@ -36,6 +36,9 @@ import (
 // swagger:model configuration
 type Configuration struct {
 
+	// key schema
+	KeySchema []*ConfigKeySchema `json:"key_schema"`
+
 	// key values
 	KeyValues []*ConfigurationKV `json:"key_values"`
 
@@ -47,6 +50,10 @@ type Configuration struct {
 func (m *Configuration) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateKeySchema(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateKeyValues(formats); err != nil {
 		res = append(res, err)
 	}
@@ -57,6 +64,30 @@ func (m *Configuration) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Configuration) validateKeySchema(formats strfmt.Registry) error {
+	if swag.IsZero(m.KeySchema) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.KeySchema); i++ {
+		if swag.IsZero(m.KeySchema[i]) { // not required
+			continue
+		}
+
+		if m.KeySchema[i] != nil {
+			if err := m.KeySchema[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("key_schema" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Configuration) validateKeyValues(formats strfmt.Registry) error {
 	if swag.IsZero(m.KeyValues) { // not required
 		return nil
@@ -85,6 +116,10 @@ func (m *Configuration) validateKeyValues(formats strfmt.Registry) error {
 func (m *Configuration) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateKeySchema(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateKeyValues(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -95,6 +130,24 @@ func (m *Configuration) ContextValidate(ctx context.Context, formats strfmt.Regi
 	return nil
 }
 
+func (m *Configuration) contextValidateKeySchema(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.KeySchema); i++ {
+
+		if m.KeySchema[i] != nil {
+			if err := m.KeySchema[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("key_schema" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Configuration) contextValidateKeyValues(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.KeyValues); i++ {
@@ -24,7 +24,9 @@ package models
 
 import (
 	"context"
+	"strconv"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 )
@@ -36,15 +38,81 @@ type LogSearchResponse struct {
 
 	// list of log search responses
 	Results interface{} `json:"results,omitempty"`
+
+	// stats
+	Stats []*LogSearchStat `json:"stats"`
+
+	// truncated
+	Truncated bool `json:"truncated,omitempty"`
 }
 
 // Validate validates this log search response
 func (m *LogSearchResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateStats(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
 	return nil
 }
 
-// ContextValidate validates this log search response based on context it is used
+func (m *LogSearchResponse) validateStats(formats strfmt.Registry) error {
+	if swag.IsZero(m.Stats) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Stats); i++ {
+		if swag.IsZero(m.Stats[i]) { // not required
+			continue
+		}
+
+		if m.Stats[i] != nil {
+			if err := m.Stats[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("stats" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this log search response based on the context it is used
 func (m *LogSearchResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateStats(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *LogSearchResponse) contextValidateStats(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Stats); i++ {
+
+		if m.Stats[i] != nil {
+			if err := m.Stats[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("stats" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
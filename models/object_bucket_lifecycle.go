@@ -36,12 +36,21 @@ import (
 // swagger:model objectBucketLifecycle
 type ObjectBucketLifecycle struct {
 
+	// abort incomplete multipart days
+	AbortIncompleteMultipartDays int64 `json:"abort_incomplete_multipart_days,omitempty"`
+
 	// expiration
 	Expiration *ExpirationResponse `json:"expiration,omitempty"`
 
 	// id
 	ID string `json:"id,omitempty"`
 
+	// noncurrent expiration days
+	NoncurrentExpirationDays int64 `json:"noncurrent_expiration_days,omitempty"`
+
+	// noncurrent transition
+	NoncurrentTransition *NoncurrentTransitionResponse `json:"noncurrent_transition,omitempty"`
+
 	// prefix
 	Prefix string `json:"prefix,omitempty"`
 
@@ -63,6 +72,10 @@ func (m *ObjectBucketLifecycle) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateNoncurrentTransition(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateTags(formats); err != nil {
 		res = append(res, err)
 	}
@@ -94,6 +107,23 @@ func (m *ObjectBucketLifecycle) validateExpiration(formats strfmt.Registry) erro
 	return nil
 }
 
+func (m *ObjectBucketLifecycle) validateNoncurrentTransition(formats strfmt.Registry) error {
+	if swag.IsZero(m.NoncurrentTransition) { // not required
+		return nil
+	}
+
+	if m.NoncurrentTransition != nil {
+		if err := m.NoncurrentTransition.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("noncurrent_transition")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ObjectBucketLifecycle) validateTags(formats strfmt.Registry) error {
 	if swag.IsZero(m.Tags) { // not required
 		return nil
@@ -143,6 +173,10 @@ func (m *ObjectBucketLifecycle) ContextValidate(ctx context.Context, formats str
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateNoncurrentTransition(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateTags(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -171,6 +205,20 @@ func (m *ObjectBucketLifecycle) contextValidateExpiration(ctx context.Context, f
 	return nil
 }
 
+func (m *ObjectBucketLifecycle) contextValidateNoncurrentTransition(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.NoncurrentTransition != nil {
+		if err := m.NoncurrentTransition.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("noncurrent_transition")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ObjectBucketLifecycle) contextValidateTags(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.Tags); i++ {
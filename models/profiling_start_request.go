@@ -28,17 +28,24 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
-	"github.com/go-openapi/validate"
 )
 
-// ProfilingStartRequest profiling start request
+// ProfilingStartRequest one of type or types is required
 //
 // swagger:model profilingStartRequest
 type ProfilingStartRequest struct {
 
+	// seconds after which the capture is stopped automatically
+	Duration int64 `json:"duration,omitempty"`
+
+	// nodes
+	Nodes []string `json:"nodes"`
+
 	// type
-	// Required: true
-	Type *ProfilerType `json:"type"`
+	Type ProfilerType `json:"type,omitempty"`
+
+	// profilers to run, type is still accepted for a single profiler
+	Types []string `json:"types"`
 }
 
 // Validate validates this profiling start request
@@ -56,22 +63,15 @@ func (m *ProfilingStartRequest) Validate(formats strfmt.Registry) error {
 }
 
 func (m *ProfilingStartRequest) validateType(formats strfmt.Registry) error {
-
-	if err := validate.Required("type", "body", m.Type); err != nil {
-		return err
+	if swag.IsZero(m.Type) { // not required
+		return nil
 	}
 
-	if err := validate.Required("type", "body", m.Type); err != nil {
-		return err
-	}
-
-	if m.Type != nil {
-		if err := m.Type.Validate(formats); err != nil {
-			if ve, ok := err.(*errors.Validation); ok {
-				return ve.ValidateName("type")
-			}
-			return err
+	if err := m.Type.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("type")
 		}
+		return err
 	}
 
 	return nil
@@ -93,13 +93,11 @@ func (m *ProfilingStartRequest) ContextValidate(ctx context.Context, formats str
 
 func (m *ProfilingStartRequest) contextValidateType(ctx context.Context, formats strfmt.Registry) error {
 
-	if m.Type != nil {
-		if err := m.Type.ContextValidate(ctx, formats); err != nil {
-			if ve, ok := err.(*errors.Validation); ok {
-				return ve.ValidateName("type")
-			}
-			return err
+	if err := m.Type.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("type")
 		}
+		return err
 	}
 
 	return nil
@@ -146,6 +146,25 @@ func decryptClaims(ciphertext string) (*TokenClaims, error) {
 	return tokenClaims, nil
 }
 
+// EncryptData encrypts data stored by Console with the same key as the session
+// tokens, returns a base64 encoded ciphertext
+func EncryptData(data []byte) (string, error) {
+	ciphertext, err := encrypt(data, []byte{})
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(ciphertext), nil
+}
+
+// DecryptData decrypts the base64 encoded ciphertext returned by EncryptData
+func DecryptData(ciphertext string) ([]byte, error) {
+	decoded, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	return decrypt(decoded, []byte{})
+}
+
 const (
 	aesGcm   = 0x00
 	c20p1305 = 0x01
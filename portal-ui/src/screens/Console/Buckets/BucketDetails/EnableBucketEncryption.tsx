@@ -25,7 +25,11 @@ import api from "../../../../common/api";
 import ModalWrapper from "../../Common/ModalWrapper/ModalWrapper";
 import InputBoxWrapper from "../../Common/FormComponents/InputBoxWrapper/InputBoxWrapper";
 import SelectWrapper from "../../Common/FormComponents/SelectWrapper/SelectWrapper";
-import { BucketEncryptionInfo } from "../types";
+import {
+  BucketEncryptionInfo,
+  KMSKeyInfo,
+  KMSListKeysResponse,
+} from "../types";
 
 const styles = (theme: Theme) =>
   createStyles({
@@ -65,6 +69,23 @@ const EnableBucketEncryption = ({
   const [loading, setLoading] = useState<boolean>(false);
   const [kmsKeyID, setKmsKeyID] = useState<string>("");
   const [encryptionType, setEncryptionType] = useState<string>("disabled");
+  const [kmsKeys, setKmsKeys] = useState<KMSKeyInfo[]>([]);
+
+  useEffect(() => {
+    if (!open) {
+      return;
+    }
+    // the keys can only be listed with KMS admin permissions, the key id is
+    // typed in when they can't be listed
+    api
+      .invoke("GET", `/api/v1/admin/kms/keys`)
+      .then((res: KMSListKeysResponse) => {
+        setKmsKeys(res.results || []);
+      })
+      .catch(() => {
+        setKmsKeys([]);
+      });
+  }, [open]);
 
   useEffect(() => {
     if (encryptionCfg) {
@@ -157,15 +178,31 @@ const EnableBucketEncryption = ({
             </Grid>
             {encryptionType === "sse-kms" && (
               <Grid item xs={12}>
-                <InputBoxWrapper
-                  id="kms-key-id"
-                  name="kms-key-id"
-                  label="KMS Key ID"
-                  value={kmsKeyID}
-                  onChange={(e: React.ChangeEvent<HTMLInputElement>) => {
-                    setKmsKeyID(e.target.value);
-                  }}
-                />
+                {kmsKeys.length > 0 ? (
+                  <SelectWrapper
+                    onChange={(e: React.ChangeEvent<{ value: unknown }>) => {
+                      setKmsKeyID(e.target.value as string);
+                    }}
+                    id="kms-key-id"
+                    name="kms-key-id"
+                    label="KMS Key ID"
+                    value={kmsKeyID}
+                    options={kmsKeys.map((key) => ({
+                      label: key.name,
+                      value: key.name,
+                    }))}
+                  />
+                ) : (
+                  <InputBoxWrapper
+                    id="kms-key-id"
+                    name="kms-key-id"
+                    label="KMS Key ID"
+                    value={kmsKeyID}
+                    onChange={(e: React.ChangeEvent<HTMLInputElement>) => {
+                      setKmsKeyID(e.target.value);
+                    }}
+                  />
+                )}
               </Grid>
             )}
             <Grid item xs={12}>
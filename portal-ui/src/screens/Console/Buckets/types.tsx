@@ -24,6 +24,16 @@ export interface BucketEncryptionInfo {
   kmsMasterKeyID: string;
 }
 
+export interface KMSKeyInfo {
+  name: string;
+  createdAt: string;
+  createdBy: string;
+}
+
+export interface KMSListKeysResponse {
+  results: KMSKeyInfo[];
+}
+
 export interface BucketInfo {
   name: string;
   access: string;
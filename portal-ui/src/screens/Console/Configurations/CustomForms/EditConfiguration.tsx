@@ -71,6 +71,7 @@ const EditConfiguration = ({
   //Local States
   const [valuesObj, setValueObj] = useState<IElementValue[]>([]);
   const [saving, setSaving] = useState<boolean>(false);
+  const [resetting, setResetting] = useState<boolean>(false);
   const [loadingConfig, setLoadingConfig] = useState<boolean>(true);
   const [configValues, setConfigValues] = useState<IElementValue[]>([]);
   //Effects
@@ -103,9 +104,9 @@ const EditConfiguration = ({
           `/api/v1/configs/${selectedConfiguration.configuration_id}`,
           payload
         )
-        .then(() => {
+        .then((res) => {
           setSaving(false);
-          serverNeedsRestart(true);
+          serverNeedsRestart(get(res, "restart", true));
 
           closeModalAndRefresh();
         })
@@ -123,6 +124,33 @@ const EditConfiguration = ({
     setErrorSnackMessage,
   ]);
 
+  useEffect(() => {
+    if (resetting) {
+      api
+        .invoke(
+          "POST",
+          `/api/v1/configs/${selectedConfiguration.configuration_id}/reset`,
+          { keys: [] }
+        )
+        .then((res) => {
+          setResetting(false);
+          serverNeedsRestart(get(res, "restart", true));
+
+          closeModalAndRefresh();
+        })
+        .catch((err) => {
+          setResetting(false);
+          setErrorSnackMessage(err);
+        });
+    }
+  }, [
+    resetting,
+    serverNeedsRestart,
+    selectedConfiguration,
+    closeModalAndRefresh,
+    setErrorSnackMessage,
+  ]);
+
   //Fetch Actions
   const submitForm = (event: React.FormEvent) => {
     event.preventDefault();
@@ -159,11 +187,21 @@ const EditConfiguration = ({
           </Grid>
           <Grid item xs={12} className={classes.settingsButtonContainer}>
             <Grid item xs={12} className={classes.innerSettingsButtonContainer}>
+              <Button
+                type="button"
+                variant="outlined"
+                color="primary"
+                disabled={saving || resetting}
+                onClick={() => setResetting(true)}
+              >
+                Restore Defaults
+              </Button>
+              &nbsp;
               <Button
                 type="submit"
                 variant="contained"
                 color="primary"
-                disabled={saving}
+                disabled={saving || resetting}
               >
                 Save
               </Button>
@@ -0,0 +1,996 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/smtp"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/auth"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/admin_api"
+	"github.com/minio/pkg/env"
+	iampolicy "github.com/minio/pkg/iam/policy"
+	"github.com/rs/xid"
+)
+
+// alertsFile is the file of the Console data directory holding the alert rules and notification targets
+const alertsFile = "alerts.json"
+
+// alertsResolvedRetention is how long resolved alerts are still listed
+const alertsResolvedRetention = time.Hour
+
+// alertsRequestTimeout bounds the Prometheus queries and the notifications
+const alertsRequestTimeout = 30 * time.Second
+
+func registerAlertsHandlers(api *operations.ConsoleAPI) {
+	// list alerts
+	api.AdminAPIListAlertsHandler = admin_api.ListAlertsHandlerFunc(func(params admin_api.ListAlertsParams, session *models.Principal) middleware.Responder {
+		alertsResp, err := getListAlertsResponse(session)
+		if err != nil {
+			return admin_api.NewListAlertsDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewListAlertsOK().WithPayload(alertsResp)
+	})
+	// list alert rules
+	api.AdminAPIListAlertRulesHandler = admin_api.ListAlertRulesHandlerFunc(func(params admin_api.ListAlertRulesParams, session *models.Principal) middleware.Responder {
+		rulesResp, err := getListAlertRulesResponse(session)
+		if err != nil {
+			return admin_api.NewListAlertRulesDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewListAlertRulesOK().WithPayload(rulesResp)
+	})
+	// create alert rule
+	api.AdminAPICreateAlertRuleHandler = admin_api.CreateAlertRuleHandlerFunc(func(params admin_api.CreateAlertRuleParams, session *models.Principal) middleware.Responder {
+		ruleResp, err := getCreateAlertRuleResponse(session, params.Body)
+		if err != nil {
+			return admin_api.NewCreateAlertRuleDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewCreateAlertRuleCreated().WithPayload(ruleResp)
+	})
+	// get alert rule
+	api.AdminAPIGetAlertRuleHandler = admin_api.GetAlertRuleHandlerFunc(func(params admin_api.GetAlertRuleParams, session *models.Principal) middleware.Responder {
+		ruleResp, err := getAlertRuleResponse(session, params.ID)
+		if err != nil {
+			return admin_api.NewGetAlertRuleDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewGetAlertRuleOK().WithPayload(ruleResp)
+	})
+	// update alert rule
+	api.AdminAPIUpdateAlertRuleHandler = admin_api.UpdateAlertRuleHandlerFunc(func(params admin_api.UpdateAlertRuleParams, session *models.Principal) middleware.Responder {
+		ruleResp, err := getUpdateAlertRuleResponse(session, params.ID, params.Body)
+		if err != nil {
+			return admin_api.NewUpdateAlertRuleDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewUpdateAlertRuleOK().WithPayload(ruleResp)
+	})
+	// delete alert rule
+	api.AdminAPIDeleteAlertRuleHandler = admin_api.DeleteAlertRuleHandlerFunc(func(params admin_api.DeleteAlertRuleParams, session *models.Principal) middleware.Responder {
+		if err := getDeleteAlertRuleResponse(session, params.ID); err != nil {
+			return admin_api.NewDeleteAlertRuleDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewDeleteAlertRuleNoContent()
+	})
+	// list alert targets
+	api.AdminAPIListAlertTargetsHandler = admin_api.ListAlertTargetsHandlerFunc(func(params admin_api.ListAlertTargetsParams, session *models.Principal) middleware.Responder {
+		targetsResp, err := getListAlertTargetsResponse(session)
+		if err != nil {
+			return admin_api.NewListAlertTargetsDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewListAlertTargetsOK().WithPayload(targetsResp)
+	})
+	// create alert target
+	api.AdminAPICreateAlertTargetHandler = admin_api.CreateAlertTargetHandlerFunc(func(params admin_api.CreateAlertTargetParams, session *models.Principal) middleware.Responder {
+		targetResp, err := getPutAlertTargetResponse(session, "", params.Body)
+		if err != nil {
+			return admin_api.NewCreateAlertTargetDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewCreateAlertTargetCreated().WithPayload(targetResp)
+	})
+	// update alert target
+	api.AdminAPIUpdateAlertTargetHandler = admin_api.UpdateAlertTargetHandlerFunc(func(params admin_api.UpdateAlertTargetParams, session *models.Principal) middleware.Responder {
+		targetResp, err := getPutAlertTargetResponse(session, params.Name, params.Body)
+		if err != nil {
+			return admin_api.NewUpdateAlertTargetDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewUpdateAlertTargetOK().WithPayload(targetResp)
+	})
+	// delete alert target
+	api.AdminAPIDeleteAlertTargetHandler = admin_api.DeleteAlertTargetHandlerFunc(func(params admin_api.DeleteAlertTargetParams, session *models.Principal) middleware.Responder {
+		if err := getDeleteAlertTargetResponse(session, params.Name); err != nil {
+			return admin_api.NewDeleteAlertTargetDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewDeleteAlertTargetNoContent()
+	})
+}
+
+// AlertRule fires when the values returned by Expr compare with Threshold
+// for at least For seconds
+type AlertRule struct {
+	ID          string
+	Name        string
+	Description string
+	Expr        string
+	Operator    string
+	Threshold   float64
+	For         int64
+	Severity    string
+	Disabled    bool
+}
+
+// AlertTarget is a webhook or an email account notified when alerts fire or resolve,
+// the secrets are encrypted in the alerts file
+type AlertTarget struct {
+	Name                  string
+	Type                  string
+	URL                   string
+	AuthToken             string `json:"-"`
+	EncryptedAuthToken    string
+	SMTPHost              string
+	SMTPPort              int32
+	SMTPUsername          string
+	SMTPPassword          string `json:"-"`
+	EncryptedSMTPPassword string
+	SMTPFrom              string
+	SMTPTo                []string
+}
+
+// encryptAlertSecret returns the encrypted secret, empty when there is none
+func encryptAlertSecret(secret string) (string, error) {
+	if secret == "" {
+		return "", nil
+	}
+	return auth.EncryptData([]byte(secret))
+}
+
+// decryptAlertSecret returns the secret encrypted by encryptAlertSecret
+func decryptAlertSecret(encrypted string) (string, error) {
+	if encrypted == "" {
+		return "", nil
+	}
+	secret, err := auth.DecryptData(encrypted)
+	if err != nil {
+		return "", err
+	}
+	return string(secret), nil
+}
+
+type alertsConfig struct {
+	Rules   []AlertRule
+	Targets []AlertTarget
+}
+
+// defaultAlertRules are the rules in place until the administrators change them
+var defaultAlertRules = []AlertRule{
+	{
+		ID:          "capacity-usage",
+		Name:        "Capacity usage",
+		Description: "Usable capacity used is above 80%",
+		Expr:        `100 * (1 - max(minio_cluster_capacity_usable_free_bytes{job="${jobid}"}) / max(minio_cluster_capacity_usable_total_bytes{job="${jobid}"}))`,
+		Operator:    models.AlertRuleOperatorGt,
+		Threshold:   80,
+		For:         600,
+		Severity:    models.AlertRuleSeverityWarning,
+	},
+	{
+		ID:          "offline-drives",
+		Name:        "Offline drives",
+		Description: "One or more drives are offline",
+		Expr:        `max(minio_cluster_disk_offline_total{job="${jobid}"})`,
+		Operator:    models.AlertRuleOperatorGt,
+		Threshold:   0,
+		For:         300,
+		Severity:    models.AlertRuleSeverityCritical,
+	},
+	{
+		ID:          "offline-nodes",
+		Name:        "Offline nodes",
+		Description: "One or more nodes are offline",
+		Expr:        `max(minio_cluster_nodes_offline_total{job="${jobid}"})`,
+		Operator:    models.AlertRuleOperatorGt,
+		Threshold:   0,
+		For:         300,
+		Severity:    models.AlertRuleSeverityCritical,
+	},
+	{
+		ID:          "heal-backlog",
+		Name:        "Heal backlog",
+		Description: "Healing could not repair objects in the last hour",
+		Expr:        `sum(increase(minio_heal_objects_error_total{job="${jobid}"}[1h]))`,
+		Operator:    models.AlertRuleOperatorGt,
+		Threshold:   0,
+		For:         0,
+		Severity:    models.AlertRuleSeverityWarning,
+	},
+}
+
+// alertSample is one of the series returned by a rule expression
+type alertSample struct {
+	Labels map[string]string
+	Value  float64
+}
+
+type alertState struct {
+	rule       AlertRule
+	labels     map[string]string
+	value      float64
+	state      string
+	activeAt   time.Time
+	firedAt    time.Time
+	resolvedAt time.Time
+}
+
+func (a *alertState) toModel() *models.Alert {
+	alert := &models.Alert{
+		RuleID:   a.rule.ID,
+		RuleName: a.rule.Name,
+		Severity: a.rule.Severity,
+		State:    a.state,
+		Labels:   a.labels,
+		Value:    a.value,
+		ActiveAt: a.activeAt.Format(time.RFC3339),
+	}
+	if !a.firedAt.IsZero() {
+		alert.FiredAt = a.firedAt.Format(time.RFC3339)
+	}
+	if !a.resolvedAt.IsZero() {
+		alert.ResolvedAt = a.resolvedAt.Format(time.RFC3339)
+	}
+	return alert
+}
+
+// alertManager keeps the alert rules and targets in a file of the Console data
+// directory and the state of the alerts in memory
+type alertManager struct {
+	sync.Mutex
+	file   string
+	loaded bool
+	config alertsConfig
+	alerts map[string]*alertState
+	query  func(ctx context.Context, expr string) ([]alertSample, error)
+	notify func(ctx context.Context, target AlertTarget, alert *models.Alert) error
+}
+
+var globalAlertManager = newAlertManager(alertsFile, queryMetrics, sendAlertNotification)
+
+func newAlertManager(file string, query func(ctx context.Context, expr string) ([]alertSample, error), notify func(ctx context.Context, target AlertTarget, alert *models.Alert) error) *alertManager {
+	return &alertManager{
+		file:   file,
+		alerts: map[string]*alertState{},
+		query:  query,
+		notify: notify,
+	}
+}
+
+// load reads the alerts file the first time it's needed, callers must hold the lock
+func (m *alertManager) load() error {
+	if m.loaded {
+		return nil
+	}
+	config := alertsConfig{Rules: append([]AlertRule{}, defaultAlertRules...)}
+	if err := readDataFile(m.file, &config); err != nil {
+		return err
+	}
+	for i := range config.Targets {
+		var err error
+		target := &config.Targets[i]
+		if target.AuthToken, err = decryptAlertSecret(target.EncryptedAuthToken); err != nil {
+			return err
+		}
+		if target.SMTPPassword, err = decryptAlertSecret(target.EncryptedSMTPPassword); err != nil {
+			return err
+		}
+	}
+	m.config = config
+	m.loaded = true
+	return nil
+}
+
+// save writes the given configuration with the secrets of the targets
+// encrypted and makes it the current one, callers must hold the lock
+func (m *alertManager) save(config alertsConfig) error {
+	targets := make([]AlertTarget, len(config.Targets))
+	for i, target := range config.Targets {
+		var err error
+		if target.EncryptedAuthToken, err = encryptAlertSecret(target.AuthToken); err != nil {
+			return err
+		}
+		if target.EncryptedSMTPPassword, err = encryptAlertSecret(target.SMTPPassword); err != nil {
+			return err
+		}
+		targets[i] = target
+	}
+	config.Targets = targets
+	if err := writeDataFile(m.file, config); err != nil {
+		return err
+	}
+	m.config = config
+	return nil
+}
+
+func (m *alertManager) listRules() ([]AlertRule, error) {
+	m.Lock()
+	defer m.Unlock()
+	if err := m.load(); err != nil {
+		return nil, err
+	}
+	return append([]AlertRule{}, m.config.Rules...), nil
+}
+
+// putRule creates the rule when it has no ID or replaces the rule with the same ID
+func (m *alertManager) putRule(rule AlertRule) (AlertRule, error) {
+	m.Lock()
+	defer m.Unlock()
+	if err := m.load(); err != nil {
+		return AlertRule{}, err
+	}
+	if rule.Operator == "" {
+		rule.Operator = models.AlertRuleOperatorGt
+	}
+	if rule.Severity == "" {
+		rule.Severity = models.AlertRuleSeverityWarning
+	}
+	config := m.config
+	config.Rules = append([]AlertRule{}, m.config.Rules...)
+	if rule.ID == "" {
+		rule.ID = xid.New().String()
+		config.Rules = append(config.Rules, rule)
+		return rule, m.save(config)
+	}
+	for i := range config.Rules {
+		if config.Rules[i].ID == rule.ID {
+			config.Rules[i] = rule
+			return rule, m.save(config)
+		}
+	}
+	return AlertRule{}, ErrorGenericNotFound
+}
+
+func (m *alertManager) deleteRule(id string) error {
+	m.Lock()
+	defer m.Unlock()
+	if err := m.load(); err != nil {
+		return err
+	}
+	config := m.config
+	config.Rules = nil
+	for _, rule := range m.config.Rules {
+		if rule.ID != id {
+			config.Rules = append(config.Rules, rule)
+		}
+	}
+	if len(config.Rules) == len(m.config.Rules) {
+		return ErrorGenericNotFound
+	}
+	if config.Rules == nil {
+		config.Rules = []AlertRule{}
+	}
+	return m.save(config)
+}
+
+func (m *alertManager) listTargets() ([]AlertTarget, error) {
+	m.Lock()
+	defer m.Unlock()
+	if err := m.load(); err != nil {
+		return nil, err
+	}
+	return append([]AlertTarget{}, m.config.Targets...), nil
+}
+
+// putTarget creates a target, or replaces the target previously named name. Empty
+// secrets keep the ones already stored since they are never returned by the API.
+func (m *alertManager) putTarget(name string, target AlertTarget) (AlertTarget, error) {
+	m.Lock()
+	defer m.Unlock()
+	if err := m.load(); err != nil {
+		return AlertTarget{}, err
+	}
+	config := m.config
+	config.Targets = nil
+	found := false
+	for _, current := range m.config.Targets {
+		if current.Name == target.Name && current.Name != name {
+			return AlertTarget{}, errAlertTargetAlreadyExists
+		}
+		if name != "" && current.Name == name {
+			found = true
+			if target.AuthToken == "" {
+				target.AuthToken = current.AuthToken
+			}
+			if target.SMTPPassword == "" {
+				target.SMTPPassword = current.SMTPPassword
+			}
+			current = target
+		}
+		config.Targets = append(config.Targets, current)
+	}
+	if name != "" && !found {
+		return AlertTarget{}, ErrorGenericNotFound
+	}
+	if name == "" {
+		config.Targets = append(config.Targets, target)
+	}
+	return target, m.save(config)
+}
+
+func (m *alertManager) deleteTarget(name string) error {
+	m.Lock()
+	defer m.Unlock()
+	if err := m.load(); err != nil {
+		return err
+	}
+	config := m.config
+	config.Targets = nil
+	for _, target := range m.config.Targets {
+		if target.Name != name {
+			config.Targets = append(config.Targets, target)
+		}
+	}
+	if len(config.Targets) == len(m.config.Targets) {
+		return ErrorGenericNotFound
+	}
+	return m.save(config)
+}
+
+// listAlerts returns the firing alerts first, then the pending and the resolved ones
+func (m *alertManager) listAlerts() []*models.Alert {
+	m.Lock()
+	defer m.Unlock()
+	order := map[string]int{
+		models.AlertStateFiring:   0,
+		models.AlertStatePending:  1,
+		models.AlertStateResolved: 2,
+	}
+	var states []*alertState
+	for _, a := range m.alerts {
+		states = append(states, a)
+	}
+	sort.Slice(states, func(i, j int) bool {
+		if order[states[i].state] != order[states[j].state] {
+			return order[states[i].state] < order[states[j].state]
+		}
+		return states[i].activeAt.Before(states[j].activeAt)
+	})
+	var alerts []*models.Alert
+	for _, a := range states {
+		alerts = append(alerts, a.toModel())
+	}
+	return alerts
+}
+
+// alertKey identifies an alert by its rule and the labels of the series
+func alertKey(ruleID string, labels map[string]string) string {
+	var keys []string
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var sb strings.Builder
+	sb.WriteString(ruleID)
+	for _, k := range keys {
+		fmt.Fprintf(&sb, ",%s=%q", k, labels[k])
+	}
+	return sb.String()
+}
+
+// compareAlertValue applies the operator of a rule
+func compareAlertValue(operator string, value, threshold float64) bool {
+	switch operator {
+	case models.AlertRuleOperatorGe:
+		return value >= threshold
+	case models.AlertRuleOperatorLt:
+		return value < threshold
+	case models.AlertRuleOperatorLe:
+		return value <= threshold
+	case models.AlertRuleOperatorEq:
+		return value == threshold
+	case models.AlertRuleOperatorNe:
+		return value != threshold
+	default:
+		return value > threshold
+	}
+}
+
+// updateRuleAlerts moves the alerts of a rule through pending, firing and resolved
+// and returns the ones that changed to firing or resolved, callers must hold the lock
+func (m *alertManager) updateRuleAlerts(rule AlertRule, samples []alertSample, now time.Time) []*models.Alert {
+	var changed []*models.Alert
+	active := map[string]bool{}
+	for _, sample := range samples {
+		if !compareAlertValue(rule.Operator, sample.Value, rule.Threshold) {
+			continue
+		}
+		key := alertKey(rule.ID, sample.Labels)
+		active[key] = true
+		a, ok := m.alerts[key]
+		if !ok || a.state == models.AlertStateResolved {
+			a = &alertState{
+				labels:   sample.Labels,
+				state:    models.AlertStatePending,
+				activeAt: now,
+			}
+			m.alerts[key] = a
+		}
+		a.rule = rule
+		a.value = sample.Value
+		if a.state == models.AlertStatePending && now.Sub(a.activeAt) >= time.Duration(rule.For)*time.Second {
+			a.state = models.AlertStateFiring
+			a.firedAt = now
+			changed = append(changed, a.toModel())
+		}
+	}
+	for key, a := range m.alerts {
+		if a.rule.ID != rule.ID || active[key] {
+			continue
+		}
+		switch a.state {
+		case models.AlertStatePending:
+			delete(m.alerts, key)
+		case models.AlertStateFiring:
+			a.state = models.AlertStateResolved
+			a.resolvedAt = now
+			changed = append(changed, a.toModel())
+		}
+	}
+	return changed
+}
+
+// evaluate runs every enabled rule once and notifies the targets of the alerts
+// that started firing or got resolved
+func (m *alertManager) evaluate(ctx context.Context, now time.Time) {
+	m.Lock()
+	if err := m.load(); err != nil {
+		m.Unlock()
+		LogError("unable to load alert rules: %v", err)
+		return
+	}
+	rules := append([]AlertRule{}, m.config.Rules...)
+	targets := append([]AlertTarget{}, m.config.Targets...)
+	m.Unlock()
+
+	enabled := map[string]bool{}
+	var changed []*models.Alert
+	for _, rule := range rules {
+		if rule.Disabled {
+			continue
+		}
+		enabled[rule.ID] = true
+		samples, err := m.query(ctx, rule.Expr)
+		if err != nil {
+			LogError("unable to evaluate alert rule %s: %v", rule.ID, err)
+			continue
+		}
+		m.Lock()
+		changed = append(changed, m.updateRuleAlerts(rule, samples, now)...)
+		m.Unlock()
+	}
+
+	m.Lock()
+	for key, a := range m.alerts {
+		// drop the alerts of removed or disabled rules and the ones resolved a while ago
+		if !enabled[a.rule.ID] || (a.state == models.AlertStateResolved && now.Sub(a.resolvedAt) > alertsResolvedRetention) {
+			delete(m.alerts, key)
+		}
+	}
+	m.Unlock()
+
+	for _, alert := range changed {
+		for _, target := range targets {
+			if err := m.notify(ctx, target, alert); err != nil {
+				LogError("unable to notify alert %s to %s: %v", alert.RuleID, target.Name, err)
+			}
+		}
+	}
+}
+
+// getAlertsEvaluationInterval returns how often the alert rules are evaluated, 1 minute by default
+func getAlertsEvaluationInterval() time.Duration {
+	interval, err := time.ParseDuration(env.Get(ConsoleAlertsEvaluationInterval, "1m"))
+	if err != nil || interval <= 0 {
+		return time.Minute
+	}
+	return interval
+}
+
+// startAlertsEvaluation evaluates the alert rules in the background against
+// Prometheus when configured or the built-in metrics collector otherwise
+func startAlertsEvaluation() {
+	go func() {
+		ticker := time.NewTicker(getAlertsEvaluationInterval())
+		defer ticker.Stop()
+		for now := range ticker.C {
+			globalAlertManager.evaluate(context.Background(), now)
+		}
+	}()
+}
+
+// promQueryResponse is the response of the Prometheus instant query API
+type promQueryResponse struct {
+	Status string `json:"status"`
+	Error  string `json:"error"`
+	Data   struct {
+		ResultType string          `json:"resultType"`
+		Result     json.RawMessage `json:"result"`
+	} `json:"data"`
+}
+
+// parsePromValue parses a [ <unix time>, "<value>" ] pair
+func parsePromValue(value []interface{}) (float64, error) {
+	if len(value) != 2 {
+		return 0, fmt.Errorf("unexpected value %v", value)
+	}
+	s, ok := value[1].(string)
+	if !ok {
+		return 0, fmt.Errorf("unexpected value %v", value)
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+// parsePromQueryResult returns the samples of a vector or scalar query result
+func parsePromQueryResult(response *promQueryResponse) ([]alertSample, error) {
+	switch response.Data.ResultType {
+	case "vector":
+		var result []struct {
+			Metric map[string]string `json:"metric"`
+			Value  []interface{}     `json:"value"`
+		}
+		if err := json.Unmarshal(response.Data.Result, &result); err != nil {
+			return nil, err
+		}
+		var samples []alertSample
+		for _, r := range result {
+			value, err := parsePromValue(r.Value)
+			if err != nil {
+				return nil, err
+			}
+			samples = append(samples, alertSample{Labels: r.Metric, Value: value})
+		}
+		return samples, nil
+	case "scalar":
+		var result []interface{}
+		if err := json.Unmarshal(response.Data.Result, &result); err != nil {
+			return nil, err
+		}
+		value, err := parsePromValue(result)
+		if err != nil {
+			return nil, err
+		}
+		return []alertSample{{Labels: map[string]string{}, Value: value}}, nil
+	}
+	return nil, fmt.Errorf("unsupported result type %s", response.Data.ResultType)
+}
+
+// queryPrometheusAlert runs an instant query against the configured Prometheus
+func queryPrometheusAlert(ctx context.Context, expr string) ([]alertSample, error) {
+	expr = strings.Replace(expr, "${jobid}", getPrometheusJobID(), -1)
+	endpoint := fmt.Sprintf("%s/api/v1/query?query=%s", getPrometheusURL(), url.QueryEscape(expr))
+	ctx, cancel := context.WithTimeout(ctx, alertsRequestTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	client := &http.Client{Transport: GetConsoleSTSClient().Transport}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var response promQueryResponse
+	if err = json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("unexpected response from prometheus (%s): %v", resp.Status, err)
+	}
+	if response.Status != "success" {
+		return nil, fmt.Errorf("prometheus query failed: %s", response.Error)
+	}
+	return parsePromQueryResult(&response)
+}
+
+// sendAlertNotification posts the alert to a webhook or emails it
+func sendAlertNotification(ctx context.Context, target AlertTarget, alert *models.Alert) error {
+	switch target.Type {
+	case models.AlertTargetTypeWebhook:
+		body, err := json.Marshal(alert)
+		if err != nil {
+			return err
+		}
+		ctx, cancel := context.WithTimeout(ctx, alertsRequestTimeout)
+		defer cancel()
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, target.URL, bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/json")
+		if target.AuthToken != "" {
+			req.Header.Set("Authorization", "Bearer "+target.AuthToken)
+		}
+		client := &http.Client{Transport: GetConsoleSTSClient().Transport}
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		if resp.StatusCode < 200 || resp.StatusCode > 299 {
+			return fmt.Errorf("webhook returned %s", resp.Status)
+		}
+		return nil
+	case models.AlertTargetTypeSMTP:
+		return smtp.SendMail(net.JoinHostPort(target.SMTPHost, strconv.Itoa(int(target.SMTPPort))),
+			alertSMTPAuth(target), target.SMTPFrom, target.SMTPTo, alertEmail(target, alert))
+	}
+	return fmt.Errorf("unknown alert target type %s", target.Type)
+}
+
+func alertSMTPAuth(target AlertTarget) smtp.Auth {
+	if target.SMTPUsername == "" {
+		return nil
+	}
+	return smtp.PlainAuth("", target.SMTPUsername, target.SMTPPassword, target.SMTPHost)
+}
+
+// alertEmail builds the message sent to SMTP targets
+func alertEmail(target AlertTarget, alert *models.Alert) []byte {
+	var labels []string
+	for k, v := range alert.Labels {
+		labels = append(labels, fmt.Sprintf("%s=%s", k, v))
+	}
+	sort.Strings(labels)
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "From: %s\r\n", target.SMTPFrom)
+	fmt.Fprintf(&sb, "To: %s\r\n", strings.Join(target.SMTPTo, ", "))
+	fmt.Fprintf(&sb, "Subject: [%s] %s\r\n", strings.ToUpper(alert.State), alert.RuleName)
+	fmt.Fprintf(&sb, "Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	fmt.Fprintf(&sb, "Rule: %s (%s)\r\n", alert.RuleName, alert.RuleID)
+	fmt.Fprintf(&sb, "Severity: %s\r\n", alert.Severity)
+	fmt.Fprintf(&sb, "State: %s\r\n", alert.State)
+	fmt.Fprintf(&sb, "Value: %v\r\n", alert.Value)
+	if len(labels) > 0 {
+		fmt.Fprintf(&sb, "Labels: %s\r\n", strings.Join(labels, ", "))
+	}
+	fmt.Fprintf(&sb, "Active since: %s\r\n", alert.ActiveAt)
+	if alert.ResolvedAt != "" {
+		fmt.Fprintf(&sb, "Resolved at: %s\r\n", alert.ResolvedAt)
+	}
+	return []byte(sb.String())
+}
+
+func alertRuleToModel(rule AlertRule) *models.AlertRule {
+	return &models.AlertRule{
+		ID:          rule.ID,
+		Name:        swag.String(rule.Name),
+		Description: rule.Description,
+		Expr:        swag.String(rule.Expr),
+		Operator:    rule.Operator,
+		Threshold:   rule.Threshold,
+		For:         rule.For,
+		Severity:    rule.Severity,
+		Disabled:    rule.Disabled,
+	}
+}
+
+func alertRuleFromModel(rule *models.AlertRule) AlertRule {
+	return AlertRule{
+		ID:          rule.ID,
+		Name:        swag.StringValue(rule.Name),
+		Description: rule.Description,
+		Expr:        swag.StringValue(rule.Expr),
+		Operator:    rule.Operator,
+		Threshold:   rule.Threshold,
+		For:         rule.For,
+		Severity:    rule.Severity,
+		Disabled:    rule.Disabled,
+	}
+}
+
+// alertTargetToModel serializes a target without its secrets
+func alertTargetToModel(target AlertTarget) *models.AlertTarget {
+	return &models.AlertTarget{
+		Name:         swag.String(target.Name),
+		Type:         swag.String(target.Type),
+		URL:          target.URL,
+		SMTPHost:     target.SMTPHost,
+		SMTPPort:     target.SMTPPort,
+		SMTPUsername: target.SMTPUsername,
+		SMTPFrom:     target.SMTPFrom,
+		SMTPTo:       target.SMTPTo,
+	}
+}
+
+// alertTargetFromModel validates the fields required by the target type
+func alertTargetFromModel(target *models.AlertTarget) (AlertTarget, error) {
+	t := AlertTarget{
+		Name:         swag.StringValue(target.Name),
+		Type:         swag.StringValue(target.Type),
+		URL:          target.URL,
+		AuthToken:    target.AuthToken,
+		SMTPHost:     target.SMTPHost,
+		SMTPPort:     target.SMTPPort,
+		SMTPUsername: target.SMTPUsername,
+		SMTPPassword: target.SMTPPassword,
+		SMTPFrom:     target.SMTPFrom,
+		SMTPTo:       target.SMTPTo,
+	}
+	switch t.Type {
+	case models.AlertTargetTypeWebhook:
+		if _, err := url.ParseRequestURI(t.URL); err != nil {
+			return t, errInvalidAlertTarget
+		}
+	case models.AlertTargetTypeSMTP:
+		if t.SMTPHost == "" || t.SMTPFrom == "" || len(t.SMTPTo) == 0 {
+			return t, errInvalidAlertTarget
+		}
+		if t.SMTPPort == 0 {
+			t.SMTPPort = 25
+		}
+	default:
+		return t, errInvalidAlertTarget
+	}
+	if t.Name == "" {
+		return t, errInvalidAlertTarget
+	}
+	return t, nil
+}
+
+// alertsAllowed returns whether the session can manage the alerts, Console
+// queries Prometheus and notifies the targets on their behalf so the config
+// update permission is needed
+func alertsAllowed(session *models.Principal) bool {
+	return sessionAllowsAction(session, iampolicy.ConfigUpdateAdminAction)
+}
+
+func getListAlertsResponse(session *models.Principal) (*models.AlertList, *models.Error) {
+	if !alertsAllowed(session) {
+		return nil, prepareError(errAccessDenied)
+	}
+	return &models.AlertList{Alerts: globalAlertManager.listAlerts()}, nil
+}
+
+func getListAlertRulesResponse(session *models.Principal) (*models.AlertRuleList, *models.Error) {
+	if !alertsAllowed(session) {
+		return nil, prepareError(errAccessDenied)
+	}
+	rules, err := globalAlertManager.listRules()
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	rulesList := &models.AlertRuleList{}
+	for _, rule := range rules {
+		rulesList.Rules = append(rulesList.Rules, alertRuleToModel(rule))
+	}
+	return rulesList, nil
+}
+
+func getAlertRuleResponse(session *models.Principal, id string) (*models.AlertRule, *models.Error) {
+	if !alertsAllowed(session) {
+		return nil, prepareError(errAccessDenied)
+	}
+	rules, err := globalAlertManager.listRules()
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	for _, rule := range rules {
+		if rule.ID == id {
+			return alertRuleToModel(rule), nil
+		}
+	}
+	return nil, prepareError(ErrorGenericNotFound)
+}
+
+func getCreateAlertRuleResponse(session *models.Principal, params *models.AlertRule) (*models.AlertRule, *models.Error) {
+	if !alertsAllowed(session) {
+		return nil, prepareError(errAccessDenied)
+	}
+	if params == nil {
+		return nil, prepareError(errAlertBodyNotInRequest)
+	}
+	rule := alertRuleFromModel(params)
+	rule.ID = ""
+	rule, err := globalAlertManager.putRule(rule)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return alertRuleToModel(rule), nil
+}
+
+func getUpdateAlertRuleResponse(session *models.Principal, id string, params *models.AlertRule) (*models.AlertRule, *models.Error) {
+	if !alertsAllowed(session) {
+		return nil, prepareError(errAccessDenied)
+	}
+	if params == nil {
+		return nil, prepareError(errAlertBodyNotInRequest)
+	}
+	rule := alertRuleFromModel(params)
+	rule.ID = id
+	rule, err := globalAlertManager.putRule(rule)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return alertRuleToModel(rule), nil
+}
+
+func getDeleteAlertRuleResponse(session *models.Principal, id string) *models.Error {
+	if !alertsAllowed(session) {
+		return prepareError(errAccessDenied)
+	}
+	if err := globalAlertManager.deleteRule(id); err != nil {
+		return prepareError(err)
+	}
+	return nil
+}
+
+func getListAlertTargetsResponse(session *models.Principal) (*models.AlertTargetList, *models.Error) {
+	if !alertsAllowed(session) {
+		return nil, prepareError(errAccessDenied)
+	}
+	targets, err := globalAlertManager.listTargets()
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	targetsList := &models.AlertTargetList{}
+	for _, target := range targets {
+		targetsList.Targets = append(targetsList.Targets, alertTargetToModel(target))
+	}
+	return targetsList, nil
+}
+
+// getPutAlertTargetResponse creates a target when name is empty or updates the target named name
+func getPutAlertTargetResponse(session *models.Principal, name string, params *models.AlertTarget) (*models.AlertTarget, *models.Error) {
+	if !alertsAllowed(session) {
+		return nil, prepareError(errAccessDenied)
+	}
+	if params == nil {
+		return nil, prepareError(errAlertBodyNotInRequest)
+	}
+	target, err := alertTargetFromModel(params)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	target, err = globalAlertManager.putTarget(name, target)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return alertTargetToModel(target), nil
+}
+
+func getDeleteAlertTargetResponse(session *models.Principal, name string) *models.Error {
+	if !alertsAllowed(session) {
+		return prepareError(errAccessDenied)
+	}
+	if err := globalAlertManager.deleteTarget(name); err != nil {
+		return prepareError(err)
+	}
+	return nil
+}
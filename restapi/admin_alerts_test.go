@@ -0,0 +1,226 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/minio/console/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlertManagerEvaluate(t *testing.T) {
+	assert := assert.New(t)
+	defer useTempDataDir(t)()
+	var samples []alertSample
+	var notified []*models.Alert
+	manager := newAlertManager(alertsFile, func(ctx context.Context, expr string) ([]alertSample, error) {
+		if expr != "drives_offline" {
+			return nil, nil
+		}
+		return samples, nil
+	}, func(ctx context.Context, target AlertTarget, alert *models.Alert) error {
+		notified = append(notified, alert)
+		return nil
+	})
+	manager.loaded = true
+	manager.config = alertsConfig{
+		Rules: []AlertRule{
+			{ID: "drives", Name: "Offline drives", Expr: "drives_offline", Operator: models.AlertRuleOperatorGt, Threshold: 0, For: 60},
+		},
+		Targets: []AlertTarget{{Name: "hook", Type: models.AlertTargetTypeWebhook}},
+	}
+	now := time.Now()
+	// Test-1 : values crossing the threshold are pending until the rule duration passes
+	samples = []alertSample{
+		{Labels: map[string]string{"server": "node1"}, Value: 2},
+		{Labels: map[string]string{"server": "node2"}, Value: 0},
+	}
+	manager.evaluate(context.Background(), now)
+	alerts := manager.listAlerts()
+	if assert.Equal(1, len(alerts)) {
+		assert.Equal(models.AlertStatePending, alerts[0].State)
+		assert.Equal("node1", alerts[0].Labels["server"])
+	}
+	assert.Empty(notified)
+	// Test-2 : the alert fires and the targets are notified
+	manager.evaluate(context.Background(), now.Add(time.Minute))
+	alerts = manager.listAlerts()
+	if assert.Equal(1, len(alerts)) {
+		assert.Equal(models.AlertStateFiring, alerts[0].State)
+	}
+	if assert.Equal(1, len(notified)) {
+		assert.Equal(models.AlertStateFiring, notified[0].State)
+	}
+	// Test-3 : the alert resolves once the value goes back under the threshold
+	samples = []alertSample{{Labels: map[string]string{"server": "node1"}, Value: 0}}
+	manager.evaluate(context.Background(), now.Add(2*time.Minute))
+	alerts = manager.listAlerts()
+	if assert.Equal(1, len(alerts)) {
+		assert.Equal(models.AlertStateResolved, alerts[0].State)
+	}
+	if assert.Equal(2, len(notified)) {
+		assert.Equal(models.AlertStateResolved, notified[1].State)
+	}
+	// Test-4 : resolved alerts are dropped after a while
+	manager.evaluate(context.Background(), now.Add(2*time.Minute+alertsResolvedRetention+time.Second))
+	assert.Empty(manager.listAlerts())
+	// Test-5 : pending alerts are dropped when the condition stops before firing
+	samples = []alertSample{{Labels: map[string]string{"server": "node1"}, Value: 1}}
+	manager.evaluate(context.Background(), now.Add(3*time.Hour))
+	assert.Equal(1, len(manager.listAlerts()))
+	samples = nil
+	manager.evaluate(context.Background(), now.Add(3*time.Hour+time.Second))
+	assert.Empty(manager.listAlerts())
+	assert.Equal(2, len(notified))
+}
+
+func TestAlertManagerStore(t *testing.T) {
+	assert := assert.New(t)
+	defer useTempDataDir(t)()
+	manager := newAlertManager(alertsFile, nil, nil)
+	// Test-1 : the default rules are used until the rules are changed
+	rules, err := manager.listRules()
+	assert.NoError(err)
+	assert.Equal(defaultAlertRules, rules)
+	// Test-2 : new rules get an id and defaults
+	rule, err := manager.putRule(AlertRule{Name: "Errors", Expr: "sum(rate(minio_s3_requests_errors_total[5m]))", Threshold: 10})
+	assert.NoError(err)
+	assert.NotEmpty(rule.ID)
+	assert.Equal(models.AlertRuleOperatorGt, rule.Operator)
+	assert.Equal(models.AlertRuleSeverityWarning, rule.Severity)
+	_, err = manager.putRule(AlertRule{ID: "unknown", Name: "Unknown"})
+	assert.Equal(ErrorGenericNotFound, err)
+	// Test-3 : removing every rule is persisted
+	rules, _ = manager.listRules()
+	for _, r := range rules {
+		assert.NoError(manager.deleteRule(r.ID))
+	}
+	assert.Equal(ErrorGenericNotFound, manager.deleteRule(rule.ID))
+	rules, err = newAlertManager(alertsFile, nil, nil).listRules()
+	assert.NoError(err)
+	assert.Empty(rules)
+	// Test-4 : secrets are kept when a target is updated without them
+	_, err = manager.putTarget("", AlertTarget{Name: "hook", Type: models.AlertTargetTypeWebhook, URL: "http://localhost/hook", AuthToken: "secret"})
+	assert.NoError(err)
+	_, err = manager.putTarget("", AlertTarget{Name: "hook", Type: models.AlertTargetTypeWebhook, URL: "http://localhost/other"})
+	assert.Equal(errAlertTargetAlreadyExists, err)
+	_, err = manager.putTarget("hook", AlertTarget{Name: "hook", Type: models.AlertTargetTypeWebhook, URL: "http://localhost/other"})
+	assert.NoError(err)
+	targets, err := newAlertManager(alertsFile, nil, nil).listTargets()
+	if assert.NoError(err) && assert.Equal(1, len(targets)) {
+		assert.Equal("http://localhost/other", targets[0].URL)
+		assert.Equal("secret", targets[0].AuthToken)
+	}
+	// Test-5 : secrets are encrypted in the alerts file
+	data, err := ioutil.ReadFile(filepath.Join(getConsoleDataDir(), alertsFile))
+	if assert.NoError(err) {
+		assert.NotContains(string(data), "secret")
+		assert.Contains(string(data), "EncryptedAuthToken")
+	}
+	assert.NoError(manager.deleteTarget("hook"))
+	assert.Equal(ErrorGenericNotFound, manager.deleteTarget("hook"))
+}
+
+func TestAlertsAllowed(t *testing.T) {
+	assert := assert.New(t)
+	// Test-1 : the alerts need the config update permission
+	assert.True(alertsAllowed(&models.Principal{Actions: []string{"admin:ConfigUpdate"}}))
+	assert.False(alertsAllowed(&models.Principal{Actions: []string{"admin:ServerInfo"}}))
+	// Test-2 : the handlers refuse the other sessions
+	_, errResp := getPutAlertTargetResponse(&models.Principal{}, "", &models.AlertTarget{})
+	if assert.NotNil(errResp) {
+		assert.Equal(int32(403), errResp.Code)
+	}
+	_, errResp = getListAlertsResponse(&models.Principal{Actions: []string{"s3:*"}})
+	if assert.NotNil(errResp) {
+		assert.Equal(int32(403), errResp.Code)
+	}
+}
+
+func TestAlertTargetFromModel(t *testing.T) {
+	assert := assert.New(t)
+	name, webhook, smtpType := "target", models.AlertTargetTypeWebhook, models.AlertTargetTypeSMTP
+	_, err := alertTargetFromModel(&models.AlertTarget{Name: &name, Type: &webhook, URL: "not a url"})
+	assert.Equal(errInvalidAlertTarget, err)
+	_, err = alertTargetFromModel(&models.AlertTarget{Name: &name, Type: &smtpType, SMTPHost: "smtp.example.com"})
+	assert.Equal(errInvalidAlertTarget, err)
+	target, err := alertTargetFromModel(&models.AlertTarget{Name: &name, Type: &smtpType, SMTPHost: "smtp.example.com", SMTPFrom: "console@example.com", SMTPTo: []string{"ops@example.com"}})
+	if assert.NoError(err) {
+		assert.Equal(int32(25), target.SMTPPort)
+	}
+	// secrets are never returned
+	target.SMTPPassword = "secret"
+	assert.Empty(alertTargetToModel(target).SMTPPassword)
+}
+
+func TestQueryPrometheusAlert(t *testing.T) {
+	assert := assert.New(t)
+	var query string
+	result := `{"status":"success","data":{"resultType":"vector","result":[{"metric":{"server":"node1"},"value":[1626000000.1,"3"]}]}}`
+	prometheus := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query().Get("query")
+		w.Write([]byte(result))
+	}))
+	defer prometheus.Close()
+	os.Setenv(PrometheusURL, prometheus.URL)
+	os.Setenv(PrometheusJobID, "minio-job")
+	defer os.Unsetenv(PrometheusURL)
+	defer os.Unsetenv(PrometheusJobID)
+	// Test-1 : vector results
+	samples, err := queryPrometheusAlert(context.Background(), `minio_cluster_disk_offline_total{job="${jobid}"}`)
+	if assert.NoError(err) && assert.Equal(1, len(samples)) {
+		assert.Equal(`minio_cluster_disk_offline_total{job="minio-job"}`, query)
+		assert.Equal(float64(3), samples[0].Value)
+		assert.Equal("node1", samples[0].Labels["server"])
+	}
+	// Test-2 : scalar results
+	result = `{"status":"success","data":{"resultType":"scalar","result":[1626000000.1,"42.5"]}}`
+	samples, err = queryPrometheusAlert(context.Background(), "scalar(vector(42.5))")
+	if assert.NoError(err) && assert.Equal(1, len(samples)) {
+		assert.Equal(42.5, samples[0].Value)
+	}
+	// Test-3 : query errors
+	result = `{"status":"error","error":"parse error"}`
+	_, err = queryPrometheusAlert(context.Background(), "(")
+	assert.Error(err)
+}
+
+func TestSendAlertNotificationWebhook(t *testing.T) {
+	assert := assert.New(t)
+	var received models.Alert
+	var authorization string
+	hook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		json.NewDecoder(r.Body).Decode(&received)
+	}))
+	defer hook.Close()
+	alert := &models.Alert{RuleID: "offline-nodes", RuleName: "Offline nodes", State: models.AlertStateFiring, Value: 1}
+	err := sendAlertNotification(context.Background(), AlertTarget{Name: "hook", Type: models.AlertTargetTypeWebhook, URL: hook.URL, AuthToken: "token"}, alert)
+	if assert.NoError(err) {
+		assert.Equal("Bearer token", authorization)
+		assert.Equal(*alert, received)
+	}
+}
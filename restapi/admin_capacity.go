@@ -0,0 +1,377 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/admin_api"
+	iampolicy "github.com/minio/pkg/iam/policy"
+)
+
+const capacityHistoryFile = "capacity-history.json"
+
+// capacityHistoryLimit is the number of daily samples kept
+const capacityHistoryLimit = 400
+
+// capacitySampleInterval is how often the sample of the current day is
+// refreshed by the background sampler
+const capacitySampleInterval = time.Hour
+
+const defaultCapacityForecastDays = 30
+
+const defaultCapacityForecastTop = 10
+
+const capacitySampleDateFormat = "2006-01-02"
+
+// capacityForecastThresholds are the usage percentages forecasted
+var capacityForecastThresholds = []int32{80, 90, 100}
+
+func registerCapacityHandlers(api *operations.ConsoleAPI) {
+	// forecast the cluster capacity
+	api.AdminAPICapacityForecastHandler = admin_api.CapacityForecastHandlerFunc(func(params admin_api.CapacityForecastParams, session *models.Principal) middleware.Responder {
+		forecast, err := getCapacityForecastResponse(session, params)
+		if err != nil {
+			return admin_api.NewCapacityForecastDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewCapacityForecastOK().WithPayload(forecast)
+	})
+}
+
+// CapacitySample is the usage of the cluster and its buckets on a day
+type CapacitySample struct {
+	Date    string
+	Time    time.Time
+	Used    int64
+	Total   int64
+	Buckets map[string]int64
+}
+
+// capacityPlanner keeps one usage sample per day in a file of the Console
+// data directory
+type capacityPlanner struct {
+	sync.Mutex
+	file    string
+	loaded  bool
+	samples []CapacitySample
+}
+
+var globalCapacityPlanner = newCapacityPlanner(capacityHistoryFile)
+
+func newCapacityPlanner(file string) *capacityPlanner {
+	return &capacityPlanner{file: file}
+}
+
+// load reads the capacity history file the first time it's needed, callers must hold the lock
+func (p *capacityPlanner) load() error {
+	if p.loaded {
+		return nil
+	}
+	var samples []CapacitySample
+	if err := readDataFile(p.file, &samples); err != nil {
+		return err
+	}
+	p.samples = samples
+	p.loaded = true
+	return nil
+}
+
+// due returns whether the usage should be sampled at now
+func (p *capacityPlanner) due(now time.Time) bool {
+	p.Lock()
+	defer p.Unlock()
+	if err := p.load(); err != nil || len(p.samples) == 0 {
+		return true
+	}
+	last := p.samples[len(p.samples)-1]
+	return last.Date != now.UTC().Format(capacitySampleDateFormat) || now.Sub(last.Time) >= capacitySampleInterval
+}
+
+// record stores the sample of its day, replacing the previous sample of the
+// same day and dropping the oldest days beyond the limit
+func (p *capacityPlanner) record(sample CapacitySample) error {
+	p.Lock()
+	defer p.Unlock()
+	if err := p.load(); err != nil {
+		return err
+	}
+	samples := append([]CapacitySample{}, p.samples...)
+	if n := len(samples); n > 0 && samples[n-1].Date == sample.Date {
+		samples[n-1] = sample
+	} else {
+		samples = append(samples, sample)
+	}
+	if len(samples) > capacityHistoryLimit {
+		samples = samples[len(samples)-capacityHistoryLimit:]
+	}
+	if err := writeDataFile(p.file, samples); err != nil {
+		return err
+	}
+	p.samples = samples
+	return nil
+}
+
+// list returns the samples of the last days, oldest first
+func (p *capacityPlanner) list(days int) ([]CapacitySample, error) {
+	p.Lock()
+	defer p.Unlock()
+	if err := p.load(); err != nil {
+		return nil, err
+	}
+	samples := p.samples
+	if len(samples) > days {
+		samples = samples[len(samples)-days:]
+	}
+	return append([]CapacitySample{}, samples...), nil
+}
+
+// getCapacitySample returns the raw drive usage of the cluster and the size of
+// every bucket at now
+func getCapacitySample(ctx context.Context, client MinioAdmin, now time.Time) (CapacitySample, error) {
+	sample := CapacitySample{
+		Date:    now.UTC().Format(capacitySampleDateFormat),
+		Time:    now,
+		Buckets: map[string]int64{},
+	}
+	serverInfo, err := client.serverInfo(ctx)
+	if err != nil {
+		return sample, err
+	}
+	for _, server := range serverInfo.Servers {
+		for _, disk := range server.Disks {
+			sample.Used += int64(disk.UsedSpace)
+			sample.Total += int64(disk.TotalSpace)
+		}
+	}
+	accountInfo, err := client.AccountInfo(ctx)
+	if err != nil {
+		return sample, err
+	}
+	for _, bucket := range accountInfo.Buckets {
+		sample.Buckets[bucket.Name] = int64(bucket.Size)
+	}
+	return sample, nil
+}
+
+// recordCapacityUsage samples the usage when the sample of the day is due
+func recordCapacityUsage(ctx context.Context, client MinioAdmin, planner *capacityPlanner, now time.Time) error {
+	if !planner.due(now) {
+		return nil
+	}
+	sample, err := getCapacitySample(ctx, client, now)
+	if err != nil {
+		return err
+	}
+	return planner.record(sample)
+}
+
+// linearTrend fits y = intercept + slope * x by least squares
+func linearTrend(x, y []float64) (slope, intercept float64) {
+	n := float64(len(x))
+	if n == 0 {
+		return 0, 0
+	}
+	var meanX, meanY float64
+	for i := range x {
+		meanX += x[i]
+		meanY += y[i]
+	}
+	meanX /= n
+	meanY /= n
+	var covariance, variance float64
+	for i := range x {
+		covariance += (x[i] - meanX) * (y[i] - meanY)
+		variance += (x[i] - meanX) * (x[i] - meanX)
+	}
+	if variance == 0 {
+		return 0, meanY
+	}
+	slope = covariance / variance
+	return slope, meanY - slope*meanX
+}
+
+// sampleDays returns the days elapsed between the sample and the first one
+func sampleDays(sample, first CapacitySample) float64 {
+	return sample.Time.Sub(first.Time).Hours() / 24
+}
+
+// forecastThreshold returns when the usage growing by growth bytes a day
+// reaches the percentage of total
+func forecastThreshold(percentage int32, used, total int64, growth float64, now time.Time) *models.CapacityThresholdForecast {
+	forecast := &models.CapacityThresholdForecast{Percentage: percentage}
+	target := float64(total) * float64(percentage) / 100
+	switch {
+	case float64(used) >= target:
+		forecast.Reached = true
+		forecast.Date = now.UTC().Format(capacitySampleDateFormat)
+	case growth <= 0:
+		forecast.DaysLeft = -1
+	default:
+		forecast.DaysLeft = int64(math.Ceil((target - float64(used)) / growth))
+		forecast.Date = now.UTC().AddDate(0, 0, int(forecast.DaysLeft)).Format(capacitySampleDateFormat)
+	}
+	return forecast
+}
+
+// getBucketsGrowth returns the buckets growing fastest over the samples
+func getBucketsGrowth(samples []CapacitySample, top int) []*models.BucketGrowth {
+	first, last := samples[0], samples[len(samples)-1]
+	var buckets []*models.BucketGrowth
+	for bucket, size := range last.Buckets {
+		var x, y []float64
+		var start int64
+		for _, sample := range samples {
+			bucketSize, ok := sample.Buckets[bucket]
+			if !ok {
+				continue
+			}
+			if len(x) == 0 {
+				start = bucketSize
+			}
+			x = append(x, sampleDays(sample, first))
+			y = append(y, float64(bucketSize))
+		}
+		if len(x) < 2 {
+			continue
+		}
+		growth, _ := linearTrend(x, y)
+		if growth <= 0 {
+			continue
+		}
+		bucketGrowth := &models.BucketGrowth{
+			Bucket:       bucket,
+			Size:         size,
+			GrowthPerDay: growth,
+		}
+		if start > 0 {
+			bucketGrowth.GrowthPercentage = 100 * float64(size-start) / float64(start)
+		}
+		buckets = append(buckets, bucketGrowth)
+	}
+	sort.Slice(buckets, func(i, j int) bool {
+		if buckets[i].GrowthPerDay != buckets[j].GrowthPerDay {
+			return buckets[i].GrowthPerDay > buckets[j].GrowthPerDay
+		}
+		return buckets[i].Bucket < buckets[j].Bucket
+	})
+	if len(buckets) > top {
+		buckets = buckets[:top]
+	}
+	return buckets
+}
+
+// getCapacityForecast fits the trend of the usage recorded over the last days
+// and forecasts when the cluster reaches every threshold. The trend needs two
+// samples at least, with fewer samples the usage is reported without forecast
+func getCapacityForecast(samples []CapacitySample, top int) *models.CapacityForecastResponse {
+	forecast := &models.CapacityForecastResponse{
+		Samples:    int64(len(samples)),
+		Thresholds: []*models.CapacityThresholdForecast{},
+		Buckets:    []*models.BucketGrowth{},
+		History:    []*models.CapacityUsageSample{},
+	}
+	if len(samples) == 0 {
+		return forecast
+	}
+	first, last := samples[0], samples[len(samples)-1]
+	var x, y []float64
+	for _, sample := range samples {
+		x = append(x, sampleDays(sample, first))
+		y = append(y, float64(sample.Used))
+		forecast.History = append(forecast.History, &models.CapacityUsageSample{
+			Date:  sample.Date,
+			Used:  sample.Used,
+			Total: sample.Total,
+		})
+	}
+	forecast.Used = last.Used
+	forecast.Total = last.Total
+	if last.Total > 0 {
+		forecast.UsagePercentage = 100 * float64(last.Used) / float64(last.Total)
+	}
+	if len(samples) < 2 {
+		return forecast
+	}
+	forecast.GrowthPerDay, _ = linearTrend(x, y)
+	for _, percentage := range capacityForecastThresholds {
+		forecast.Thresholds = append(forecast.Thresholds, forecastThreshold(percentage, last.Used, last.Total, forecast.GrowthPerDay, last.Time))
+	}
+	if buckets := getBucketsGrowth(samples, top); len(buckets) > 0 {
+		forecast.Buckets = buckets
+	}
+	return forecast
+}
+
+// startCapacitySampler records the usage of the cluster in the background
+// with the monitoring credentials, the forecast has no samples without them
+func startCapacitySampler() {
+	client, err := newMonitorAdminClient()
+	if err != nil {
+		LogInfo("the capacity forecast is off: %v", err)
+		return
+	}
+	go func() {
+		sample := func(now time.Time) {
+			if err := recordCapacityUsage(context.Background(), client, globalCapacityPlanner, now); err != nil {
+				LogError("unable to record the capacity usage: %v", err)
+			}
+		}
+		sample(time.Now())
+		ticker := time.NewTicker(capacitySampleInterval)
+		defer ticker.Stop()
+		for now := range ticker.C {
+			sample(now)
+		}
+	}()
+}
+
+// capacityForecast forecasts the capacity from the samples of the last days
+func capacityForecast(planner *capacityPlanner, days, top int) (*models.CapacityForecastResponse, error) {
+	samples, err := planner.list(days)
+	if err != nil {
+		return nil, err
+	}
+	return getCapacityForecast(samples, top), nil
+}
+
+// getCapacityForecastResponse forecasts from the recorded samples, MinIO isn't
+// queried so the session is checked for the server info permission
+func getCapacityForecastResponse(session *models.Principal, params admin_api.CapacityForecastParams) (*models.CapacityForecastResponse, *models.Error) {
+	if !sessionAllowsAction(session, iampolicy.ServerInfoAdminAction) {
+		return nil, prepareError(errAccessDenied)
+	}
+	days := defaultCapacityForecastDays
+	if params.Days != nil && *params.Days > 0 {
+		days = int(*params.Days)
+	}
+	top := defaultCapacityForecastTop
+	if params.Top != nil && *params.Top > 0 {
+		top = int(*params.Top)
+	}
+	forecast, err := capacityForecast(globalCapacityPlanner, days, top)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return forecast, nil
+}
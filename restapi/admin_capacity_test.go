@@ -0,0 +1,97 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/minio/madmin-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCapacityForecast(t *testing.T) {
+	assert := assert.New(t)
+	defer useTempDataDir(t)()
+	ctx := context.Background()
+	adminClient := adminClientMock{}
+	planner := newCapacityPlanner(capacityHistoryFile)
+	start := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	// the cluster grows 10 bytes a day out of 1000, bucket "logs" 8 and "images" 2
+	day := 0
+	minioServerInfoMock = func(ctx context.Context) (madmin.InfoMessage, error) {
+		used := uint64(500 + 10*day)
+		return madmin.InfoMessage{Servers: []madmin.ServerProperties{
+			{Disks: []madmin.Disk{{UsedSpace: used / 2, TotalSpace: 500}, {UsedSpace: used / 2, TotalSpace: 500}}},
+		}}, nil
+	}
+	minioAccountInfoMock = func(ctx context.Context) (madmin.AccountInfo, error) {
+		return madmin.AccountInfo{Buckets: []madmin.BucketAccessInfo{
+			{Name: "images", Size: uint64(100 + 2*day)},
+			{Name: "logs", Size: uint64(200 + 8*day)},
+			{Name: "archive", Size: 50},
+		}}, nil
+	}
+	// Test-1 : a single sample reports the usage without forecast
+	assert.NoError(recordCapacityUsage(ctx, adminClient, planner, start))
+	forecast, err := capacityForecast(planner, 30, 10)
+	if assert.NoError(err) {
+		assert.Equal(int64(1), forecast.Samples)
+		assert.Equal(float64(50), forecast.UsagePercentage)
+		assert.Empty(forecast.Thresholds)
+	}
+	// Test-2 : the sample of a day is only refreshed after the sample interval
+	assert.False(planner.due(start.Add(time.Minute)))
+	assert.True(planner.due(start.Add(capacitySampleInterval)))
+	// Test-3 : one sample per day
+	for day = 1; day <= 10; day++ {
+		assert.NoError(recordCapacityUsage(ctx, adminClient, planner, start.AddDate(0, 0, day)))
+	}
+	day = 10
+	forecast, err = capacityForecast(planner, 30, 10)
+	if assert.NoError(err) && assert.Len(forecast.Thresholds, 3) {
+		assert.Equal(int64(11), forecast.Samples)
+		assert.InDelta(10, forecast.GrowthPerDay, 0.001)
+		assert.Equal(int64(20), forecast.Thresholds[0].DaysLeft)
+		assert.Equal("2021-07-01", forecast.Thresholds[0].Date)
+		assert.Equal(int64(40), forecast.Thresholds[2].DaysLeft)
+		if assert.Len(forecast.Buckets, 2) {
+			assert.Equal("logs", forecast.Buckets[0].Bucket)
+			assert.Equal(int64(280), forecast.Buckets[0].Size)
+			assert.Equal(float64(40), forecast.Buckets[0].GrowthPercentage)
+			assert.Equal("images", forecast.Buckets[1].Bucket)
+		}
+	}
+	// Test-4 : the window and the number of buckets are limited
+	forecast, err = capacityForecast(planner, 5, 1)
+	if assert.NoError(err) {
+		assert.Equal(int64(5), forecast.Samples)
+		assert.Len(forecast.Buckets, 1)
+	}
+	// Test-5 : thresholds already reached and usage that isn't growing
+	threshold := forecastThreshold(80, 900, 1000, 10, start)
+	assert.True(threshold.Reached)
+	threshold = forecastThreshold(90, 500, 1000, 0, start)
+	assert.Equal(int64(-1), threshold.DaysLeft)
+	assert.Equal("", threshold.Date)
+	// Test-6 : the history survives restarts
+	samples, err := newCapacityPlanner(capacityHistoryFile).list(30)
+	if assert.NoError(err) {
+		assert.Len(samples, 11)
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/swag"
@@ -55,6 +56,14 @@ func registerConfigHandlers(api *operations.ConsoleAPI) {
 		}
 		return admin_api.NewSetConfigOK().WithPayload(resp)
 	})
+	// Reset Configuration
+	api.AdminAPIResetConfigHandler = admin_api.ResetConfigHandlerFunc(func(params admin_api.ResetConfigParams, session *models.Principal) middleware.Responder {
+		resp, err := resetConfigResponse(session, params.Name, params.Body)
+		if err != nil {
+			return admin_api.NewResetConfigDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewResetConfigOK().WithPayload(resp)
+	})
 }
 
 // listConfig gets all configurations' names and their descriptions
@@ -133,14 +142,10 @@ func getConfigResponse(session *models.Principal, params admin_api.ConfigInfoPar
 	// defining the client to be used
 	adminClient := AdminClient{Client: mAdmin}
 
-	configkv, err := getConfig(ctx, adminClient, params.Name)
+	configurationObj, err := getConfigWithSchema(ctx, adminClient, params.Name)
 	if err != nil {
 		return nil, prepareError(err)
 	}
-	configurationObj := &models.Configuration{
-		Name:      params.Name,
-		KeyValues: configkv,
-	}
 	return configurationObj, nil
 }
 
@@ -184,13 +189,37 @@ func setConfigResponse(session *models.Principal, name string, configRequest *mo
 	// create a MinIO Admin Client interface implementation
 	// defining the client to be used
 	adminClient := AdminClient{Client: mAdmin}
-	configName := name
-
 	ctx := context.Background()
 
-	needsRestart, err := setConfigWithARNAccountID(ctx, adminClient, &configName, configRequest.KeyValues, configRequest.ArnResourceID)
+	needsRestart, err := setConfigAndRecord(ctx, adminClient, session.AccountAccessKey, name, configRequest)
 	if err != nil {
 		return nil, prepareError(err)
 	}
 	return &models.SetConfigResponse{Restart: needsRestart}, nil
 }
+
+// setConfigAndRecord validates and sets a configuration, the change is
+// recorded in the configuration history
+func setConfigAndRecord(ctx context.Context, client MinioAdmin, user, name string, configRequest *models.SetConfigRequest) (bool, error) {
+	configName := name
+	kvs, err := validateConfigKVs(ctx, client, name, configRequest.KeyValues, configRequest.ArnResourceID)
+	if err != nil {
+		return false, err
+	}
+	// nothing left to set when only masked secrets were sent back
+	if len(kvs) == 0 {
+		return false, nil
+	}
+	subsystem := name
+	if configRequest.ArnResourceID != "" {
+		subsystem = fmt.Sprintf("%s:%s", name, configRequest.ArnResourceID)
+	}
+	needsRestart, _, err := recordConfigChange(ctx, client, globalConfigHistory, ConfigRevision{
+		User:      user,
+		Action:    models.ConfigRevisionActionSet,
+		Subsystem: subsystem,
+	}, time.Now(), func() (bool, error) {
+		return setConfigWithARNAccountID(ctx, client, &configName, kvs, configRequest.ArnResourceID)
+	})
+	return needsRestart, err
+}
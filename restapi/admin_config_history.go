@@ -0,0 +1,599 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/pkg/auth"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/admin_api"
+	iampolicy "github.com/minio/pkg/iam/policy"
+)
+
+const configHistoryFile = "config-history.json"
+
+// configHistoryLimit is the number of revisions kept, the oldest ones are dropped first
+const configHistoryLimit = 100
+
+func registerConfigHistoryHandlers(api *operations.ConsoleAPI) {
+	// export the full server configuration
+	api.AdminAPIExportConfigHandler = admin_api.ExportConfigHandlerFunc(func(params admin_api.ExportConfigParams, session *models.Principal) middleware.Responder {
+		data, err := getExportConfigResponse(session, params)
+		if err != nil {
+			return admin_api.NewExportConfigDefault(int(err.Code)).WithPayload(err)
+		}
+		// Custom response writer to set the content-disposition header to tell the
+		// HTTP client the name and extension of the file we are returning
+		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+			w.Header().Set("Content-Type", "application/octet-stream")
+			w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=minio-config-%s.txt", time.Now().UTC().Format("20060102150405")))
+			io.Copy(w, bytes.NewReader(data))
+		})
+	})
+	// list the configuration revisions
+	api.AdminAPIListConfigHistoryHandler = admin_api.ListConfigHistoryHandlerFunc(func(params admin_api.ListConfigHistoryParams, session *models.Principal) middleware.Responder {
+		history, err := getListConfigHistoryResponse(session, params)
+		if err != nil {
+			return admin_api.NewListConfigHistoryDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewListConfigHistoryOK().WithPayload(history)
+	})
+	// take a snapshot of the configuration
+	api.AdminAPICreateConfigSnapshotHandler = admin_api.CreateConfigSnapshotHandlerFunc(func(params admin_api.CreateConfigSnapshotParams, session *models.Principal) middleware.Responder {
+		revision, err := getCreateConfigSnapshotResponse(session, params)
+		if err != nil {
+			return admin_api.NewCreateConfigSnapshotDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewCreateConfigSnapshotCreated().WithPayload(revision)
+	})
+	// compare two revisions
+	api.AdminAPIDiffConfigRevisionsHandler = admin_api.DiffConfigRevisionsHandlerFunc(func(params admin_api.DiffConfigRevisionsParams, session *models.Principal) middleware.Responder {
+		diff, err := getDiffConfigRevisionsResponse(session, params)
+		if err != nil {
+			return admin_api.NewDiffConfigRevisionsDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewDiffConfigRevisionsOK().WithPayload(diff)
+	})
+	// restore the configuration of a revision
+	api.AdminAPIRestoreConfigRevisionHandler = admin_api.RestoreConfigRevisionHandlerFunc(func(params admin_api.RestoreConfigRevisionParams, session *models.Principal) middleware.Responder {
+		restore, err := getRestoreConfigRevisionResponse(session, params)
+		if err != nil {
+			return admin_api.NewRestoreConfigRevisionDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewRestoreConfigRevisionOK().WithPayload(restore)
+	})
+}
+
+// ConfigRevision is the full server configuration after a change made from
+// Console, a snapshot or a change detected outside of Console. The
+// configuration has the secrets of the server so only its encrypted form is
+// stored
+type ConfigRevision struct {
+	ID              int64
+	Time            time.Time
+	User            string
+	Action          string
+	Subsystem       string
+	Comment         string
+	Config          string `json:"-"`
+	EncryptedConfig string
+}
+
+// configHistoryManager keeps the configuration revisions in a file of the
+// Console data directory
+type configHistoryManager struct {
+	sync.Mutex
+	file      string
+	loaded    bool
+	revisions []ConfigRevision
+}
+
+var globalConfigHistory = newConfigHistoryManager(configHistoryFile)
+
+func newConfigHistoryManager(file string) *configHistoryManager {
+	return &configHistoryManager{file: file}
+}
+
+// load reads the history file the first time it's needed, callers must hold the lock
+func (m *configHistoryManager) load() error {
+	if m.loaded {
+		return nil
+	}
+	var revisions []ConfigRevision
+	if err := readDataFile(m.file, &revisions); err != nil {
+		return err
+	}
+	for i := range revisions {
+		config, err := auth.DecryptData(revisions[i].EncryptedConfig)
+		if err != nil {
+			return err
+		}
+		revisions[i].Config = string(config)
+	}
+	m.revisions = revisions
+	m.loaded = true
+	return nil
+}
+
+// list returns the revisions, oldest first
+func (m *configHistoryManager) list() ([]ConfigRevision, error) {
+	m.Lock()
+	defer m.Unlock()
+	if err := m.load(); err != nil {
+		return nil, err
+	}
+	return append([]ConfigRevision{}, m.revisions...), nil
+}
+
+func (m *configHistoryManager) get(id int64) (ConfigRevision, error) {
+	m.Lock()
+	defer m.Unlock()
+	if err := m.load(); err != nil {
+		return ConfigRevision{}, err
+	}
+	for _, revision := range m.revisions {
+		if revision.ID == id {
+			return revision, nil
+		}
+	}
+	return ConfigRevision{}, errConfigRevisionNotFound
+}
+
+// add stores a new revision with the next id, when onlyIfChanged is set the
+// revision is skipped if the configuration is the same as the latest one
+func (m *configHistoryManager) add(revision ConfigRevision, onlyIfChanged bool) (*ConfigRevision, error) {
+	m.Lock()
+	defer m.Unlock()
+	if err := m.load(); err != nil {
+		return nil, err
+	}
+	revision.ID = 1
+	if n := len(m.revisions); n > 0 {
+		latest := m.revisions[n-1]
+		if onlyIfChanged && latest.Config == revision.Config {
+			return nil, nil
+		}
+		revision.ID = latest.ID + 1
+	}
+	encrypted, err := auth.EncryptData([]byte(revision.Config))
+	if err != nil {
+		return nil, err
+	}
+	revision.EncryptedConfig = encrypted
+	revisions := append(append([]ConfigRevision{}, m.revisions...), revision)
+	if len(revisions) > configHistoryLimit {
+		revisions = revisions[len(revisions)-configHistoryLimit:]
+	}
+	if err := writeDataFile(m.file, revisions); err != nil {
+		return nil, err
+	}
+	m.revisions = revisions
+	return &revision, nil
+}
+
+// configTargets maps every subsystem target of a server configuration, like
+// `identity_openid` or `notify_webhook:1`, to its keys and values. Comments
+// describe the settings coming from environment variables which can't be
+// changed so they are left out
+type configTargets map[string]map[string]string
+
+// splitConfigLine splits a configuration line on the spaces outside of quotes
+func splitConfigLine(line string) []string {
+	var fields []string
+	var field strings.Builder
+	quoted, hasField := false, false
+	for _, r := range line {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			hasField = true
+		case (r == ' ' || r == '\t') && !quoted:
+			if hasField {
+				fields = append(fields, field.String())
+				field.Reset()
+				hasField = false
+			}
+		default:
+			field.WriteRune(r)
+			hasField = true
+		}
+	}
+	if hasField {
+		fields = append(fields, field.String())
+	}
+	return fields
+}
+
+func parseServerConfig(config string) configTargets {
+	targets := configTargets{}
+	scanner := bufio.NewScanner(strings.NewReader(config))
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := splitConfigLine(line)
+		kvs := map[string]string{}
+		for _, field := range fields[1:] {
+			kv := strings.SplitN(field, "=", 2)
+			if len(kv) == 2 {
+				kvs[kv[0]] = kv[1]
+			} else {
+				kvs[kv[0]] = ""
+			}
+		}
+		targets[fields[0]] = kvs
+	}
+	return targets
+}
+
+// maskServerConfig hides the values of the sensitive keys of a server
+// configuration, the comments and the order of the lines are kept
+func maskServerConfig(config string) string {
+	var lines []string
+	for _, line := range strings.Split(config, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			lines = append(lines, line)
+			continue
+		}
+		fields := splitConfigLine(trimmed)
+		for i, field := range fields[1:] {
+			kv := strings.SplitN(field, "=", 2)
+			if len(kv) == 2 {
+				kv[1] = maskConfigValue(kv[0], kv[1])
+				if strings.ContainsAny(kv[1], " \t") {
+					kv[1] = fmt.Sprintf("%q", kv[1])
+				}
+			}
+			fields[i+1] = strings.Join(kv, "=")
+		}
+		lines = append(lines, strings.Join(fields, " "))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// configTargetLine builds the line setting every key of a target
+func configTargetLine(target string, kvs map[string]string) string {
+	var keys []string
+	for key := range kvs {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	elements := []string{target}
+	for _, key := range keys {
+		value := kvs[key]
+		if strings.ContainsAny(value, " \t") {
+			value = fmt.Sprintf("%q", value)
+		}
+		elements = append(elements, fmt.Sprintf("%s=%s", key, value))
+	}
+	return strings.Join(elements, " ")
+}
+
+// maskConfigValue hides the value of the sensitive keys and the password of URL values
+func maskConfigValue(key, value string) string {
+	if value != "" && isSensitiveConfigKey(key) {
+		return configMaskedValue
+	}
+	return maskConfigURLPassword(value)
+}
+
+// diffConfig returns the keys changed between two configurations sorted by
+// subsystem and key, the values of the sensitive keys are masked
+func diffConfig(from, to string) []*models.ConfigChange {
+	before, after := parseServerConfig(from), parseServerConfig(to)
+	changes := []*models.ConfigChange{}
+	for target, kvs := range after {
+		for key, value := range kvs {
+			previous, ok := before[target][key]
+			switch {
+			case !ok:
+				changes = append(changes, &models.ConfigChange{Subsystem: target, Key: key, After: maskConfigValue(key, value), Type: models.ConfigChangeTypeAdded})
+			case previous != value:
+				changes = append(changes, &models.ConfigChange{Subsystem: target, Key: key, Before: maskConfigValue(key, previous), After: maskConfigValue(key, value), Type: models.ConfigChangeTypeModified})
+			}
+		}
+	}
+	for target, kvs := range before {
+		for key, value := range kvs {
+			if _, ok := after[target][key]; !ok {
+				changes = append(changes, &models.ConfigChange{Subsystem: target, Key: key, Before: maskConfigValue(key, value), Type: models.ConfigChangeTypeRemoved})
+			}
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Subsystem != changes[j].Subsystem {
+			return changes[i].Subsystem < changes[j].Subsystem
+		}
+		return changes[i].Key < changes[j].Key
+	})
+	return changes
+}
+
+// configRevisionToModel returns a revision with the keys changed since the previous one
+func configRevisionToModel(revision ConfigRevision, previous *ConfigRevision) *models.ConfigRevision {
+	result := &models.ConfigRevision{
+		ID:        revision.ID,
+		Time:      revision.Time.Format(time.RFC3339),
+		User:      revision.User,
+		Action:    revision.Action,
+		Subsystem: revision.Subsystem,
+		Comment:   revision.Comment,
+		Changes:   []string{},
+	}
+	if previous != nil {
+		for _, change := range diffConfig(previous.Config, revision.Config) {
+			result.Changes = append(result.Changes, fmt.Sprintf("%s %s", change.Subsystem, change.Key))
+		}
+	}
+	return result
+}
+
+// recordConfigChange applies a configuration change and records the resulting
+// configuration. The configuration before the change is recorded first when
+// it differs from the latest revision, the change was made outside of Console
+// then. Failing to record the history doesn't stop the change
+func recordConfigChange(ctx context.Context, client MinioAdmin, history *configHistoryManager, revision ConfigRevision, now time.Time, apply func() (bool, error)) (bool, *ConfigRevision, error) {
+	before, err := client.getServerConfig(ctx)
+	if err != nil {
+		LogError("unable to get the configuration before the change: %v", err)
+	} else {
+		baseline := ConfigRevision{Time: now, Action: models.ConfigRevisionActionExternal, Comment: "changed outside of Console", Config: string(before)}
+		revisions, err := history.list()
+		if err == nil && len(revisions) == 0 {
+			baseline.Comment = "configuration before the first change"
+		}
+		if _, err := history.add(baseline, true); err != nil {
+			LogError("unable to record the configuration before the change: %v", err)
+		}
+	}
+	restart, err := apply()
+	if err != nil {
+		return false, nil, err
+	}
+	after, err := client.getServerConfig(ctx)
+	if err != nil {
+		LogError("unable to get the configuration after the change: %v", err)
+		return restart, nil, nil
+	}
+	revision.Time = now
+	revision.Config = string(after)
+	recorded, err := history.add(revision, false)
+	if err != nil {
+		LogError("unable to record the configuration change: %v", err)
+	}
+	return restart, recorded, nil
+}
+
+func getExportConfigResponse(session *models.Principal, params admin_api.ExportConfigParams) ([]byte, *models.Error) {
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	config, err := adminClient.getServerConfig(params.HTTPRequest.Context())
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return []byte(maskServerConfig(string(config))), nil
+}
+
+// listConfigHistory returns the revisions newest first
+func listConfigHistory(history *configHistoryManager) (*models.ConfigHistoryResponse, error) {
+	revisions, err := history.list()
+	if err != nil {
+		return nil, err
+	}
+	response := &models.ConfigHistoryResponse{Revisions: []*models.ConfigRevision{}}
+	for i := len(revisions) - 1; i >= 0; i-- {
+		var previous *ConfigRevision
+		if i > 0 {
+			previous = &revisions[i-1]
+		}
+		response.Revisions = append(response.Revisions, configRevisionToModel(revisions[i], previous))
+	}
+	return response, nil
+}
+
+// getListConfigHistoryResponse returns the revisions, MinIO isn't queried so
+// the session is checked for the config update permission
+func getListConfigHistoryResponse(session *models.Principal, params admin_api.ListConfigHistoryParams) (*models.ConfigHistoryResponse, *models.Error) {
+	if !sessionAllowsAction(session, iampolicy.ConfigUpdateAdminAction) {
+		return nil, prepareError(errAccessDenied)
+	}
+	history, err := listConfigHistory(globalConfigHistory)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return history, nil
+}
+
+// takeConfigSnapshot records the current configuration as a revision
+func takeConfigSnapshot(ctx context.Context, client MinioAdmin, history *configHistoryManager, user, comment string, now time.Time) (*models.ConfigRevision, error) {
+	config, err := client.getServerConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	revisions, err := history.list()
+	if err != nil {
+		return nil, err
+	}
+	revision, err := history.add(ConfigRevision{
+		Time:    now,
+		User:    user,
+		Action:  models.ConfigRevisionActionSnapshot,
+		Comment: comment,
+		Config:  string(config),
+	}, false)
+	if err != nil {
+		return nil, err
+	}
+	var previous *ConfigRevision
+	if len(revisions) > 0 {
+		previous = &revisions[len(revisions)-1]
+	}
+	return configRevisionToModel(*revision, previous), nil
+}
+
+func getCreateConfigSnapshotResponse(session *models.Principal, params admin_api.CreateConfigSnapshotParams) (*models.ConfigRevision, *models.Error) {
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	revision, err := takeConfigSnapshot(params.HTTPRequest.Context(), adminClient, globalConfigHistory, session.AccountAccessKey, params.Body.Comment, time.Now())
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return revision, nil
+}
+
+// diffConfigRevisions compares two revisions, the current server configuration
+// is used when there's no second revision
+func diffConfigRevisions(ctx context.Context, client MinioAdmin, history *configHistoryManager, from int64, to *int64) (*models.ConfigDiffResponse, error) {
+	fromRevision, err := history.get(from)
+	if err != nil {
+		return nil, err
+	}
+	response := &models.ConfigDiffResponse{From: from}
+	var toConfig string
+	if to != nil {
+		toRevision, err := history.get(*to)
+		if err != nil {
+			return nil, err
+		}
+		response.To = *to
+		toConfig = toRevision.Config
+	} else {
+		config, err := client.getServerConfig(ctx)
+		if err != nil {
+			return nil, err
+		}
+		toConfig = string(config)
+	}
+	response.Changes = diffConfig(fromRevision.Config, toConfig)
+	return response, nil
+}
+
+// getDiffConfigRevisionsResponse compares two revisions, MinIO isn't queried
+// when both are given so the session is checked for the config update permission
+func getDiffConfigRevisionsResponse(session *models.Principal, params admin_api.DiffConfigRevisionsParams) (*models.ConfigDiffResponse, *models.Error) {
+	if !sessionAllowsAction(session, iampolicy.ConfigUpdateAdminAction) {
+		return nil, prepareError(errAccessDenied)
+	}
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	diff, err := diffConfigRevisions(params.HTTPRequest.Context(), adminClient, globalConfigHistory, params.From, params.To)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return diff, nil
+}
+
+// applyConfig sets the given configuration in one call when it differs from
+// the current one. MinIO merges the configuration it's given with the current
+// one, so the targets added since are removed afterwards. A configuration set
+// this way always needs a restart
+func applyConfig(ctx context.Context, client MinioAdmin, config string) (bool, error) {
+	current, err := client.getServerConfig(ctx)
+	if err != nil {
+		return false, err
+	}
+	if len(diffConfig(string(current), config)) == 0 {
+		return false, nil
+	}
+	if err := client.setServerConfig(ctx, []byte(config)); err != nil {
+		return false, err
+	}
+	before, after := parseServerConfig(string(current)), parseServerConfig(config)
+	var targets []string
+	for target := range before {
+		if _, ok := after[target]; !ok {
+			targets = append(targets, target)
+		}
+	}
+	sort.Strings(targets)
+	for _, target := range targets {
+		if err := client.delConfigKV(ctx, target); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// restoreConfigRevision brings the configuration back to a revision, the
+// restore is recorded as a new revision
+func restoreConfigRevision(ctx context.Context, client MinioAdmin, history *configHistoryManager, id int64, user string, now time.Time) (*models.ConfigRestoreResponse, error) {
+	target, err := history.get(id)
+	if err != nil {
+		return nil, err
+	}
+	restart, revision, err := recordConfigChange(ctx, client, history, ConfigRevision{
+		User:    user,
+		Action:  models.ConfigRevisionActionRestore,
+		Comment: fmt.Sprintf("restored revision %d", id),
+	}, now, func() (bool, error) {
+		return applyConfig(ctx, client, target.Config)
+	})
+	if err != nil {
+		return nil, err
+	}
+	response := &models.ConfigRestoreResponse{Restart: restart}
+	if revision != nil {
+		response.Revision = configRevisionToModel(*revision, &target)
+	}
+	return response, nil
+}
+
+func getRestoreConfigRevisionResponse(session *models.Principal, params admin_api.RestoreConfigRevisionParams) (*models.ConfigRestoreResponse, *models.Error) {
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	restore, err := restoreConfigRevision(params.HTTPRequest.Context(), adminClient, globalConfigHistory, params.ID, session.AccountAccessKey, time.Now())
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return restore, nil
+}
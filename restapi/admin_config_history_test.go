@@ -0,0 +1,211 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations/admin_api"
+	"github.com/stretchr/testify/assert"
+)
+
+var minioGetServerConfigMock func() ([]byte, error)
+var minioDelConfigKVMock func(kv string) error
+var minioSetServerConfigMock func(config []byte) error
+
+// mock function getServerConfig()
+func (ac adminClientMock) getServerConfig(ctx context.Context) ([]byte, error) {
+	return minioGetServerConfigMock()
+}
+
+// mock function setServerConfig()
+func (ac adminClientMock) setServerConfig(ctx context.Context, config []byte) error {
+	return minioSetServerConfigMock(config)
+}
+
+// mock function delConfigKV()
+func (ac adminClientMock) delConfigKV(ctx context.Context, kv string) error {
+	return minioDelConfigKVMock(kv)
+}
+
+func TestParseServerConfig(t *testing.T) {
+	assert := assert.New(t)
+	targets := parseServerConfig(`# MINIO_REGION_NAME=us-east-1
+region name=
+identity_openid config_url=https://idp/.well-known client_id=console scopes="openid profile"
+notify_webhook:1 endpoint=http://hook queue_limit=0
+
+`)
+	// Test-1 : comments describing environment settings are ignored
+	assert.Equal(3, len(targets))
+	assert.Equal(map[string]string{"name": ""}, targets["region"])
+	// Test-2 : quoted values keep their spaces
+	assert.Equal("openid profile", targets["identity_openid"]["scopes"])
+	assert.Equal("http://hook", targets["notify_webhook:1"]["endpoint"])
+	// Test-3 : the line to set a target quotes the values with spaces
+	assert.Equal(`identity_openid client_id=console config_url=https://idp/.well-known scopes="openid profile"`, configTargetLine("identity_openid", targets["identity_openid"]))
+}
+
+func TestMaskServerConfig(t *testing.T) {
+	assert := assert.New(t)
+	masked := maskServerConfig("# MINIO_IDENTITY_OPENID_CLIENT_SECRET=env\nidentity_openid client_id=console client_secret=\"a secret\" scopes=\"openid profile\"\nregion name=\n")
+	// Test-1 : the sensitive values are masked, the comments and the other values are kept
+	assert.Equal("# MINIO_IDENTITY_OPENID_CLIENT_SECRET=env\nidentity_openid client_id=console client_secret="+configMaskedValue+" scopes=\"openid profile\"\nregion name=\n", masked)
+}
+
+func TestDiffConfig(t *testing.T) {
+	assert := assert.New(t)
+	changes := diffConfig("region name=\nnotify_webhook:1 endpoint=http://a queue_limit=0\n", "region name=eu-west-1\nnotify_webhook:1 endpoint=http://a\napi requests_max=0\n")
+	if assert.Equal(3, len(changes)) {
+		assert.Equal(&models.ConfigChange{Subsystem: "api", Key: "requests_max", After: "0", Type: models.ConfigChangeTypeAdded}, changes[0])
+		assert.Equal(&models.ConfigChange{Subsystem: "notify_webhook:1", Key: "queue_limit", Before: "0", Type: models.ConfigChangeTypeRemoved}, changes[1])
+		assert.Equal(&models.ConfigChange{Subsystem: "region", Key: "name", After: "eu-west-1", Type: models.ConfigChangeTypeModified}, changes[2])
+	}
+	// Test-2 : the values of the sensitive keys are masked
+	changes = diffConfig("identity_openid client_secret=old\n", "identity_openid client_secret=new\n")
+	if assert.Equal(1, len(changes)) {
+		assert.Equal(configMaskedValue, changes[0].Before)
+		assert.Equal(configMaskedValue, changes[0].After)
+	}
+}
+
+func TestConfigHistory(t *testing.T) {
+	assert := assert.New(t)
+	defer useTempDataDir(t)()
+	ctx := context.Background()
+	adminClient := adminClientMock{}
+	history := newConfigHistoryManager(configHistoryFile)
+	now := time.Now()
+	config := "region name=\nnotify_webhook:1 endpoint=http://a\n"
+	minioGetServerConfigMock = func() ([]byte, error) {
+		return []byte(config), nil
+	}
+	var set, deleted []string
+	minioSetConfigKVMock = func(kv string) (bool, error) {
+		set = append(set, kv)
+		config = "region name=eu-west-1\n"
+		return false, nil
+	}
+	minioDelConfigKVMock = func(kv string) error {
+		deleted = append(deleted, kv)
+		return nil
+	}
+	// Test-1 : the configuration before the first change is recorded too
+	restart, revision, err := recordConfigChange(ctx, adminClient, history, ConfigRevision{User: "admin", Action: models.ConfigRevisionActionSet, Subsystem: "region"}, now, func() (bool, error) {
+		return adminClient.setConfigKV(ctx, "region name=eu-west-1")
+	})
+	if assert.NoError(err) {
+		assert.False(restart)
+		assert.Equal(int64(2), revision.ID)
+	}
+	// Test-2 : changes made outside of Console are detected on the next change
+	config = "region name=eu-west-2\n"
+	_, err = takeConfigSnapshot(ctx, adminClient, history, "admin", "before upgrade", now)
+	assert.NoError(err)
+	list, err := listConfigHistory(history)
+	if assert.NoError(err) && assert.Equal(3, len(list.Revisions)) {
+		assert.Equal(models.ConfigRevisionActionSnapshot, list.Revisions[0].Action)
+		assert.Equal([]string{"region name"}, list.Revisions[0].Changes)
+		assert.Equal([]string{"notify_webhook:1 endpoint", "region name"}, list.Revisions[1].Changes)
+		assert.Equal(models.ConfigRevisionActionExternal, list.Revisions[2].Action)
+	}
+	// Test-3 : diff against the current configuration
+	config = "region name=eu-west-3\n"
+	diff, err := diffConfigRevisions(ctx, adminClient, history, 3, nil)
+	if assert.NoError(err) && assert.Equal(1, len(diff.Changes)) {
+		assert.Equal("eu-west-3", diff.Changes[0].After)
+	}
+	// Test-4 : restoring sets the revision in one call and removes the new targets
+	var restored []string
+	minioSetServerConfigMock = func(data []byte) error {
+		restored = append(restored, string(data))
+		config = string(data)
+		return nil
+	}
+	restore, err := restoreConfigRevision(ctx, adminClient, history, 1, "admin", now)
+	if assert.NoError(err) {
+		assert.Equal([]string{"region name=\nnotify_webhook:1 endpoint=http://a\n"}, restored)
+		assert.Empty(deleted)
+		assert.True(restore.Restart)
+		assert.Equal(models.ConfigRevisionActionRestore, restore.Revision.Action)
+		assert.Equal("restored revision 1", restore.Revision.Comment)
+	}
+	config = "region name=\nnotify_webhook:1 endpoint=http://a\nnotify_webhook:2 endpoint=http://b\n"
+	restore, err = restoreConfigRevision(ctx, adminClient, history, 1, "admin", now)
+	if assert.NoError(err) {
+		assert.Equal([]string{"notify_webhook:2"}, deleted)
+		assert.True(restore.Restart)
+	}
+	restored = nil
+	restore, err = restoreConfigRevision(ctx, adminClient, history, 1, "admin", now)
+	if assert.NoError(err) {
+		assert.Empty(restored)
+		assert.False(restore.Restart)
+	}
+	// Test-5 : unknown revisions
+	_, err = restoreConfigRevision(ctx, adminClient, history, 100, "admin", now)
+	assert.True(errors.Is(err, errConfigRevisionNotFound))
+	// Test-6 : failed changes aren't recorded
+	list, _ = listConfigHistory(history)
+	minioSetConfigKVMock = func(kv string) (bool, error) {
+		return false, errors.New("invalid key")
+	}
+	_, _, err = recordConfigChange(ctx, adminClient, history, ConfigRevision{Action: models.ConfigRevisionActionSet}, now, func() (bool, error) {
+		return adminClient.setConfigKV(ctx, "region foo=bar")
+	})
+	assert.Error(err)
+	after, _ := listConfigHistory(history)
+	assert.Equal(len(list.Revisions), len(after.Revisions))
+	// Test-7 : the stored revisions are encrypted and read back
+	config = "identity_openid client_secret=topsecret\n"
+	_, err = takeConfigSnapshot(ctx, adminClient, history, "admin", "", now)
+	assert.NoError(err)
+	data, err := ioutil.ReadFile(filepath.Join(getConsoleDataDir(), configHistoryFile))
+	if assert.NoError(err) {
+		assert.NotContains(string(data), "topsecret")
+	}
+	revisions, err := newConfigHistoryManager(configHistoryFile).list()
+	if assert.NoError(err) {
+		assert.Equal(config, revisions[len(revisions)-1].Config)
+	}
+}
+
+func TestConfigHistoryAccess(t *testing.T) {
+	assert := assert.New(t)
+	defer useTempDataDir(t)()
+	reader := &models.Principal{Actions: []string{"admin:ServerInfo"}}
+	// Test-1 : the history is only read with the config update permission
+	_, errResp := getListConfigHistoryResponse(reader, admin_api.ListConfigHistoryParams{})
+	if assert.NotNil(errResp) {
+		assert.Equal(int32(403), errResp.Code)
+	}
+	_, errResp = getDiffConfigRevisionsResponse(reader, admin_api.DiffConfigRevisionsParams{From: 1, To: swag.Int64(2)})
+	if assert.NotNil(errResp) {
+		assert.Equal(int32(403), errResp.Code)
+	}
+	// Test-2 : the history is listed with the config update permission
+	history, errResp := getListConfigHistoryResponse(&models.Principal{Actions: []string{"admin:ConfigUpdate"}}, admin_api.ListConfigHistoryParams{})
+	assert.Nil(errResp)
+	assert.NotNil(history)
+}
@@ -0,0 +1,293 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/admin_api"
+	madmin "github.com/minio/madmin-go"
+)
+
+// configDefaultTargetID is how MinIO names the target without a name
+const configDefaultTargetID = "_"
+
+func registerConfigTargetsHandlers(api *operations.ConsoleAPI) {
+	// List the targets of a subsystem
+	api.AdminAPIListConfigTargetsHandler = admin_api.ListConfigTargetsHandlerFunc(func(params admin_api.ListConfigTargetsParams, session *models.Principal) middleware.Responder {
+		resp, err := getListConfigTargetsResponse(session, params)
+		if err != nil {
+			return admin_api.NewListConfigTargetsDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewListConfigTargetsOK().WithPayload(resp)
+	})
+	// Get a target
+	api.AdminAPIGetConfigTargetHandler = admin_api.GetConfigTargetHandlerFunc(func(params admin_api.GetConfigTargetParams, session *models.Principal) middleware.Responder {
+		resp, err := getConfigTargetResponse(session, params)
+		if err != nil {
+			return admin_api.NewGetConfigTargetDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewGetConfigTargetOK().WithPayload(resp)
+	})
+	// Add or edit a target
+	api.AdminAPISetConfigTargetHandler = admin_api.SetConfigTargetHandlerFunc(func(params admin_api.SetConfigTargetParams, session *models.Principal) middleware.Responder {
+		resp, err := getSetConfigTargetResponse(session, params)
+		if err != nil {
+			return admin_api.NewSetConfigTargetDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewSetConfigTargetOK().WithPayload(resp)
+	})
+	// Delete a target
+	api.AdminAPIDeleteConfigTargetHandler = admin_api.DeleteConfigTargetHandlerFunc(func(params admin_api.DeleteConfigTargetParams, session *models.Principal) middleware.Responder {
+		resp, err := getDeleteConfigTargetResponse(session, params)
+		if err != nil {
+			return admin_api.NewDeleteConfigTargetDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewDeleteConfigTargetOK().WithPayload(resp)
+	})
+}
+
+// configTargetsStatus returns the status reported by the server for the
+// targets of a subsystem by target name
+func configTargetsStatus(services madmin.Services, subSys string) map[string]string {
+	statuses := map[string]string{}
+	add := func(id string, status madmin.Status) {
+		id = strings.TrimPrefix(id, subSys+":")
+		if id == configDefaultTargetID {
+			id = ""
+		}
+		statuses[id] = status.Status
+	}
+	switch {
+	case strings.HasPrefix(subSys, "notify_"):
+		kind := strings.TrimPrefix(subSys, "notify_")
+		for _, notification := range services.Notifications {
+			for _, targets := range notification[kind] {
+				for id, status := range targets {
+					add(id, status)
+				}
+			}
+		}
+	case subSys == "logger_webhook":
+		for _, logger := range services.Logger {
+			for id, status := range logger {
+				add(id, status)
+			}
+		}
+	case subSys == "audit_webhook":
+		for _, audit := range services.Audit {
+			for id, status := range audit {
+				add(id, status)
+			}
+		}
+	}
+	return statuses
+}
+
+// configTargetEnabled tells if a target is enabled, either by its enable key
+// or, when it has none, by having all its required keys set
+func configTargetEnabled(kvs map[string]string, help madmin.Help) bool {
+	if enable, ok := kvs[madmin.EnableKey]; ok {
+		switch strings.ToLower(enable) {
+		case "on", "true", "enabled":
+			return true
+		}
+		return false
+	}
+	for _, kh := range help.KeysHelp {
+		if !kh.Optional && kvs[kh.Key] == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// listConfigTargets returns the default and named targets of a subsystem with
+// the sensitive values masked and the keys in the order of the help
+func listConfigTargets(ctx context.Context, client MinioAdmin, name string) (*models.ConfigTargetsResponse, error) {
+	help, err := client.helpConfigKV(ctx, name, "", false)
+	if err != nil {
+		return nil, err
+	}
+	config, err := client.getConfigKV(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	statuses := map[string]string{}
+	info, err := client.serverInfo(ctx)
+	if err != nil {
+		LogError("unable to get the status of the %s targets: %v", name, err)
+	} else {
+		statuses = configTargetsStatus(info.Services, name)
+	}
+	parsed := parseServerConfig(string(config))
+	var names []string
+	for target := range parsed {
+		if target == name || strings.HasPrefix(target, name+":") {
+			names = append(names, target)
+		}
+	}
+	sort.Strings(names)
+	response := &models.ConfigTargetsResponse{MultipleTargets: help.MultipleTargets, Targets: []*models.ConfigTarget{}}
+	for _, target := range names {
+		kvs := parsed[target]
+		targetName := strings.TrimPrefix(strings.TrimPrefix(target, name), ":")
+		result := &models.ConfigTarget{
+			Name:      targetName,
+			Enabled:   configTargetEnabled(kvs, help),
+			Status:    statuses[targetName],
+			KeyValues: []*models.ConfigurationKV{},
+		}
+		seen := map[string]bool{}
+		for _, kh := range help.KeysHelp {
+			if value, ok := kvs[kh.Key]; ok {
+				result.KeyValues = append(result.KeyValues, &models.ConfigurationKV{Key: kh.Key, Value: maskConfigValue(kh.Key, value)})
+				seen[kh.Key] = true
+			}
+		}
+		var extra []string
+		for key := range kvs {
+			if !seen[key] {
+				extra = append(extra, key)
+			}
+		}
+		sort.Strings(extra)
+		for _, key := range extra {
+			result.KeyValues = append(result.KeyValues, &models.ConfigurationKV{Key: key, Value: maskConfigValue(key, kvs[key])})
+		}
+		response.Targets = append(response.Targets, result)
+	}
+	return response, nil
+}
+
+func getListConfigTargetsResponse(session *models.Principal, params admin_api.ListConfigTargetsParams) (*models.ConfigTargetsResponse, *models.Error) {
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	targets, err := listConfigTargets(params.HTTPRequest.Context(), adminClient, params.Name)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return targets, nil
+}
+
+// getConfigTarget returns a named target of a subsystem
+func getConfigTarget(ctx context.Context, client MinioAdmin, name, target string) (*models.ConfigTarget, error) {
+	targets, err := listConfigTargets(ctx, client, name)
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range targets.Targets {
+		if t.Name == target {
+			return t, nil
+		}
+	}
+	return nil, errConfigTargetNotFound
+}
+
+func getConfigTargetResponse(session *models.Principal, params admin_api.GetConfigTargetParams) (*models.ConfigTarget, *models.Error) {
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	target, err := getConfigTarget(params.HTTPRequest.Context(), adminClient, params.Name, params.Target)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return target, nil
+}
+
+// checkConfigTarget makes sure a subsystem supports named targets and the
+// name can be used for one
+func checkConfigTarget(ctx context.Context, client MinioAdmin, name, target string) error {
+	if target == "" || target == configDefaultTargetID {
+		return fmt.Errorf("%w: a target name is required", errInvalidConfigValue)
+	}
+	if err := validateConfigTargetID(target); err != nil {
+		return err
+	}
+	help, err := client.helpConfigKV(ctx, name, "", false)
+	if err != nil {
+		return err
+	}
+	if !help.MultipleTargets {
+		return fmt.Errorf("%w: %s doesn't support named targets", errInvalidConfigValue, name)
+	}
+	return nil
+}
+
+// setConfigTarget adds or edits a named target of a subsystem
+func setConfigTarget(ctx context.Context, client MinioAdmin, user, name, target string, kvs []*models.ConfigurationKV) (bool, error) {
+	if err := checkConfigTarget(ctx, client, name, target); err != nil {
+		return false, err
+	}
+	return setConfigAndRecord(ctx, client, user, name, &models.SetConfigRequest{KeyValues: kvs, ArnResourceID: target})
+}
+
+func getSetConfigTargetResponse(session *models.Principal, params admin_api.SetConfigTargetParams) (*models.SetConfigResponse, *models.Error) {
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	restart, err := setConfigTarget(params.HTTPRequest.Context(), adminClient, session.AccountAccessKey, params.Name, params.Target, params.Body.KeyValues)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return &models.SetConfigResponse{Restart: restart}, nil
+}
+
+// deleteConfigTarget removes a named target of a subsystem
+func deleteConfigTarget(ctx context.Context, client MinioAdmin, user, name, target string) (bool, error) {
+	if err := checkConfigTarget(ctx, client, name, target); err != nil {
+		return false, err
+	}
+	if _, err := getConfigTarget(ctx, client, name, target); err != nil {
+		return false, err
+	}
+	return resetConfigAndRecord(ctx, client, user, name, nil, target, fmt.Sprintf("deleted target %s", target))
+}
+
+func getDeleteConfigTargetResponse(session *models.Principal, params admin_api.DeleteConfigTargetParams) (*models.SetConfigResponse, *models.Error) {
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	restart, err := deleteConfigTarget(params.HTTPRequest.Context(), adminClient, session.AccountAccessKey, params.Name, params.Target)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return &models.SetConfigResponse{Restart: restart}, nil
+}
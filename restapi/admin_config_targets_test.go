@@ -0,0 +1,139 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/minio/console/models"
+	madmin "github.com/minio/madmin-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func mockWebhookTargets() {
+	minioHelpConfigKVMock = func(subSys, key string, envOnly bool) (madmin.Help, error) {
+		return madmin.Help{
+			SubSys:          "notify_webhook",
+			MultipleTargets: true,
+			KeysHelp: madmin.HelpKVS{
+				{Key: "endpoint", Type: "url"},
+				{Key: "auth_token", Type: "string", Optional: true},
+				{Key: "queue_limit", Type: "number", Optional: true},
+			},
+		}, nil
+	}
+	minioGetConfigKVMock = func(key string) ([]byte, error) {
+		return []byte(`# MINIO_NOTIFY_WEBHOOK_ENABLE=off
+notify_webhook endpoint= auth_token= queue_limit=0
+notify_webhook:primary queue_limit=0 endpoint=http://primary auth_token=secret client_password=secret
+notify_webhook:audit endpoint=http://audit auth_token= queue_limit=0 comment="audit events"
+`), nil
+	}
+	minioServerInfoMock = func(ctx context.Context) (madmin.InfoMessage, error) {
+		return madmin.InfoMessage{Services: madmin.Services{
+			Notifications: []map[string][]madmin.TargetIDStatus{
+				{"webhook": {
+					{"primary": {Status: "online"}},
+					{"audit": {Status: "offline"}},
+				}},
+				{"amqp": {{"primary": {Status: "offline"}}}},
+			},
+		}}, nil
+	}
+}
+
+func TestListConfigTargets(t *testing.T) {
+	assert := assert.New(t)
+	adminClient := adminClientMock{}
+	mockWebhookTargets()
+	targets, err := listConfigTargets(context.Background(), adminClient, "notify_webhook")
+	if assert.NoError(err) && assert.Equal(3, len(targets.Targets)) {
+		assert.True(targets.MultipleTargets)
+		// Test-1 : the default target is disabled without its required keys
+		assert.Equal("", targets.Targets[0].Name)
+		assert.False(targets.Targets[0].Enabled)
+		assert.Equal("", targets.Targets[0].Status)
+		// Test-2 : named targets are sorted with the status reported by the server
+		audit := targets.Targets[1]
+		assert.Equal("audit", audit.Name)
+		assert.True(audit.Enabled)
+		assert.Equal("offline", audit.Status)
+		assert.Equal(&models.ConfigurationKV{Key: "comment", Value: "audit events"}, audit.KeyValues[3])
+		// Test-3 : keys follow the help order and secrets are masked
+		primary := targets.Targets[2]
+		assert.Equal("online", primary.Status)
+		assert.Equal("endpoint", primary.KeyValues[0].Key)
+		assert.Equal(configMaskedValue, primary.KeyValues[1].Value)
+		// Test-4 : secrets missing from the help are masked too
+		assert.Equal(&models.ConfigurationKV{Key: "client_password", Value: configMaskedValue}, primary.KeyValues[3])
+	}
+	// Test-5 : the targets are listed when the status isn't available
+	minioServerInfoMock = func(ctx context.Context) (madmin.InfoMessage, error) {
+		return madmin.InfoMessage{}, errors.New("timeout")
+	}
+	target, err := getConfigTarget(context.Background(), adminClient, "notify_webhook", "primary")
+	if assert.NoError(err) {
+		assert.Equal("", target.Status)
+	}
+	_, err = getConfigTarget(context.Background(), adminClient, "notify_webhook", "missing")
+	assert.True(errors.Is(err, errConfigTargetNotFound))
+}
+
+func TestSetAndDeleteConfigTarget(t *testing.T) {
+	assert := assert.New(t)
+	defer useTempDataDir(t)()
+	adminClient := adminClientMock{}
+	mockWebhookTargets()
+	minioGetServerConfigMock = func() ([]byte, error) {
+		return []byte("notify_webhook:primary endpoint=http://primary\n"), nil
+	}
+	var set, deleted []string
+	minioSetConfigKVMock = func(kv string) (bool, error) {
+		set = append(set, kv)
+		return true, nil
+	}
+	minioDelConfigKVMock = func(kv string) error {
+		deleted = append(deleted, kv)
+		return nil
+	}
+	// Test-1 : set a named target keeping its stored secret
+	restart, err := setConfigTarget(context.Background(), adminClient, "admin", "notify_webhook", "primary", []*models.ConfigurationKV{
+		{Key: "endpoint", Value: "http://new"},
+		{Key: "auth_token", Value: configMaskedValue},
+	})
+	if assert.NoError(err) {
+		assert.True(restart)
+		assert.Equal([]string{"notify_webhook:primary endpoint=http://new"}, set)
+	}
+	// Test-2 : delete a named target
+	_, err = deleteConfigTarget(context.Background(), adminClient, "admin", "notify_webhook", "audit")
+	assert.NoError(err)
+	assert.Equal([]string{"notify_webhook:audit"}, deleted)
+	_, err = deleteConfigTarget(context.Background(), adminClient, "admin", "notify_webhook", "missing")
+	assert.True(errors.Is(err, errConfigTargetNotFound))
+	// Test-3 : the default target isn't a named one
+	_, err = setConfigTarget(context.Background(), adminClient, "admin", "notify_webhook", "_", []*models.ConfigurationKV{{Key: "endpoint", Value: "http://a"}})
+	assert.True(errors.Is(err, errInvalidConfigValue))
+	// Test-4 : subsystems without named targets
+	minioHelpConfigKVMock = func(subSys, key string, envOnly bool) (madmin.Help, error) {
+		return madmin.Help{SubSys: "region", KeysHelp: madmin.HelpKVS{{Key: "name", Type: "string"}}}, nil
+	}
+	_, err = setConfigTarget(context.Background(), adminClient, "admin", "region", "eu", []*models.ConfigurationKV{{Key: "name", Value: "eu"}})
+	assert.True(errors.Is(err, errInvalidConfigValue))
+}
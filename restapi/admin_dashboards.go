@@ -0,0 +1,637 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"regexp"
+	"sort"
+	"sync"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/admin_api"
+	iampolicy "github.com/minio/pkg/iam/policy"
+)
+
+// dashboardsFile is the file of the Console data directory holding the user defined
+// widgets and dashboards, widgets use the same format as the built-in `Metric`
+const dashboardsFile = "dashboards.json"
+
+// userWidgetFirstID is the first ID assigned to user defined widgets so they don't
+// collide with the built-in ones
+const userWidgetFirstID = 1000
+
+// widgetVariableName is the form of the widget variable names, the same pattern
+// finds the `$name` references replaced in the widget targets
+const widgetVariableName = `[a-zA-Z_][a-zA-Z0-9_]*`
+
+var (
+	widgetVariableNameRegex      = regexp.MustCompile(`^` + widgetVariableName + `$`)
+	widgetVariableReferenceRegex = regexp.MustCompile(`\$(` + widgetVariableName + `)`)
+)
+
+func registerDashboardsHandlers(api *operations.ConsoleAPI) {
+	// list dashboard widgets
+	api.AdminAPIListDashboardWidgetsHandler = admin_api.ListDashboardWidgetsHandlerFunc(func(params admin_api.ListDashboardWidgetsParams, session *models.Principal) middleware.Responder {
+		widgetsResp, err := getListDashboardWidgetsResponse(session)
+		if err != nil {
+			return admin_api.NewListDashboardWidgetsDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewListDashboardWidgetsOK().WithPayload(widgetsResp)
+	})
+	// create dashboard widget
+	api.AdminAPICreateDashboardWidgetHandler = admin_api.CreateDashboardWidgetHandlerFunc(func(params admin_api.CreateDashboardWidgetParams, session *models.Principal) middleware.Responder {
+		widgetResp, err := getCreateDashboardWidgetResponse(session, params.Body)
+		if err != nil {
+			return admin_api.NewCreateDashboardWidgetDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewCreateDashboardWidgetCreated().WithPayload(widgetResp)
+	})
+	// get dashboard widget
+	api.AdminAPIGetDashboardWidgetHandler = admin_api.GetDashboardWidgetHandlerFunc(func(params admin_api.GetDashboardWidgetParams, session *models.Principal) middleware.Responder {
+		widgetResp, err := getDashboardWidgetResponse(session, params.WidgetID)
+		if err != nil {
+			return admin_api.NewGetDashboardWidgetDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewGetDashboardWidgetOK().WithPayload(widgetResp)
+	})
+	// update dashboard widget
+	api.AdminAPIUpdateDashboardWidgetHandler = admin_api.UpdateDashboardWidgetHandlerFunc(func(params admin_api.UpdateDashboardWidgetParams, session *models.Principal) middleware.Responder {
+		widgetResp, err := getUpdateDashboardWidgetResponse(session, params.WidgetID, params.Body)
+		if err != nil {
+			return admin_api.NewUpdateDashboardWidgetDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewUpdateDashboardWidgetOK().WithPayload(widgetResp)
+	})
+	// delete dashboard widget
+	api.AdminAPIDeleteDashboardWidgetHandler = admin_api.DeleteDashboardWidgetHandlerFunc(func(params admin_api.DeleteDashboardWidgetParams, session *models.Principal) middleware.Responder {
+		if err := getDeleteDashboardWidgetResponse(session, params.WidgetID); err != nil {
+			return admin_api.NewDeleteDashboardWidgetDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewDeleteDashboardWidgetNoContent()
+	})
+	// list dashboards
+	api.AdminAPIListDashboardsHandler = admin_api.ListDashboardsHandlerFunc(func(params admin_api.ListDashboardsParams, session *models.Principal) middleware.Responder {
+		dashboardsResp, err := getListDashboardsResponse(session)
+		if err != nil {
+			return admin_api.NewListDashboardsDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewListDashboardsOK().WithPayload(dashboardsResp)
+	})
+	// create dashboard
+	api.AdminAPICreateDashboardHandler = admin_api.CreateDashboardHandlerFunc(func(params admin_api.CreateDashboardParams, session *models.Principal) middleware.Responder {
+		dashboardResp, err := getCreateDashboardResponse(session, params.Body)
+		if err != nil {
+			return admin_api.NewCreateDashboardDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewCreateDashboardCreated().WithPayload(dashboardResp)
+	})
+	// get dashboard
+	api.AdminAPIGetDashboardHandler = admin_api.GetDashboardHandlerFunc(func(params admin_api.GetDashboardParams, session *models.Principal) middleware.Responder {
+		dashboardResp, err := getDashboardResponse(session, params.Name)
+		if err != nil {
+			return admin_api.NewGetDashboardDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewGetDashboardOK().WithPayload(dashboardResp)
+	})
+	// update dashboard
+	api.AdminAPIUpdateDashboardHandler = admin_api.UpdateDashboardHandlerFunc(func(params admin_api.UpdateDashboardParams, session *models.Principal) middleware.Responder {
+		dashboardResp, err := getUpdateDashboardResponse(session, params.Name, params.Body)
+		if err != nil {
+			return admin_api.NewUpdateDashboardDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewUpdateDashboardOK().WithPayload(dashboardResp)
+	})
+	// delete dashboard
+	api.AdminAPIDeleteDashboardHandler = admin_api.DeleteDashboardHandlerFunc(func(params admin_api.DeleteDashboardParams, session *models.Principal) middleware.Responder {
+		if err := getDeleteDashboardResponse(session, params.Name); err != nil {
+			return admin_api.NewDeleteDashboardDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewDeleteDashboardNoContent()
+	})
+}
+
+// Dashboard is a named arrangement of widgets
+type Dashboard struct {
+	Name        string
+	Description string
+	Panels      []DashboardPanel
+}
+
+// DashboardPanel places a widget, either built-in or user defined, in a dashboard
+type DashboardPanel struct {
+	WidgetID int32
+	GridPos  GridPos
+}
+
+type dashboardsConfig struct {
+	Widgets    []Metric
+	Dashboards []Dashboard
+}
+
+// dashboardStore keeps the user defined widgets and dashboards in a file of the
+// Console data directory
+type dashboardStore struct {
+	sync.Mutex
+	file   string
+	loaded bool
+	config dashboardsConfig
+}
+
+var globalDashboardStore = &dashboardStore{file: dashboardsFile}
+
+// load reads the store file the first time it's needed, callers must hold the lock
+func (s *dashboardStore) load() error {
+	if s.loaded {
+		return nil
+	}
+	var config dashboardsConfig
+	if err := readDataFile(s.file, &config); err != nil {
+		return err
+	}
+	s.config = config
+	s.loaded = true
+	return nil
+}
+
+// save writes the given configuration and makes it the current one, callers must hold the lock
+func (s *dashboardStore) save(config dashboardsConfig) error {
+	if err := writeDataFile(s.file, config); err != nil {
+		return err
+	}
+	s.config = config
+	return nil
+}
+
+// listWidgets returns the user defined widgets, errors loading the store are
+// logged so the built-in widgets keep working
+func (s *dashboardStore) listWidgets() []Metric {
+	s.Lock()
+	defer s.Unlock()
+	if err := s.load(); err != nil {
+		LogError("unable to load dashboards: %v", err)
+		return nil
+	}
+	return append([]Metric{}, s.config.Widgets...)
+}
+
+func (s *dashboardStore) addWidget(m Metric) (Metric, error) {
+	if err := validateWidgetVariables(m); err != nil {
+		return Metric{}, err
+	}
+	s.Lock()
+	defer s.Unlock()
+	if err := s.load(); err != nil {
+		return Metric{}, err
+	}
+	m.ID = userWidgetFirstID
+	for _, w := range s.config.Widgets {
+		if w.ID >= m.ID {
+			m.ID = w.ID + 1
+		}
+	}
+	config := s.config
+	config.Widgets = append(append([]Metric{}, s.config.Widgets...), m)
+	return m, s.save(config)
+}
+
+func (s *dashboardStore) updateWidget(m Metric) (Metric, error) {
+	if err := validateWidgetVariables(m); err != nil {
+		return Metric{}, err
+	}
+	s.Lock()
+	defer s.Unlock()
+	if err := s.load(); err != nil {
+		return Metric{}, err
+	}
+	config := s.config
+	config.Widgets = append([]Metric{}, s.config.Widgets...)
+	for i, w := range config.Widgets {
+		if w.ID == m.ID {
+			config.Widgets[i] = m
+			return m, s.save(config)
+		}
+	}
+	return Metric{}, ErrorGenericNotFound
+}
+
+// deleteWidget removes a user defined widget which is not used by any dashboard
+func (s *dashboardStore) deleteWidget(id int32) error {
+	s.Lock()
+	defer s.Unlock()
+	if err := s.load(); err != nil {
+		return err
+	}
+	for _, d := range s.config.Dashboards {
+		for _, panel := range d.Panels {
+			if panel.WidgetID == id {
+				return errDashboardWidgetInUse
+			}
+		}
+	}
+	config := s.config
+	config.Widgets = nil
+	found := false
+	for _, w := range s.config.Widgets {
+		if w.ID == id {
+			found = true
+			continue
+		}
+		config.Widgets = append(config.Widgets, w)
+	}
+	if !found {
+		return ErrorGenericNotFound
+	}
+	return s.save(config)
+}
+
+func (s *dashboardStore) listDashboards() ([]Dashboard, error) {
+	s.Lock()
+	defer s.Unlock()
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	dashboards := append([]Dashboard{}, s.config.Dashboards...)
+	sort.Slice(dashboards, func(i, j int) bool {
+		return dashboards[i].Name < dashboards[j].Name
+	})
+	return dashboards, nil
+}
+
+func (s *dashboardStore) getDashboard(name string) (Dashboard, error) {
+	s.Lock()
+	defer s.Unlock()
+	if err := s.load(); err != nil {
+		return Dashboard{}, err
+	}
+	for _, d := range s.config.Dashboards {
+		if d.Name == name {
+			return d, nil
+		}
+	}
+	return Dashboard{}, ErrorGenericNotFound
+}
+
+// widgetExists reports whether a widget is built-in or user defined, callers must hold the lock
+func (s *dashboardStore) widgetExists(id int32) bool {
+	for _, w := range widgets {
+		if w.ID == id {
+			return true
+		}
+	}
+	for _, w := range s.config.Widgets {
+		if w.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// putDashboard creates or replaces the dashboard previously named name, an empty name creates a new dashboard
+func (s *dashboardStore) putDashboard(name string, d Dashboard) error {
+	s.Lock()
+	defer s.Unlock()
+	if err := s.load(); err != nil {
+		return err
+	}
+	if d.Name == "" {
+		return errDashboardNameRequired
+	}
+	for _, panel := range d.Panels {
+		if !s.widgetExists(panel.WidgetID) {
+			return errDashboardUnknownWidget
+		}
+	}
+	config := s.config
+	config.Dashboards = nil
+	found := false
+	for _, current := range s.config.Dashboards {
+		if current.Name == d.Name && current.Name != name {
+			return errDashboardAlreadyExists
+		}
+		if name != "" && current.Name == name {
+			found = true
+			current = d
+		}
+		config.Dashboards = append(config.Dashboards, current)
+	}
+	if name != "" && !found {
+		return ErrorGenericNotFound
+	}
+	if name == "" {
+		config.Dashboards = append(config.Dashboards, d)
+	}
+	return s.save(config)
+}
+
+func (s *dashboardStore) deleteDashboard(name string) error {
+	s.Lock()
+	defer s.Unlock()
+	if err := s.load(); err != nil {
+		return err
+	}
+	config := s.config
+	config.Dashboards = nil
+	found := false
+	for _, d := range s.config.Dashboards {
+		if d.Name == name {
+			found = true
+			continue
+		}
+		config.Dashboards = append(config.Dashboards, d)
+	}
+	if !found {
+		return ErrorGenericNotFound
+	}
+	return s.save(config)
+}
+
+// validateWidgetVariables checks the variable names can be referenced as `$name`
+// in the widget targets
+func validateWidgetVariables(m Metric) error {
+	for _, variable := range m.Variables {
+		if !widgetVariableNameRegex.MatchString(variable.Name) {
+			return errInvalidWidgetVariable
+		}
+	}
+	return nil
+}
+
+func isBuiltInWidget(id int32) bool {
+	for _, w := range widgets {
+		if w.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+func gridPosToModel(pos GridPos) *models.DashboardGridPos {
+	return &models.DashboardGridPos{H: pos.H, W: pos.W, X: pos.X, Y: pos.Y}
+}
+
+func gridPosFromModel(pos *models.DashboardGridPos) GridPos {
+	if pos == nil {
+		return GridPos{}
+	}
+	return GridPos{H: pos.H, W: pos.W, X: pos.X, Y: pos.Y}
+}
+
+// metricToDashboardWidget serializes a widget definition for the API
+func metricToDashboardWidget(m Metric) *models.DashboardWidget {
+	widget := &models.DashboardWidget{
+		ID:            m.ID,
+		Title:         swag.String(m.Title),
+		Type:          swag.String(m.Type),
+		Unit:          m.Unit,
+		BuiltIn:       isBuiltInWidget(m.ID),
+		MaxDataPoints: m.MaxDataPoints,
+		GridPos:       gridPosToModel(m.GridPos),
+		Targets:       []*models.DashboardWidgetTarget{},
+	}
+	if len(m.Options.ReduceOptions.Calcs) > 0 {
+		widget.Options = &models.DashboardWidgetOptions{
+			ReduceOptions: &models.DashboardWidgetReduceOptions{
+				Calcs: m.Options.ReduceOptions.Calcs,
+			},
+		}
+	}
+	for _, variable := range m.Variables {
+		widget.Variables = append(widget.Variables, variable.Name)
+	}
+	for _, target := range m.Targets {
+		widget.Targets = append(widget.Targets, &models.DashboardWidgetTarget{
+			Expr:         swag.String(target.Expr),
+			Interval:     target.Interval,
+			LegendFormat: target.LegendFormat,
+			Step:         target.Step,
+		})
+	}
+	return widget
+}
+
+// dashboardWidgetToMetric builds a widget definition from an API request
+func dashboardWidgetToMetric(widget *models.DashboardWidget) Metric {
+	m := Metric{
+		ID:            widget.ID,
+		Title:         swag.StringValue(widget.Title),
+		Type:          swag.StringValue(widget.Type),
+		Unit:          widget.Unit,
+		MaxDataPoints: widget.MaxDataPoints,
+		GridPos:       gridPosFromModel(widget.GridPos),
+	}
+	if widget.Options != nil && widget.Options.ReduceOptions != nil {
+		m.Options.ReduceOptions.Calcs = widget.Options.ReduceOptions.Calcs
+	}
+	for _, variable := range widget.Variables {
+		m.Variables = append(m.Variables, WidgetLabel{Name: variable})
+	}
+	for _, target := range widget.Targets {
+		if target == nil {
+			continue
+		}
+		m.Targets = append(m.Targets, Target{
+			Expr:         swag.StringValue(target.Expr),
+			Interval:     target.Interval,
+			LegendFormat: target.LegendFormat,
+			Step:         target.Step,
+		})
+	}
+	return m
+}
+
+func dashboardToModel(d Dashboard) *models.Dashboard {
+	dashboard := &models.Dashboard{
+		Name:        swag.String(d.Name),
+		Description: d.Description,
+		Panels:      []*models.DashboardPanel{},
+	}
+	for _, panel := range d.Panels {
+		dashboard.Panels = append(dashboard.Panels, &models.DashboardPanel{
+			WidgetID: swag.Int32(panel.WidgetID),
+			GridPos:  gridPosToModel(panel.GridPos),
+		})
+	}
+	return dashboard
+}
+
+func dashboardFromModel(dashboard *models.Dashboard) Dashboard {
+	d := Dashboard{
+		Name:        swag.StringValue(dashboard.Name),
+		Description: dashboard.Description,
+	}
+	for _, panel := range dashboard.Panels {
+		if panel == nil {
+			continue
+		}
+		d.Panels = append(d.Panels, DashboardPanel{
+			WidgetID: swag.Int32Value(panel.WidgetID),
+			GridPos:  gridPosFromModel(panel.GridPos),
+		})
+	}
+	return d
+}
+
+// checkDashboardsAccess fails unless the session can read the dashboards, or
+// change them when write is set. The dashboards are shared by every user and
+// the queries of the user defined widgets run with the Prometheus access of Console
+func checkDashboardsAccess(session *models.Principal, write bool) error {
+	var action iampolicy.Action = iampolicy.ServerInfoAdminAction
+	if write {
+		action = iampolicy.ConfigUpdateAdminAction
+	}
+	if !sessionAllowsAction(session, action) {
+		return errAccessDenied
+	}
+	return nil
+}
+
+// getListDashboardWidgetsResponse returns the built-in widgets followed by the user defined ones
+func getListDashboardWidgetsResponse(session *models.Principal) (*models.DashboardWidgetList, *models.Error) {
+	if err := checkDashboardsAccess(session, false); err != nil {
+		return nil, prepareError(err)
+	}
+	widgetList := &models.DashboardWidgetList{}
+	for _, m := range getWidgets() {
+		widgetList.Widgets = append(widgetList.Widgets, metricToDashboardWidget(m))
+	}
+	return widgetList, nil
+}
+
+func getDashboardWidgetResponse(session *models.Principal, widgetID int32) (*models.DashboardWidget, *models.Error) {
+	if err := checkDashboardsAccess(session, false); err != nil {
+		return nil, prepareError(err)
+	}
+	for _, m := range getWidgets() {
+		if m.ID == widgetID {
+			return metricToDashboardWidget(m), nil
+		}
+	}
+	return nil, prepareError(ErrorGenericNotFound)
+}
+
+func getCreateDashboardWidgetResponse(session *models.Principal, params *models.DashboardWidget) (*models.DashboardWidget, *models.Error) {
+	if err := checkDashboardsAccess(session, true); err != nil {
+		return nil, prepareError(err)
+	}
+	if params == nil {
+		return nil, prepareError(errDashboardBodyNotInRequest)
+	}
+	m, err := globalDashboardStore.addWidget(dashboardWidgetToMetric(params))
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return metricToDashboardWidget(m), nil
+}
+
+func getUpdateDashboardWidgetResponse(session *models.Principal, widgetID int32, params *models.DashboardWidget) (*models.DashboardWidget, *models.Error) {
+	if err := checkDashboardsAccess(session, true); err != nil {
+		return nil, prepareError(err)
+	}
+	if params == nil {
+		return nil, prepareError(errDashboardBodyNotInRequest)
+	}
+	if isBuiltInWidget(widgetID) {
+		return nil, prepareError(errBuiltInDashboardWidget)
+	}
+	m := dashboardWidgetToMetric(params)
+	m.ID = widgetID
+	m, err := globalDashboardStore.updateWidget(m)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return metricToDashboardWidget(m), nil
+}
+
+func getDeleteDashboardWidgetResponse(session *models.Principal, widgetID int32) *models.Error {
+	if err := checkDashboardsAccess(session, true); err != nil {
+		return prepareError(err)
+	}
+	if isBuiltInWidget(widgetID) {
+		return prepareError(errBuiltInDashboardWidget)
+	}
+	if err := globalDashboardStore.deleteWidget(widgetID); err != nil {
+		return prepareError(err)
+	}
+	return nil
+}
+
+func getListDashboardsResponse(session *models.Principal) (*models.DashboardList, *models.Error) {
+	if err := checkDashboardsAccess(session, false); err != nil {
+		return nil, prepareError(err)
+	}
+	dashboards, err := globalDashboardStore.listDashboards()
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	dashboardList := &models.DashboardList{}
+	for _, d := range dashboards {
+		dashboardList.Dashboards = append(dashboardList.Dashboards, dashboardToModel(d))
+	}
+	return dashboardList, nil
+}
+
+func getDashboardResponse(session *models.Principal, name string) (*models.Dashboard, *models.Error) {
+	if err := checkDashboardsAccess(session, false); err != nil {
+		return nil, prepareError(err)
+	}
+	d, err := globalDashboardStore.getDashboard(name)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return dashboardToModel(d), nil
+}
+
+func getCreateDashboardResponse(session *models.Principal, params *models.Dashboard) (*models.Dashboard, *models.Error) {
+	if err := checkDashboardsAccess(session, true); err != nil {
+		return nil, prepareError(err)
+	}
+	if params == nil {
+		return nil, prepareError(errDashboardBodyNotInRequest)
+	}
+	d := dashboardFromModel(params)
+	if err := globalDashboardStore.putDashboard("", d); err != nil {
+		return nil, prepareError(err)
+	}
+	return dashboardToModel(d), nil
+}
+
+func getUpdateDashboardResponse(session *models.Principal, name string, params *models.Dashboard) (*models.Dashboard, *models.Error) {
+	if err := checkDashboardsAccess(session, true); err != nil {
+		return nil, prepareError(err)
+	}
+	if params == nil {
+		return nil, prepareError(errDashboardBodyNotInRequest)
+	}
+	d := dashboardFromModel(params)
+	if err := globalDashboardStore.putDashboard(name, d); err != nil {
+		return nil, prepareError(err)
+	}
+	return dashboardToModel(d), nil
+}
+
+func getDeleteDashboardResponse(session *models.Principal, name string) *models.Error {
+	if err := checkDashboardsAccess(session, true); err != nil {
+		return prepareError(err)
+	}
+	if err := globalDashboardStore.deleteDashboard(name); err != nil {
+		return prepareError(err)
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations/admin_api"
+	"github.com/stretchr/testify/assert"
+)
+
+// useTempDataDir points the Console data directory to a temporary one until the returned function is called
+func useTempDataDir(t *testing.T) func() {
+	dir, err := ioutil.TempDir("", "console-data")
+	if err != nil {
+		t.Fatal(err)
+	}
+	previous, set := os.LookupEnv(ConsoleDataDir)
+	os.Setenv(ConsoleDataDir, dir)
+	return func() {
+		if set {
+			os.Setenv(ConsoleDataDir, previous)
+		} else {
+			os.Unsetenv(ConsoleDataDir)
+		}
+		os.RemoveAll(dir)
+	}
+}
+
+func TestDashboardStore(t *testing.T) {
+	assert := assert.New(t)
+	defer useTempDataDir(t)()
+	store := &dashboardStore{file: dashboardsFile}
+	// Test-1 : user widgets get IDs after the built-in ones
+	first, err := store.addWidget(Metric{Title: "Requests", Type: "graph", Targets: []Target{{Expr: "sum(rate(minio_s3_requests_total[5m]))"}}})
+	assert.NoError(err)
+	assert.Equal(int32(userWidgetFirstID), first.ID)
+	second, err := store.addWidget(Metric{Title: "Errors", Type: "stat"})
+	assert.NoError(err)
+	assert.Equal(int32(userWidgetFirstID+1), second.ID)
+	// Test-2 : dashboards can only reference existing widgets
+	err = store.putDashboard("", Dashboard{Name: "ops", Panels: []DashboardPanel{{WidgetID: first.ID}, {WidgetID: 1}}})
+	assert.NoError(err)
+	err = store.putDashboard("", Dashboard{Name: "other", Panels: []DashboardPanel{{WidgetID: 5000}}})
+	assert.Equal(errDashboardUnknownWidget, err)
+	err = store.putDashboard("", Dashboard{Name: "ops"})
+	assert.Equal(errDashboardAlreadyExists, err)
+	err = store.putDashboard("", Dashboard{})
+	assert.Equal(errDashboardNameRequired, err)
+	// Test-3 : widgets used by a dashboard can't be deleted
+	assert.Equal(errDashboardWidgetInUse, store.deleteWidget(first.ID))
+	assert.NoError(store.deleteWidget(second.ID))
+	assert.Equal(ErrorGenericNotFound, store.deleteWidget(second.ID))
+	// Test-4 : dashboards can be renamed
+	assert.NoError(store.putDashboard("ops", Dashboard{Name: "operations", Panels: []DashboardPanel{{WidgetID: first.ID}}}))
+	_, err = store.getDashboard("ops")
+	assert.Equal(ErrorGenericNotFound, err)
+	assert.Equal(ErrorGenericNotFound, store.putDashboard("ops", Dashboard{Name: "ops"}))
+	// Test-5 : the store is persisted in the data directory
+	reloaded := &dashboardStore{file: dashboardsFile}
+	widgets := reloaded.listWidgets()
+	if assert.Equal(1, len(widgets)) {
+		assert.Equal(first, widgets[0])
+	}
+	dashboard, err := reloaded.getDashboard("operations")
+	if assert.NoError(err) {
+		assert.Equal(1, len(dashboard.Panels))
+	}
+	assert.NoError(reloaded.deleteDashboard("operations"))
+	assert.Equal(ErrorGenericNotFound, reloaded.deleteDashboard("operations"))
+	// Test-6 : variable names must be usable as `$name` in the targets
+	for _, name := range []string{"", "1bucket", "bucket-name", "$bucket"} {
+		_, err = store.addWidget(Metric{Title: "Errors", Type: "stat", Variables: []WidgetLabel{{Name: name}}})
+		assert.Equal(errInvalidWidgetVariable, err)
+		_, err = store.updateWidget(Metric{ID: first.ID, Title: "Errors", Type: "stat", Variables: []WidgetLabel{{Name: name}}})
+		assert.Equal(errInvalidWidgetVariable, err)
+	}
+}
+
+func TestDashboardWidgetConversion(t *testing.T) {
+	assert := assert.New(t)
+	widget := &models.DashboardWidget{
+		Title:         swag.String("Bucket requests"),
+		Type:          swag.String("graph"),
+		Unit:          "reqps",
+		MaxDataPoints: 100,
+		Options: &models.DashboardWidgetOptions{
+			ReduceOptions: &models.DashboardWidgetReduceOptions{Calcs: []string{"last"}},
+		},
+		GridPos:   &models.DashboardGridPos{H: 8, W: 12},
+		Variables: []string{"bucket"},
+		Targets: []*models.DashboardWidgetTarget{
+			{Expr: swag.String(`sum(rate(minio_bucket_requests_total{bucket=~"$bucket"}[$__interval]))`), LegendFormat: "{{bucket}}", Step: 30},
+		},
+	}
+	m := dashboardWidgetToMetric(widget)
+	assert.Equal([]WidgetLabel{{Name: "bucket"}}, m.Variables)
+	assert.Equal([]string{"last"}, m.Options.ReduceOptions.Calcs)
+	assert.Equal(widget, metricToDashboardWidget(m))
+	// built-in widgets are flagged
+	assert.True(metricToDashboardWidget(widgets[0]).BuiltIn)
+}
+
+func TestGetWidgetDetailsFromStore(t *testing.T) {
+	assert := assert.New(t)
+	defer useTempDataDir(t)()
+	previousStore := globalDashboardStore
+	globalDashboardStore = &dashboardStore{file: dashboardsFile}
+	defer func() { globalDashboardStore = previousStore }()
+
+	m, err := globalDashboardStore.addWidget(Metric{
+		Title:     "Bucket requests",
+		Type:      "graph",
+		Variables: []WidgetLabel{{Name: "bucket"}, {Name: "bucket_2"}},
+		Targets:   []Target{{Expr: `sum(rate(minio_bucket_requests_total{bucket=~"$bucket",other=~"$bucket_2"}[5m]))`, LegendFormat: "{{bucket}}"}},
+	})
+	assert.NoError(err)
+
+	var query string
+	prometheus := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/api/v1/label/") {
+			values := []string{}
+			if r.URL.Path == "/api/v1/label/bucket/values" {
+				values = []string{"photos", "logs"}
+			}
+			if r.URL.Path == "/api/v1/label/bucket_2/values" {
+				values = []string{"backups"}
+			}
+			json.NewEncoder(w).Encode(LabelResponse{Status: "success", Data: values})
+			return
+		}
+		query = r.URL.Query().Get("query")
+		json.NewEncoder(w).Encode(PromResp{Status: "success", Data: PromRespData{ResultType: "matrix"}})
+	}))
+	defer prometheus.Close()
+
+	// Test-1 : user defined widgets are resolved and their variables replaced, names
+	// with digits and underscores included
+	details, errResp := getWidgetDetails(prometheus.URL, "minio-job", m.ID, nil, nil, nil)
+	if assert.Nil(errResp) {
+		assert.Equal("Bucket requests", details.Title)
+		assert.Equal(1, len(details.Targets))
+		assert.Equal(`sum(rate(minio_bucket_requests_total{bucket=~"(photos|logs)",other=~"(backups)"}[5m]))`, query)
+	}
+	// Test-2 : unknown widgets are not found
+	_, errResp = getWidgetDetails(prometheus.URL, "minio-job", m.ID+1, nil, nil, nil)
+	if assert.NotNil(errResp) {
+		assert.Equal(int32(404), errResp.Code)
+	}
+}
+
+func TestCheckDashboardsAccess(t *testing.T) {
+	assert := assert.New(t)
+	reader := &models.Principal{Actions: []string{"admin:ServerInfo"}}
+	editor := &models.Principal{Actions: []string{"admin:ServerInfo", "admin:ConfigUpdate"}}
+	// Test-1 : reading needs the server info permission
+	assert.NoError(checkDashboardsAccess(reader, false))
+	assert.Equal(errAccessDenied, checkDashboardsAccess(&models.Principal{Actions: []string{"s3:*"}}, false))
+	// Test-2 : changing needs the config update permission
+	assert.Equal(errAccessDenied, checkDashboardsAccess(reader, true))
+	assert.NoError(checkDashboardsAccess(editor, true))
+	_, errResp := getCreateDashboardWidgetResponse(reader, &models.DashboardWidget{})
+	if assert.NotNil(errResp) {
+		assert.Equal(int32(403), errResp.Code)
+	}
+	// Test-3 : the user defined widgets aren't run for the other sessions
+	_, errResp = getAdminInfoWidgetResponse(&models.Principal{}, admin_api.DashboardWidgetDetailsParams{WidgetID: userWidgetFirstID})
+	if assert.NotNil(errResp) {
+		assert.Equal(int32(403), errResp.Code)
+	}
+}
@@ -99,6 +99,8 @@ type healStatus struct {
 	// health color code.
 	HealthBeforeCols map[col]int64 `json:"healthBeforeCols"`
 	HealthAfterCols  map[col]int64 `json:"healthAfterCols"`
+	// names of the items found corrupted, sent to the webhooks once the heal finishes
+	corrupted []string
 }
 
 type healOptions struct {
@@ -106,6 +108,8 @@ type healOptions struct {
 	Prefix     string
 	ForceStart bool
 	ForceStop  bool
+	// RequestedBy is the user that started the heal
+	RequestedBy string
 	madmin.HealOpts
 }
 
@@ -139,6 +143,7 @@ func startHeal(ctx context.Context, conn WSConn, client MinioAdmin, hOpts *healO
 			hs.writeStatus(&res, conn)
 
 			if res.Summary == "finished" {
+				publishHealCorrupted(hOpts.RequestedBy, hOpts.BucketName, hOpts.Prefix, hOpts.DryRun, hs.corrupted)
 				return nil
 			}
 
@@ -224,6 +229,9 @@ func (h *healStatus) updateStats(i madmin.HealResultItem) error {
 	itemStatus.Before.Online, itemStatus.After.Online = beforeUp, afterUp
 	itemStatus.Before.Missing, itemStatus.After.Missing = i.GetMissingCounts()
 	itemStatus.Before.Corrupted, itemStatus.After.Corrupted = i.GetCorruptedCounts()
+	if itemStatus.Before.Corrupted > 0 {
+		h.corrupted = append(h.corrupted, itemStatus.Name)
+	}
 	itemStatus.Before.Offline, itemStatus.After.Offline = i.GetOfflineCounts()
 	itemStatus.Before.Drives = i.Before.Drives
 	itemStatus.After.Drives = i.After.Drives
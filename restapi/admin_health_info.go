@@ -28,6 +28,22 @@ import (
 	madmin "github.com/minio/madmin-go"
 )
 
+// healthDataTypes are the health info collected from the servers
+var healthDataTypes = []madmin.HealthDataType{
+	madmin.HealthDataTypePerfDrive,
+	madmin.HealthDataTypePerfNet,
+	madmin.HealthDataTypeMinioInfo,
+	madmin.HealthDataTypeMinioConfig,
+	madmin.HealthDataTypeSysCPU,
+	madmin.HealthDataTypeSysDriveHw,
+	madmin.HealthDataTypeSysDocker,
+	madmin.HealthDataTypeSysOsInfo,
+	madmin.HealthDataTypeSysLoad,
+	madmin.HealthDataTypeSysMem,
+	madmin.HealthDataTypeSysNet,
+	madmin.HealthDataTypeSysProcess,
+}
+
 // startHealthInfo starts fetching mc.ServerHealthInfo and
 // sends messages with the corresponding data on the websocket connection
 func startHealthInfo(ctx context.Context, conn WSConn, client MinioAdmin, deadline *time.Duration) error {
@@ -36,21 +52,6 @@ func startHealthInfo(ctx context.Context, conn WSConn, client MinioAdmin, deadli
 	}
 
 	// Fetch info of all servers (cluster or single server)
-	healthDataTypes := []madmin.HealthDataType{
-		madmin.HealthDataTypePerfDrive,
-		madmin.HealthDataTypePerfNet,
-		madmin.HealthDataTypeMinioInfo,
-		madmin.HealthDataTypeMinioConfig,
-		madmin.HealthDataTypeSysCPU,
-		madmin.HealthDataTypeSysDriveHw,
-		madmin.HealthDataTypeSysDocker,
-		madmin.HealthDataTypeSysOsInfo,
-		madmin.HealthDataTypeSysLoad,
-		madmin.HealthDataTypeSysMem,
-		madmin.HealthDataTypeSysNet,
-		madmin.HealthDataTypeSysProcess,
-	}
-
 	healthInfo, _, err := client.serverHealthInfo(ctx, healthDataTypes, *deadline)
 	if err != nil {
 		return err
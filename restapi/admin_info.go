@@ -23,7 +23,6 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
-	"regexp"
 	"strings"
 	"time"
 
@@ -48,7 +47,7 @@ func registerAdminInfoHandlers(api *operations.ConsoleAPI) {
 	})
 	// return single widget results
 	api.AdminAPIDashboardWidgetDetailsHandler = admin_api.DashboardWidgetDetailsHandlerFunc(func(params admin_api.DashboardWidgetDetailsParams, session *models.Principal) middleware.Responder {
-		infoResp, err := getAdminInfoWidgetResponse(params)
+		infoResp, err := getAdminInfoWidgetResponse(session, params)
 		if err != nil {
 			return admin_api.NewDashboardWidgetDetailsDefault(int(err.Code)).WithPayload(err)
 		}
@@ -107,10 +106,13 @@ type Metric struct {
 	ID            int32
 	Title         string
 	Type          string
+	Unit          string
 	Options       MetricOptions
 	Targets       []Target
 	GridPos       GridPos
 	MaxDataPoints int32
+	// Variables are extra labels whose values can be used as $name in the targets
+	Variables []WidgetLabel
 }
 
 type GridPos struct {
@@ -804,7 +806,8 @@ func getAdminInfoResponse(session *models.Principal) (*models.AdminInfoResponse,
 }
 
 func getUsageWidgetsForDeployment(prometheusURL string, mAdmin *madmin.AdminClient) (*models.AdminInfoResponse, *models.Error) {
-	if prometheusURL == "" {
+	// without Prometheus the widgets are served by the built-in collector once it has metrics
+	if prometheusURL == "" && (globalMetricsCollector == nil || !globalMetricsCollector.ready()) {
 		// create a minioClient interface implementation
 		// defining the client to be used
 		adminClient := AdminClient{Client: mAdmin}
@@ -878,22 +881,63 @@ func unmarshalPrometheus(endpoint string, data interface{}) bool {
 	return false
 }
 
-func getAdminInfoWidgetResponse(params admin_api.DashboardWidgetDetailsParams) (*models.WidgetDetails, *models.Error) {
+// getAdminInfoWidgetResponse returns the results of a widget, the user defined
+// widgets need the dashboards read permission since their queries are run by Console
+func getAdminInfoWidgetResponse(session *models.Principal, params admin_api.DashboardWidgetDetailsParams) (*models.WidgetDetails, *models.Error) {
+	if !isBuiltInWidget(params.WidgetID) {
+		if err := checkDashboardsAccess(session, false); err != nil {
+			return nil, prepareError(err)
+		}
+	}
 	prometheusURL := getPrometheusURL()
 	prometheusJobID := getPrometheusJobID()
 
 	return getWidgetDetails(prometheusURL, prometheusJobID, params.WidgetID, params.Step, params.Start, params.End)
 }
 
+// getWidgets returns the built-in widgets followed by the ones defined by the users
+func getWidgets() []Metric {
+	return append(append([]Metric{}, widgets...), globalDashboardStore.listWidgets()...)
+}
+
+// getWidgetLabels returns the labels to resolve for a widget, the common ones
+// plus the variables of the widget
+func getWidgetLabels(widgetID int32) []WidgetLabel {
+	widgetLabels := append([]WidgetLabel{}, labels...)
+	for _, m := range getWidgets() {
+		if m.ID != widgetID {
+			continue
+		}
+		for _, variable := range m.Variables {
+			found := false
+			for _, lbl := range widgetLabels {
+				if lbl.Name == variable.Name {
+					found = true
+					break
+				}
+			}
+			if !found {
+				widgetLabels = append(widgetLabels, variable)
+			}
+		}
+	}
+	return widgetLabels
+}
+
 func getWidgetDetails(prometheusURL string, prometheusJobID string, widgetID int32, step *int32, start *int64, end *int64) (*models.WidgetDetails, *models.Error) {
+	queryPrometheus := unmarshalPrometheus
+	if prometheusURL == "" && globalMetricsCollector != nil {
+		queryPrometheus = globalMetricsCollector.unmarshalPrometheus
+	}
 	labelResultsCh := make(chan LabelResults)
+	widgetLabels := getWidgetLabels(widgetID)
 
-	for _, lbl := range labels {
+	for _, lbl := range widgetLabels {
 		go func(lbl WidgetLabel) {
 			endpoint := fmt.Sprintf("%s/api/v1/label/%s/values", prometheusURL, lbl.Name)
 
 			var response LabelResponse
-			if unmarshalPrometheus(endpoint, &response) {
+			if queryPrometheus(endpoint, &response) {
 				return
 			}
 
@@ -912,7 +956,7 @@ LabelsWaitLoop:
 			break LabelsWaitLoop
 		case res := <-labelResultsCh:
 			labelMap[res.Label] = res.Response.Data
-			if len(labelMap) >= len(labels) {
+			if len(labelMap) >= len(widgetLabels) {
 				break LabelsWaitLoop
 			}
 		}
@@ -920,7 +964,7 @@ LabelsWaitLoop:
 
 	// launch a goroutines per widget
 
-	for _, m := range widgets {
+	for _, m := range getWidgets() {
 		if m.ID != widgetID {
 			continue
 		}
@@ -952,20 +996,19 @@ LabelsWaitLoop:
 				// replace the `$__interval` global for step with unit (s for seconds)
 				queryExpr := strings.ReplaceAll(target.Expr, "$__interval", fmt.Sprintf("%ds", 120))
 				if strings.Contains(queryExpr, "$") {
-					var re = regexp.MustCompile(`\$([a-z]+)`)
-
-					for _, match := range re.FindAllStringSubmatch(queryExpr, -1) {
-						if val, ok := labelMap[match[1]]; ok {
-							queryExpr = strings.ReplaceAll(queryExpr, "$"+match[1], fmt.Sprintf("(%s)", strings.Join(val, "|")))
+					queryExpr = widgetVariableReferenceRegex.ReplaceAllStringFunc(queryExpr, func(reference string) string {
+						if val, ok := labelMap[reference[1:]]; ok {
+							return fmt.Sprintf("(%s)", strings.Join(val, "|"))
 						}
-					}
+						return reference
+					})
 				}
 
 				queryExpr = strings.Replace(queryExpr, "${jobid}", prometheusJobID, -1)
 				endpoint := fmt.Sprintf("%s/api/v1/%s?query=%s%s", prometheusURL, apiType, url.QueryEscape(queryExpr), extraParamters)
 
 				var response PromResp
-				if unmarshalPrometheus(endpoint, &response) {
+				if queryPrometheus(endpoint, &response) {
 					return
 				}
 
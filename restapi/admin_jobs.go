@@ -0,0 +1,937 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/admin_api"
+	"github.com/minio/pkg/env"
+	iampolicy "github.com/minio/pkg/iam/policy"
+	"github.com/rs/xid"
+)
+
+const jobsFile = "jobs.json"
+
+const jobRunsFile = "job-runs.json"
+
+// jobReportsDir is the directory of the data directory holding the reports of the runs
+const jobReportsDir = "job-reports"
+
+// jobRunsHistoryLimit is the number of runs kept per job, the oldest ones are dropped first
+const jobRunsHistoryLimit = 50
+
+// jobRunLogLimit is the number of log lines kept per run
+const jobRunLogLimit = 500
+
+// jobsSchedulerInterval is how often the schedules are checked
+const jobsSchedulerInterval = 30 * time.Second
+
+const (
+	jobRunQueued    = "queued"
+	jobRunRunning   = "running"
+	jobRunSucceeded = "succeeded"
+	jobRunFailed    = "failed"
+)
+
+const (
+	jobTriggerSchedule = "schedule"
+	jobTriggerManual   = "manual"
+)
+
+func registerJobsHandlers(api *operations.ConsoleAPI) {
+	// list the jobs
+	api.AdminAPIListJobsHandler = admin_api.ListJobsHandlerFunc(func(params admin_api.ListJobsParams, session *models.Principal) middleware.Responder {
+		jobs, err := getListJobsResponse()
+		if err != nil {
+			return admin_api.NewListJobsDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewListJobsOK().WithPayload(jobs)
+	})
+	// create a job
+	api.AdminAPICreateJobHandler = admin_api.CreateJobHandlerFunc(func(params admin_api.CreateJobParams, session *models.Principal) middleware.Responder {
+		job, err := getCreateJobResponse(session, params)
+		if err != nil {
+			return admin_api.NewCreateJobDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewCreateJobCreated().WithPayload(job)
+	})
+	// get a job
+	api.AdminAPIGetJobHandler = admin_api.GetJobHandlerFunc(func(params admin_api.GetJobParams, session *models.Principal) middleware.Responder {
+		job, err := getJobResponse(params.ID)
+		if err != nil {
+			return admin_api.NewGetJobDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewGetJobOK().WithPayload(job)
+	})
+	// delete a job
+	api.AdminAPIDeleteJobHandler = admin_api.DeleteJobHandlerFunc(func(params admin_api.DeleteJobParams, session *models.Principal) middleware.Responder {
+		if err := getDeleteJobResponse(session, params.ID); err != nil {
+			return admin_api.NewDeleteJobDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewDeleteJobNoContent()
+	})
+	// pause the schedule of a job
+	api.AdminAPIPauseJobHandler = admin_api.PauseJobHandlerFunc(func(params admin_api.PauseJobParams, session *models.Principal) middleware.Responder {
+		job, err := getSetJobPausedResponse(session, params.ID, true)
+		if err != nil {
+			return admin_api.NewPauseJobDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewPauseJobOK().WithPayload(job)
+	})
+	// resume the schedule of a job
+	api.AdminAPIResumeJobHandler = admin_api.ResumeJobHandlerFunc(func(params admin_api.ResumeJobParams, session *models.Principal) middleware.Responder {
+		job, err := getSetJobPausedResponse(session, params.ID, false)
+		if err != nil {
+			return admin_api.NewResumeJobDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewResumeJobOK().WithPayload(job)
+	})
+	// run a job now
+	api.AdminAPIRunJobHandler = admin_api.RunJobHandlerFunc(func(params admin_api.RunJobParams, session *models.Principal) middleware.Responder {
+		run, err := getRunJobResponse(session, params)
+		if err != nil {
+			return admin_api.NewRunJobDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewRunJobAccepted().WithPayload(run)
+	})
+	// list the runs of a job
+	api.AdminAPIListJobRunsHandler = admin_api.ListJobRunsHandlerFunc(func(params admin_api.ListJobRunsParams, session *models.Principal) middleware.Responder {
+		runs, err := getListJobRunsResponse(params.ID)
+		if err != nil {
+			return admin_api.NewListJobRunsDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewListJobRunsOK().WithPayload(runs)
+	})
+	// get a run with its logs
+	api.AdminAPIGetJobRunHandler = admin_api.GetJobRunHandlerFunc(func(params admin_api.GetJobRunParams, session *models.Principal) middleware.Responder {
+		run, err := getJobRunResponse(params.ID, params.Run)
+		if err != nil {
+			return admin_api.NewGetJobRunDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewGetJobRunOK().WithPayload(run)
+	})
+	// download the report of a run
+	api.AdminAPIDownloadJobRunReportHandler = admin_api.DownloadJobRunReportHandlerFunc(func(params admin_api.DownloadJobRunReportParams, session *models.Principal) middleware.Responder {
+		name, data, err := getDownloadJobRunReportResponse(session, params.ID, params.Run)
+		if err != nil {
+			return admin_api.NewDownloadJobRunReportDefault(int(err.Code)).WithPayload(err)
+		}
+		// Custom response writer to set the content-disposition header to tell the
+		// HTTP client the name and extension of the file we are returning
+		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+			w.Header().Set("Content-Type", "application/octet-stream")
+			w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s", name))
+			io.Copy(w, bytes.NewReader(data))
+		})
+	})
+}
+
+// JobParams are the parameters of a job, each job type uses some of them
+type JobParams struct {
+	Bucket    string
+	Prefix    string
+	Recursive bool
+	DryRun    bool
+	DeepScan  bool
+	// Deadline is the number of seconds the health info collection can take
+	Deadline int64
+}
+
+// Job is a job run by the scheduler on a cron schedule
+type Job struct {
+	ID        string
+	Name      string
+	Type      string
+	Schedule  string
+	Paused    bool
+	Params    JobParams
+	Created   time.Time
+	CreatedBy string
+}
+
+// JobRun is a run of a job, Report is the file of the data directory where
+// the report of the run is stored, if any
+type JobRun struct {
+	ID          int64
+	JobID       string
+	Trigger     string
+	TriggeredBy string
+	Status      string
+	Queued      time.Time
+	Started     time.Time
+	Finished    time.Time
+	Summary     string
+	Error       string
+	Report      string
+	Logs        []string
+}
+
+// jobResult is the outcome of a successful run, the report is stored as JSON
+type jobResult struct {
+	Summary string
+	Report  interface{}
+}
+
+// jobType validates the parameters of the jobs of a type and runs them, logf
+// adds a line to the logs of the run, actions are the admin actions a session
+// needs to manage the jobs of the type
+type jobType struct {
+	actions  []iampolicy.Action
+	validate func(params JobParams) error
+	run      func(ctx context.Context, client MinioAdmin, params JobParams, logf func(format string, args ...interface{})) (jobResult, error)
+}
+
+// jobManager keeps the jobs and their runs in files of the Console data
+// directory and runs them, at most concurrency runs at the same time
+type jobManager struct {
+	sync.Mutex
+	jobsFile string
+	runsFile string
+	loaded   bool
+	jobs     []Job
+	runs     []JobRun
+	lastRun  int64
+	nextRun  map[string]time.Time
+	// cancel stops the run in progress of a job
+	cancel map[string]context.CancelFunc
+	slots  chan struct{}
+	types  map[string]jobType
+	// newClient returns the client used by the scheduled runs
+	newClient func() (MinioAdmin, error)
+}
+
+var globalJobManager = newJobManager(jobsFile, jobRunsFile, getJobsConcurrency(), newJobsAdminClient)
+
+func newJobManager(jobsFile, runsFile string, concurrency int, newClient func() (MinioAdmin, error)) *jobManager {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	return &jobManager{
+		jobsFile:  jobsFile,
+		runsFile:  runsFile,
+		nextRun:   map[string]time.Time{},
+		cancel:    map[string]context.CancelFunc{},
+		slots:     make(chan struct{}, concurrency),
+		types:     jobTypes,
+		newClient: newClient,
+	}
+}
+
+// getJobsConcurrency returns the number of runs allowed at the same time, 2 by default
+func getJobsConcurrency() int {
+	concurrency, err := strconv.Atoi(env.Get(ConsoleJobsConcurrency, "2"))
+	if err != nil || concurrency < 1 {
+		return 2
+	}
+	return concurrency
+}
+
+// newJobsAdminClient returns an admin client with the credentials configured
+// for the scheduled jobs, the sessions of the users expire so they can't be used
+func newJobsAdminClient() (MinioAdmin, error) {
+	accessKey, secretKey := env.Get(ConsoleJobsAccessKey, ""), env.Get(ConsoleJobsSecretKey, "")
+	if accessKey == "" || secretKey == "" {
+		return nil, errJobsCredentialsNotSet
+	}
+	mAdmin, err := newAdminFromCreds(accessKey, secretKey, getMinIOEndpoint(), getMinIOEndpointIsSecure())
+	if err != nil {
+		return nil, err
+	}
+	mAdmin.SetCustomTransport(GetConsoleSTSClient().Transport)
+	return AdminClient{Client: mAdmin}, nil
+}
+
+// load reads the jobs and runs files the first time they're needed, runs that
+// didn't finish before Console stopped are marked as failed. Callers must hold the lock
+func (m *jobManager) load() error {
+	if m.loaded {
+		return nil
+	}
+	var jobs []Job
+	if err := readDataFile(m.jobsFile, &jobs); err != nil {
+		return err
+	}
+	var runs []JobRun
+	if err := readDataFile(m.runsFile, &runs); err != nil {
+		return err
+	}
+	interrupted := false
+	for i := range runs {
+		if runs[i].ID > m.lastRun {
+			m.lastRun = runs[i].ID
+		}
+		if runs[i].Status == jobRunQueued || runs[i].Status == jobRunRunning {
+			runs[i].Status = jobRunFailed
+			runs[i].Error = "interrupted by a restart of Console"
+			interrupted = true
+		}
+	}
+	m.jobs = jobs
+	m.runs = runs
+	m.loaded = true
+	if interrupted {
+		return m.saveRuns()
+	}
+	return nil
+}
+
+// saveJobs writes the jobs file, callers must hold the lock
+func (m *jobManager) saveJobs() error {
+	return writeDataFile(m.jobsFile, m.jobs)
+}
+
+// saveRuns writes the runs file, callers must hold the lock
+func (m *jobManager) saveRuns() error {
+	return writeDataFile(m.runsFile, m.runs)
+}
+
+// find returns the index of the job, callers must hold the lock
+func (m *jobManager) find(id string) int {
+	for i := range m.jobs {
+		if m.jobs[i].ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// findRun returns the index of the run of the job, callers must hold the lock
+func (m *jobManager) findRun(jobID string, runID int64) int {
+	for i := range m.runs {
+		if m.runs[i].JobID == jobID && m.runs[i].ID == runID {
+			return i
+		}
+	}
+	return -1
+}
+
+// validateJob checks the name, type, schedule and parameters of a job
+func (m *jobManager) validateJob(job Job) error {
+	if strings.TrimSpace(job.Name) == "" {
+		return fmt.Errorf("%w: the name can't be empty", errInvalidJob)
+	}
+	typ, ok := m.types[job.Type]
+	if !ok {
+		return fmt.Errorf("%w: unknown type %s", errInvalidJob, job.Type)
+	}
+	if _, err := parseCronSchedule(job.Schedule); err != nil {
+		return fmt.Errorf("%w: %v", errInvalidJob, err)
+	}
+	if err := typ.validate(job.Params); err != nil {
+		return fmt.Errorf("%w: %v", errInvalidJob, err)
+	}
+	return nil
+}
+
+// allowed checks the session has the admin actions the jobs of the type need
+func (m *jobManager) allowed(session *models.Principal, jobType string) error {
+	typ, ok := m.types[jobType]
+	if !ok {
+		return fmt.Errorf("%w: unknown type %s", errInvalidJob, jobType)
+	}
+	for _, action := range typ.actions {
+		if !sessionAllowsAction(session, action) {
+			return fmt.Errorf("%w, it needs the %s permission", errJobNotAllowed, action)
+		}
+	}
+	return nil
+}
+
+// authorize checks the session has the admin actions the job needs
+func (m *jobManager) authorize(session *models.Principal, id string) error {
+	m.Lock()
+	defer m.Unlock()
+	if err := m.load(); err != nil {
+		return err
+	}
+	i := m.find(id)
+	if i < 0 {
+		return errJobNotFound
+	}
+	return m.allowed(session, m.jobs[i].Type)
+}
+
+// scheduleNext sets the next run of the job after now, callers must hold the lock
+func (m *jobManager) scheduleNext(job Job, now time.Time) {
+	schedule, err := parseCronSchedule(job.Schedule)
+	if err != nil {
+		delete(m.nextRun, job.ID)
+		return
+	}
+	m.nextRun[job.ID] = schedule.next(now)
+}
+
+// lastRunOf returns the latest run of the job, callers must hold the lock
+func (m *jobManager) lastRunOf(id string) *JobRun {
+	for i := len(m.runs) - 1; i >= 0; i-- {
+		if m.runs[i].JobID == id {
+			run := m.runs[i]
+			return &run
+		}
+	}
+	return nil
+}
+
+// jobModel returns the job with its next and last runs, callers must hold the lock
+func (m *jobManager) jobModel(job Job) *models.Job {
+	model := &models.Job{
+		ID:        job.ID,
+		Name:      job.Name,
+		Type:      job.Type,
+		Schedule:  job.Schedule,
+		Paused:    job.Paused,
+		Params:    getJobParamsModel(job.Params),
+		Created:   job.Created.UTC().Format(time.RFC3339),
+		CreatedBy: job.CreatedBy,
+	}
+	if next, ok := m.nextRun[job.ID]; ok && !job.Paused && !next.IsZero() {
+		model.NextRun = next.UTC().Format(time.RFC3339)
+	}
+	if run := m.lastRunOf(job.ID); run != nil {
+		model.LastRun = getJobRunModel(*run, false)
+	}
+	return model
+}
+
+func (m *jobManager) list() (*models.JobList, error) {
+	m.Lock()
+	defer m.Unlock()
+	if err := m.load(); err != nil {
+		return nil, err
+	}
+	list := &models.JobList{Jobs: []*models.Job{}}
+	for _, job := range m.jobs {
+		list.Jobs = append(list.Jobs, m.jobModel(job))
+	}
+	return list, nil
+}
+
+func (m *jobManager) get(id string) (*models.Job, error) {
+	m.Lock()
+	defer m.Unlock()
+	if err := m.load(); err != nil {
+		return nil, err
+	}
+	i := m.find(id)
+	if i < 0 {
+		return nil, errJobNotFound
+	}
+	return m.jobModel(m.jobs[i]), nil
+}
+
+// create validates and stores a new job, its first run is scheduled after now
+func (m *jobManager) create(job Job, now time.Time) (*models.Job, error) {
+	m.Lock()
+	defer m.Unlock()
+	if err := m.load(); err != nil {
+		return nil, err
+	}
+	if err := m.validateJob(job); err != nil {
+		return nil, err
+	}
+	job.ID = xid.New().String()
+	job.Created = now
+	m.jobs = append(m.jobs, job)
+	if err := m.saveJobs(); err != nil {
+		m.jobs = m.jobs[:len(m.jobs)-1]
+		return nil, err
+	}
+	m.scheduleNext(job, now)
+	return m.jobModel(job), nil
+}
+
+// setPaused pauses or resumes the schedule of the job, a resumed job runs on
+// its next scheduled time, the runs missed while paused are skipped
+func (m *jobManager) setPaused(id string, paused bool, now time.Time) (*models.Job, error) {
+	m.Lock()
+	defer m.Unlock()
+	if err := m.load(); err != nil {
+		return nil, err
+	}
+	i := m.find(id)
+	if i < 0 {
+		return nil, errJobNotFound
+	}
+	previous := m.jobs[i].Paused
+	m.jobs[i].Paused = paused
+	if err := m.saveJobs(); err != nil {
+		m.jobs[i].Paused = previous
+		return nil, err
+	}
+	m.scheduleNext(m.jobs[i], now)
+	return m.jobModel(m.jobs[i]), nil
+}
+
+// remove deletes the job with its runs and reports, a run in progress is canceled
+func (m *jobManager) remove(id string) error {
+	m.Lock()
+	defer m.Unlock()
+	if err := m.load(); err != nil {
+		return err
+	}
+	i := m.find(id)
+	if i < 0 {
+		return errJobNotFound
+	}
+	jobs := append(append([]Job{}, m.jobs[:i]...), m.jobs[i+1:]...)
+	if err := writeDataFile(m.jobsFile, jobs); err != nil {
+		return err
+	}
+	m.jobs = jobs
+	delete(m.nextRun, id)
+	if cancel, ok := m.cancel[id]; ok {
+		cancel()
+	}
+	var runs []JobRun
+	for _, run := range m.runs {
+		if run.JobID != id {
+			runs = append(runs, run)
+			continue
+		}
+		removeJobRunReport(run)
+	}
+	m.runs = runs
+	return m.saveRuns()
+}
+
+// removeJobRunReport deletes the report file of the run, if any
+func removeJobRunReport(run JobRun) {
+	if run.Report == "" {
+		return
+	}
+	if err := os.Remove(filepath.Join(getConsoleDataDir(), run.Report)); err != nil && !os.IsNotExist(err) {
+		LogError("unable to remove the report of run %d of job %s: %v", run.ID, run.JobID, err)
+	}
+}
+
+// trimRuns drops the oldest finished runs of the job beyond the history limit, callers must hold the lock
+func (m *jobManager) trimRuns(id string) {
+	count := 0
+	for _, run := range m.runs {
+		if run.JobID == id {
+			count++
+		}
+	}
+	var runs []JobRun
+	for _, run := range m.runs {
+		if run.JobID == id && count > jobRunsHistoryLimit && (run.Status == jobRunSucceeded || run.Status == jobRunFailed) {
+			count--
+			removeJobRunReport(run)
+			continue
+		}
+		runs = append(runs, run)
+	}
+	m.runs = runs
+}
+
+// updateRun applies fn to the run and saves the runs, callers must hold the lock
+func (m *jobManager) updateRun(jobID string, runID int64, fn func(run *JobRun)) {
+	i := m.findRun(jobID, runID)
+	if i < 0 {
+		return
+	}
+	fn(&m.runs[i])
+	if err := m.saveRuns(); err != nil {
+		LogError("unable to store run %d of job %s: %v", runID, jobID, err)
+	}
+}
+
+// trigger queues a run of the job with the client, a job only has one run in
+// progress at a time. When the client can't be created the run is recorded as failed
+func (m *jobManager) trigger(id, trigger, triggeredBy string, newClient func() (MinioAdmin, error), now time.Time) (*models.JobRun, error) {
+	m.Lock()
+	defer m.Unlock()
+	if err := m.load(); err != nil {
+		return nil, err
+	}
+	i := m.find(id)
+	if i < 0 {
+		return nil, errJobNotFound
+	}
+	job := m.jobs[i]
+	if _, ok := m.cancel[id]; ok {
+		return nil, errJobRunning
+	}
+	m.lastRun++
+	run := JobRun{
+		ID:          m.lastRun,
+		JobID:       id,
+		Trigger:     trigger,
+		TriggeredBy: triggeredBy,
+		Status:      jobRunQueued,
+		Queued:      now,
+	}
+	client, err := newClient()
+	if err != nil {
+		run.Status = jobRunFailed
+		run.Finished = now
+		run.Error = err.Error()
+	}
+	m.runs = append(m.runs, run)
+	m.trimRuns(id)
+	if err := m.saveRuns(); err != nil {
+		return nil, err
+	}
+	if run.Status == jobRunQueued {
+		ctx, cancel := context.WithCancel(context.Background())
+		m.cancel[id] = cancel
+		go m.execute(ctx, job, run.ID, client)
+	}
+	return getJobRunModel(run, false), nil
+}
+
+// execute runs the job once a slot is available
+func (m *jobManager) execute(ctx context.Context, job Job, runID int64, client MinioAdmin) {
+	defer func() {
+		m.Lock()
+		if cancel, ok := m.cancel[job.ID]; ok {
+			cancel()
+			delete(m.cancel, job.ID)
+		}
+		m.Unlock()
+	}()
+	select {
+	case m.slots <- struct{}{}:
+	case <-ctx.Done():
+		m.Lock()
+		m.updateRun(job.ID, runID, func(run *JobRun) {
+			run.Status = jobRunFailed
+			run.Finished = time.Now()
+			run.Error = ctx.Err().Error()
+		})
+		m.Unlock()
+		return
+	}
+	defer func() { <-m.slots }()
+
+	m.Lock()
+	m.updateRun(job.ID, runID, func(run *JobRun) {
+		run.Status = jobRunRunning
+		run.Started = time.Now()
+	})
+	m.Unlock()
+
+	var logsMu sync.Mutex
+	var logs []string
+	logf := func(format string, args ...interface{}) {
+		logsMu.Lock()
+		defer logsMu.Unlock()
+		if len(logs) >= jobRunLogLimit {
+			return
+		}
+		logs = append(logs, time.Now().UTC().Format(time.RFC3339)+" "+fmt.Sprintf(format, args...))
+	}
+	result, err := m.types[job.Type].run(ctx, client, job.Params, logf)
+	var report string
+	if err == nil && result.Report != nil {
+		report = filepath.Join(jobReportsDir, fmt.Sprintf("%s-%d.json", job.ID, runID))
+		if err = writeDataFile(report, result.Report); err != nil {
+			report = ""
+		}
+	}
+
+	logsMu.Lock()
+	defer logsMu.Unlock()
+	m.Lock()
+	defer m.Unlock()
+	m.updateRun(job.ID, runID, func(run *JobRun) {
+		run.Finished = time.Now()
+		run.Logs = logs
+		run.Summary = result.Summary
+		run.Report = report
+		run.Status = jobRunSucceeded
+		if err != nil {
+			run.Status = jobRunFailed
+			run.Error = err.Error()
+		}
+	})
+}
+
+// tick triggers the runs of the jobs due at now, the schedules of new jobs
+// start after now so a restart doesn't replay the runs missed while Console was down
+func (m *jobManager) tick(now time.Time) {
+	m.Lock()
+	if err := m.load(); err != nil {
+		m.Unlock()
+		LogError("unable to load the jobs: %v", err)
+		return
+	}
+	var due []string
+	for _, job := range m.jobs {
+		next, ok := m.nextRun[job.ID]
+		if !ok {
+			m.scheduleNext(job, now)
+			continue
+		}
+		if job.Paused || next.IsZero() || now.Before(next) {
+			continue
+		}
+		due = append(due, job.ID)
+		m.scheduleNext(job, now)
+	}
+	m.Unlock()
+	for _, id := range due {
+		if _, err := m.trigger(id, jobTriggerSchedule, "", m.newClient, now); err != nil {
+			LogError("unable to run job %s: %v", id, err)
+		}
+	}
+}
+
+func (m *jobManager) listRuns(id string) (*models.JobRunList, error) {
+	m.Lock()
+	defer m.Unlock()
+	if err := m.load(); err != nil {
+		return nil, err
+	}
+	if m.find(id) < 0 {
+		return nil, errJobNotFound
+	}
+	list := &models.JobRunList{Runs: []*models.JobRun{}}
+	// latest first
+	for i := len(m.runs) - 1; i >= 0; i-- {
+		if m.runs[i].JobID == id {
+			list.Runs = append(list.Runs, getJobRunModel(m.runs[i], false))
+		}
+	}
+	return list, nil
+}
+
+func (m *jobManager) getRun(id string, runID int64) (JobRun, error) {
+	m.Lock()
+	defer m.Unlock()
+	if err := m.load(); err != nil {
+		return JobRun{}, err
+	}
+	if m.find(id) < 0 {
+		return JobRun{}, errJobNotFound
+	}
+	i := m.findRun(id, runID)
+	if i < 0 {
+		return JobRun{}, errJobRunNotFound
+	}
+	return m.runs[i], nil
+}
+
+// startJobsScheduler checks the schedules of the jobs in the background
+func startJobsScheduler() {
+	go func() {
+		globalJobManager.tick(time.Now())
+		ticker := time.NewTicker(jobsSchedulerInterval)
+		defer ticker.Stop()
+		for now := range ticker.C {
+			globalJobManager.tick(now)
+		}
+	}()
+}
+
+func getJobParams(params *models.JobParams) JobParams {
+	if params == nil {
+		return JobParams{}
+	}
+	return JobParams{
+		Bucket:    params.Bucket,
+		Prefix:    params.Prefix,
+		Recursive: params.Recursive,
+		DryRun:    params.DryRun,
+		DeepScan:  params.DeepScan,
+		Deadline:  params.Deadline,
+	}
+}
+
+func getJobParamsModel(params JobParams) *models.JobParams {
+	return &models.JobParams{
+		Bucket:    params.Bucket,
+		Prefix:    params.Prefix,
+		Recursive: params.Recursive,
+		DryRun:    params.DryRun,
+		DeepScan:  params.DeepScan,
+		Deadline:  params.Deadline,
+	}
+}
+
+// formatJobTime formats the time of a run, empty when it didn't happen yet
+func formatJobTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func getJobRunModel(run JobRun, withLogs bool) *models.JobRun {
+	model := &models.JobRun{
+		ID:          run.ID,
+		JobID:       run.JobID,
+		Trigger:     run.Trigger,
+		TriggeredBy: run.TriggeredBy,
+		Status:      run.Status,
+		Queued:      formatJobTime(run.Queued),
+		Started:     formatJobTime(run.Started),
+		Finished:    formatJobTime(run.Finished),
+		Summary:     run.Summary,
+		Error:       run.Error,
+		HasReport:   run.Report != "",
+	}
+	if withLogs {
+		model.Logs = append([]string{}, run.Logs...)
+	}
+	return model
+}
+
+// parseJobRunID parses the id of a run, an invalid id can't match any run
+func parseJobRunID(run string) (int64, error) {
+	id, err := strconv.ParseInt(run, 10, 64)
+	if err != nil {
+		return 0, errJobRunNotFound
+	}
+	return id, nil
+}
+
+func getListJobsResponse() (*models.JobList, *models.Error) {
+	jobs, err := globalJobManager.list()
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return jobs, nil
+}
+
+func getCreateJobResponse(session *models.Principal, params admin_api.CreateJobParams) (*models.Job, *models.Error) {
+	job := Job{
+		Name:      *params.Body.Name,
+		Type:      *params.Body.Type,
+		Schedule:  *params.Body.Schedule,
+		Paused:    params.Body.Paused,
+		Params:    getJobParams(params.Body.Params),
+		CreatedBy: session.AccountAccessKey,
+	}
+	if err := globalJobManager.allowed(session, job.Type); err != nil {
+		return nil, prepareError(err)
+	}
+	created, err := globalJobManager.create(job, time.Now())
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return created, nil
+}
+
+func getJobResponse(id string) (*models.Job, *models.Error) {
+	job, err := globalJobManager.get(id)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return job, nil
+}
+
+func getDeleteJobResponse(session *models.Principal, id string) *models.Error {
+	if err := globalJobManager.authorize(session, id); err != nil {
+		return prepareError(err)
+	}
+	if err := globalJobManager.remove(id); err != nil {
+		return prepareError(err)
+	}
+	return nil
+}
+
+func getSetJobPausedResponse(session *models.Principal, id string, paused bool) (*models.Job, *models.Error) {
+	if err := globalJobManager.authorize(session, id); err != nil {
+		return nil, prepareError(err)
+	}
+	job, err := globalJobManager.setPaused(id, paused, time.Now())
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return job, nil
+}
+
+// getRunJobResponse runs the job with the session of the admin, so jobs can
+// be run on demand without the scheduled jobs credentials
+func getRunJobResponse(session *models.Principal, params admin_api.RunJobParams) (*models.JobRun, *models.Error) {
+	if err := globalJobManager.authorize(session, params.ID); err != nil {
+		return nil, prepareError(err)
+	}
+	newClient := func() (MinioAdmin, error) {
+		mAdmin, err := NewMinioAdminClient(session)
+		if err != nil {
+			return nil, err
+		}
+		// create a MinIO Admin Client interface implementation
+		// defining the client to be used
+		return AdminClient{Client: mAdmin}, nil
+	}
+	run, err := globalJobManager.trigger(params.ID, jobTriggerManual, session.AccountAccessKey, newClient, time.Now())
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return run, nil
+}
+
+func getListJobRunsResponse(id string) (*models.JobRunList, *models.Error) {
+	runs, err := globalJobManager.listRuns(id)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return runs, nil
+}
+
+func getJobRunResponse(id, runID string) (*models.JobRun, *models.Error) {
+	runNumber, err := parseJobRunID(runID)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	run, err := globalJobManager.getRun(id, runNumber)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return getJobRunModel(run, true), nil
+}
+
+func getDownloadJobRunReportResponse(session *models.Principal, id, runID string) (string, []byte, *models.Error) {
+	if err := globalJobManager.authorize(session, id); err != nil {
+		return "", nil, prepareError(err)
+	}
+	runNumber, err := parseJobRunID(runID)
+	if err != nil {
+		return "", nil, prepareError(err)
+	}
+	run, err := globalJobManager.getRun(id, runNumber)
+	if err != nil {
+		return "", nil, prepareError(err)
+	}
+	if run.Report == "" {
+		return "", nil, prepareError(ErrorGenericNotFound)
+	}
+	data, err := ioutil.ReadFile(filepath.Join(getConsoleDataDir(), run.Report))
+	if err != nil {
+		return "", nil, prepareError(err)
+	}
+	return filepath.Base(run.Report), data, nil
+}
@@ -0,0 +1,172 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronDescriptors are the shorthands accepted instead of the five fields
+var cronDescriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// cronSearchLimit bounds the search of the next run of a schedule that can't
+// be satisfied, like the 30th of February
+const cronSearchLimit = 5 * 366 * 24 * time.Hour
+
+// cronSchedule is a parsed cron expression: minute, hour, day of the month,
+// month and day of the week. Schedules are evaluated in UTC
+type cronSchedule struct {
+	minutes []bool
+	hours   []bool
+	days    []bool
+	months  []bool
+	weekday []bool
+	// when both the day of the month and the day of the week are restricted a
+	// day matches when either matches, like cron does
+	daysRestricted    bool
+	weekdayRestricted bool
+}
+
+// parseCronField parses a comma separated list of *, values and ranges with
+// an optional step into the values allowed between min and max
+func parseCronField(field string, min, max int) ([]bool, bool, error) {
+	allowed := make([]bool, max+1)
+	restricted := false
+	for _, part := range strings.Split(field, ",") {
+		rangePart, step := part, 1
+		if i := strings.Index(part, "/"); i >= 0 {
+			rangePart = part[:i]
+			n, err := strconv.Atoi(part[i+1:])
+			if err != nil || n <= 0 {
+				return nil, false, fmt.Errorf("invalid step in %q", part)
+			}
+			step = n
+		}
+		from, to := min, max
+		switch {
+		case rangePart == "*":
+			if step > 1 {
+				restricted = true
+			}
+		case strings.Contains(rangePart, "-"):
+			bounds := strings.SplitN(rangePart, "-", 2)
+			var err error
+			if from, err = strconv.Atoi(bounds[0]); err != nil {
+				return nil, false, fmt.Errorf("invalid range %q", part)
+			}
+			if to, err = strconv.Atoi(bounds[1]); err != nil {
+				return nil, false, fmt.Errorf("invalid range %q", part)
+			}
+			restricted = true
+		default:
+			value, err := strconv.Atoi(rangePart)
+			if err != nil {
+				return nil, false, fmt.Errorf("invalid value %q", part)
+			}
+			from, to = value, value
+			// a single value with a step runs from the value to the maximum
+			if step > 1 {
+				to = max
+			}
+			restricted = true
+		}
+		if from < min || to > max || from > to {
+			return nil, false, fmt.Errorf("%q is out of the range %d-%d", part, min, max)
+		}
+		for value := from; value <= to; value += step {
+			allowed[value] = true
+		}
+	}
+	return allowed, restricted, nil
+}
+
+// parseCronSchedule parses a five fields cron expression or one of the
+// @hourly, @daily, @weekly, @monthly and @yearly shorthands
+func parseCronSchedule(expr string) (*cronSchedule, error) {
+	expr = strings.TrimSpace(expr)
+	if descriptor, ok := cronDescriptors[strings.ToLower(expr)]; ok {
+		expr = descriptor
+	}
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("expected 5 fields in the schedule, got %d", len(fields))
+	}
+	schedule := &cronSchedule{}
+	var err error
+	if schedule.minutes, _, err = parseCronField(fields[0], 0, 59); err != nil {
+		return nil, err
+	}
+	if schedule.hours, _, err = parseCronField(fields[1], 0, 23); err != nil {
+		return nil, err
+	}
+	if schedule.days, schedule.daysRestricted, err = parseCronField(fields[2], 1, 31); err != nil {
+		return nil, err
+	}
+	if schedule.months, _, err = parseCronField(fields[3], 1, 12); err != nil {
+		return nil, err
+	}
+	// 7 is accepted for Sunday too
+	weekday, restricted, err := parseCronField(fields[4], 0, 7)
+	if err != nil {
+		return nil, err
+	}
+	weekday[0] = weekday[0] || weekday[7]
+	schedule.weekday, schedule.weekdayRestricted = weekday[:7], restricted
+	return schedule, nil
+}
+
+// dayMatches returns whether the schedule runs on the day of t
+func (s *cronSchedule) dayMatches(t time.Time) bool {
+	day, weekday := s.days[t.Day()], s.weekday[t.Weekday()]
+	if s.daysRestricted && s.weekdayRestricted {
+		return day || weekday
+	}
+	return day && weekday
+}
+
+// next returns the first time after t the schedule runs, the zero time when
+// the schedule never runs
+func (s *cronSchedule) next(t time.Time) time.Time {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.Add(cronSearchLimit)
+	for t.Before(limit) {
+		switch {
+		case !s.months[t.Month()]:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		case !s.dayMatches(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+		case !s.hours[t.Hour()]:
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, time.UTC)
+		case !s.minutes[t.Minute()]:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
@@ -0,0 +1,65 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCronSchedule(t *testing.T) {
+	assert := assert.New(t)
+	// Test-1 : invalid schedules
+	for _, expr := range []string{"", "* * *", "60 * * * *", "*/0 * * * *", "5-1 * * * *", "a * * * *", "* * 0 * *", "@often"} {
+		_, err := parseCronSchedule(expr)
+		assert.Error(err, expr)
+	}
+	// Test-2 : next run of valid schedules, 2021-06-02 is a Wednesday
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2021, 6, day, hour, minute, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		expr string
+		from time.Time
+		want time.Time
+	}{
+		{"*/15 * * * *", at(2, 10, 7), at(2, 10, 15)},
+		{"*/15 * * * *", at(2, 10, 45), at(2, 11, 0)},
+		{"0 3 * * *", at(2, 3, 0), at(3, 3, 0)},
+		{"@daily", at(2, 10, 0), at(3, 0, 0)},
+		{"@weekly", at(2, 10, 0), at(6, 0, 0)},
+		{"0 0 * * 7", at(2, 10, 0), at(6, 0, 0)},
+		{"30 9 * * 1-5", at(4, 10, 0), at(7, 9, 30)},
+		// the day of the month or the day of the week
+		{"0 0 1,15 * 1", at(2, 10, 0), at(7, 0, 0)},
+		{"0 0 1 * *", at(2, 10, 0), time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 12 29 2 *", at(2, 10, 0), time.Date(2024, 2, 29, 12, 0, 0, 0, time.UTC)},
+	}
+	for _, test := range tests {
+		schedule, err := parseCronSchedule(test.expr)
+		if assert.NoError(err, test.expr) {
+			assert.Equal(test.want, schedule.next(test.from), test.expr)
+		}
+	}
+	// Test-3 : schedules that never run
+	schedule, err := parseCronSchedule("0 0 30 2 *")
+	if assert.NoError(err) {
+		assert.True(schedule.next(at(2, 10, 0)).IsZero())
+	}
+}
@@ -0,0 +1,232 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go"
+	"github.com/stretchr/testify/assert"
+)
+
+// waitJobRun waits until the run of the job finishes
+func waitJobRun(t *testing.T, m *jobManager, jobID string, runID int64) JobRun {
+	var run JobRun
+	assert.Eventually(t, func() bool {
+		var err error
+		run, err = m.getRun(jobID, runID)
+		return err == nil && (run.Status == jobRunSucceeded || run.Status == jobRunFailed)
+	}, 5*time.Second, 10*time.Millisecond)
+	return run
+}
+
+func TestJobManager(t *testing.T) {
+	assert := assert.New(t)
+	defer useTempDataDir(t)()
+	noCredentials := func() (MinioAdmin, error) {
+		return nil, errJobsCredentialsNotSet
+	}
+	mockClient := func() (MinioAdmin, error) {
+		return adminClientMock{}, nil
+	}
+	m := newJobManager(jobsFile, jobRunsFile, 1, noCredentials)
+	release := make(chan struct{})
+	m.types = map[string]jobType{
+		"test": {
+			validate: func(params JobParams) error {
+				if params.Bucket == "" {
+					return errors.New("a bucket is needed")
+				}
+				return nil
+			},
+			run: func(ctx context.Context, client MinioAdmin, params JobParams, logf func(format string, args ...interface{})) (jobResult, error) {
+				logf("testing %s", params.Bucket)
+				select {
+				case <-release:
+				case <-ctx.Done():
+					return jobResult{}, ctx.Err()
+				}
+				return jobResult{Summary: "done", Report: map[string]string{"bucket": params.Bucket}}, nil
+			},
+		},
+	}
+	now := time.Date(2021, 6, 2, 10, 7, 0, 0, time.UTC)
+	// Test-1 : invalid jobs
+	for _, job := range []Job{
+		{Name: "", Type: "test", Schedule: "@daily", Params: JobParams{Bucket: "a"}},
+		{Name: "job", Type: "unknown", Schedule: "@daily", Params: JobParams{Bucket: "a"}},
+		{Name: "job", Type: "test", Schedule: "every day", Params: JobParams{Bucket: "a"}},
+		{Name: "job", Type: "test", Schedule: "@daily"},
+	} {
+		_, err := m.create(job, now)
+		assert.True(errors.Is(err, errInvalidJob))
+	}
+	// Test-2 : the next run follows the schedule
+	job, err := m.create(Job{Name: "job", Type: "test", Schedule: "*/15 * * * *", Params: JobParams{Bucket: "a"}, CreatedBy: "admin"}, now)
+	if !assert.NoError(err) {
+		return
+	}
+	assert.Equal("2021-06-02T10:15:00Z", job.NextRun)
+	// Test-3 : scheduled runs fail without the scheduled jobs credentials
+	m.tick(now.Add(5 * time.Minute))
+	runs, err := m.listRuns(job.ID)
+	if assert.NoError(err) {
+		assert.Empty(runs.Runs)
+	}
+	m.tick(now.Add(8 * time.Minute))
+	runs, err = m.listRuns(job.ID)
+	if assert.NoError(err) && assert.Len(runs.Runs, 1) {
+		assert.Equal(jobRunFailed, runs.Runs[0].Status)
+		assert.Equal(jobTriggerSchedule, runs.Runs[0].Trigger)
+		assert.Equal(errJobsCredentialsNotSet.Error(), runs.Runs[0].Error)
+	}
+	job, err = m.get(job.ID)
+	if assert.NoError(err) {
+		assert.Equal("2021-06-02T10:30:00Z", job.NextRun)
+		assert.Equal(jobRunFailed, job.LastRun.Status)
+	}
+	// Test-4 : a job runs once at a time
+	run, err := m.trigger(job.ID, jobTriggerManual, "admin", mockClient, now)
+	if !assert.NoError(err) {
+		return
+	}
+	_, err = m.trigger(job.ID, jobTriggerManual, "admin", mockClient, now)
+	assert.True(errors.Is(err, errJobRunning))
+	close(release)
+	finished := waitJobRun(t, m, job.ID, run.ID)
+	assert.Equal(jobRunSucceeded, finished.Status)
+	assert.Equal("done", finished.Summary)
+	assert.Equal("admin", finished.TriggeredBy)
+	if assert.Len(finished.Logs, 1) {
+		assert.Contains(finished.Logs[0], "testing a")
+	}
+	var report map[string]string
+	assert.NoError(readDataFile(finished.Report, &report))
+	assert.Equal("a", report["bucket"])
+	// Test-5 : paused jobs aren't scheduled
+	job, err = m.setPaused(job.ID, true, now)
+	if assert.NoError(err) {
+		assert.Equal("", job.NextRun)
+	}
+	m.tick(now.Add(time.Hour))
+	runs, err = m.listRuns(job.ID)
+	if assert.NoError(err) {
+		assert.Len(runs.Runs, 2)
+	}
+	// Test-6 : jobs and runs are reloaded, runs in progress are interrupted
+	reloaded := newJobManager(jobsFile, jobRunsFile, 1, noCredentials)
+	reloaded.types = m.types
+	list, err := reloaded.list()
+	if assert.NoError(err) && assert.Len(list.Jobs, 1) {
+		assert.True(list.Jobs[0].Paused)
+		assert.Equal(int64(2), list.Jobs[0].LastRun.ID)
+	}
+	// Test-7 : deleting a job deletes its runs and reports
+	assert.NoError(m.remove(job.ID))
+	_, err = os.Stat(filepath.Join(getConsoleDataDir(), finished.Report))
+	assert.True(os.IsNotExist(err))
+	_, err = m.listRuns(job.ID)
+	assert.True(errors.Is(err, errJobNotFound))
+	assert.True(errors.Is(m.remove(job.ID), errJobNotFound))
+}
+
+func TestJobManagerAuthorize(t *testing.T) {
+	assert := assert.New(t)
+	defer useTempDataDir(t)()
+	m := newJobManager(jobsFile, jobRunsFile, 1, newJobsAdminClient)
+	now := time.Now()
+	job, err := m.create(Job{Name: "heal", Type: jobTypeHeal, Schedule: "@daily"}, now)
+	if !assert.NoError(err) {
+		return
+	}
+	healer := &models.Principal{Actions: []string{"admin:Heal"}}
+	reader := &models.Principal{Actions: []string{"admin:ServerInfo"}}
+	// Test-1 : the session needs the admin actions of the job type
+	assert.NoError(m.allowed(healer, jobTypeHeal))
+	assert.True(errors.Is(m.allowed(reader, jobTypeHeal), errJobNotAllowed))
+	assert.True(errors.Is(m.allowed(healer, jobTypeHealthInfo), errJobNotAllowed))
+	assert.NoError(m.allowed(reader, jobTypeUsageSnapshot))
+	assert.True(errors.Is(m.allowed(healer, "unknown"), errInvalidJob))
+	// Test-2 : the stored jobs are checked by their type
+	assert.NoError(m.authorize(healer, job.ID))
+	assert.True(errors.Is(m.authorize(reader, job.ID), errJobNotAllowed))
+	assert.True(errors.Is(m.authorize(healer, "missing"), errJobNotFound))
+	// Test-3 : admins with every action are allowed
+	assert.NoError(m.authorize(&models.Principal{Actions: []string{"admin:*"}}, job.ID))
+}
+
+func TestJobTypes(t *testing.T) {
+	assert := assert.New(t)
+	defer useTempDataDir(t)()
+	ctx := context.Background()
+	adminClient := adminClientMock{}
+	var logs []string
+	logf := func(format string, args ...interface{}) {
+		logs = append(logs, format)
+	}
+	// Test-1 : heal logs the corrupted items
+	jobHealPollInterval = time.Millisecond
+	polls := 0
+	minioHealMock = func(ctx context.Context, bucket, prefix string, healOpts madmin.HealOpts, clientToken string,
+		forceStart, forceStop bool) (madmin.HealStartSuccess, madmin.HealTaskStatus, error) {
+		if clientToken == "" {
+			return madmin.HealStartSuccess{ClientToken: "token"}, madmin.HealTaskStatus{}, nil
+		}
+		polls++
+		status := madmin.HealTaskStatus{Summary: "running"}
+		if polls == 2 {
+			status.Summary = "finished"
+			item := madmin.HealResultItem{Type: madmin.HealItemObject, Bucket: bucket, Object: "a.txt"}
+			item.Before.Drives = []madmin.HealDriveInfo{{State: madmin.DriveStateCorrupt}, {State: madmin.DriveStateOk}}
+			item.After.Drives = []madmin.HealDriveInfo{{State: madmin.DriveStateOk}, {State: madmin.DriveStateOk}}
+			status.Items = []madmin.HealResultItem{item}
+		}
+		return madmin.HealStartSuccess{}, status, nil
+	}
+	result, err := runHealJob(ctx, adminClient, JobParams{Bucket: "bucket"}, logf)
+	if assert.NoError(err) {
+		assert.Equal("scanned 1 items, healed 1, 1 were corrupted", result.Summary)
+		assert.Contains(logs, "%s had %d corrupted drives")
+	}
+	assert.Error(validateHealJob(JobParams{Prefix: "logs/"}))
+	// Test-2 : the health info is the report of the run
+	minioServerHealthInfoMock = func(ctx context.Context, healthDataTypes []madmin.HealthDataType, deadline time.Duration) (interface{}, string, error) {
+		assert.Equal(time.Minute, deadline)
+		return map[string]string{"status": "ok"}, "3", nil
+	}
+	result, err = runHealthInfoJob(ctx, adminClient, JobParams{Deadline: 60}, logf)
+	if assert.NoError(err) {
+		assert.Equal(map[string]string{"status": "ok"}, result.Report)
+	}
+	// Test-3 : the usage snapshot is recorded for the capacity forecast
+	minioServerInfoMock = func(ctx context.Context) (madmin.InfoMessage, error) {
+		return madmin.InfoMessage{Servers: []madmin.ServerProperties{{Disks: []madmin.Disk{{UsedSpace: 10, TotalSpace: 100}}}}}, nil
+	}
+	minioAccountInfoMock = func(ctx context.Context) (madmin.AccountInfo, error) {
+		return madmin.AccountInfo{Buckets: []madmin.BucketAccessInfo{{Name: "bucket", Size: 10}}}, nil
+	}
+	result, err = runUsageSnapshotJob(ctx, adminClient, JobParams{}, logf)
+	if assert.NoError(err) {
+		assert.Equal("10 of 100 bytes used, 1 buckets", result.Summary)
+	}
+}
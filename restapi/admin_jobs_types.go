@@ -0,0 +1,164 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/minio/madmin-go"
+	iampolicy "github.com/minio/pkg/iam/policy"
+)
+
+const (
+	jobTypeHeal          = "heal"
+	jobTypeHealthInfo    = "health-info"
+	jobTypeUsageSnapshot = "usage-snapshot"
+)
+
+// defaultJobHealthInfoDeadline is how long the health info collection takes when no deadline is set
+const defaultJobHealthInfoDeadline = time.Hour
+
+// jobHealPollInterval is how often the status of a heal is checked
+var jobHealPollInterval = time.Second
+
+var jobTypes = map[string]jobType{
+	jobTypeHeal: {
+		actions:  []iampolicy.Action{iampolicy.HealAdminAction},
+		validate: validateHealJob,
+		run:      runHealJob,
+	},
+	jobTypeHealthInfo: {
+		actions:  []iampolicy.Action{iampolicy.HealthInfoAdminAction},
+		validate: validateHealthInfoJob,
+		run:      runHealthInfoJob,
+	},
+	jobTypeUsageSnapshot: {
+		actions:  []iampolicy.Action{iampolicy.ServerInfoAdminAction},
+		validate: func(params JobParams) error { return nil },
+		run:      runUsageSnapshotJob,
+	},
+}
+
+func validateHealJob(params JobParams) error {
+	if params.Prefix != "" && params.Bucket == "" {
+		return errors.New("a prefix needs a bucket")
+	}
+	return nil
+}
+
+// runHealJob heals the bucket and prefix of the job, or the whole cluster
+// when there is no bucket, and logs the items that were corrupted, they are
+// sent to the webhooks too
+func runHealJob(ctx context.Context, client MinioAdmin, params JobParams, logf func(format string, args ...interface{})) (jobResult, error) {
+	opts := madmin.HealOpts{
+		Recursive: params.Recursive,
+		DryRun:    params.DryRun,
+		ScanMode:  madmin.HealNormalScan,
+	}
+	if params.DeepScan {
+		opts.ScanMode = madmin.HealDeepScan
+	}
+	healStart, _, err := client.heal(ctx, params.Bucket, params.Prefix, opts, "", false, false)
+	if err != nil {
+		return jobResult{}, err
+	}
+	logf("heal started on %s", healStart.StartTime.UTC().Format(time.RFC3339))
+	var scanned, healed int64
+	var corrupted []string
+	for {
+		_, status, err := client.heal(ctx, params.Bucket, params.Prefix, opts, healStart.ClientToken, false, false)
+		if err != nil {
+			return jobResult{}, err
+		}
+		for _, item := range status.Items {
+			scanned++
+			beforeOnline, afterOnline := item.GetOnlineCounts()
+			if afterOnline > beforeOnline {
+				healed++
+			}
+			if beforeCorrupted, _ := item.GetCorruptedCounts(); beforeCorrupted > 0 {
+				_, name := getHRITypeAndName(item)
+				corrupted = append(corrupted, name)
+				logf("%s had %d corrupted drives", name, beforeCorrupted)
+			}
+		}
+		switch status.Summary {
+		case "finished":
+			summary := fmt.Sprintf("scanned %d items, healed %d, %d were corrupted", scanned, healed, len(corrupted))
+			logf("heal finished, %s", summary)
+			publishHealCorrupted("", params.Bucket, params.Prefix, params.DryRun, corrupted)
+			return jobResult{Summary: summary}, nil
+		case "stopped":
+			return jobResult{}, fmt.Errorf("heal had an error - %s", status.FailureDetail)
+		}
+		select {
+		case <-ctx.Done():
+			return jobResult{}, ctx.Err()
+		case <-time.After(jobHealPollInterval):
+		}
+	}
+}
+
+func validateHealthInfoJob(params JobParams) error {
+	if params.Deadline < 0 {
+		return errors.New("the deadline can't be negative")
+	}
+	return nil
+}
+
+// runHealthInfoJob collects the health info of the cluster, it's stored as
+// the report of the run
+func runHealthInfoJob(ctx context.Context, client MinioAdmin, params JobParams, logf func(format string, args ...interface{})) (jobResult, error) {
+	deadline := defaultJobHealthInfoDeadline
+	if params.Deadline > 0 {
+		deadline = time.Duration(params.Deadline) * time.Second
+	}
+	logf("collecting the health info for up to %s", deadline)
+	healthInfo, version, err := client.serverHealthInfo(ctx, healthDataTypes, deadline)
+	if err != nil {
+		return jobResult{}, err
+	}
+	return jobResult{
+		Summary: fmt.Sprintf("health info version %s collected", version),
+		Report:  healthInfo,
+	}, nil
+}
+
+// runUsageSnapshotJob records the usage of the cluster and its buckets used
+// by the capacity forecast
+func runUsageSnapshotJob(ctx context.Context, client MinioAdmin, params JobParams, logf func(format string, args ...interface{})) (jobResult, error) {
+	sample, err := getCapacitySample(ctx, client, time.Now())
+	if err != nil {
+		return jobResult{}, err
+	}
+	if err := globalCapacityPlanner.record(sample); err != nil {
+		return jobResult{}, err
+	}
+	var buckets []string
+	for bucket := range sample.Buckets {
+		buckets = append(buckets, bucket)
+	}
+	sort.Strings(buckets)
+	for _, bucket := range buckets {
+		logf("bucket %s uses %d bytes", bucket, sample.Buckets[bucket])
+	}
+	return jobResult{Summary: fmt.Sprintf("%d of %d bytes used, %d buckets", sample.Used, sample.Total, len(sample.Buckets))}, nil
+}
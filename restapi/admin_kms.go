@@ -0,0 +1,294 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/admin_api"
+	"github.com/minio/minio-go/v7"
+)
+
+// kmsKeyARNPrefix is the prefix S3 clients may add to the key of a bucket encryption
+const kmsKeyARNPrefix = "arn:aws:kms:"
+
+func registerKMSHandlers(api *operations.ConsoleAPI) {
+	// get the KMS status
+	api.AdminAPIKMSStatusHandler = admin_api.KMSStatusHandlerFunc(func(params admin_api.KMSStatusParams, session *models.Principal) middleware.Responder {
+		status, err := getKMSStatusResponse(session, params)
+		if err != nil {
+			return admin_api.NewKMSStatusDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewKMSStatusOK().WithPayload(status)
+	})
+	// list the KMS keys
+	api.AdminAPIKMSListKeysHandler = admin_api.KMSListKeysHandlerFunc(func(params admin_api.KMSListKeysParams, session *models.Principal) middleware.Responder {
+		keys, err := getKMSListKeysResponse(session, params)
+		if err != nil {
+			return admin_api.NewKMSListKeysDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewKMSListKeysOK().WithPayload(keys)
+	})
+	// create a KMS key
+	api.AdminAPIKMSCreateKeyHandler = admin_api.KMSCreateKeyHandlerFunc(func(params admin_api.KMSCreateKeyParams, session *models.Principal) middleware.Responder {
+		if err := getKMSCreateKeyResponse(session, params); err != nil {
+			return admin_api.NewKMSCreateKeyDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewKMSCreateKeyCreated()
+	})
+	// import a KMS key
+	api.AdminAPIKMSImportKeyHandler = admin_api.KMSImportKeyHandlerFunc(func(params admin_api.KMSImportKeyParams, session *models.Principal) middleware.Responder {
+		if err := getKMSImportKeyResponse(session, params); err != nil {
+			return admin_api.NewKMSImportKeyDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewKMSImportKeyCreated()
+	})
+	// get the status of a KMS key
+	api.AdminAPIKMSKeyStatusHandler = admin_api.KMSKeyStatusHandlerFunc(func(params admin_api.KMSKeyStatusParams, session *models.Principal) middleware.Responder {
+		status, err := getKMSKeyStatusResponse(session, params)
+		if err != nil {
+			return admin_api.NewKMSKeyStatusDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewKMSKeyStatusOK().WithPayload(status)
+	})
+	// delete a KMS key
+	api.AdminAPIKMSDeleteKeyHandler = admin_api.KMSDeleteKeyHandlerFunc(func(params admin_api.KMSDeleteKeyParams, session *models.Principal) middleware.Responder {
+		if err := getKMSDeleteKeyResponse(session, params); err != nil {
+			return admin_api.NewKMSDeleteKeyDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewKMSDeleteKeyNoContent()
+	})
+}
+
+// getKMSStatus returns the KMS connected to MinIO with its endpoints sorted by URL
+func getKMSStatus(ctx context.Context, client MinioAdmin) (*models.KmsStatusResponse, error) {
+	status, err := client.kmsStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	endpoints := []*models.KmsEndpoint{}
+	for endpoint, state := range status.Endpoints {
+		endpoints = append(endpoints, &models.KmsEndpoint{URL: endpoint, Status: string(state)})
+	}
+	sort.Slice(endpoints, func(i, j int) bool { return endpoints[i].URL < endpoints[j].URL })
+	return &models.KmsStatusResponse{
+		Name:         status.Name,
+		DefaultKeyID: status.DefaultKeyID,
+		Endpoints:    endpoints,
+	}, nil
+}
+
+func getKMSStatusResponse(session *models.Principal, params admin_api.KMSStatusParams) (*models.KmsStatusResponse, *models.Error) {
+	adminClient, err := newSignedAdminClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	status, err := getKMSStatus(params.HTTPRequest.Context(), adminClient)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return status, nil
+}
+
+// listKMSKeys returns the keys matching the pattern sorted by name, every key is listed when there's no pattern
+func listKMSKeys(ctx context.Context, client MinioAdmin, pattern string) (*models.KmsListKeysResponse, error) {
+	if pattern == "" {
+		pattern = "*"
+	}
+	keys, err := client.kmsListKeys(ctx, pattern)
+	if err != nil {
+		return nil, err
+	}
+	results := []*models.KmsKeyInfo{}
+	for _, key := range keys {
+		info := &models.KmsKeyInfo{Name: key.Name, CreatedBy: key.CreatedBy}
+		if !key.CreatedAt.IsZero() {
+			info.CreatedAt = key.CreatedAt.Format(time.RFC3339)
+		}
+		results = append(results, info)
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Name < results[j].Name })
+	return &models.KmsListKeysResponse{Results: results}, nil
+}
+
+func getKMSListKeysResponse(session *models.Principal, params admin_api.KMSListKeysParams) (*models.KmsListKeysResponse, *models.Error) {
+	adminClient, err := newSignedAdminClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	pattern := ""
+	if params.Pattern != nil {
+		pattern = *params.Pattern
+	}
+	keys, err := listKMSKeys(params.HTTPRequest.Context(), adminClient, pattern)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return keys, nil
+}
+
+// validateKMSKeyName checks the name of a key being created or imported
+func validateKMSKeyName(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return fmt.Errorf("%w: the key name is required", errInvalidKMSKey)
+	}
+	if strings.ContainsAny(name, "/*") {
+		return fmt.Errorf("%w: the key name can't contain '/' or '*'", errInvalidKMSKey)
+	}
+	return nil
+}
+
+func createKMSKey(ctx context.Context, client MinioAdmin, name string) error {
+	if err := validateKMSKeyName(name); err != nil {
+		return err
+	}
+	return client.createKey(ctx, name)
+}
+
+func getKMSCreateKeyResponse(session *models.Principal, params admin_api.KMSCreateKeyParams) *models.Error {
+	adminClient, err := newSignedAdminClient(session)
+	if err != nil {
+		return prepareError(err)
+	}
+	if err := createKMSKey(params.HTTPRequest.Context(), adminClient, *params.Body.Key); err != nil {
+		return prepareError(err)
+	}
+	return nil
+}
+
+// importKMSKey imports the base64 encoded key bytes under the given name
+func importKMSKey(ctx context.Context, client MinioAdmin, name, encoded string) error {
+	if err := validateKMSKeyName(name); err != nil {
+		return err
+	}
+	content, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil || len(content) == 0 {
+		return fmt.Errorf("%w: the key bytes must be base64 encoded", errInvalidKMSKey)
+	}
+	return client.kmsImportKey(ctx, name, content)
+}
+
+func getKMSImportKeyResponse(session *models.Principal, params admin_api.KMSImportKeyParams) *models.Error {
+	adminClient, err := newSignedAdminClient(session)
+	if err != nil {
+		return prepareError(err)
+	}
+	if err := importKMSKey(params.HTTPRequest.Context(), adminClient, params.Name, *params.Body.Bytes); err != nil {
+		return prepareError(err)
+	}
+	return nil
+}
+
+func getKMSKeyStatus(ctx context.Context, client MinioAdmin, name string) (*models.KmsKeyStatusResponse, error) {
+	status, err := client.getKeyStatus(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return &models.KmsKeyStatusResponse{
+		KeyID:         status.KeyID,
+		EncryptionErr: status.EncryptionErr,
+		DecryptionErr: status.DecryptionErr,
+	}, nil
+}
+
+func getKMSKeyStatusResponse(session *models.Principal, params admin_api.KMSKeyStatusParams) (*models.KmsKeyStatusResponse, *models.Error) {
+	adminClient, err := newSignedAdminClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	status, err := getKMSKeyStatus(params.HTTPRequest.Context(), adminClient, params.Name)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return status, nil
+}
+
+// bucketsUsingKMSKey returns the buckets whose default encryption uses the key,
+// buckets without an encryption configuration are skipped. The buckets are
+// listed with the session, which only sees the buckets its policy allows, so
+// the listing is compared with the bucket count of the server and the usage
+// can't be verified when some buckets are hidden
+func bucketsUsingKMSKey(ctx context.Context, adminClient MinioAdmin, client MinioClient, name string) ([]string, error) {
+	buckets, err := client.listBucketsWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	info, err := adminClient.serverInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if info.Buckets.Count > uint64(len(buckets)) {
+		return nil, errKMSKeyUsageNotVerified
+	}
+	var using []string
+	for _, bucket := range buckets {
+		config, err := client.getBucketEncryption(ctx, bucket.Name)
+		if err != nil {
+			if minio.ToErrorResponse(err).Code == "ServerSideEncryptionConfigurationNotFoundError" {
+				continue
+			}
+			return nil, err
+		}
+		for _, rule := range config.Rules {
+			if strings.TrimPrefix(rule.Apply.KmsMasterKeyID, kmsKeyARNPrefix) == name {
+				using = append(using, bucket.Name)
+				break
+			}
+		}
+	}
+	return using, nil
+}
+
+// deleteKMSKey deletes a key unless a bucket encryption uses it, the objects
+// encrypted with a deleted key can't be read anymore
+func deleteKMSKey(ctx context.Context, adminClient MinioAdmin, client MinioClient, name string) error {
+	buckets, err := bucketsUsingKMSKey(ctx, adminClient, client, name)
+	if err != nil {
+		return err
+	}
+	if len(buckets) > 0 {
+		return fmt.Errorf("%w: %s", errKMSKeyInUse, strings.Join(buckets, ", "))
+	}
+	return adminClient.kmsDeleteKey(ctx, name)
+}
+
+func getKMSDeleteKeyResponse(session *models.Principal, params admin_api.KMSDeleteKeyParams) *models.Error {
+	ctx := params.HTTPRequest.Context()
+	adminClient, err := newSignedAdminClient(session)
+	if err != nil {
+		return prepareError(err)
+	}
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return prepareError(err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+	if err := deleteKMSKey(ctx, adminClient, minioClient, params.Name); err != nil {
+		return prepareError(err)
+	}
+	return nil
+}
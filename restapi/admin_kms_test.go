@@ -0,0 +1,223 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/minio/madmin-go"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/sse"
+	"github.com/stretchr/testify/assert"
+)
+
+var minioKMSStatusMock func(ctx context.Context) (madmin.KMSStatus, error)
+var minioCreateKeyMock func(ctx context.Context, keyID string) error
+var minioGetKeyStatusMock func(ctx context.Context, keyID string) (*madmin.KMSKeyStatus, error)
+var minioKMSListKeysMock func(ctx context.Context, pattern string) ([]KMSKeyInfo, error)
+var minioKMSImportKeyMock func(ctx context.Context, keyID string, content []byte) error
+var minioKMSDeleteKeyMock func(ctx context.Context, keyID string) error
+
+// mock function of kmsStatus()
+func (ac adminClientMock) kmsStatus(ctx context.Context) (madmin.KMSStatus, error) {
+	return minioKMSStatusMock(ctx)
+}
+
+// mock function of createKey()
+func (ac adminClientMock) createKey(ctx context.Context, keyID string) error {
+	return minioCreateKeyMock(ctx, keyID)
+}
+
+// mock function of getKeyStatus()
+func (ac adminClientMock) getKeyStatus(ctx context.Context, keyID string) (*madmin.KMSKeyStatus, error) {
+	return minioGetKeyStatusMock(ctx, keyID)
+}
+
+// mock function of kmsListKeys()
+func (ac adminClientMock) kmsListKeys(ctx context.Context, pattern string) ([]KMSKeyInfo, error) {
+	return minioKMSListKeysMock(ctx, pattern)
+}
+
+// mock function of kmsImportKey()
+func (ac adminClientMock) kmsImportKey(ctx context.Context, keyID string, content []byte) error {
+	return minioKMSImportKeyMock(ctx, keyID, content)
+}
+
+// mock function of kmsDeleteKey()
+func (ac adminClientMock) kmsDeleteKey(ctx context.Context, keyID string) error {
+	return minioKMSDeleteKeyMock(ctx, keyID)
+}
+
+func TestGetKMSStatusAndKeys(t *testing.T) {
+	assert := assert.New(t)
+	adminClient := adminClientMock{}
+	minioKMSStatusMock = func(ctx context.Context) (madmin.KMSStatus, error) {
+		return madmin.KMSStatus{
+			Name:         "KES",
+			DefaultKeyID: "minio-key",
+			Endpoints: map[string]madmin.ItemState{
+				"https://kes2:7373": madmin.ItemOffline,
+				"https://kes1:7373": madmin.ItemOnline,
+			},
+		}, nil
+	}
+	// Test-1 : endpoints are sorted by URL
+	status, err := getKMSStatus(context.Background(), adminClient)
+	if assert.NoError(err) {
+		assert.Equal("minio-key", status.DefaultKeyID)
+		if assert.Equal(2, len(status.Endpoints)) {
+			assert.Equal("https://kes1:7373", status.Endpoints[0].URL)
+			assert.Equal("offline", status.Endpoints[1].Status)
+		}
+	}
+	// Test-2 : every key is listed without a pattern
+	var listPattern string
+	createdAt := time.Date(2021, 9, 1, 10, 0, 0, 0, time.UTC)
+	minioKMSListKeysMock = func(ctx context.Context, pattern string) ([]KMSKeyInfo, error) {
+		listPattern = pattern
+		return []KMSKeyInfo{{Name: "photos-key"}, {Name: "minio-key", CreatedAt: createdAt, CreatedBy: "admin"}}, nil
+	}
+	keys, err := listKMSKeys(context.Background(), adminClient, "")
+	if assert.NoError(err) {
+		assert.Equal("*", listPattern)
+		if assert.Equal(2, len(keys.Results)) {
+			assert.Equal("minio-key", keys.Results[0].Name)
+			assert.Equal("2021-09-01T10:00:00Z", keys.Results[0].CreatedAt)
+			assert.Empty(keys.Results[1].CreatedAt)
+		}
+	}
+}
+
+func TestCreateAndImportKMSKey(t *testing.T) {
+	assert := assert.New(t)
+	adminClient := adminClientMock{}
+	var created string
+	minioCreateKeyMock = func(ctx context.Context, keyID string) error {
+		created = keyID
+		return nil
+	}
+	var imported []byte
+	minioKMSImportKeyMock = func(ctx context.Context, keyID string, content []byte) error {
+		imported = content
+		return nil
+	}
+	// Test-1 : keys are created and imported
+	if assert.NoError(createKMSKey(context.Background(), adminClient, "photos-key")) {
+		assert.Equal("photos-key", created)
+	}
+	if assert.NoError(importKMSKey(context.Background(), adminClient, "logs-key", "c2VjcmV0")) {
+		assert.Equal([]byte("secret"), imported)
+	}
+	// Test-2 : invalid names and key bytes are rejected
+	assert.True(errors.Is(createKMSKey(context.Background(), adminClient, " "), errInvalidKMSKey))
+	assert.True(errors.Is(createKMSKey(context.Background(), adminClient, "keys/*"), errInvalidKMSKey))
+	assert.True(errors.Is(importKMSKey(context.Background(), adminClient, "logs-key", "not base64!"), errInvalidKMSKey))
+}
+
+func TestDeleteKMSKey(t *testing.T) {
+	assert := assert.New(t)
+	adminClient := adminClientMock{}
+	client := minioClientMock{}
+	minioListBucketsWithContextMock = func(ctx context.Context) ([]minio.BucketInfo, error) {
+		return []minio.BucketInfo{{Name: "photos"}, {Name: "logs"}, {Name: "plain"}}, nil
+	}
+	minioGetBucketEncryptionMock = func(ctx context.Context, bucketName string) (*sse.Configuration, error) {
+		switch bucketName {
+		case "photos":
+			return sse.NewConfigurationSSEKMS("arn:aws:kms:photos-key"), nil
+		case "logs":
+			return sse.NewConfigurationSSES3(), nil
+		}
+		return nil, minio.ErrorResponse{Code: "ServerSideEncryptionConfigurationNotFoundError"}
+	}
+	minioServerInfoMock = func(ctx context.Context) (madmin.InfoMessage, error) {
+		return madmin.InfoMessage{Buckets: madmin.Buckets{Count: 3}}, nil
+	}
+	var deleted string
+	minioKMSDeleteKeyMock = func(ctx context.Context, keyID string) error {
+		deleted = keyID
+		return nil
+	}
+	// Test-1 : keys used by a bucket encryption aren't deleted
+	err := deleteKMSKey(context.Background(), adminClient, client, "photos-key")
+	assert.True(errors.Is(err, errKMSKeyInUse))
+	assert.Contains(err.Error(), "photos")
+	assert.Empty(deleted)
+	// Test-2 : unused keys are deleted
+	if assert.NoError(deleteKMSKey(context.Background(), adminClient, client, "logs-key")) {
+		assert.Equal("logs-key", deleted)
+	}
+	// Test-3 : errors getting the bucket encryption stop the deletion
+	minioGetBucketEncryptionMock = func(ctx context.Context, bucketName string) (*sse.Configuration, error) {
+		return nil, errors.New("connection refused")
+	}
+	deleted = ""
+	assert.Error(deleteKMSKey(context.Background(), adminClient, client, "logs-key"))
+	assert.Empty(deleted)
+	// Test-4 : sessions that can't see every bucket can't verify the key usage
+	minioServerInfoMock = func(ctx context.Context) (madmin.InfoMessage, error) {
+		return madmin.InfoMessage{Buckets: madmin.Buckets{Count: 4}}, nil
+	}
+	assert.Equal(errKMSKeyUsageNotVerified, deleteKMSKey(context.Background(), adminClient, client, "logs-key"))
+	assert.Empty(deleted)
+}
+
+func TestKMSAdminRequests(t *testing.T) {
+	assert := assert.New(t)
+	var method, path, keyID string
+	var body kmsImportKeyRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path, keyID = r.Method, r.URL.Path, r.URL.Query().Get("key-id")
+		switch r.URL.Path {
+		case kmsAPIPrefix + "/key/list":
+			w.Write([]byte(`[{"name":"minio-key","createdBy":"admin","createdAt":"2021-09-01T10:00:00Z"}]`))
+		case kmsAPIPrefix + "/key/import":
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+			}
+		}
+	}))
+	defer server.Close()
+	os.Setenv(ConsoleMinIOServer, server.URL)
+	defer os.Unsetenv(ConsoleMinIOServer)
+	adminClient := AdminClient{Creds: credentials.NewStaticV4("access", "secret", "")}
+	// Test-1 : list keys
+	keys, err := adminClient.kmsListKeys(context.Background(), "*")
+	if assert.NoError(err) && assert.Equal(1, len(keys)) {
+		assert.Equal("admin", keys[0].CreatedBy)
+		assert.Equal(2021, keys[0].CreatedAt.Year())
+	}
+	// Test-2 : the imported key bytes are sent in the body
+	if assert.NoError(adminClient.kmsImportKey(context.Background(), "logs-key", []byte("secret"))) {
+		assert.Equal(http.MethodPost, method)
+		assert.Equal("logs-key", keyID)
+		assert.Equal([]byte("secret"), body.Bytes)
+	}
+	// Test-3 : delete a key
+	if assert.NoError(adminClient.kmsDeleteKey(context.Background(), "logs-key")) {
+		assert.Equal(http.MethodDelete, method)
+		assert.Equal(kmsAPIPrefix+"/key/delete", path)
+	}
+}
@@ -0,0 +1,501 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/minio/pkg/env"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+// metricsFile stores a snapshot of the collected metrics so they survive restarts
+const metricsFile = "metrics.json"
+
+// metricsSnapshotEvery is the number of scrapes between snapshots
+const metricsSnapshotEvery = 10
+
+// metricsScrapeTimeout bounds every scrape of the MinIO metrics endpoint
+const metricsScrapeTimeout = 30 * time.Second
+
+// collectedSeries holds the values of a series aligned with the scrape times,
+// NaN marks the scrapes where the series was missing
+type collectedSeries struct {
+	labels map[string]string
+	values []float64
+}
+
+// metricsCollector keeps a bounded time series of the MinIO cluster metrics
+// for deployments without Prometheus
+type metricsCollector struct {
+	sync.RWMutex
+	// capacity is the number of scrapes kept
+	capacity int
+	times    []int64
+	series   map[string]*collectedSeries
+}
+
+var globalMetricsCollector *metricsCollector
+
+func newMetricsCollector(capacity int) *metricsCollector {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &metricsCollector{
+		capacity: capacity,
+		series:   make(map[string]*collectedSeries),
+	}
+}
+
+// add stores the samples of a scrape taken at t, dropping the oldest scrapes
+// beyond the capacity of the collector
+func (c *metricsCollector) add(t int64, samples []promSample) {
+	c.Lock()
+	defer c.Unlock()
+	if len(c.times) > 0 && t <= c.times[len(c.times)-1] {
+		return
+	}
+	c.times = append(c.times, t)
+	n := len(c.times)
+	for _, s := range samples {
+		key := promLabelsKey(s.labels)
+		series, ok := c.series[key]
+		if !ok {
+			series = &collectedSeries{labels: s.labels}
+			for i := 0; i < n-1; i++ {
+				series.values = append(series.values, math.NaN())
+			}
+			c.series[key] = series
+		}
+		if len(series.values) == n {
+			series.values[n-1] = s.value
+			continue
+		}
+		series.values = append(series.values, s.value)
+	}
+	drop := 0
+	if n > c.capacity {
+		drop = n - c.capacity
+		c.times = c.times[drop:]
+	}
+	for key, series := range c.series {
+		if len(series.values) < n {
+			series.values = append(series.values, math.NaN())
+		}
+		series.values = series.values[drop:]
+		empty := true
+		for _, v := range series.values {
+			if !math.IsNaN(v) {
+				empty = false
+				break
+			}
+		}
+		if empty {
+			delete(c.series, key)
+		}
+	}
+}
+
+// ready returns whether at least one scrape was stored
+func (c *metricsCollector) ready() bool {
+	c.RLock()
+	defer c.RUnlock()
+	return len(c.times) > 0
+}
+
+func (c *metricsCollector) selectSeries(matchers []promMatcher, from, to int64, fn func(labels map[string]string, points []metricPoint)) {
+	c.RLock()
+	defer c.RUnlock()
+	first := sort.Search(len(c.times), func(i int) bool { return c.times[i] > from })
+	last := sort.Search(len(c.times), func(i int) bool { return c.times[i] > to })
+	if first >= last {
+		return
+	}
+	for _, series := range c.series {
+		matched := true
+		for _, m := range matchers {
+			if !m.matches(series.labels[m.name]) {
+				matched = false
+				break
+			}
+		}
+		if !matched {
+			continue
+		}
+		var points []metricPoint
+		for i := first; i < last; i++ {
+			if !math.IsNaN(series.values[i]) {
+				points = append(points, metricPoint{T: c.times[i], V: series.values[i]})
+			}
+		}
+		if len(points) > 0 {
+			fn(series.labels, points)
+		}
+	}
+}
+
+// labelValues returns the sorted values of a label across all series
+func (c *metricsCollector) labelValues(name string) []string {
+	c.RLock()
+	defer c.RUnlock()
+	set := make(map[string]bool)
+	for _, series := range c.series {
+		if value, ok := series.labels[name]; ok && value != "" {
+			set[value] = true
+		}
+	}
+	values := []string{}
+	for value := range set {
+		values = append(values, value)
+	}
+	sort.Strings(values)
+	return values
+}
+
+// unmarshalPrometheus answers the label values and range query endpoints of
+// the Prometheus API from the collected metrics, it has the same contract as
+// the package level unmarshalPrometheus
+func (c *metricsCollector) unmarshalPrometheus(endpoint string, data interface{}) bool {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		LogError("Unable to parse metrics query %s, %v", endpoint, err)
+		return true
+	}
+	var response interface{}
+	switch {
+	case strings.HasPrefix(u.Path, "/api/v1/label/") && strings.HasSuffix(u.Path, "/values"):
+		name := strings.TrimSuffix(strings.TrimPrefix(u.Path, "/api/v1/label/"), "/values")
+		response = map[string]interface{}{"status": "success", "data": c.labelValues(name)}
+	case u.Path == "/api/v1/query_range":
+		query := u.Query()
+		start, _ := strconv.ParseInt(query.Get("start"), 10, 64)
+		end, _ := strconv.ParseInt(query.Get("end"), 10, 64)
+		step, _ := strconv.ParseInt(query.Get("step"), 10, 64)
+		result, err := queryPromRange(c, query.Get("query"), start, end, step)
+		if err != nil {
+			LogError("Unable to evaluate metrics query %s, %v", query.Get("query"), err)
+			// an empty result keeps the widget rendering
+			result = []promRangeResult{}
+		}
+		response = map[string]interface{}{
+			"status": "success",
+			"data":   map[string]interface{}{"resultType": "matrix", "result": result},
+		}
+	default:
+		LogError("Unsupported metrics query %s", endpoint)
+		return true
+	}
+	body, err := json.Marshal(response)
+	if err == nil {
+		err = json.Unmarshal(body, data)
+	}
+	if err != nil {
+		LogError("Unexpected error serializing metrics query %s, %v", endpoint, err)
+		return true
+	}
+	return false
+}
+
+// query evaluates an instant query at t
+func (c *metricsCollector) query(query string, t int64) ([]alertSample, error) {
+	expr, err := parsePromQL(query)
+	if err != nil {
+		return nil, err
+	}
+	value, err := evalPromQL(c, expr, t)
+	if err != nil {
+		return nil, err
+	}
+	if value.rangeSel != nil {
+		return nil, fmt.Errorf("range vectors are not supported in instant queries")
+	}
+	if value.scalar {
+		return []alertSample{{Labels: map[string]string{}, Value: value.value}}, nil
+	}
+	var samples []alertSample
+	for _, s := range value.samples {
+		samples = append(samples, alertSample{Labels: s.labels, Value: s.value})
+	}
+	return samples, nil
+}
+
+// queryMetrics runs an instant query against Prometheus when configured or
+// against the built-in collector otherwise, no samples are returned when
+// neither is available
+func queryMetrics(ctx context.Context, expr string) ([]alertSample, error) {
+	if getPrometheusURL() != "" {
+		return queryPrometheusAlert(ctx, expr)
+	}
+	if globalMetricsCollector == nil || !globalMetricsCollector.ready() {
+		return nil, nil
+	}
+	expr = strings.Replace(expr, "${jobid}", getPrometheusJobID(), -1)
+	return globalMetricsCollector.query(expr, time.Now().Unix())
+}
+
+// metricsSnapshotSeries stores the values of a series as gzipped little
+// endian floats, which keeps the snapshot small and preserves the NaNs
+type metricsSnapshotSeries struct {
+	Labels map[string]string `json:"labels"`
+	Values []byte            `json:"values"`
+}
+
+type metricsSnapshot struct {
+	Times  []int64                 `json:"times"`
+	Series []metricsSnapshotSeries `json:"series"`
+}
+
+func encodeMetricValues(values []float64) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if err := binary.Write(zw, binary.LittleEndian, values); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func decodeMetricValues(data []byte, n int) ([]float64, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+	values := make([]float64, n)
+	if err = binary.Read(zr, binary.LittleEndian, values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+func (c *metricsCollector) snapshot() (metricsSnapshot, error) {
+	c.RLock()
+	defer c.RUnlock()
+	snapshot := metricsSnapshot{Times: append([]int64{}, c.times...)}
+	for _, series := range c.series {
+		values, err := encodeMetricValues(series.values)
+		if err != nil {
+			return metricsSnapshot{}, err
+		}
+		snapshot.Series = append(snapshot.Series, metricsSnapshotSeries{Labels: series.labels, Values: values})
+	}
+	return snapshot, nil
+}
+
+// restore loads a snapshot, series that can't be decoded are ignored
+func (c *metricsCollector) restore(snapshot metricsSnapshot) {
+	c.Lock()
+	defer c.Unlock()
+	c.times = snapshot.Times
+	c.series = make(map[string]*collectedSeries)
+	for _, s := range snapshot.Series {
+		values, err := decodeMetricValues(s.Values, len(snapshot.Times))
+		if err != nil {
+			continue
+		}
+		c.series[promLabelsKey(s.Labels)] = &collectedSeries{labels: s.Labels, values: values}
+	}
+	if drop := len(c.times) - c.capacity; drop > 0 {
+		c.times = c.times[drop:]
+		for _, series := range c.series {
+			series.values = series.values[drop:]
+		}
+	}
+}
+
+// parseMetrics parses metrics in the Prometheus text format, the given labels
+// are added to every sample
+func parseMetrics(r io.Reader, extraLabels map[string]string) ([]promSample, error) {
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(r)
+	if err != nil {
+		return nil, err
+	}
+	var samples []promSample
+	addSample := func(name string, m *dto.Metric, extra map[string]string, value float64) {
+		labels := map[string]string{"__name__": name}
+		for k, v := range extraLabels {
+			labels[k] = v
+		}
+		for _, lp := range m.GetLabel() {
+			labels[lp.GetName()] = lp.GetValue()
+		}
+		for k, v := range extra {
+			labels[k] = v
+		}
+		samples = append(samples, promSample{labels: labels, value: value})
+	}
+	for name, family := range families {
+		for _, m := range family.GetMetric() {
+			switch family.GetType() {
+			case dto.MetricType_COUNTER:
+				addSample(name, m, nil, m.GetCounter().GetValue())
+			case dto.MetricType_GAUGE:
+				addSample(name, m, nil, m.GetGauge().GetValue())
+			case dto.MetricType_UNTYPED:
+				addSample(name, m, nil, m.GetUntyped().GetValue())
+			case dto.MetricType_SUMMARY:
+				s := m.GetSummary()
+				for _, q := range s.GetQuantile() {
+					addSample(name, m, map[string]string{"quantile": strconv.FormatFloat(q.GetQuantile(), 'f', -1, 64)}, q.GetValue())
+				}
+				addSample(name+"_sum", m, nil, s.GetSampleSum())
+				addSample(name+"_count", m, nil, float64(s.GetSampleCount()))
+			case dto.MetricType_HISTOGRAM:
+				h := m.GetHistogram()
+				infSeen := false
+				for _, b := range h.GetBucket() {
+					infSeen = math.IsInf(b.GetUpperBound(), 1)
+					addSample(name+"_bucket", m, map[string]string{"le": strconv.FormatFloat(b.GetUpperBound(), 'f', -1, 64)}, float64(b.GetCumulativeCount()))
+				}
+				if !infSeen {
+					addSample(name+"_bucket", m, map[string]string{"le": "+Inf"}, float64(h.GetSampleCount()))
+				}
+				addSample(name+"_sum", m, nil, h.GetSampleSum())
+				addSample(name+"_count", m, nil, float64(h.GetSampleCount()))
+			}
+		}
+	}
+	return samples, nil
+}
+
+// getMetricsCollectorEndpoint returns the MinIO cluster metrics endpoint
+func getMetricsCollectorEndpoint() string {
+	return strings.TrimSuffix(getMinIOServer(), "/") + "/minio/v2/metrics/cluster"
+}
+
+// scrapeMetrics fetches and parses the metrics of the MinIO cluster
+func scrapeMetrics(ctx context.Context, client *http.Client, endpoint, token string, extraLabels map[string]string) ([]promSample, error) {
+	ctx, cancel := context.WithTimeout(ctx, metricsScrapeTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response scraping %s: %s", endpoint, resp.Status)
+	}
+	return parseMetrics(resp.Body, extraLabels)
+}
+
+// getMetricsCollectorEnabled returns whether the built-in collector should run,
+// it is off by default and needs the token of the MinIO metrics endpoint, the
+// collector is never used when Prometheus is configured
+func getMetricsCollectorEnabled() bool {
+	if getPrometheusURL() != "" || env.Get(ConsoleMetricsCollector, "off") != "on" {
+		return false
+	}
+	if env.Get(ConsoleMetricsAuthToken, "") == "" {
+		LogInfo("The metrics collector is not started, %s is not set", ConsoleMetricsAuthToken)
+		return false
+	}
+	return true
+}
+
+// getMetricsCollectorInterval returns how often the metrics are scraped, 1 minute by default
+func getMetricsCollectorInterval() time.Duration {
+	interval, err := time.ParseDuration(env.Get(ConsoleMetricsCollectorInterval, "1m"))
+	if err != nil || interval <= 0 {
+		return time.Minute
+	}
+	return interval
+}
+
+// getMetricsCollectorRetention returns for how long the metrics are kept, 24 hours by default
+func getMetricsCollectorRetention() time.Duration {
+	retention, err := time.ParseDuration(env.Get(ConsoleMetricsCollectorRetention, "24h"))
+	if err != nil || retention <= 0 {
+		return 24 * time.Hour
+	}
+	return retention
+}
+
+// startMetricsCollector scrapes the MinIO cluster metrics in the background
+// when enabled and Prometheus is not configured
+func startMetricsCollector() {
+	if !getMetricsCollectorEnabled() {
+		return
+	}
+	interval := getMetricsCollectorInterval()
+	collector := newMetricsCollector(int(getMetricsCollectorRetention() / interval))
+	var snapshot metricsSnapshot
+	if err := readDataFile(metricsFile, &snapshot); err != nil {
+		LogError("Unable to load the collected metrics: %v", err)
+	}
+	collector.restore(snapshot)
+	globalMetricsCollector = collector
+
+	endpoint := getMetricsCollectorEndpoint()
+	token := env.Get(ConsoleMetricsAuthToken, "")
+	extraLabels := map[string]string{"job": getPrometheusJobID()}
+	if u, err := url.Parse(endpoint); err == nil {
+		extraLabels["instance"] = u.Host
+	}
+	go func() {
+		scrapes := 0
+		collect := func(now time.Time) {
+			samples, err := scrapeMetrics(context.Background(), GetConsoleSTSClient(), endpoint, token, extraLabels)
+			if err != nil {
+				LogError("Unable to collect metrics: %v", err)
+				return
+			}
+			collector.add(now.Unix(), samples)
+			scrapes++
+			if scrapes%metricsSnapshotEvery != 0 {
+				return
+			}
+			snapshot, err := collector.snapshot()
+			if err == nil {
+				err = writeDataFile(metricsFile, snapshot)
+			}
+			if err != nil {
+				LogError("Unable to store the collected metrics: %v", err)
+			}
+		}
+		collect(time.Now())
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for now := range ticker.C {
+			collect(now)
+		}
+	}()
+}
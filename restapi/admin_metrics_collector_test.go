@@ -0,0 +1,171 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricsCollectorAdd(t *testing.T) {
+	assert := assert.New(t)
+	collector := newMetricsCollector(3)
+	node1 := map[string]string{"__name__": "up", "server": "node1"}
+	node2 := map[string]string{"__name__": "up", "server": "node2"}
+	collector.add(60, []promSample{{labels: node1, value: 1}})
+	collector.add(120, []promSample{{labels: node1, value: 2}, {labels: node2, value: 1}})
+	// Test-1 : series appearing later are padded with missing values
+	assert.Equal(2, len(collector.series))
+	assert.True(math.IsNaN(collector.series[promLabelsKey(node2)].values[0]))
+	// Test-2 : out of order scrapes are ignored
+	collector.add(90, []promSample{{labels: node1, value: 5}})
+	assert.Equal([]int64{60, 120}, collector.times)
+	// Test-3 : the oldest scrapes are dropped beyond the capacity
+	collector.add(180, []promSample{{labels: node1, value: 3}})
+	collector.add(240, []promSample{{labels: node1, value: 4}})
+	assert.Equal([]int64{120, 180, 240}, collector.times)
+	assert.Equal([]float64{2, 3, 4}, collector.series[promLabelsKey(node1)].values)
+	// Test-4 : series without values in the window are removed
+	collector.add(300, []promSample{{labels: node1, value: 5}})
+	_, ok := collector.series[promLabelsKey(node2)]
+	assert.False(ok)
+	assert.Equal([]string{"node1"}, collector.labelValues("server"))
+}
+
+func TestMetricsCollectorSnapshot(t *testing.T) {
+	assert := assert.New(t)
+	collector := newTestMetricsCollector()
+	collector.add(360, []promSample{{labels: map[string]string{"__name__": "up"}, value: 1}})
+	snapshot, err := collector.snapshot()
+	assert.NoError(err)
+	restored := newMetricsCollector(3)
+	restored.restore(snapshot)
+	assert.Equal([]int64{240, 300, 360}, restored.times)
+	assert.Equal(len(collector.series), len(restored.series))
+	values := restored.series[promLabelsKey(map[string]string{"__name__": "starttime_seconds", "job": "minio-job", "server": "node1"})].values
+	assert.Equal(100.0, values[0])
+	assert.True(math.IsNaN(values[2]))
+}
+
+func TestMetricsCollectorUnmarshalPrometheus(t *testing.T) {
+	assert := assert.New(t)
+	collector := newTestMetricsCollector()
+	// Test-1 : label values
+	var labelResponse LabelResponse
+	assert.False(collector.unmarshalPrometheus("/api/v1/label/server/values", &labelResponse))
+	assert.Equal([]string{"node1", "node2"}, labelResponse.Data)
+	// Test-2 : range queries
+	var response PromResp
+	endpoint := fmt.Sprintf("/api/v1/query_range?query=%s&start=240&end=300&step=60", "sum(starttime_seconds)")
+	assert.False(collector.unmarshalPrometheus(endpoint, &response))
+	assert.Equal("success", response.Status)
+	assert.Equal("matrix", response.Data.ResultType)
+	if assert.Equal(1, len(response.Data.Result)) {
+		assert.Equal(2, len(response.Data.Result[0].Values))
+	}
+	// Test-3 : invalid queries return an empty result
+	endpoint = "/api/v1/query_range?query=sum(&start=240&end=300&step=60"
+	response = PromResp{}
+	assert.False(collector.unmarshalPrometheus(endpoint, &response))
+	assert.Empty(response.Data.Result)
+	// Test-4 : other endpoints are not supported
+	assert.True(collector.unmarshalPrometheus("/api/v1/series", &response))
+}
+
+func TestMetricsCollectorQuery(t *testing.T) {
+	assert := assert.New(t)
+	collector := newTestMetricsCollector()
+	// Test-1 : instant vectors
+	samples, err := collector.query("starttime_seconds", 300)
+	if assert.NoError(err) {
+		assert.Equal(2, len(samples))
+	}
+	// Test-2 : scalars
+	samples, err = collector.query("time()", 300)
+	if assert.NoError(err) && assert.Equal(1, len(samples)) {
+		assert.Equal(float64(300), samples[0].Value)
+	}
+	// Test-3 : range vectors and invalid queries
+	_, err = collector.query("starttime_seconds[5m]", 300)
+	assert.Error(err)
+	_, err = collector.query("sum(", 300)
+	assert.Error(err)
+	// Test-4 : the default alert rules can be evaluated by the collector
+	for _, rule := range defaultAlertRules {
+		_, err = collector.query(strings.Replace(rule.Expr, "${jobid}", "minio-job", -1), 300)
+		assert.NoError(err, rule.ID)
+	}
+}
+
+func TestGetMetricsCollectorEnabled(t *testing.T) {
+	assert := assert.New(t)
+	defer os.Unsetenv(ConsoleMetricsCollector)
+	defer os.Unsetenv(ConsoleMetricsAuthToken)
+	// Test-1 : the collector is off by default
+	assert.False(getMetricsCollectorEnabled())
+	// Test-2 : it doesn't scrape until the token of the metrics endpoint is set
+	os.Setenv(ConsoleMetricsCollector, "on")
+	assert.False(getMetricsCollectorEnabled())
+	os.Setenv(ConsoleMetricsAuthToken, "token")
+	assert.True(getMetricsCollectorEnabled())
+}
+
+func TestScrapeMetrics(t *testing.T) {
+	assert := assert.New(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		fmt.Fprint(w, strings.Join([]string{
+			"# TYPE minio_s3_requests_total counter",
+			`minio_s3_requests_total{api="GetObject",server="node1"} 10`,
+			"# TYPE minio_node_go_routine_total gauge",
+			`minio_node_go_routine_total{server="node1"} 42`,
+			"# TYPE minio_ttfb_seconds histogram",
+			`minio_ttfb_seconds_bucket{le="0.5"} 3`,
+			`minio_ttfb_seconds_bucket{le="+Inf"} 4`,
+			"minio_ttfb_seconds_sum 1.5",
+			"minio_ttfb_seconds_count 4",
+			"",
+		}, "\n"))
+	}))
+	defer server.Close()
+	// Test-1 : the token is required by the endpoint
+	_, err := scrapeMetrics(context.Background(), server.Client(), server.URL, "", nil)
+	assert.Error(err)
+	// Test-2 : samples are labeled with the job
+	samples, err := scrapeMetrics(context.Background(), server.Client(), server.URL, "token", map[string]string{"job": "minio-job"})
+	assert.NoError(err)
+	values := map[string]float64{}
+	for _, s := range samples {
+		assert.Equal("minio-job", s.labels["job"])
+		values[s.labels["__name__"]+s.labels["le"]] += s.value
+	}
+	assert.Equal(10.0, values["minio_s3_requests_total"])
+	assert.Equal(42.0, values["minio_node_go_routine_total"])
+	assert.Equal(7.0, values["minio_ttfb_seconds_bucket0.5"]+values["minio_ttfb_seconds_bucket+Inf"])
+	assert.Equal(4.0, values["minio_ttfb_seconds_count"])
+}
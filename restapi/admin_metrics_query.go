@@ -0,0 +1,794 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// The built-in metrics collector is not a PromQL engine, it only evaluates the
+// forms used by the dashboard widgets and the default alert rules:
+//
+//   - selectors with =, !=, =~ and !~ label matchers, and ranges as the
+//     argument of rate, irate and increase
+//   - the abs and time functions
+//   - the sum, min, max, avg, count, topk and bottomk aggregations with by or
+//     without, before or after the arguments
+//   - +, -, * and / between vectors and scalars, matching the series on all
+//     their labels
+//
+// Anything else, like offset, @, subqueries, comparison and set operators,
+// on/ignoring/group_left vector matching or other functions, is rejected with
+// errPromQLNotSupported and needs a Prometheus server.
+
+// metricsLookbackDelta is how far back an instant selector looks for a sample
+const metricsLookbackDelta = 5 * time.Minute
+
+type promExpr interface{}
+
+type promNumberExpr struct {
+	value float64
+}
+
+type promMatcher struct {
+	name  string
+	op    string
+	value string
+	re    *regexp.Regexp
+}
+
+func (m promMatcher) matches(value string) bool {
+	switch m.op {
+	case "=":
+		return value == m.value
+	case "!=":
+		return value != m.value
+	case "=~":
+		return m.re.MatchString(value)
+	case "!~":
+		return !m.re.MatchString(value)
+	}
+	return false
+}
+
+type promSelectorExpr struct {
+	matchers []promMatcher
+	rng      time.Duration
+}
+
+type promCallExpr struct {
+	fn   string
+	args []promExpr
+}
+
+type promAggregateExpr struct {
+	op       string
+	without  bool
+	grouping []string
+	param    promExpr
+	expr     promExpr
+}
+
+type promBinaryExpr struct {
+	op  string
+	lhs promExpr
+	rhs promExpr
+}
+
+var promAggregations = map[string]bool{
+	"sum": true, "min": true, "max": true, "avg": true, "count": true, "topk": true, "bottomk": true,
+}
+
+var promFunctions = map[string]bool{
+	"rate": true, "irate": true, "increase": true, "time": true, "abs": true,
+}
+
+type promToken struct {
+	kind  string // ident, number, string, duration, op or eof
+	value string
+}
+
+// lexPromQL splits a query in tokens
+func lexPromQL(query string) ([]promToken, error) {
+	var tokens []promToken
+	runes := []rune(query)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '"' || r == '\'' || r == '`':
+			j := i + 1
+			var sb strings.Builder
+			for ; j < len(runes) && runes[j] != r; j++ {
+				if runes[j] == '\\' && r != '`' && j+1 < len(runes) {
+					j++
+				}
+				sb.WriteRune(runes[j])
+			}
+			if j >= len(runes) {
+				return nil, fmt.Errorf("unterminated string in query")
+			}
+			tokens = append(tokens, promToken{kind: "string", value: sb.String()})
+			i = j + 1
+		case unicode.IsDigit(r) || (r == '.' && i+1 < len(runes) && unicode.IsDigit(runes[i+1])):
+			j := i
+			for j < len(runes) && (unicode.IsDigit(runes[j]) || runes[j] == '.' || runes[j] == 'e' || runes[j] == 'E' ||
+				((runes[j] == '+' || runes[j] == '-') && (runes[j-1] == 'e' || runes[j-1] == 'E'))) {
+				j++
+			}
+			kind := "number"
+			// durations such as 5m or 120s
+			for j < len(runes) && unicode.IsLetter(runes[j]) {
+				kind = "duration"
+				j++
+			}
+			tokens = append(tokens, promToken{kind: kind, value: string(runes[i:j])})
+			i = j
+		case unicode.IsLetter(r) || r == '_' || r == ':':
+			j := i
+			for j < len(runes) && (unicode.IsLetter(runes[j]) || unicode.IsDigit(runes[j]) || runes[j] == '_' || runes[j] == ':') {
+				j++
+			}
+			tokens = append(tokens, promToken{kind: "ident", value: string(runes[i:j])})
+			i = j
+		default:
+			if i+1 < len(runes) {
+				two := string(runes[i : i+2])
+				if two == "!=" || two == "=~" || two == "!~" {
+					tokens = append(tokens, promToken{kind: "op", value: two})
+					i += 2
+					continue
+				}
+			}
+			if !strings.ContainsRune("(){}[],=+-*/", r) {
+				return nil, fmt.Errorf("unexpected character %q in query", r)
+			}
+			tokens = append(tokens, promToken{kind: "op", value: string(r)})
+			i++
+		}
+	}
+	return append(tokens, promToken{kind: "eof"}), nil
+}
+
+type promParser struct {
+	tokens []promToken
+	pos    int
+}
+
+// parsePromQL parses a query in an expression tree
+func parsePromQL(query string) (promExpr, error) {
+	tokens, err := lexPromQL(query)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errPromQLNotSupported, err)
+	}
+	p := &promParser{tokens: tokens}
+	expr, err := p.parseExpr()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errPromQLNotSupported, err)
+	}
+	if p.peek().kind != "eof" {
+		return nil, fmt.Errorf("%w: unexpected %q in query", errPromQLNotSupported, p.peek().value)
+	}
+	return expr, nil
+}
+
+func (p *promParser) peek() promToken {
+	return p.tokens[p.pos]
+}
+
+func (p *promParser) next() promToken {
+	t := p.tokens[p.pos]
+	if t.kind != "eof" {
+		p.pos++
+	}
+	return t
+}
+
+func (p *promParser) isOp(value string) bool {
+	t := p.peek()
+	return t.kind == "op" && t.value == value
+}
+
+func (p *promParser) expect(value string) error {
+	if t := p.next(); t.kind != "op" || t.value != value {
+		return fmt.Errorf("expected %q in query, found %q", value, t.value)
+	}
+	return nil
+}
+
+func (p *promParser) parseExpr() (promExpr, error) {
+	lhs, err := p.parseTerm()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp("+") || p.isOp("-") {
+		op := p.next().value
+		rhs, err := p.parseTerm()
+		if err != nil {
+			return nil, err
+		}
+		lhs = &promBinaryExpr{op: op, lhs: lhs, rhs: rhs}
+	}
+	return lhs, nil
+}
+
+func (p *promParser) parseTerm() (promExpr, error) {
+	lhs, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOp("*") || p.isOp("/") {
+		op := p.next().value
+		rhs, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		lhs = &promBinaryExpr{op: op, lhs: lhs, rhs: rhs}
+	}
+	return lhs, nil
+}
+
+func (p *promParser) parseUnary() (promExpr, error) {
+	if p.isOp("-") {
+		p.next()
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &promBinaryExpr{op: "*", lhs: &promNumberExpr{value: -1}, rhs: expr}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *promParser) parsePrimary() (promExpr, error) {
+	t := p.peek()
+	switch {
+	case t.kind == "number":
+		p.next()
+		value, err := strconv.ParseFloat(t.value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q in query", t.value)
+		}
+		return &promNumberExpr{value: value}, nil
+	case t.kind == "op" && t.value == "(":
+		p.next()
+		expr, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		return expr, p.expect(")")
+	case t.kind == "op" && t.value == "{":
+		return p.parseSelector("")
+	case t.kind == "ident":
+		p.next()
+		if promAggregations[t.value] {
+			return p.parseAggregate(t.value)
+		}
+		if p.isOp("(") {
+			return p.parseCall(t.value)
+		}
+		return p.parseSelector(t.value)
+	}
+	return nil, fmt.Errorf("unexpected %q in query", t.value)
+}
+
+func (p *promParser) parseCall(fn string) (promExpr, error) {
+	if !promFunctions[fn] {
+		return nil, fmt.Errorf("unsupported function %s", fn)
+	}
+	p.next()
+	call := &promCallExpr{fn: fn}
+	for !p.isOp(")") {
+		arg, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		call.args = append(call.args, arg)
+		if !p.isOp(",") {
+			break
+		}
+		p.next()
+	}
+	if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+	expected := 1
+	if fn == "time" {
+		expected = 0
+	}
+	if len(call.args) != expected {
+		return nil, fmt.Errorf("function %s expects %d argument(s)", fn, expected)
+	}
+	return call, nil
+}
+
+func (p *promParser) parseGrouping(agg *promAggregateExpr) error {
+	t := p.peek()
+	if t.kind != "ident" || (t.value != "by" && t.value != "without") {
+		return nil
+	}
+	p.next()
+	agg.without = t.value == "without"
+	if err := p.expect("("); err != nil {
+		return err
+	}
+	for !p.isOp(")") {
+		lbl := p.next()
+		if lbl.kind != "ident" {
+			return fmt.Errorf("unexpected %q in grouping", lbl.value)
+		}
+		agg.grouping = append(agg.grouping, lbl.value)
+		if !p.isOp(",") {
+			break
+		}
+		p.next()
+	}
+	return p.expect(")")
+}
+
+func (p *promParser) parseAggregate(op string) (promExpr, error) {
+	agg := &promAggregateExpr{op: op}
+	if err := p.parseGrouping(agg); err != nil {
+		return nil, err
+	}
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	if op == "topk" || op == "bottomk" {
+		param, err := p.parseExpr()
+		if err != nil {
+			return nil, err
+		}
+		agg.param = param
+		if err = p.expect(","); err != nil {
+			return nil, err
+		}
+	}
+	expr, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	agg.expr = expr
+	if err = p.expect(")"); err != nil {
+		return nil, err
+	}
+	if agg.grouping == nil {
+		if err = p.parseGrouping(agg); err != nil {
+			return nil, err
+		}
+	}
+	return agg, nil
+}
+
+func (p *promParser) parseSelector(name string) (promExpr, error) {
+	sel := &promSelectorExpr{}
+	if name != "" {
+		sel.matchers = append(sel.matchers, promMatcher{name: "__name__", op: "=", value: name})
+	}
+	if p.isOp("{") {
+		p.next()
+		for !p.isOp("}") {
+			lbl := p.next()
+			if lbl.kind != "ident" {
+				return nil, fmt.Errorf("unexpected %q in label matchers", lbl.value)
+			}
+			op := p.next()
+			if op.kind != "op" || (op.value != "=" && op.value != "!=" && op.value != "=~" && op.value != "!~") {
+				return nil, fmt.Errorf("unexpected %q in label matchers", op.value)
+			}
+			value := p.next()
+			if value.kind != "string" {
+				return nil, fmt.Errorf("unexpected %q in label matchers", value.value)
+			}
+			matcher := promMatcher{name: lbl.value, op: op.value, value: value.value}
+			if op.value == "=~" || op.value == "!~" {
+				re, err := regexp.Compile("^(?:" + value.value + ")$")
+				if err != nil {
+					return nil, err
+				}
+				matcher.re = re
+			}
+			sel.matchers = append(sel.matchers, matcher)
+			if !p.isOp(",") {
+				break
+			}
+			p.next()
+		}
+		if err := p.expect("}"); err != nil {
+			return nil, err
+		}
+	}
+	if len(sel.matchers) == 0 {
+		return nil, fmt.Errorf("empty selector in query")
+	}
+	if p.isOp("[") {
+		p.next()
+		t := p.next()
+		if t.kind != "duration" {
+			return nil, fmt.Errorf("invalid range %q in query", t.value)
+		}
+		rng, err := parsePromDuration(t.value)
+		if err != nil {
+			return nil, err
+		}
+		sel.rng = rng
+		if err = p.expect("]"); err != nil {
+			return nil, err
+		}
+	}
+	return sel, nil
+}
+
+// parsePromDuration parses durations such as 30s, 5m, 1h or 1d
+func parsePromDuration(value string) (time.Duration, error) {
+	if strings.HasSuffix(value, "d") {
+		days, err := strconv.Atoi(strings.TrimSuffix(value, "d"))
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", value)
+		}
+		return time.Duration(days) * 24 * time.Hour, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+	return d, nil
+}
+
+// promSample is an element of an instant vector
+type promSample struct {
+	labels map[string]string
+	value  float64
+}
+
+// promValue is the result of evaluating an expression at a point in time,
+// either a scalar or an instant vector
+type promValue struct {
+	scalar   bool
+	value    float64
+	samples  []promSample
+	rangeSel *promSelectorExpr
+}
+
+// promSeriesSource gives access to the stored samples
+type promSeriesSource interface {
+	// selectSeries calls fn with the labels and the samples within (from, to]
+	// of every series matching the matchers
+	selectSeries(matchers []promMatcher, from, to int64, fn func(labels map[string]string, points []metricPoint))
+}
+
+// metricPoint is a sample of a series
+type metricPoint struct {
+	T int64
+	V float64
+}
+
+func evalPromQL(src promSeriesSource, expr promExpr, t int64) (promValue, error) {
+	switch e := expr.(type) {
+	case *promNumberExpr:
+		return promValue{scalar: true, value: e.value}, nil
+	case *promSelectorExpr:
+		if e.rng > 0 {
+			return promValue{rangeSel: e}, nil
+		}
+		var samples []promSample
+		src.selectSeries(e.matchers, t-int64(metricsLookbackDelta/time.Second), t, func(labels map[string]string, points []metricPoint) {
+			if len(points) > 0 {
+				samples = append(samples, promSample{labels: labels, value: points[len(points)-1].V})
+			}
+		})
+		return promValue{samples: samples}, nil
+	case *promCallExpr:
+		return evalPromCall(src, e, t)
+	case *promAggregateExpr:
+		return evalPromAggregate(src, e, t)
+	case *promBinaryExpr:
+		lhs, err := evalPromQL(src, e.lhs, t)
+		if err != nil {
+			return promValue{}, err
+		}
+		rhs, err := evalPromQL(src, e.rhs, t)
+		if err != nil {
+			return promValue{}, err
+		}
+		return evalPromBinary(e.op, lhs, rhs)
+	}
+	return promValue{}, fmt.Errorf("unsupported expression")
+}
+
+func evalPromCall(src promSeriesSource, call *promCallExpr, t int64) (promValue, error) {
+	if call.fn == "time" {
+		return promValue{scalar: true, value: float64(t)}, nil
+	}
+	arg, err := evalPromQL(src, call.args[0], t)
+	if err != nil {
+		return promValue{}, err
+	}
+	if call.fn == "abs" {
+		if arg.rangeSel != nil {
+			return promValue{}, fmt.Errorf("abs expects an instant vector")
+		}
+		if arg.scalar {
+			return promValue{scalar: true, value: math.Abs(arg.value)}, nil
+		}
+		for i := range arg.samples {
+			arg.samples[i] = promSample{labels: withoutMetricName(arg.samples[i].labels), value: math.Abs(arg.samples[i].value)}
+		}
+		return arg, nil
+	}
+	if arg.rangeSel == nil {
+		return promValue{}, fmt.Errorf("%s expects a range vector", call.fn)
+	}
+	rng := int64(arg.rangeSel.rng / time.Second)
+	var samples []promSample
+	src.selectSeries(arg.rangeSel.matchers, t-rng, t, func(labels map[string]string, points []metricPoint) {
+		if len(points) < 2 {
+			return
+		}
+		var value float64
+		if call.fn == "irate" {
+			last, prev := points[len(points)-1], points[len(points)-2]
+			delta := last.V - prev.V
+			if delta < 0 {
+				delta = last.V
+			}
+			value = delta / float64(last.T-prev.T)
+		} else {
+			// counters may be reset when a server restarts
+			var delta float64
+			for i := 1; i < len(points); i++ {
+				if points[i].V < points[i-1].V {
+					delta += points[i].V
+				} else {
+					delta += points[i].V - points[i-1].V
+				}
+			}
+			value = delta / float64(points[len(points)-1].T-points[0].T)
+			if call.fn == "increase" {
+				value *= float64(rng)
+			}
+		}
+		samples = append(samples, promSample{labels: withoutMetricName(labels), value: value})
+	})
+	return promValue{samples: samples}, nil
+}
+
+func withoutMetricName(labels map[string]string) map[string]string {
+	result := make(map[string]string, len(labels))
+	for k, v := range labels {
+		if k != "__name__" {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// promLabelsKey returns a key identifying a set of labels
+func promLabelsKey(labels map[string]string) string {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var sb strings.Builder
+	for _, k := range keys {
+		sb.WriteString(k)
+		sb.WriteByte(0)
+		sb.WriteString(labels[k])
+		sb.WriteByte(0)
+	}
+	return sb.String()
+}
+
+func evalPromAggregate(src promSeriesSource, agg *promAggregateExpr, t int64) (promValue, error) {
+	arg, err := evalPromQL(src, agg.expr, t)
+	if err != nil {
+		return promValue{}, err
+	}
+	if arg.scalar || arg.rangeSel != nil {
+		return promValue{}, fmt.Errorf("%s expects an instant vector", agg.op)
+	}
+	k := 0
+	if agg.param != nil {
+		param, err := evalPromQL(src, agg.param, t)
+		if err != nil {
+			return promValue{}, err
+		}
+		if !param.scalar {
+			return promValue{}, fmt.Errorf("%s expects a scalar parameter", agg.op)
+		}
+		k = int(param.value)
+	}
+	type group struct {
+		labels  map[string]string
+		samples []promSample
+	}
+	var order []string
+	groups := make(map[string]*group)
+	for _, s := range arg.samples {
+		grouping := make(map[string]string)
+		if agg.without {
+			for name, value := range s.labels {
+				grouping[name] = value
+			}
+			delete(grouping, "__name__")
+			for _, name := range agg.grouping {
+				delete(grouping, name)
+			}
+		} else {
+			for _, name := range agg.grouping {
+				if value, ok := s.labels[name]; ok {
+					grouping[name] = value
+				}
+			}
+		}
+		key := promLabelsKey(grouping)
+		g, ok := groups[key]
+		if !ok {
+			g = &group{labels: grouping}
+			groups[key] = g
+			order = append(order, key)
+		}
+		g.samples = append(g.samples, s)
+	}
+	var result []promSample
+	for _, key := range order {
+		g := groups[key]
+		switch agg.op {
+		case "topk", "bottomk":
+			samples := append([]promSample{}, g.samples...)
+			sort.SliceStable(samples, func(i, j int) bool {
+				if agg.op == "topk" {
+					return samples[i].value > samples[j].value
+				}
+				return samples[i].value < samples[j].value
+			})
+			if k < len(samples) {
+				samples = samples[:k]
+			}
+			result = append(result, samples...)
+			continue
+		}
+		value := g.samples[0].value
+		for _, s := range g.samples[1:] {
+			switch agg.op {
+			case "sum", "avg":
+				value += s.value
+			case "min":
+				value = math.Min(value, s.value)
+			case "max":
+				value = math.Max(value, s.value)
+			}
+		}
+		switch agg.op {
+		case "avg":
+			value /= float64(len(g.samples))
+		case "count":
+			value = float64(len(g.samples))
+		}
+		result = append(result, promSample{labels: g.labels, value: value})
+	}
+	return promValue{samples: result}, nil
+}
+
+func promArithmetic(op string, lhs, rhs float64) float64 {
+	switch op {
+	case "+":
+		return lhs + rhs
+	case "-":
+		return lhs - rhs
+	case "*":
+		return lhs * rhs
+	default:
+		return lhs / rhs
+	}
+}
+
+func evalPromBinary(op string, lhs, rhs promValue) (promValue, error) {
+	if lhs.rangeSel != nil || rhs.rangeSel != nil {
+		return promValue{}, fmt.Errorf("binary operations are not supported on range vectors")
+	}
+	switch {
+	case lhs.scalar && rhs.scalar:
+		return promValue{scalar: true, value: promArithmetic(op, lhs.value, rhs.value)}, nil
+	case rhs.scalar:
+		var samples []promSample
+		for _, s := range lhs.samples {
+			samples = append(samples, promSample{labels: withoutMetricName(s.labels), value: promArithmetic(op, s.value, rhs.value)})
+		}
+		return promValue{samples: samples}, nil
+	case lhs.scalar:
+		var samples []promSample
+		for _, s := range rhs.samples {
+			samples = append(samples, promSample{labels: withoutMetricName(s.labels), value: promArithmetic(op, lhs.value, s.value)})
+		}
+		return promValue{samples: samples}, nil
+	}
+	// vectors are matched one to one on their labels
+	rhsByKey := make(map[string]float64)
+	for _, s := range rhs.samples {
+		rhsByKey[promLabelsKey(withoutMetricName(s.labels))] = s.value
+	}
+	var samples []promSample
+	for _, s := range lhs.samples {
+		labels := withoutMetricName(s.labels)
+		if value, ok := rhsByKey[promLabelsKey(labels)]; ok {
+			samples = append(samples, promSample{labels: labels, value: promArithmetic(op, s.value, value)})
+		}
+	}
+	return promValue{samples: samples}, nil
+}
+
+// formatPromValue formats a sample the way the Prometheus API does
+func formatPromValue(t int64, value float64) []interface{} {
+	return []interface{}{t, strconv.FormatFloat(value, 'f', -1, 64)}
+}
+
+// promRangeResult is an element of a matrix result
+type promRangeResult struct {
+	Metric map[string]string `json:"metric"`
+	Values []interface{}     `json:"values"`
+}
+
+// queryPromRange evaluates a query at every step between start and end
+func queryPromRange(src promSeriesSource, query string, start, end, step int64) ([]promRangeResult, error) {
+	expr, err := parsePromQL(query)
+	if err != nil {
+		return nil, err
+	}
+	if step <= 0 {
+		return nil, fmt.Errorf("invalid step %d", step)
+	}
+	var order []string
+	series := make(map[string]*promRangeResult)
+	for t := start; t <= end; t += step {
+		value, err := evalPromQL(src, expr, t)
+		if err != nil {
+			return nil, err
+		}
+		if value.rangeSel != nil {
+			return nil, fmt.Errorf("range vectors are not supported in range queries")
+		}
+		samples := value.samples
+		if value.scalar {
+			samples = []promSample{{labels: map[string]string{}, value: value.value}}
+		}
+		for _, s := range samples {
+			key := promLabelsKey(s.labels)
+			r, ok := series[key]
+			if !ok {
+				r = &promRangeResult{Metric: s.labels}
+				series[key] = r
+				order = append(order, key)
+			}
+			r.Values = append(r.Values, formatPromValue(t, s.value))
+		}
+	}
+	result := []promRangeResult{}
+	for _, key := range order {
+		result = append(result, *series[key])
+	}
+	return result, nil
+}
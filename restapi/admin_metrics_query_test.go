@@ -0,0 +1,128 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestMetricsCollector() *metricsCollector {
+	collector := newMetricsCollector(60)
+	for i := int64(0); i < 5; i++ {
+		collector.add(60*(i+1), []promSample{
+			{labels: map[string]string{"__name__": "requests_total", "job": "minio-job", "server": "node1", "api": "GetObject"}, value: float64(60 * i)},
+			{labels: map[string]string{"__name__": "requests_total", "job": "minio-job", "server": "node1", "api": "PutObject"}, value: float64(120 * i)},
+			{labels: map[string]string{"__name__": "requests_total", "job": "minio-job", "server": "node2", "api": "GetObject"}, value: float64(30 * i)},
+			{labels: map[string]string{"__name__": "starttime_seconds", "job": "minio-job", "server": "node1"}, value: 100},
+			{labels: map[string]string{"__name__": "starttime_seconds", "job": "minio-job", "server": "node2"}, value: 200},
+		})
+	}
+	return collector
+}
+
+func TestParsePromQL(t *testing.T) {
+	assert := assert.New(t)
+	queries := []string{
+		`count(count by (bucket) (minio_bucket_usage_total_bytes{job="minio-job"}))`,
+		`sum by (server,api) (rate(minio_s3_requests_total{job="minio-job"}[120s]))`,
+		`sum without (server,instance) (minio_node_go_routine_total{job="minio-job"})`,
+		`topk(1, sum(minio_bucket_usage_object_total{job="minio-job"}) by (instance))`,
+		`time() - max(minio_node_process_starttime_seconds{job="minio-job"})`,
+		`minio_bucket_usage_total_bytes{bucket=~"(a|b)", job!="other"} / 1024`,
+	}
+	for _, query := range queries {
+		_, err := parsePromQL(query)
+		assert.NoError(err, query)
+	}
+	// every built-in widget can be evaluated by the collector
+	for _, widget := range widgets {
+		for _, target := range widget.Targets {
+			query := strings.Replace(target.Expr, "$__interval", "120s", -1)
+			query = strings.Replace(query, "${jobid}", "minio-job", -1)
+			_, err := parsePromQL(query)
+			assert.NoError(err, query)
+		}
+	}
+	invalid := []string{
+		`sum(`,
+		`unknown_fn(metric)`,
+		`metric{job=}`,
+		`metric[5x]`,
+		`{}`,
+		`metric offset 5m`,
+		`rate(metric[5m:1m])`,
+		`metric > 1`,
+		`a / on(server) b`,
+		`a and b`,
+		`histogram_quantile(0.9, metric)`,
+	}
+	for _, query := range invalid {
+		_, err := parsePromQL(query)
+		assert.True(errors.Is(err, errPromQLNotSupported), query)
+	}
+}
+
+func TestQueryPromRange(t *testing.T) {
+	assert := assert.New(t)
+	collector := newTestMetricsCollector()
+	// Test-1 : instant selectors return the latest sample with its labels
+	result, err := queryPromRange(collector, `requests_total{server="node1",api="GetObject"}`, 300, 300, 60)
+	assert.NoError(err)
+	if assert.Equal(1, len(result)) {
+		assert.Equal("requests_total", result[0].Metric["__name__"])
+		assert.Equal([]interface{}{formatPromValue(300, 240)}, result[0].Values)
+	}
+	// Test-2 : rate aggregated by server
+	result, err = queryPromRange(collector, `sum by (server) (rate(requests_total{job="minio-job"}[2m]))`, 300, 300, 60)
+	assert.NoError(err)
+	rates := map[string]interface{}{}
+	for _, r := range result {
+		rates[r.Metric["server"]] = r.Values[0].([]interface{})[1]
+		assert.Equal(1, len(r.Metric))
+	}
+	assert.Equal(map[string]interface{}{"node1": "3", "node2": "0.5"}, rates)
+	// Test-3 : scalars are returned as a series without labels
+	result, err = queryPromRange(collector, `time() - max(starttime_seconds)`, 240, 300, 60)
+	assert.NoError(err)
+	if assert.Equal(1, len(result)) {
+		assert.Empty(result[0].Metric)
+		assert.Equal([]interface{}{formatPromValue(240, 40), formatPromValue(300, 100)}, result[0].Values)
+	}
+	// Test-4 : topk keeps the labels of the selected samples
+	result, err = queryPromRange(collector, `topk(1, requests_total)`, 300, 300, 60)
+	assert.NoError(err)
+	if assert.Equal(1, len(result)) {
+		assert.Equal("PutObject", result[0].Metric["api"])
+	}
+	// Test-5 : count and regular expression matchers
+	result, err = queryPromRange(collector, `count(requests_total{api=~"Get.*"})`, 300, 300, 60)
+	assert.NoError(err)
+	if assert.Equal(1, len(result)) {
+		assert.Equal([]interface{}{formatPromValue(300, 2)}, result[0].Values)
+	}
+	// Test-6 : samples older than the lookback delta are not returned
+	result, err = queryPromRange(collector, `requests_total`, 1000, 1000, 60)
+	assert.NoError(err)
+	assert.Empty(result)
+	// Test-7 : range vectors can't be returned
+	_, err = queryPromRange(collector, `requests_total[5m]`, 300, 300, 60)
+	assert.Error(err)
+}
@@ -0,0 +1,414 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/admin_api"
+	"github.com/minio/madmin-go"
+	"github.com/minio/pkg/env"
+	iampolicy "github.com/minio/pkg/iam/policy"
+)
+
+const driveStateHistoryFile = "drive-state-history.json"
+
+// driveStateHistorySize is the number of drive state transitions kept
+const driveStateHistorySize = 500
+
+// driveStateSampleInterval is how often the state of the drives is sampled
+const driveStateSampleInterval = time.Minute
+
+// driveFlappingTransitions is the number of transitions within
+// driveFlappingWindow after which a drive is reported as flapping
+const driveFlappingTransitions = 3
+
+const driveFlappingWindow = 24 * time.Hour
+
+// defaultDriveHistoryLimit is the number of events returned when no limit is given
+const defaultDriveHistoryLimit = 100
+
+// Drive states tracked by the drive state history
+const (
+	driveStateOnline  = "online"
+	driveStateOffline = "offline"
+	driveStateHealing = "healing"
+)
+
+func registerNodesHandlers(api *operations.ConsoleAPI) {
+	// List servers and drives
+	api.AdminAPIListNodesHandler = admin_api.ListNodesHandlerFunc(func(params admin_api.ListNodesParams, session *models.Principal) middleware.Responder {
+		resp, err := getListNodesResponse(session)
+		if err != nil {
+			return admin_api.NewListNodesDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewListNodesOK().WithPayload(resp)
+	})
+	// Server details
+	api.AdminAPINodeInfoHandler = admin_api.NodeInfoHandlerFunc(func(params admin_api.NodeInfoParams, session *models.Principal) middleware.Responder {
+		resp, err := getNodeInfoResponse(session, params)
+		if err != nil {
+			return admin_api.NewNodeInfoDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewNodeInfoOK().WithPayload(resp)
+	})
+	// Drive state transitions
+	api.AdminAPIDriveStateHistoryHandler = admin_api.DriveStateHistoryHandlerFunc(func(params admin_api.DriveStateHistoryParams, session *models.Principal) middleware.Responder {
+		resp, err := getDriveStateHistoryResponse(session, params)
+		if err != nil {
+			return admin_api.NewDriveStateHistoryDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewDriveStateHistoryOK().WithPayload(resp)
+	})
+}
+
+// driveStateHistory keeps the last transitions of the drives between the
+// online, offline and healing states in a file of the Console data
+// directory, the state of the drives is sampled in the background
+type driveStateHistory struct {
+	sync.Mutex
+	file string
+	// capacity is the number of events kept
+	capacity int
+	loaded   bool
+	states   map[string]string
+	events   []*models.DriveStateEvent
+}
+
+// driveStateRecord is the content of the drive state history file
+type driveStateRecord struct {
+	States map[string]string
+	Events []*models.DriveStateEvent
+}
+
+var globalDriveStateHistory = newDriveStateHistory(driveStateHistoryFile, driveStateHistorySize)
+
+func newDriveStateHistory(file string, capacity int) *driveStateHistory {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &driveStateHistory{
+		file:     file,
+		capacity: capacity,
+		states:   make(map[string]string),
+	}
+}
+
+// load reads the history file the first time it's needed, callers must hold the lock
+func (h *driveStateHistory) load() error {
+	if h.loaded {
+		return nil
+	}
+	var record driveStateRecord
+	if err := readDataFile(h.file, &record); err != nil {
+		return err
+	}
+	if record.States != nil {
+		h.states = record.States
+	}
+	h.events = record.Events
+	h.loaded = true
+	return nil
+}
+
+// driveHistoryState reduces the state of a drive to online, offline or healing
+func driveHistoryState(disk madmin.Disk) string {
+	switch {
+	case disk.State != madmin.DriveStateOk:
+		return driveStateOffline
+	case disk.Healing:
+		return driveStateHealing
+	}
+	return driveStateOnline
+}
+
+// driveID identifies a drive of a server, drives reported without an endpoint
+// are identified by their path
+func driveID(server madmin.ServerProperties, disk madmin.Disk) string {
+	if disk.Endpoint != "" {
+		return disk.Endpoint
+	}
+	return server.Endpoint + disk.DrivePath
+}
+
+// observe records the drives whose state changed since the previous observation,
+// the first time a drive is seen its state is only remembered. The history is
+// stored when it changed
+func (h *driveStateHistory) observe(info madmin.InfoMessage, now time.Time) error {
+	h.Lock()
+	defer h.Unlock()
+	if err := h.load(); err != nil {
+		return err
+	}
+	changed := false
+	for _, server := range info.Servers {
+		for _, disk := range server.Disks {
+			id := driveID(server, disk)
+			state := driveHistoryState(disk)
+			previous, ok := h.states[id]
+			h.states[id] = state
+			if ok && previous == state {
+				continue
+			}
+			changed = true
+			if !ok {
+				continue
+			}
+			h.events = append(h.events, &models.DriveStateEvent{
+				Time:   now.UTC().Format(time.RFC3339),
+				Server: server.Endpoint,
+				Drive:  id,
+				Path:   disk.DrivePath,
+				From:   previous,
+				To:     state,
+			})
+		}
+	}
+	if len(h.events) > h.capacity {
+		h.events = h.events[len(h.events)-h.capacity:]
+	}
+	if !changed {
+		return nil
+	}
+	return writeDataFile(h.file, driveStateRecord{States: h.states, Events: h.events})
+}
+
+// history returns the most recent transitions first, optionally only the ones of
+// a drive matched by its endpoint or path, along with the drives that are flapping
+func (h *driveStateHistory) history(drive string, limit int, now time.Time) (*models.DriveHistoryResponse, error) {
+	h.Lock()
+	defer h.Unlock()
+	if err := h.load(); err != nil {
+		return nil, err
+	}
+	resp := &models.DriveHistoryResponse{
+		Events:   []*models.DriveStateEvent{},
+		Flapping: []string{},
+	}
+	transitions := make(map[string]int)
+	for i := len(h.events) - 1; i >= 0; i-- {
+		event := h.events[i]
+		if t, err := time.Parse(time.RFC3339, event.Time); err == nil && now.Sub(t) <= driveFlappingWindow {
+			transitions[event.Drive]++
+		}
+		if drive != "" && event.Drive != drive && event.Path != drive {
+			continue
+		}
+		if len(resp.Events) < limit {
+			resp.Events = append(resp.Events, event)
+		}
+	}
+	for id, count := range transitions {
+		if count >= driveFlappingTransitions {
+			resp.Flapping = append(resp.Flapping, id)
+		}
+	}
+	sort.Strings(resp.Flapping)
+	return resp, nil
+}
+
+// newMonitorAdminClient returns an admin client with the credentials
+// configured for the background monitoring, the sessions of the users expire
+// so they can't be used
+func newMonitorAdminClient() (MinioAdmin, error) {
+	accessKey, secretKey := env.Get(ConsoleMonitorAccessKey, ""), env.Get(ConsoleMonitorSecretKey, "")
+	if accessKey == "" || secretKey == "" {
+		return nil, errMonitorCredentialsNotSet
+	}
+	mAdmin, err := newAdminFromCreds(accessKey, secretKey, getMinIOEndpoint(), getMinIOEndpointIsSecure())
+	if err != nil {
+		return nil, err
+	}
+	mAdmin.SetCustomTransport(GetConsoleSTSClient().Transport)
+	return AdminClient{Client: mAdmin}, nil
+}
+
+// sampleDriveStates records the current state of the drives
+func sampleDriveStates(ctx context.Context, client MinioAdmin, history *driveStateHistory, now time.Time) error {
+	info, err := client.serverInfo(ctx)
+	if err != nil {
+		return err
+	}
+	return history.observe(info, now)
+}
+
+// startDriveStateSampler samples the state of the drives in the background
+// with the monitoring credentials, the history isn't recorded without them
+func startDriveStateSampler() {
+	client, err := newMonitorAdminClient()
+	if err != nil {
+		LogInfo("the drive state history is off: %v", err)
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(driveStateSampleInterval)
+		defer ticker.Stop()
+		for now := range ticker.C {
+			if err := sampleDriveStates(context.Background(), client, globalDriveStateHistory, now); err != nil {
+				LogError("unable to sample the state of the drives: %v", err)
+			}
+		}
+	}()
+}
+
+// getDriveInfo returns the details of a drive without truncating its space to int64
+func getDriveInfo(disk madmin.Disk) *models.DriveInfo {
+	return &models.DriveInfo{
+		Endpoint:       disk.Endpoint,
+		Path:           disk.DrivePath,
+		State:          disk.State,
+		Healing:        disk.Healing,
+		RootDisk:       disk.RootDisk,
+		Model:          disk.Model,
+		UUID:           disk.UUID,
+		TotalSpace:     disk.TotalSpace,
+		UsedSpace:      disk.UsedSpace,
+		AvailableSpace: disk.AvailableSpace,
+		PoolIndex:      int64(disk.PoolIndex),
+		SetIndex:       int64(disk.SetIndex),
+		DiskIndex:      int64(disk.DiskIndex),
+	}
+}
+
+// getNodeInfo returns the state, version, network status and drives of a server
+func getNodeInfo(server madmin.ServerProperties) *models.NodeInfo {
+	node := &models.NodeInfo{
+		Endpoint:  server.Endpoint,
+		State:     server.State,
+		Uptime:    server.Uptime,
+		Version:   server.Version,
+		CommitID:  server.CommitID,
+		PoolIndex: int64(server.PoolNumber),
+		Network:   []*models.NodeNetworkStatus{},
+		Drives:    []*models.DriveInfo{},
+	}
+	for endpoint, state := range server.Network {
+		node.Network = append(node.Network, &models.NodeNetworkStatus{Endpoint: endpoint, State: state})
+	}
+	sort.Slice(node.Network, func(i, j int) bool {
+		return node.Network[i].Endpoint < node.Network[j].Endpoint
+	})
+	for _, disk := range server.Disks {
+		switch driveHistoryState(disk) {
+		case driveStateOffline:
+			node.OfflineDrives++
+		case driveStateHealing:
+			node.HealingDrives++
+			node.OnlineDrives++
+		default:
+			node.OnlineDrives++
+		}
+		node.TotalSpace += disk.TotalSpace
+		node.UsedSpace += disk.UsedSpace
+		node.AvailableSpace += disk.AvailableSpace
+		node.Drives = append(node.Drives, getDriveInfo(disk))
+	}
+	return node
+}
+
+// listNodes returns every server of the cluster
+func listNodes(ctx context.Context, client MinioAdmin) (*models.NodesResponse, error) {
+	info, err := client.serverInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &models.NodesResponse{Servers: []*models.NodeInfo{}}
+	for _, server := range info.Servers {
+		node := getNodeInfo(server)
+		resp.TotalSpace += node.TotalSpace
+		resp.UsedSpace += node.UsedSpace
+		resp.AvailableSpace += node.AvailableSpace
+		resp.Servers = append(resp.Servers, node)
+	}
+	return resp, nil
+}
+
+// nodeInfo returns the server with the given endpoint, with or without its scheme
+func nodeInfo(ctx context.Context, client MinioAdmin, endpoint string) (*models.NodeInfo, error) {
+	nodes, err := listNodes(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	for _, node := range nodes.Servers {
+		if node.Endpoint == endpoint || strings.TrimPrefix(strings.TrimPrefix(node.Endpoint, "http://"), "https://") == endpoint {
+			return node, nil
+		}
+	}
+	return nil, ErrorGenericNotFound
+}
+
+func getListNodesResponse(session *models.Principal) (*models.NodesResponse, *models.Error) {
+	ctx := context.Background()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+
+	resp, err := listNodes(ctx, adminClient)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return resp, nil
+}
+
+func getNodeInfoResponse(session *models.Principal, params admin_api.NodeInfoParams) (*models.NodeInfo, *models.Error) {
+	ctx := context.Background()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+
+	resp, err := nodeInfo(ctx, adminClient, params.Node)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return resp, nil
+}
+
+// getDriveStateHistoryResponse returns the recorded history, MinIO isn't
+// queried so the session is checked for the server info permission
+func getDriveStateHistoryResponse(session *models.Principal, params admin_api.DriveStateHistoryParams) (*models.DriveHistoryResponse, *models.Error) {
+	if !sessionAllowsAction(session, iampolicy.ServerInfoAdminAction) {
+		return nil, prepareError(errAccessDenied)
+	}
+	drive := ""
+	if params.Drive != nil {
+		drive = *params.Drive
+	}
+	limit := defaultDriveHistoryLimit
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = int(*params.Limit)
+	}
+	history, err := globalDriveStateHistory.history(drive, limit, time.Now())
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return history, nil
+}
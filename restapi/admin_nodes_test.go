@@ -0,0 +1,151 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListNodes(t *testing.T) {
+	assert := assert.New(t)
+	adminClient := adminClientMock{}
+	ctx := context.Background()
+	minioServerInfoMock = func(ctx context.Context) (madmin.InfoMessage, error) {
+		info := healthyServersInfo()
+		info.Servers[0].Network = map[string]string{"node2:9000": "online", "node1:9000": "online"}
+		info.Servers[0].Disks = []madmin.Disk{
+			{Endpoint: "http://node1:9000/data1", DrivePath: "/data1", State: "ok", TotalSpace: math.MaxUint64 - 10, UsedSpace: math.MaxUint64 - 20, PoolIndex: 0, SetIndex: 1, DiskIndex: 2},
+			{Endpoint: "http://node1:9000/data2", DrivePath: "/data2", State: "offline"},
+			{Endpoint: "http://node1:9000/data3", DrivePath: "/data3", State: "ok", Healing: true},
+		}
+		return info, nil
+	}
+	// Test-1 : drives details and space aren't truncated
+	nodes, err := listNodes(ctx, adminClient)
+	if assert.NoError(err) && assert.Len(nodes.Servers, 2) {
+		node := nodes.Servers[0]
+		assert.Equal(uint64(math.MaxUint64-20), node.UsedSpace)
+		assert.Equal(int64(2), node.OnlineDrives)
+		assert.Equal(int64(1), node.OfflineDrives)
+		assert.Equal(int64(1), node.HealingDrives)
+		assert.Equal("node1:9000", node.Network[0].Endpoint)
+		assert.Equal(int64(1), node.Drives[0].SetIndex)
+		assert.Equal(int64(2), node.Drives[0].DiskIndex)
+	}
+	// Test-2 : a server by its endpoint
+	node, err := nodeInfo(ctx, adminClient, "node2:9000")
+	if assert.NoError(err) {
+		assert.Equal(int64(50), node.Uptime)
+	}
+	_, err = nodeInfo(ctx, adminClient, "node3:9000")
+	assert.True(errors.Is(err, ErrorGenericNotFound))
+	// Test-3 : the cluster can't be reached
+	minioServerInfoMock = func(ctx context.Context) (madmin.InfoMessage, error) {
+		return madmin.InfoMessage{}, errors.New("connection refused")
+	}
+	_, err = listNodes(ctx, adminClient)
+	assert.Error(err)
+}
+
+func TestDriveStateHistory(t *testing.T) {
+	assert := assert.New(t)
+	defer useTempDataDir(t)()
+	history := newDriveStateHistory(driveStateHistoryFile, 4)
+	now := time.Unix(100000, 0)
+	info := func(state string, healing bool) madmin.InfoMessage {
+		return madmin.InfoMessage{Servers: []madmin.ServerProperties{
+			{Endpoint: "node1:9000", Disks: []madmin.Disk{
+				{DrivePath: "/data1", State: state, Healing: healing},
+				{DrivePath: "/data2", State: "ok"},
+			}},
+		}}
+	}
+	events := func(drive string, limit int, now time.Time) []*models.DriveStateEvent {
+		resp, err := history.history(drive, limit, now)
+		assert.NoError(err)
+		return resp.Events
+	}
+	// Test-1 : the first observation doesn't record transitions
+	assert.NoError(history.observe(info("ok", false), now))
+	assert.Empty(events("", 10, now))
+	// Test-2 : transitions are returned newest first
+	assert.NoError(history.observe(info("offline", false), now.Add(time.Minute)))
+	assert.NoError(history.observe(info("ok", true), now.Add(2*time.Minute)))
+	assert.NoError(history.observe(info("ok", false), now.Add(3*time.Minute)))
+	resp, err := history.history("", 10, now.Add(3*time.Minute))
+	if assert.NoError(err) && assert.Len(resp.Events, 3) {
+		assert.Equal("healing", resp.Events[0].From)
+		assert.Equal("online", resp.Events[0].To)
+		assert.Equal("node1:9000/data1", resp.Events[2].Drive)
+		assert.Equal("offline", resp.Events[2].To)
+		assert.Equal([]string{"node1:9000/data1"}, resp.Flapping)
+	}
+	// Test-3 : filter by path and limit
+	assert.Len(events("/data1", 2, now), 2)
+	assert.Empty(events("/data2", 10, now))
+	// Test-4 : old transitions aren't flapping anymore
+	resp, err = history.history("", 10, now.Add(48*time.Hour))
+	if assert.NoError(err) {
+		assert.Empty(resp.Flapping)
+	}
+	// Test-5 : only the last transitions are kept
+	assert.NoError(history.observe(info("offline", false), now.Add(4*time.Minute)))
+	assert.NoError(history.observe(info("ok", false), now.Add(5*time.Minute)))
+	assert.Len(events("", 10, now), 4)
+	// Test-6 : the history survives restarts
+	history = newDriveStateHistory(driveStateHistoryFile, 4)
+	assert.Len(events("", 10, now), 4)
+	assert.NoError(history.observe(info("offline", false), now.Add(6*time.Minute)))
+	if recorded := events("", 10, now); assert.Len(recorded, 4) {
+		assert.Equal("online", recorded[0].From)
+	}
+}
+
+func TestSampleDriveStates(t *testing.T) {
+	assert := assert.New(t)
+	defer useTempDataDir(t)()
+	adminClient := adminClientMock{}
+	history := newDriveStateHistory(driveStateHistoryFile, 10)
+	state := "ok"
+	minioServerInfoMock = func(ctx context.Context) (madmin.InfoMessage, error) {
+		return madmin.InfoMessage{Servers: []madmin.ServerProperties{
+			{Endpoint: "node1:9000", Disks: []madmin.Disk{{DrivePath: "/data1", State: state}}},
+		}}, nil
+	}
+	now := time.Now()
+	// Test-1 : the samples record the transitions
+	assert.NoError(sampleDriveStates(context.Background(), adminClient, history, now))
+	state = "offline"
+	assert.NoError(sampleDriveStates(context.Background(), adminClient, history, now.Add(time.Minute)))
+	resp, err := history.history("", 10, now)
+	if assert.NoError(err) {
+		assert.Len(resp.Events, 1)
+	}
+	// Test-2 : the cluster can't be reached
+	minioServerInfoMock = func(ctx context.Context) (madmin.InfoMessage, error) {
+		return madmin.InfoMessage{}, errors.New("connection refused")
+	}
+	assert.Error(sampleDriveStates(context.Background(), adminClient, history, now))
+}
@@ -159,7 +159,19 @@ func getAddNotificationEndpointResponse(session *models.Principal, params *admin
 	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
 	defer cancel()
 	// serialize output
-	notfEndpointResp, err := addNotificationEndpoint(ctx, adminClient, params)
+	var notfEndpointResp *models.SetNotificationEndpointResponse
+	_, _, err = recordConfigChange(ctx, adminClient, globalConfigHistory, ConfigRevision{
+		User:      session.AccountAccessKey,
+		Action:    models.ConfigRevisionActionSet,
+		Subsystem: "notify_" + string(*params.Body.Service),
+	}, time.Now(), func() (bool, error) {
+		resp, err := addNotificationEndpoint(ctx, adminClient, params)
+		if err != nil {
+			return false, err
+		}
+		notfEndpointResp = resp
+		return resp.Restart, nil
+	})
 	if err != nil {
 		return nil, prepareError(err)
 	}
@@ -52,6 +52,7 @@ func registersPoliciesHandler(api *operations.ConsoleAPI) {
 		if err != nil {
 			return admin_api.NewAddPolicyDefault(int(err.Code)).WithPayload(err)
 		}
+		publishConsoleEvent(session.AccountAccessKey, consoleEventPolicyChanged, policyChangedEvent{Action: policyChangedCreated, Policy: policyResponse.Name})
 		return admin_api.NewAddPolicyCreated().WithPayload(policyResponse)
 	})
 	// Remove Policy
@@ -59,6 +60,7 @@ func registersPoliciesHandler(api *operations.ConsoleAPI) {
 		if err := getRemovePolicyResponse(session, params); err != nil {
 			return admin_api.NewRemovePolicyDefault(int(err.Code)).WithPayload(err)
 		}
+		publishConsoleEvent(session.AccountAccessKey, consoleEventPolicyChanged, policyChangedEvent{Action: policyChangedRemoved, Policy: params.Name})
 		return admin_api.NewRemovePolicyNoContent()
 	})
 	// Set Policy
@@ -66,6 +68,7 @@ func registersPoliciesHandler(api *operations.ConsoleAPI) {
 		if err := getSetPolicyResponse(session, params.Name, params.Body); err != nil {
 			return admin_api.NewSetPolicyDefault(int(err.Code)).WithPayload(err)
 		}
+		publishConsoleEvent(session.AccountAccessKey, consoleEventPolicyChanged, newPolicyAttachedEvent(params.Name, *params.Body.EntityType, *params.Body.EntityName))
 		return admin_api.NewSetPolicyNoContent()
 	})
 	// Set Policy Multiple User/Groups
@@ -73,6 +76,7 @@ func registersPoliciesHandler(api *operations.ConsoleAPI) {
 		if err := getSetPolicyMultipleResponse(session, params.Name, params.Body); err != nil {
 			return admin_api.NewSetPolicyMultipleDefault(int(err.Code)).WithPayload(err)
 		}
+		publishConsoleEvent(session.AccountAccessKey, consoleEventPolicyChanged, newPolicyAttachedMultipleEvent(params.Name, params.Body.Users, params.Body.Groups))
 		return admin_api.NewSetPolicyMultipleNoContent()
 	})
 	api.AdminAPIListPoliciesWithBucketHandler = admin_api.ListPoliciesWithBucketHandlerFunc(func(params admin_api.ListPoliciesWithBucketParams, session *models.Principal) middleware.Responder {
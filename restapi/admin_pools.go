@@ -0,0 +1,506 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/admin_api"
+	"github.com/minio/madmin-go"
+)
+
+// Erasure set states, from the best to the worst
+const (
+	erasureSetHealthy     = "healthy"
+	erasureSetDegraded    = "degraded"
+	erasureSetReadOnly    = "read-only"
+	erasureSetUnavailable = "unavailable"
+)
+
+var erasureSetStates = []string{erasureSetHealthy, erasureSetDegraded, erasureSetReadOnly, erasureSetUnavailable}
+
+// rebalanceStarted is the status of the pools being rebalanced
+const rebalanceStarted = "Started"
+
+func registerPoolsHandlers(api *operations.ConsoleAPI) {
+	// Pools and erasure sets
+	api.AdminAPIClusterTopologyHandler = admin_api.ClusterTopologyHandlerFunc(func(params admin_api.ClusterTopologyParams, session *models.Principal) middleware.Responder {
+		resp, err := getClusterTopologyResponse(session)
+		if err != nil {
+			return admin_api.NewClusterTopologyDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewClusterTopologyOK().WithPayload(resp)
+	})
+	// List pools
+	api.AdminAPIListPoolsStatusHandler = admin_api.ListPoolsStatusHandlerFunc(func(params admin_api.ListPoolsStatusParams, session *models.Principal) middleware.Responder {
+		resp, err := getListPoolsStatusResponse(session)
+		if err != nil {
+			return admin_api.NewListPoolsStatusDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewListPoolsStatusOK().WithPayload(resp)
+	})
+	// Decommission status of a pool
+	api.AdminAPIPoolDecommissionStatusHandler = admin_api.PoolDecommissionStatusHandlerFunc(func(params admin_api.PoolDecommissionStatusParams, session *models.Principal) middleware.Responder {
+		resp, err := getPoolDecommissionStatusResponse(session, params)
+		if err != nil {
+			return admin_api.NewPoolDecommissionStatusDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewPoolDecommissionStatusOK().WithPayload(resp)
+	})
+	// Start decommissioning a pool
+	api.AdminAPIStartPoolDecommissionHandler = admin_api.StartPoolDecommissionHandlerFunc(func(params admin_api.StartPoolDecommissionParams, session *models.Principal) middleware.Responder {
+		if err := getStartPoolDecommissionResponse(session, params); err != nil {
+			return admin_api.NewStartPoolDecommissionDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewStartPoolDecommissionNoContent()
+	})
+	// Cancel the decommission of a pool
+	api.AdminAPICancelPoolDecommissionHandler = admin_api.CancelPoolDecommissionHandlerFunc(func(params admin_api.CancelPoolDecommissionParams, session *models.Principal) middleware.Responder {
+		if err := getCancelPoolDecommissionResponse(session, params); err != nil {
+			return admin_api.NewCancelPoolDecommissionDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewCancelPoolDecommissionNoContent()
+	})
+	// Rebalance progress
+	api.AdminAPIRebalanceStatusHandler = admin_api.RebalanceStatusHandlerFunc(func(params admin_api.RebalanceStatusParams, session *models.Principal) middleware.Responder {
+		resp, err := getRebalanceStatusResponse(session)
+		if err != nil {
+			return admin_api.NewRebalanceStatusDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewRebalanceStatusOK().WithPayload(resp)
+	})
+}
+
+// erasureParity returns the parity of the standard and reduced redundancy
+// storage classes reported by the erasure backend, zero when unknown
+func erasureParity(info madmin.InfoMessage) (standard, rrs int64) {
+	switch backend := info.Backend.(type) {
+	case madmin.ErasureBackend:
+		return int64(backend.StandardSCParity), int64(backend.RRSCParity)
+	case map[string]interface{}:
+		if v, ok := backend["standardSCParity"].(float64); ok {
+			standard = int64(v)
+		}
+		if v, ok := backend["rrSCParity"].(float64); ok {
+			rrs = int64(v)
+		}
+	}
+	return standard, rrs
+}
+
+// defaultErasureParity is the parity MinIO uses for a set of the given size
+// when no storage class is configured
+func defaultErasureParity(drives int64) int64 {
+	switch {
+	case drives <= 1:
+		return 0
+	case drives <= 3:
+		return 1
+	case drives <= 5:
+		return 2
+	case drives <= 7:
+		return 3
+	}
+	return 4
+}
+
+// erasureSetState returns whether the set can still serve writes and reads
+// with its online drives
+func erasureSetState(set *models.ErasureSet) string {
+	total := set.OnlineDrives + set.OfflineDrives
+	data := total - set.Parity
+	writeQuorum := data
+	if data == set.Parity {
+		writeQuorum++
+	}
+	switch {
+	case set.OfflineDrives == 0 && set.HealingDrives == 0:
+		return erasureSetHealthy
+	case set.OnlineDrives >= writeQuorum:
+		return erasureSetDegraded
+	case set.OnlineDrives >= data:
+		return erasureSetReadOnly
+	}
+	return erasureSetUnavailable
+}
+
+// worstErasureSetState returns the worst of two erasure set states
+func worstErasureSetState(a, b string) string {
+	for i := len(erasureSetStates) - 1; i >= 0; i-- {
+		if a == erasureSetStates[i] || b == erasureSetStates[i] {
+			return erasureSetStates[i]
+		}
+	}
+	return a
+}
+
+// getClusterTopology groups the drives of the cluster by pool and erasure set
+func getClusterTopology(info madmin.InfoMessage) *models.TopologyResponse {
+	standardParity, rrsParity := erasureParity(info)
+	topology := &models.TopologyResponse{
+		Pools:            []*models.PoolTopology{},
+		UnassignedDrives: []*models.DriveInfo{},
+		StandardParity:   standardParity,
+		RrsParity:        rrsParity,
+	}
+	pools := make(map[int]*models.PoolTopology)
+	sets := make(map[[2]int]*models.ErasureSet)
+	for _, server := range info.Servers {
+		for _, disk := range server.Disks {
+			drive := getDriveInfo(disk)
+			if disk.PoolIndex < 0 || disk.SetIndex < 0 {
+				topology.UnassignedDrives = append(topology.UnassignedDrives, drive)
+				continue
+			}
+			pool, ok := pools[disk.PoolIndex]
+			if !ok {
+				pool = &models.PoolTopology{Index: int64(disk.PoolIndex), Sets: []*models.ErasureSet{}}
+				pools[disk.PoolIndex] = pool
+				topology.Pools = append(topology.Pools, pool)
+			}
+			key := [2]int{disk.PoolIndex, disk.SetIndex}
+			set, ok := sets[key]
+			if !ok {
+				set = &models.ErasureSet{PoolIndex: int64(disk.PoolIndex), SetIndex: int64(disk.SetIndex), Drives: []*models.DriveInfo{}}
+				sets[key] = set
+				pool.Sets = append(pool.Sets, set)
+			}
+			switch driveHistoryState(disk) {
+			case driveStateOffline:
+				set.OfflineDrives++
+			case driveStateHealing:
+				set.HealingDrives++
+				set.OnlineDrives++
+			default:
+				set.OnlineDrives++
+			}
+			set.TotalSpace += disk.TotalSpace
+			set.UsedSpace += disk.UsedSpace
+			set.AvailableSpace += disk.AvailableSpace
+			set.Drives = append(set.Drives, drive)
+		}
+	}
+	sort.Slice(topology.Pools, func(i, j int) bool {
+		return topology.Pools[i].Index < topology.Pools[j].Index
+	})
+	for _, pool := range topology.Pools {
+		sort.Slice(pool.Sets, func(i, j int) bool {
+			return pool.Sets[i].SetIndex < pool.Sets[j].SetIndex
+		})
+		pool.State = erasureSetHealthy
+		for _, set := range pool.Sets {
+			sort.Slice(set.Drives, func(i, j int) bool {
+				return set.Drives[i].DiskIndex < set.Drives[j].DiskIndex
+			})
+			drives := set.OnlineDrives + set.OfflineDrives
+			set.Parity = standardParity
+			if set.Parity == 0 || set.Parity > drives/2 {
+				set.Parity = defaultErasureParity(drives)
+			}
+			set.State = erasureSetState(set)
+			pool.State = worstErasureSetState(pool.State, set.State)
+			pool.TotalSpace += set.TotalSpace
+			pool.UsedSpace += set.UsedSpace
+			pool.AvailableSpace += set.AvailableSpace
+		}
+	}
+	return topology
+}
+
+func getClusterTopologyResponse(session *models.Principal) (*models.TopologyResponse, *models.Error) {
+	ctx := context.Background()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+
+	info, err := adminClient.serverInfo(ctx)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return getClusterTopology(info), nil
+}
+
+// getPoolStatus returns the status of a pool with the progress of its decommission
+func getPoolStatus(pool PoolStatus) *models.PoolStatus {
+	status := &models.PoolStatus{
+		ID:      int64(pool.ID),
+		Cmdline: pool.CmdLine,
+	}
+	if !pool.LastUpdate.IsZero() {
+		status.LastUpdate = pool.LastUpdate.UTC().Format(time.RFC3339)
+	}
+	if d := pool.Decommission; d != nil {
+		status.Decommission = &models.PoolDecommissionInfo{
+			StartSize:                 d.StartSize,
+			TotalSize:                 d.TotalSize,
+			CurrentSize:               d.CurrentSize,
+			Complete:                  d.Complete,
+			Failed:                    d.Failed,
+			Canceled:                  d.Canceled,
+			ObjectsDecommissioned:     d.ObjectsDecommissioned,
+			ObjectsDecommissionFailed: d.ObjectsDecommissionFailed,
+			BytesDecommissioned:       d.BytesDone,
+			BytesDecommissionFailed:   d.BytesFailed,
+		}
+		if !d.StartTime.IsZero() {
+			status.Decommission.StartTime = d.StartTime.UTC().Format(time.RFC3339)
+		}
+		// the sizes are the free space of the pool, the progress is how much
+		// of the space used when the decommission started was freed
+		usedAtStart := d.TotalSize - d.StartSize
+		usedNow := d.TotalSize - d.CurrentSize
+		switch {
+		case d.Complete:
+			status.Decommission.Percentage = 100
+		case usedAtStart > 0 && usedNow < usedAtStart:
+			status.Decommission.Percentage = 100 * float64(usedAtStart-usedNow) / float64(usedAtStart)
+		}
+	}
+	return status
+}
+
+// decommissionActive returns whether the pool is being decommissioned
+func decommissionActive(pool PoolStatus) bool {
+	d := pool.Decommission
+	return d != nil && !d.Complete && !d.Failed && !d.Canceled
+}
+
+// parsePoolIndex parses the index of a pool as received in the path
+func parsePoolIndex(pool string) (int, error) {
+	index, err := strconv.Atoi(pool)
+	if err != nil || index < 0 {
+		return 0, errInvalidPool
+	}
+	return index, nil
+}
+
+// findPool returns the pool with the given index
+func findPool(ctx context.Context, client MinioAdmin, pool string) (*PoolStatus, []PoolStatus, error) {
+	index, err := parsePoolIndex(pool)
+	if err != nil {
+		return nil, nil, err
+	}
+	pools, err := client.listPoolsStatus(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := range pools {
+		if pools[i].ID == index {
+			return &pools[i], pools, nil
+		}
+	}
+	return nil, nil, errPoolNotFound
+}
+
+func listPoolsStatus(ctx context.Context, client MinioAdmin) (*models.PoolsStatusResponse, error) {
+	pools, err := client.listPoolsStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(pools, func(i, j int) bool {
+		return pools[i].ID < pools[j].ID
+	})
+	resp := &models.PoolsStatusResponse{Pools: []*models.PoolStatus{}}
+	for _, pool := range pools {
+		resp.Pools = append(resp.Pools, getPoolStatus(pool))
+	}
+	return resp, nil
+}
+
+func poolDecommissionStatus(ctx context.Context, client MinioAdmin, pool string) (*models.PoolStatus, error) {
+	p, _, err := findPool(ctx, client, pool)
+	if err != nil {
+		return nil, err
+	}
+	status, err := client.statusPool(ctx, p.CmdLine)
+	if err != nil {
+		return nil, err
+	}
+	return getPoolStatus(*status), nil
+}
+
+// decommissionPreflightCheck lists what makes decommissioning the pool unsafe: being
+// the last pool, an unhealthy cluster or not enough free space left in the other pools
+func decommissionPreflightCheck(ctx context.Context, client MinioAdmin, pool *PoolStatus, pools []PoolStatus) error {
+	if decommissionActive(*pool) {
+		return fmt.Errorf("%w: the pool is already being decommissioned", errDecommissionPreflightFailed)
+	}
+	info, err := client.serverInfo(ctx)
+	if err != nil {
+		return err
+	}
+	issues := serverHealthIssues(info)
+	// pools already decommissioned or being decommissioned don't take new data
+	remaining := make(map[int]bool)
+	for _, p := range pools {
+		if p.ID != pool.ID && (p.Decommission == nil || p.Decommission.Canceled || p.Decommission.Failed) {
+			remaining[p.ID] = true
+		}
+	}
+	if len(remaining) == 0 {
+		issues = append(issues, "no other pool can hold the data of the pool")
+	}
+	var used, available uint64
+	for _, server := range info.Servers {
+		for _, disk := range server.Disks {
+			switch {
+			case disk.PoolIndex == pool.ID:
+				used += disk.UsedSpace
+			case remaining[disk.PoolIndex]:
+				available += disk.AvailableSpace
+			}
+		}
+	}
+	if len(remaining) > 0 && used > available {
+		issues = append(issues, fmt.Sprintf("the other pools have %d bytes available for the %d bytes used by the pool", available, used))
+	}
+	if len(issues) > 0 {
+		return fmt.Errorf("%w: %s", errDecommissionPreflightFailed, strings.Join(issues, ", "))
+	}
+	return nil
+}
+
+// startPoolDecommission starts moving the data of the pool to the other pools
+// after the pre-flight check, unless forced
+func startPoolDecommission(ctx context.Context, client MinioAdmin, pool string, force bool) error {
+	p, pools, err := findPool(ctx, client, pool)
+	if err != nil {
+		return err
+	}
+	if !force {
+		if err := decommissionPreflightCheck(ctx, client, p, pools); err != nil {
+			return err
+		}
+	}
+	return client.decommissionPool(ctx, p.CmdLine)
+}
+
+func cancelPoolDecommission(ctx context.Context, client MinioAdmin, pool string) error {
+	p, _, err := findPool(ctx, client, pool)
+	if err != nil {
+		return err
+	}
+	return client.cancelDecommissionPool(ctx, p.CmdLine)
+}
+
+func getRebalanceStatus(ctx context.Context, client MinioAdmin) (*models.RebalanceStatusResponse, error) {
+	status, err := client.rebalanceStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &models.RebalanceStatusResponse{
+		ID:    status.ID,
+		Pools: []*models.RebalancePoolStatus{},
+	}
+	if !status.StoppedAt.IsZero() {
+		resp.StoppedAt = status.StoppedAt.UTC().Format(time.RFC3339)
+	}
+	for _, pool := range status.Pools {
+		if pool.Status == rebalanceStarted {
+			resp.Running = true
+		}
+		resp.Pools = append(resp.Pools, &models.RebalancePoolStatus{
+			ID:       int64(pool.ID),
+			Status:   pool.Status,
+			Used:     pool.Used,
+			Objects:  pool.Progress.NumObjects,
+			Versions: pool.Progress.NumVersions,
+			Bytes:    pool.Progress.Bytes,
+			Bucket:   pool.Progress.Bucket,
+			Object:   pool.Progress.Object,
+			Elapsed:  int64(pool.Progress.Elapsed.Seconds()),
+			Eta:      int64(pool.Progress.ETA.Seconds()),
+		})
+	}
+	return resp, nil
+}
+
+func getListPoolsStatusResponse(session *models.Principal) (*models.PoolsStatusResponse, *models.Error) {
+	ctx := context.Background()
+	adminClient, err := newSignedAdminClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	resp, err := listPoolsStatus(ctx, adminClient)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return resp, nil
+}
+
+func getPoolDecommissionStatusResponse(session *models.Principal, params admin_api.PoolDecommissionStatusParams) (*models.PoolStatus, *models.Error) {
+	ctx := context.Background()
+	adminClient, err := newSignedAdminClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	resp, err := poolDecommissionStatus(ctx, adminClient, params.Pool)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return resp, nil
+}
+
+func getStartPoolDecommissionResponse(session *models.Principal, params admin_api.StartPoolDecommissionParams) *models.Error {
+	ctx := context.Background()
+	adminClient, err := newSignedAdminClient(session)
+	if err != nil {
+		return prepareError(err)
+	}
+	force := params.Force != nil && *params.Force
+	if err := startPoolDecommission(ctx, adminClient, params.Pool, force); err != nil {
+		return prepareError(err)
+	}
+	return nil
+}
+
+func getCancelPoolDecommissionResponse(session *models.Principal, params admin_api.CancelPoolDecommissionParams) *models.Error {
+	ctx := context.Background()
+	adminClient, err := newSignedAdminClient(session)
+	if err != nil {
+		return prepareError(err)
+	}
+	if err := cancelPoolDecommission(ctx, adminClient, params.Pool); err != nil {
+		return prepareError(err)
+	}
+	return nil
+}
+
+func getRebalanceStatusResponse(session *models.Principal) (*models.RebalanceStatusResponse, *models.Error) {
+	ctx := context.Background()
+	adminClient, err := newSignedAdminClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	resp, err := getRebalanceStatus(ctx, adminClient)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return resp, nil
+}
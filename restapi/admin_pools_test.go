@@ -0,0 +1,186 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go"
+	"github.com/stretchr/testify/assert"
+)
+
+var minioListPoolsStatusMock func(ctx context.Context) ([]PoolStatus, error)
+var minioStatusPoolMock func(ctx context.Context, pool string) (*PoolStatus, error)
+var minioDecommissionPoolMock func(ctx context.Context, pool string) error
+var minioCancelDecommissionPoolMock func(ctx context.Context, pool string) error
+var minioRebalanceStatusMock func(ctx context.Context) (*RebalanceStatus, error)
+
+// mock function of listPoolsStatus()
+func (ac adminClientMock) listPoolsStatus(ctx context.Context) ([]PoolStatus, error) {
+	return minioListPoolsStatusMock(ctx)
+}
+
+// mock function of statusPool()
+func (ac adminClientMock) statusPool(ctx context.Context, pool string) (*PoolStatus, error) {
+	return minioStatusPoolMock(ctx, pool)
+}
+
+// mock function of decommissionPool()
+func (ac adminClientMock) decommissionPool(ctx context.Context, pool string) error {
+	return minioDecommissionPoolMock(ctx, pool)
+}
+
+// mock function of cancelDecommissionPool()
+func (ac adminClientMock) cancelDecommissionPool(ctx context.Context, pool string) error {
+	return minioCancelDecommissionPoolMock(ctx, pool)
+}
+
+// mock function of rebalanceStatus()
+func (ac adminClientMock) rebalanceStatus(ctx context.Context) (*RebalanceStatus, error) {
+	return minioRebalanceStatusMock(ctx)
+}
+
+// twoPoolsInfo has two pools of one erasure set of four drives
+func twoPoolsInfo() madmin.InfoMessage {
+	drive := func(pool, disk int, used, available uint64) madmin.Disk {
+		return madmin.Disk{State: "ok", PoolIndex: pool, SetIndex: 0, DiskIndex: disk, UsedSpace: used, AvailableSpace: available, TotalSpace: used + available}
+	}
+	return madmin.InfoMessage{
+		Backend: map[string]interface{}{"backendType": "Erasure", "standardSCParity": float64(2), "rrSCParity": float64(1)},
+		Servers: []madmin.ServerProperties{
+			{Endpoint: "node1:9000", State: "online", Disks: []madmin.Disk{drive(0, 1, 10, 90), drive(0, 0, 10, 90)}},
+			{Endpoint: "node2:9000", State: "online", Disks: []madmin.Disk{drive(0, 2, 10, 90), drive(0, 3, 10, 90)}},
+			{Endpoint: "node3:9000", State: "online", Disks: []madmin.Disk{drive(1, 0, 100, 20), drive(1, 1, 100, 20), drive(1, 2, 100, 20), drive(1, 3, 100, 20)}},
+		},
+	}
+}
+
+func TestClusterTopology(t *testing.T) {
+	assert := assert.New(t)
+	// Test-1 : drives grouped by pool and erasure set
+	topology := getClusterTopology(twoPoolsInfo())
+	assert.Equal(int64(2), topology.StandardParity)
+	assert.Equal(int64(1), topology.RrsParity)
+	if assert.Len(topology.Pools, 2) && assert.Len(topology.Pools[0].Sets, 1) {
+		set := topology.Pools[0].Sets[0]
+		assert.Equal("healthy", set.State)
+		assert.Equal(int64(4), set.OnlineDrives)
+		assert.Equal(uint64(360), set.AvailableSpace)
+		assert.Equal(int64(0), set.Drives[0].DiskIndex)
+		assert.Equal(uint64(400), topology.Pools[1].UsedSpace)
+	}
+	// Test-2 : the set state depends on the drives that are still online
+	info := twoPoolsInfo()
+	info.Servers[0].Disks[0].Healing = true
+	info.Servers[2].Disks[0].State = "offline"
+	info.Servers[2].Disks[1].State = "offline"
+	info.Servers[2].Disks[2].State = "offline"
+	info.Servers[2].Disks = append(info.Servers[2].Disks, madmin.Disk{State: "offline", PoolIndex: -1, SetIndex: -1})
+	topology = getClusterTopology(info)
+	assert.Equal("degraded", topology.Pools[0].State)
+	assert.Equal("unavailable", topology.Pools[1].State)
+	assert.Len(topology.UnassignedDrives, 1)
+	set := &models.ErasureSet{OnlineDrives: 2, OfflineDrives: 2, Parity: 2}
+	assert.Equal("read-only", erasureSetState(set))
+	set = &models.ErasureSet{OnlineDrives: 7, OfflineDrives: 1, Parity: 4}
+	assert.Equal("degraded", erasureSetState(set))
+	set = &models.ErasureSet{OnlineDrives: 4, OfflineDrives: 4, Parity: 4}
+	assert.Equal("read-only", erasureSetState(set))
+}
+
+func TestPoolDecommission(t *testing.T) {
+	assert := assert.New(t)
+	adminClient := adminClientMock{}
+	ctx := context.Background()
+	pools := []PoolStatus{
+		{ID: 1, CmdLine: "http://node3/data{1...4}"},
+		{ID: 0, CmdLine: "http://node{1...2}/data{1...2}"},
+	}
+	minioListPoolsStatusMock = func(ctx context.Context) ([]PoolStatus, error) {
+		return pools, nil
+	}
+	minioServerInfoMock = func(ctx context.Context) (madmin.InfoMessage, error) {
+		return twoPoolsInfo(), nil
+	}
+	var decommissioned string
+	minioDecommissionPoolMock = func(ctx context.Context, pool string) error {
+		decommissioned = pool
+		return nil
+	}
+	// Test-1 : the first pool fits in the free space of the second one
+	assert.NoError(startPoolDecommission(ctx, adminClient, "0", false))
+	assert.Equal("http://node{1...2}/data{1...2}", decommissioned)
+	// Test-2 : the second pool doesn't fit in the first one unless forced
+	decommissioned = ""
+	err := startPoolDecommission(ctx, adminClient, "1", false)
+	if assert.True(errors.Is(err, errDecommissionPreflightFailed)) {
+		assert.Contains(err.Error(), "the other pools have 360 bytes available for the 400 bytes used by the pool")
+	}
+	assert.NoError(startPoolDecommission(ctx, adminClient, "1", true))
+	assert.Equal("http://node3/data{1...4}", decommissioned)
+	// Test-3 : invalid and unknown pools
+	assert.True(errors.Is(startPoolDecommission(ctx, adminClient, "first", false), errInvalidPool))
+	assert.True(errors.Is(cancelPoolDecommission(ctx, adminClient, "5"), errPoolNotFound))
+	// Test-4 : the last pool can't be decommissioned
+	pools[0].Decommission = &PoolDecommissionInfo{StartTime: time.Now()}
+	err = startPoolDecommission(ctx, adminClient, "0", false)
+	if assert.True(errors.Is(err, errDecommissionPreflightFailed)) {
+		assert.Contains(err.Error(), "no other pool can hold the data of the pool")
+	}
+	assert.True(errors.Is(startPoolDecommission(ctx, adminClient, "1", false), errDecommissionPreflightFailed))
+	// Test-5 : decommission progress
+	minioStatusPoolMock = func(ctx context.Context, pool string) (*PoolStatus, error) {
+		return &PoolStatus{ID: 1, CmdLine: pool, Decommission: &PoolDecommissionInfo{TotalSize: 1000, StartSize: 200, CurrentSize: 600}}, nil
+	}
+	status, err := poolDecommissionStatus(ctx, adminClient, "1")
+	if assert.NoError(err) {
+		assert.Equal(float64(50), status.Decommission.Percentage)
+		assert.Equal("http://node3/data{1...4}", status.Cmdline)
+	}
+	list, err := listPoolsStatus(ctx, adminClient)
+	if assert.NoError(err) && assert.Len(list.Pools, 2) {
+		assert.Equal(int64(0), list.Pools[0].ID)
+	}
+}
+
+func TestRebalanceStatus(t *testing.T) {
+	assert := assert.New(t)
+	adminClient := adminClientMock{}
+	ctx := context.Background()
+	minioRebalanceStatusMock = func(ctx context.Context) (*RebalanceStatus, error) {
+		return &RebalanceStatus{ID: "rebalance-1", Pools: []RebalPoolStatus{
+			{ID: 0, Status: "Started", Used: 0.7, Progress: RebalPoolProgress{NumObjects: 10, Bytes: 100, Elapsed: time.Minute, ETA: 2 * time.Minute}},
+			{ID: 1, Used: 0.2},
+		}}, nil
+	}
+	status, err := getRebalanceStatus(ctx, adminClient)
+	if assert.NoError(err) && assert.Len(status.Pools, 2) {
+		assert.True(status.Running)
+		assert.Equal(int64(60), status.Pools[0].Elapsed)
+		assert.Equal(int64(120), status.Pools[0].Eta)
+		assert.Equal("", status.StoppedAt)
+	}
+	minioRebalanceStatusMock = func(ctx context.Context) (*RebalanceStatus, error) {
+		return nil, errors.New("rebalance not started")
+	}
+	_, err = getRebalanceStatus(ctx, adminClient)
+	assert.Error(err)
+}
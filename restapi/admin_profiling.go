@@ -17,9 +17,15 @@
 package restapi
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
 	"io"
+	"io/ioutil"
 	"net/http"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
@@ -27,8 +33,17 @@ import (
 	"github.com/minio/console/restapi/operations"
 	"github.com/minio/console/restapi/operations/admin_api"
 	"github.com/minio/madmin-go"
+	iampolicy "github.com/minio/pkg/iam/policy"
+	"github.com/rs/xid"
 )
 
+// profilingCapturesToKeep is the number of finished captures Console keeps in memory
+// so they can be downloaded again
+const profilingCapturesToKeep = 5
+
+// profilingSummaryDefaultTop is the number of functions listed per node in a capture summary
+const profilingSummaryDefaultTop = 10
+
 func registerProfilingHandler(api *operations.ConsoleAPI) {
 	// Start Profiling
 	api.AdminAPIProfilingStartHandler = admin_api.ProfilingStartHandlerFunc(func(params admin_api.ProfilingStartParams, session *models.Principal) middleware.Responder {
@@ -40,22 +55,219 @@ func registerProfilingHandler(api *operations.ConsoleAPI) {
 	})
 	// Stop and download profiling data
 	api.AdminAPIProfilingStopHandler = admin_api.ProfilingStopHandlerFunc(func(params admin_api.ProfilingStopParams, session *models.Principal) middleware.Responder {
-		profilingStopResponse, err := getProfilingStopResponse(session)
+		capture, err := getProfilingStopResponse(session)
 		if err != nil {
 			return admin_api.NewProfilingStopDefault(int(err.Code)).WithPayload(err)
 		}
-		// Custom response writer to set the content-disposition header to tell the
-		// HTTP client the name and extension of the file we are returning
-		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
-			defer profilingStopResponse.Close()
+		return profilingCaptureResponder(capture)
+	})
+	// List profiling captures kept by Console
+	api.AdminAPIListProfilingCapturesHandler = admin_api.ListProfilingCapturesHandlerFunc(func(params admin_api.ListProfilingCapturesParams, session *models.Principal) middleware.Responder {
+		captures, err := getListProfilingCapturesResponse(session)
+		if err != nil {
+			return admin_api.NewListProfilingCapturesDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewListProfilingCapturesOK().WithPayload(captures)
+	})
+	// Download a stored profiling capture
+	api.AdminAPIDownloadProfilingCaptureHandler = admin_api.DownloadProfilingCaptureHandlerFunc(func(params admin_api.DownloadProfilingCaptureParams, session *models.Principal) middleware.Responder {
+		capture, err := getDownloadProfilingCaptureResponse(session, params.CaptureID)
+		if err != nil {
+			return admin_api.NewDownloadProfilingCaptureDefault(int(err.Code)).WithPayload(err)
+		}
+		return profilingCaptureResponder(capture)
+	})
+	// Top functions per node of a stored capture
+	api.AdminAPIProfilingCaptureSummaryHandler = admin_api.ProfilingCaptureSummaryHandlerFunc(func(params admin_api.ProfilingCaptureSummaryParams, session *models.Principal) middleware.Responder {
+		summary, err := getProfilingCaptureSummaryResponse(session, params)
+		if err != nil {
+			return admin_api.NewProfilingCaptureSummaryDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewProfilingCaptureSummaryOK().WithPayload(summary)
+	})
+}
 
-			w.Header().Set("Content-Type", "application/octet-stream")
-			w.Header().Set("Content-Disposition", "attachment; filename=profile.zip")
-			io.Copy(w, profilingStopResponse)
-		})
+// profilingCaptureResponder writes the zip file of a capture
+func profilingCaptureResponder(capture *profilingCapture) middleware.Responder {
+	// Custom response writer to set the content-disposition header to tell the
+	// HTTP client the name and extension of the file we are returning
+	return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Disposition", "attachment; filename=profile.zip")
+		io.Copy(w, bytes.NewReader(capture.data))
 	})
 }
 
+// profilingCapture is a profiling session started from Console and, once stopped,
+// the zip file returned by MinIO
+type profilingCapture struct {
+	id         string
+	types      []string
+	nodes      []string
+	status     string
+	startedAt  time.Time
+	finishedAt time.Time
+	err        string
+	data       []byte
+	timer      *time.Timer
+}
+
+func (c *profilingCapture) toModel() *models.ProfilingCapture {
+	capture := &models.ProfilingCapture{
+		ID:        c.id,
+		Types:     c.types,
+		Nodes:     c.nodes,
+		Status:    c.status,
+		StartedAt: c.startedAt.Format(time.RFC3339),
+		Size:      int64(len(c.data)),
+		Error:     c.err,
+	}
+	if !c.finishedAt.IsZero() {
+		capture.FinishedAt = c.finishedAt.Format(time.RFC3339)
+	}
+	return capture
+}
+
+// profilingCaptureStore keeps the running capture and the last finished ones
+type profilingCaptureStore struct {
+	sync.Mutex
+	active *profilingCapture
+	// captures are sorted from the most recent to the oldest
+	captures []*profilingCapture
+}
+
+var globalProfilingCaptures = &profilingCaptureStore{}
+
+// begin registers a new running capture, a capture that was still running is
+// kept as failed since MinIO restarts the profilers
+func (s *profilingCaptureStore) begin(types, nodes []string) *profilingCapture {
+	s.Lock()
+	defer s.Unlock()
+	if s.active != nil {
+		if s.active.timer != nil {
+			s.active.timer.Stop()
+		}
+		s.active.status = models.ProfilingCaptureStatusFailed
+		s.active.err = "replaced by a newer capture"
+		s.active.finishedAt = time.Now()
+		s.keepLocked(s.active)
+	}
+	s.active = &profilingCapture{
+		id:        xid.New().String(),
+		types:     types,
+		nodes:     nodes,
+		status:    models.ProfilingCaptureStatusRunning,
+		startedAt: time.Now(),
+	}
+	return s.active
+}
+
+// schedule calls stop once the capture has been running for the given duration
+func (s *profilingCaptureStore) schedule(c *profilingCapture, duration time.Duration, stop func()) {
+	s.Lock()
+	defer s.Unlock()
+	c.timer = time.AfterFunc(duration, stop)
+}
+
+// takeActive removes the running capture from the store, if id is not empty the
+// running capture is only returned when it matches it. A capture is created when
+// profiling was not started through Console.
+func (s *profilingCaptureStore) takeActive(id string) *profilingCapture {
+	s.Lock()
+	defer s.Unlock()
+	c := s.active
+	if id != "" && (c == nil || c.id != id) {
+		return nil
+	}
+	s.active = nil
+	if c == nil {
+		return &profilingCapture{id: xid.New().String(), startedAt: time.Now()}
+	}
+	if c.timer != nil {
+		c.timer.Stop()
+	}
+	return c
+}
+
+// keep stores a finished capture dropping the oldest ones
+func (s *profilingCaptureStore) keep(c *profilingCapture) {
+	s.Lock()
+	defer s.Unlock()
+	s.keepLocked(c)
+}
+
+func (s *profilingCaptureStore) keepLocked(c *profilingCapture) {
+	s.captures = append([]*profilingCapture{c}, s.captures...)
+	if len(s.captures) > profilingCapturesToKeep {
+		s.captures = s.captures[:profilingCapturesToKeep]
+	}
+}
+
+func (s *profilingCaptureStore) get(id string) *profilingCapture {
+	s.Lock()
+	defer s.Unlock()
+	for _, c := range s.captures {
+		if c.id == id {
+			return c
+		}
+	}
+	return nil
+}
+
+func (s *profilingCaptureStore) list() []*models.ProfilingCapture {
+	s.Lock()
+	defer s.Unlock()
+	var captures []*models.ProfilingCapture
+	if s.active != nil {
+		captures = append(captures, s.active.toModel())
+	}
+	for _, c := range s.captures {
+		captures = append(captures, c.toModel())
+	}
+	return captures
+}
+
+// getProfilerTypes validates the requested profilers and returns them in the
+// comma separated form MinIO expects, the single type of the older clients is
+// still accepted
+func getProfilerTypes(params *models.ProfilingStartRequest) ([]string, models.ProfilerType, error) {
+	var types []string
+	if params.Type != "" {
+		types = append(types, string(params.Type))
+	}
+	for _, t := range params.Types {
+		if err := models.ProfilerType(t).Validate(nil); err != nil {
+			return nil, "", errInvalidProfilerType
+		}
+		types = append(types, t)
+	}
+	if len(types) == 0 {
+		return nil, "", errProfilerTypeNotSet
+	}
+	return types, models.ProfilerType(strings.Join(types, ",")), nil
+}
+
+// normalizeProfilingNode returns the host:port form used by MinIO to name the
+// nodes in the profiling results
+func normalizeProfilingNode(node string) string {
+	node = strings.TrimPrefix(node, "http://")
+	node = strings.TrimPrefix(node, "https://")
+	return strings.TrimSuffix(node, "/")
+}
+
+func profilingNodeSelected(node string, nodes []string) bool {
+	if len(nodes) == 0 {
+		return true
+	}
+	node = normalizeProfilingNode(node)
+	for _, n := range nodes {
+		if normalizeProfilingNode(n) == node {
+			return true
+		}
+	}
+	return false
+}
+
 // startProfiling() starts the profiling on the Minio server
 // Enable 1 of the 7 profiling mechanisms: "cpu","mem","block","mutex","trace","threads","goroutines"
 // in the Minio server, returns []*models.StartProfilingItem that contains individual status of this operation
@@ -82,6 +294,40 @@ func startProfiling(ctx context.Context, client MinioAdmin, profilerType models.
 	return items, nil
 }
 
+// startProfilingCapture starts the requested profilers and registers the capture in the store,
+// MinIO profiles every node so the results are restricted to the selected ones. If a duration
+// is set the capture is stopped automatically using newClient.
+func startProfilingCapture(ctx context.Context, client MinioAdmin, store *profilingCaptureStore, params *models.ProfilingStartRequest, newClient func() (MinioAdmin, error)) ([]*models.StartProfilingItem, error) {
+	types, profilerType, err := getProfilerTypes(params)
+	if err != nil {
+		return nil, err
+	}
+	items, err := startProfiling(ctx, client, profilerType)
+	if err != nil {
+		return nil, err
+	}
+	var selected []*models.StartProfilingItem
+	for _, item := range items {
+		if profilingNodeSelected(item.NodeName, params.Nodes) {
+			selected = append(selected, item)
+		}
+	}
+	capture := store.begin(types, params.Nodes)
+	if params.Duration > 0 {
+		store.schedule(capture, time.Duration(params.Duration)*time.Second, func() {
+			client, err := newClient()
+			if err != nil {
+				LogError("error stopping timed profiling capture: %v", err)
+				return
+			}
+			if _, err := stopProfilingCapture(context.Background(), client, store, capture.id); err != nil {
+				LogError("error stopping timed profiling capture: %v", err)
+			}
+		})
+	}
+	return selected, nil
+}
+
 // getProfilingStartResponse performs startProfiling() and serializes it to the handler's output
 func getProfilingStartResponse(session *models.Principal, params *models.ProfilingStartRequest) (*models.StartProfilingList, *models.Error) {
 	ctx := context.Background()
@@ -95,7 +341,14 @@ func getProfilingStartResponse(session *models.Principal, params *models.Profili
 	// create a MinIO Admin Client interface implementation
 	// defining the client to be used
 	adminClient := AdminClient{Client: mAdmin}
-	profilingItems, err := startProfiling(ctx, adminClient, *params.Type)
+	newClient := func() (MinioAdmin, error) {
+		mAdmin, err := NewMinioAdminClient(session)
+		if err != nil {
+			return nil, err
+		}
+		return AdminClient{Client: mAdmin}, nil
+	}
+	profilingItems, err := startProfilingCapture(ctx, adminClient, globalProfilingCaptures, params, newClient)
 	if err != nil {
 		return nil, prepareError(err)
 	}
@@ -116,8 +369,73 @@ func stopProfiling(ctx context.Context, client MinioAdmin) (io.ReadCloser, error
 	return profilingData, nil
 }
 
-// getProfilingStopResponse() performs setPolicy() and serializes it to the handler's output
-func getProfilingStopResponse(session *models.Principal) (io.ReadCloser, *models.Error) {
+// filterProfilingNodes removes from the zip returned by MinIO the profiles of the
+// nodes which were not selected, files are named profile-<node>-<type>.<ext>
+func filterProfilingNodes(data []byte, nodes []string) ([]byte, error) {
+	if len(nodes) == 0 {
+		return data, nil
+	}
+	zipReader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	zipWriter := zip.NewWriter(&buf)
+	for _, file := range zipReader.File {
+		keep := false
+		for _, node := range nodes {
+			if strings.HasPrefix(file.Name, "profile-"+normalizeProfilingNode(node)+"-") {
+				keep = true
+				break
+			}
+		}
+		if !keep {
+			continue
+		}
+		if err := zipWriter.Copy(file); err != nil {
+			return nil, err
+		}
+	}
+	if err := zipWriter.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// stopProfilingCapture stops the profilers and keeps the resulting zip in the store,
+// when id is set only the matching running capture is stopped
+func stopProfilingCapture(ctx context.Context, client MinioAdmin, store *profilingCaptureStore, id string) (*profilingCapture, error) {
+	capture := store.takeActive(id)
+	if capture == nil {
+		return nil, ErrorGenericNotFound
+	}
+	data, err := func() ([]byte, error) {
+		profilingData, err := stopProfiling(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+		defer profilingData.Close()
+		data, err := ioutil.ReadAll(profilingData)
+		if err != nil {
+			return nil, err
+		}
+		return filterProfilingNodes(data, capture.nodes)
+	}()
+	capture.finishedAt = time.Now()
+	if err != nil {
+		capture.status = models.ProfilingCaptureStatusFailed
+		capture.err = err.Error()
+		store.keep(capture)
+		return nil, err
+	}
+	capture.status = models.ProfilingCaptureStatusCompleted
+	capture.data = data
+	store.keep(capture)
+	return capture, nil
+}
+
+// getProfilingStopResponse() performs stopProfilingCapture() and returns the capture for the handler's output
+func getProfilingStopResponse(session *models.Principal) (*profilingCapture, *models.Error) {
 	ctx := context.Background()
 	mAdmin, err := NewMinioAdminClient(session)
 	if err != nil {
@@ -126,9 +444,64 @@ func getProfilingStopResponse(session *models.Principal) (io.ReadCloser, *models
 	// create a MinIO Admin Client interface implementation
 	// defining the client to be used
 	adminClient := AdminClient{Client: mAdmin}
-	profilingData, err := stopProfiling(ctx, adminClient)
+	capture, err := stopProfilingCapture(ctx, adminClient, globalProfilingCaptures, "")
 	if err != nil {
 		return nil, prepareError(err)
 	}
-	return profilingData, nil
+	return capture, nil
+}
+
+// getListProfilingCapturesResponse returns the running capture and the ones kept for download,
+// MinIO isn't queried so the session is checked for the profiling permission
+func getListProfilingCapturesResponse(session *models.Principal) (*models.ProfilingCaptureList, *models.Error) {
+	if !sessionAllowsAction(session, iampolicy.ProfilingAdminAction) {
+		return nil, prepareError(errAccessDenied)
+	}
+	return &models.ProfilingCaptureList{Captures: globalProfilingCaptures.list()}, nil
+}
+
+// getCompletedProfilingCapture returns a stored capture that finished successfully
+func getCompletedProfilingCapture(store *profilingCaptureStore, id string) (*profilingCapture, error) {
+	capture := store.get(id)
+	if capture == nil {
+		return nil, ErrorGenericNotFound
+	}
+	if capture.status != models.ProfilingCaptureStatusCompleted {
+		return nil, errProfilingCaptureNotCompleted
+	}
+	return capture, nil
+}
+
+// getDownloadProfilingCaptureResponse returns a capture kept in memory, MinIO
+// isn't queried so the session is checked for the profiling permission
+func getDownloadProfilingCaptureResponse(session *models.Principal, id string) (*profilingCapture, *models.Error) {
+	if !sessionAllowsAction(session, iampolicy.ProfilingAdminAction) {
+		return nil, prepareError(errAccessDenied)
+	}
+	capture, err := getCompletedProfilingCapture(globalProfilingCaptures, id)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return capture, nil
+}
+
+// getProfilingCaptureSummaryResponse summarizes a capture kept in memory, MinIO
+// isn't queried so the session is checked for the profiling permission
+func getProfilingCaptureSummaryResponse(session *models.Principal, params admin_api.ProfilingCaptureSummaryParams) (*models.ProfilingSummary, *models.Error) {
+	if !sessionAllowsAction(session, iampolicy.ProfilingAdminAction) {
+		return nil, prepareError(errAccessDenied)
+	}
+	capture, err := getCompletedProfilingCapture(globalProfilingCaptures, params.CaptureID)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	top := profilingSummaryDefaultTop
+	if params.Top != nil && *params.Top > 0 {
+		top = int(*params.Top)
+	}
+	nodes, err := summarizeProfilingCapture(capture.data, top)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return &models.ProfilingSummary{CaptureID: capture.id, Nodes: nodes}, nil
 }
@@ -0,0 +1,132 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"archive/zip"
+	"bytes"
+	"sort"
+	"strings"
+
+	"github.com/google/pprof/profile"
+	"github.com/minio/console/models"
+)
+
+// topFunctions returns the functions of the profile with the highest flat value,
+// the "cpu" sample type is used when present and the last one otherwise
+func topFunctions(p *profile.Profile, top int) (functions []*models.ProfilingFunction, total int64, unit string) {
+	if len(p.SampleType) == 0 {
+		return nil, 0, ""
+	}
+	index := len(p.SampleType) - 1
+	for i, sampleType := range p.SampleType {
+		if sampleType.Type == "cpu" {
+			index = i
+		}
+	}
+	unit = p.SampleType[index].Unit
+	flat := map[string]int64{}
+	cum := map[string]int64{}
+	for _, sample := range p.Sample {
+		if index >= len(sample.Value) {
+			continue
+		}
+		value := sample.Value[index]
+		total += value
+		seen := map[string]bool{}
+		// locations and their lines are ordered from the innermost function
+		for i, location := range sample.Location {
+			for j, line := range location.Line {
+				if line.Function == nil {
+					continue
+				}
+				name := line.Function.Name
+				if i == 0 && j == 0 {
+					flat[name] += value
+				}
+				if !seen[name] {
+					seen[name] = true
+					cum[name] += value
+				}
+			}
+		}
+	}
+	for name, value := range cum {
+		function := &models.ProfilingFunction{
+			Name: name,
+			Flat: flat[name],
+			Cum:  value,
+		}
+		if total > 0 {
+			function.FlatPercent = float64(function.Flat) * 100 / float64(total)
+			function.CumPercent = float64(function.Cum) * 100 / float64(total)
+		}
+		functions = append(functions, function)
+	}
+	sort.Slice(functions, func(i, j int) bool {
+		if functions[i].Flat != functions[j].Flat {
+			return functions[i].Flat > functions[j].Flat
+		}
+		if functions[i].Cum != functions[j].Cum {
+			return functions[i].Cum > functions[j].Cum
+		}
+		return functions[i].Name < functions[j].Name
+	})
+	if len(functions) > top {
+		functions = functions[:top]
+	}
+	return functions, total, unit
+}
+
+// summarizeProfilingCapture lists the top functions of every CPU profile found in the
+// zip returned by MinIO, profiles are named profile-<node>-cpu.pprof
+func summarizeProfilingCapture(data []byte, top int) ([]*models.ProfilingNodeSummary, error) {
+	zipReader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	var nodes []*models.ProfilingNodeSummary
+	for _, file := range zipReader.File {
+		if !strings.HasPrefix(file.Name, "profile-") || !strings.HasSuffix(file.Name, "-cpu.pprof") {
+			continue
+		}
+		node := strings.TrimSuffix(strings.TrimPrefix(file.Name, "profile-"), "-cpu.pprof")
+		f, err := file.Open()
+		if err != nil {
+			return nil, err
+		}
+		p, err := profile.Parse(f)
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+		functions, total, unit := topFunctions(p, top)
+		nodes = append(nodes, &models.ProfilingNodeSummary{
+			Node:      node,
+			Total:     total,
+			Unit:      unit,
+			Functions: functions,
+		})
+	}
+	if len(nodes) == 0 {
+		return nil, errNoCPUProfileInCapture
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].Node < nodes[j].Node
+	})
+	return nodes, nil
+}
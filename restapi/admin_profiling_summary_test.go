@@ -0,0 +1,105 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+
+	"github.com/google/pprof/profile"
+	"github.com/stretchr/testify/assert"
+)
+
+// newCPUProfile returns a gzipped pprof profile where main calls work and
+// work calls both compute and, inlined, hash
+func newCPUProfile(t *testing.T) []byte {
+	functions := map[string]*profile.Function{}
+	for i, name := range []string{"main", "work", "compute", "hash"} {
+		functions[name] = &profile.Function{ID: uint64(i + 1), Name: name}
+	}
+	location := func(id uint64, names ...string) *profile.Location {
+		l := &profile.Location{ID: id}
+		for _, name := range names {
+			l.Line = append(l.Line, profile.Line{Function: functions[name]})
+		}
+		return l
+	}
+	main, work, compute := location(1, "main"), location(2, "work"), location(3, "compute")
+	// hash is inlined into work
+	hash := location(4, "hash", "work")
+	p := &profile.Profile{
+		SampleType: []*profile.ValueType{{Type: "samples", Unit: "count"}, {Type: "cpu", Unit: "nanoseconds"}},
+		// the leaf location goes first
+		Sample: []*profile.Sample{
+			{Location: []*profile.Location{compute, work, main}, Value: []int64{3, 300}},
+			{Location: []*profile.Location{hash, main}, Value: []int64{1, 100}},
+			{Location: []*profile.Location{work, main}, Value: []int64{6, 600}},
+		},
+		Location: []*profile.Location{main, work, compute, hash},
+		Function: []*profile.Function{functions["main"], functions["work"], functions["compute"], functions["hash"]},
+	}
+	var buf bytes.Buffer
+	if err := p.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestSummarizeProfilingCapture(t *testing.T) {
+	assert := assert.New(t)
+	var buf bytes.Buffer
+	zipWriter := zip.NewWriter(&buf)
+	files := map[string][]byte{
+		"profile-127.0.0.1:9000-cpu.pprof": newCPUProfile(t),
+		"profile-127.0.0.1:9000-mem.pprof": []byte("not a cpu profile"),
+	}
+	for name, data := range files {
+		w, err := zipWriter.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = w.Write(data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zipWriter.Close(); err != nil {
+		t.Fatal(err)
+	}
+	// Test-1 : summarizeProfilingCapture() returns the flat and cumulative cpu time per function
+	nodes, err := summarizeProfilingCapture(buf.Bytes(), 3)
+	if assert.NoError(err) && assert.Equal(1, len(nodes)) {
+		assert.Equal("127.0.0.1:9000", nodes[0].Node)
+		assert.Equal(int64(1000), nodes[0].Total)
+		assert.Equal("nanoseconds", nodes[0].Unit)
+		functions := nodes[0].Functions
+		if assert.Equal(3, len(functions)) {
+			assert.Equal("work", functions[0].Name)
+			assert.Equal(int64(600), functions[0].Flat)
+			assert.Equal(int64(1000), functions[0].Cum)
+			assert.Equal(float64(60), functions[0].FlatPercent)
+			assert.Equal("compute", functions[1].Name)
+			assert.Equal(int64(300), functions[1].Flat)
+			assert.Equal("hash", functions[2].Name)
+			assert.Equal(int64(100), functions[2].Flat)
+			assert.Equal(float64(10), functions[2].CumPercent)
+		}
+	}
+	// Test-2 : summarizeProfilingCapture() fails when there is no cpu profile
+	_, err = summarizeProfilingCapture(newProfilingZip(t, "profile-127.0.0.1:9000-mem.pprof"), 3)
+	assert.Equal(errNoCPUProfileInCapture, err)
+}
@@ -17,14 +17,17 @@
 package restapi
 
 import (
+	"archive/zip"
 	"bytes"
 	"context"
 	"io"
 	"testing"
+	"time"
 
 	"errors"
 
 	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations/admin_api"
 	"github.com/minio/madmin-go"
 	"github.com/stretchr/testify/assert"
 )
@@ -114,3 +117,164 @@ func TestStopProfiling(t *testing.T) {
 		assert.Equal("error", err.Error())
 	}
 }
+
+// newProfilingZip returns a zip file with one entry per given name
+func newProfilingZip(t *testing.T, names ...string) []byte {
+	var buf bytes.Buffer
+	zipWriter := zip.NewWriter(&buf)
+	for _, name := range names {
+		w, err := zipWriter.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = w.Write([]byte(name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zipWriter.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestStartProfilingCapture(t *testing.T) {
+	ctx := context.Background()
+	assert := assert.New(t)
+	adminClient := adminClientMock{}
+	store := &profilingCaptureStore{}
+	var requestedProfiler madmin.ProfilerType
+	minioStartProfiling = func(profiler madmin.ProfilerType) ([]madmin.StartProfilingResult, error) {
+		requestedProfiler = profiler
+		return []madmin.StartProfilingResult{
+			{NodeName: "127.0.0.1:9000", Success: true},
+			{NodeName: "127.0.0.1:9001", Success: true},
+		}, nil
+	}
+	newClient := func() (MinioAdmin, error) {
+		return adminClient, nil
+	}
+	// Test-1 : startProfilingCapture() requests all the selected profilers and keeps only the selected nodes
+	items, err := startProfilingCapture(ctx, adminClient, store, &models.ProfilingStartRequest{
+		Types: []string{"cpu", "mem", "goroutines"},
+		Nodes: []string{"http://127.0.0.1:9001/"},
+	}, newClient)
+	if assert.NoError(err) {
+		assert.Equal(madmin.ProfilerType("cpu,mem,goroutines"), requestedProfiler)
+		assert.Equal(1, len(items))
+		assert.Equal("127.0.0.1:9001", items[0].NodeName)
+	}
+	captures := store.list()
+	if assert.Equal(1, len(captures)) {
+		assert.Equal(models.ProfilingCaptureStatusRunning, captures[0].Status)
+	}
+	// Test-2 : startProfilingCapture() rejects unknown profilers
+	_, err = startProfilingCapture(ctx, adminClient, store, &models.ProfilingStartRequest{
+		Types: []string{"cpu", "heap"},
+	}, newClient)
+	assert.Equal(errInvalidProfilerType, err)
+	// Test-3 : startProfilingCapture() requires at least one profiler
+	_, err = startProfilingCapture(ctx, adminClient, store, &models.ProfilingStartRequest{}, newClient)
+	assert.Equal(errProfilerTypeNotSet, err)
+	// Test-4 : the single type of the older clients is still accepted
+	types, requested, err := getProfilerTypes(&models.ProfilingStartRequest{Type: models.ProfilerTypeMem})
+	if assert.NoError(err) {
+		assert.Equal([]string{"mem"}, types)
+		assert.Equal(models.ProfilerTypeMem, requested)
+	}
+	// Test-5 : a timed capture is stopped and kept automatically
+	minioStopProfiling = func() (io.ReadCloser, error) {
+		return &ClosingBuffer{bytes.NewBuffer(newProfilingZip(t, "profile-127.0.0.1:9000-cpu.pprof"))}, nil
+	}
+	stopped := make(chan struct{})
+	timedClient := func() (MinioAdmin, error) {
+		defer close(stopped)
+		return adminClient, nil
+	}
+	_, err = startProfilingCapture(ctx, adminClient, store, &models.ProfilingStartRequest{
+		Type:     models.ProfilerTypeCPU,
+		Duration: 1,
+	}, timedClient)
+	assert.NoError(err)
+	<-stopped
+	assert.Eventually(func() bool {
+		captures := store.list()
+		return len(captures) == 2 && captures[0].Status == models.ProfilingCaptureStatusCompleted
+	}, 5*time.Second, 10*time.Millisecond)
+	// the capture started on Test-1 was replaced by the timed one
+	assert.Equal(models.ProfilingCaptureStatusFailed, store.list()[1].Status)
+}
+
+func TestStopProfilingCapture(t *testing.T) {
+	ctx := context.Background()
+	assert := assert.New(t)
+	adminClient := adminClientMock{}
+	store := &profilingCaptureStore{}
+	minioStopProfiling = func() (io.ReadCloser, error) {
+		return &ClosingBuffer{bytes.NewBuffer(newProfilingZip(t,
+			"profile-127.0.0.1:9000-cpu.pprof",
+			"profile-127.0.0.1:9000-mem.pprof",
+			"profile-127.0.0.1:9001-cpu.pprof"))}, nil
+	}
+	// Test-1 : stopProfilingCapture() keeps only the files of the selected nodes
+	started := store.begin([]string{"cpu", "mem"}, []string{"127.0.0.1:9000"})
+	capture, err := stopProfilingCapture(ctx, adminClient, store, "")
+	if assert.NoError(err) {
+		assert.Equal(started.id, capture.id)
+		zipReader, err := zip.NewReader(bytes.NewReader(capture.data), int64(len(capture.data)))
+		if assert.NoError(err) {
+			var names []string
+			for _, file := range zipReader.File {
+				names = append(names, file.Name)
+			}
+			assert.Equal([]string{"profile-127.0.0.1:9000-cpu.pprof", "profile-127.0.0.1:9000-mem.pprof"}, names)
+		}
+	}
+	stored, err := getCompletedProfilingCapture(store, started.id)
+	if assert.NoError(err) {
+		assert.Equal(capture.data, stored.data)
+	}
+	// Test-2 : only the last captures are kept
+	for i := 0; i < profilingCapturesToKeep; i++ {
+		store.begin([]string{"cpu"}, nil)
+		_, err = stopProfilingCapture(ctx, adminClient, store, "")
+		assert.NoError(err)
+	}
+	assert.Equal(profilingCapturesToKeep, len(store.list()))
+	_, err = getCompletedProfilingCapture(store, started.id)
+	assert.Equal(ErrorGenericNotFound, err)
+	// Test-3 : failed captures are kept but can't be downloaded
+	minioStopProfiling = func() (io.ReadCloser, error) {
+		return nil, errors.New("error")
+	}
+	failed := store.begin([]string{"cpu"}, nil)
+	_, err = stopProfilingCapture(ctx, adminClient, store, "")
+	assert.Error(err)
+	_, err = getCompletedProfilingCapture(store, failed.id)
+	assert.Equal(errProfilingCaptureNotCompleted, err)
+	// Test-4 : a timed stop doesn't stop a capture that isn't running anymore
+	_, err = stopProfilingCapture(ctx, adminClient, store, failed.id)
+	assert.Equal(ErrorGenericNotFound, err)
+}
+
+func TestProfilingCapturesAccess(t *testing.T) {
+	assert := assert.New(t)
+	reader := &models.Principal{Actions: []string{"admin:ServerInfo"}}
+	// Test-1 : the stored captures need the profiling permission
+	_, err := getListProfilingCapturesResponse(reader)
+	if assert.NotNil(err) {
+		assert.Equal(int32(403), err.Code)
+	}
+	_, err = getDownloadProfilingCaptureResponse(reader, "capture")
+	if assert.NotNil(err) {
+		assert.Equal(int32(403), err.Code)
+	}
+	_, err = getProfilingCaptureSummaryResponse(reader, admin_api.ProfilingCaptureSummaryParams{CaptureID: "capture"})
+	if assert.NotNil(err) {
+		assert.Equal(int32(403), err.Code)
+	}
+	// Test-2 : the sessions allowed to profile can list them
+	captures, err := getListProfilingCapturesResponse(&models.Principal{Actions: []string{"admin:Profiling"}})
+	if assert.Nil(err) {
+		assert.NotNil(captures)
+	}
+}
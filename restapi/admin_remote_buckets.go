@@ -108,6 +108,33 @@ func registerAdminBucketRemoteHandlers(api *operations.ConsoleAPI) {
 
 		return user_api.NewDeleteBucketReplicationRuleNoContent()
 	})
+
+	// update replication rule
+	api.UserAPIUpdateBucketReplicationRuleHandler = user_api.UpdateBucketReplicationRuleHandlerFunc(func(params user_api.UpdateBucketReplicationRuleParams, session *models.Principal) middleware.Responder {
+		updateResp, err := updateReplicationRuleResponse(session, params)
+		if err != nil {
+			return user_api.NewUpdateBucketReplicationRuleDefault(int(err.Code)).WithPayload(err)
+		}
+		return user_api.NewUpdateBucketReplicationRuleOK().WithPayload(updateResp)
+	})
+
+	// enable or disable a replication rule
+	api.UserAPISetBucketReplicationRuleStatusHandler = user_api.SetBucketReplicationRuleStatusHandlerFunc(func(params user_api.SetBucketReplicationRuleStatusParams, session *models.Principal) middleware.Responder {
+		statusResp, err := setReplicationRuleStatusResponse(session, params)
+		if err != nil {
+			return user_api.NewSetBucketReplicationRuleStatusDefault(int(err.Code)).WithPayload(err)
+		}
+		return user_api.NewSetBucketReplicationRuleStatusOK().WithPayload(statusResp)
+	})
+
+	// reorder replication rules
+	api.UserAPISetBucketReplicationRulesOrderHandler = user_api.SetBucketReplicationRulesOrderHandlerFunc(func(params user_api.SetBucketReplicationRulesOrderParams, session *models.Principal) middleware.Responder {
+		err := setReplicationRulesOrderResponse(session, params)
+		if err != nil {
+			return user_api.NewSetBucketReplicationRulesOrderDefault(int(err.Code)).WithPayload(err)
+		}
+		return user_api.NewSetBucketReplicationRulesOrderNoContent()
+	})
 }
 
 func getListRemoteBucketsResponse(session *models.Principal) (*models.ListRemoteBucketsResponse, error) {
@@ -518,3 +545,277 @@ func deleteReplicationRuleResponse(session *models.Principal, params user_api.De
 	}
 	return nil
 }
+
+// replicationOptionStatus returns the value the replication options use for a toggle
+func replicationOptionStatus(enabled bool) string {
+	if enabled {
+		return "enable"
+	}
+	return "disable"
+}
+
+// updateReplicationRuleOptions returns the options to edit a rule in place,
+// only the settings of the update are applied, the ones left out, a zero
+// priority or an empty status keep the current values of the rule
+func updateReplicationRuleOptions(rule replication.Rule, update *models.UpdateBucketReplicationRule) replication.Options {
+	opts := replication.Options{
+		ID:     rule.ID,
+		Op:     replication.SetOption,
+		Prefix: rule.Prefix(),
+	}
+	if update.Prefix != nil {
+		opts.Prefix = *update.Prefix
+	}
+	if update.Tags != nil {
+		opts.TagString = *update.Tags
+		opts.IsTagSet = true
+	}
+	if update.StorageClass != nil {
+		opts.StorageClass = *update.StorageClass
+		opts.IsSCSet = true
+	}
+	if update.DeleteMarkerReplication != nil {
+		opts.ReplicateDeleteMarkers = replicationOptionStatus(*update.DeleteMarkerReplication)
+	}
+	if update.DeletesReplication != nil {
+		opts.ReplicateDeletes = replicationOptionStatus(*update.DeletesReplication)
+	}
+	if update.MetadataReplication != nil {
+		opts.ReplicaSync = replicationOptionStatus(*update.MetadataReplication)
+	}
+	if update.ExistingObjects != nil {
+		opts.ExistingObjectReplicate = replicationOptionStatus(*update.ExistingObjects)
+	}
+	if update.Status != "" {
+		opts.RuleStatus = replicationOptionStatus(update.Status == models.UpdateBucketReplicationRuleStatusEnabled)
+	}
+	if update.Priority > 0 {
+		opts.Priority = strconv.Itoa(int(update.Priority))
+	}
+	return opts
+}
+
+// findReplicationRule returns the rule with the given id
+func findReplicationRule(cfg replication.Config, ruleID string) *replication.Rule {
+	for i := range cfg.Rules {
+		if cfg.Rules[i].ID == ruleID {
+			return &cfg.Rules[i]
+		}
+	}
+	return nil
+}
+
+// replicationRuleTargetArn returns the ARN of the remote target a rule replicates
+// to, configurations created with older releases keep it in the role
+func replicationRuleTargetArn(cfg replication.Config, rule replication.Rule) string {
+	if cfg.Role != "" {
+		return cfg.Role
+	}
+	return rule.Destination.Bucket
+}
+
+// reorderReplicationRules assigns the rule priorities following the order of
+// the ids, the first rule gets the highest priority
+func reorderReplicationRules(cfg *replication.Config, ruleIDs []string) error {
+	if len(ruleIDs) != len(cfg.Rules) {
+		return errInvalidReplicationOrder
+	}
+	priorities := make(map[string]int)
+	for i, id := range ruleIDs {
+		if _, ok := priorities[id]; ok {
+			return errInvalidReplicationOrder
+		}
+		priorities[id] = len(ruleIDs) - i
+	}
+	for i := range cfg.Rules {
+		priority, ok := priorities[cfg.Rules[i].ID]
+		if !ok {
+			return errInvalidReplicationOrder
+		}
+		cfg.Rules[i].Priority = priority
+	}
+	return nil
+}
+
+// newReplicationTargetClient creates a client for the bucket of a remote target
+func newReplicationTargetClient(target madmin.BucketTarget) (MinioClient, error) {
+	mClient, err := newMinioClientFromCreds(target.Credentials.AccessKey, target.Credentials.SecretKey, target.Endpoint, target.Secure)
+	if err != nil {
+		return nil, err
+	}
+	return minioClient{client: mClient}, nil
+}
+
+// replicationTargetNotVerified is the warning returned when the remote bucket
+// of a target can't be checked
+const replicationTargetNotVerified = "the versioning of the remote bucket could not be verified, MinIO did not return the credentials of the target"
+
+// validateReplicationTarget checks the remote bucket of the target still has
+// versioning enabled, which replication requires. MinIO doesn't always return
+// the secret key of the targets, without it the remote bucket can't be reached
+// and a warning is returned instead
+func validateReplicationTarget(ctx context.Context, targets []madmin.BucketTarget, arn string, newClient func(target madmin.BucketTarget) (MinioClient, error)) (string, error) {
+	for _, target := range targets {
+		if target.Arn != arn {
+			continue
+		}
+		if target.Credentials == nil || target.Credentials.SecretKey == "" {
+			return replicationTargetNotVerified, nil
+		}
+		client, err := newClient(target)
+		if err != nil {
+			return "", err
+		}
+		versioning, err := client.getBucketVersioning(ctx, target.TargetBucket)
+		if err != nil {
+			return "", err
+		}
+		if !versioning.Enabled() {
+			return "", errRemoteBucketNotVersioned
+		}
+		return "", nil
+	}
+	return "", errReplicationTargetNotFound
+}
+
+// editReplicationRule modifies a rule of the bucket replication configuration
+// in place, the options are built from the current rule. The remote target is
+// validated when the rule stays or becomes enabled, the warning tells when it
+// couldn't be
+func editReplicationRule(ctx context.Context, session *models.Principal, bucketName, ruleID string, edit func(rule replication.Rule) replication.Options) (string, error) {
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		LogError("error creating MinIO Client: %v", err)
+		return "", err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minClient := minioClient{client: mClient}
+
+	cfg, err := minClient.getBucketReplication(ctx, bucketName)
+	if err != nil {
+		return "", err
+	}
+	rule := findReplicationRule(cfg, ruleID)
+	if rule == nil {
+		return "", ErrorGenericNotFound
+	}
+	opts := edit(*rule)
+
+	var warning string
+	enabled := rule.Status == replication.Enabled
+	if opts.RuleStatus != "" {
+		enabled = opts.RuleStatus == "enable"
+	}
+	if enabled {
+		mAdmin, err := NewMinioAdminClient(session)
+		if err != nil {
+			LogError("error creating Madmin Client: %v", err)
+			return "", err
+		}
+		adminClient := AdminClient{Client: mAdmin}
+		targets, err := adminClient.listRemoteBuckets(ctx, bucketName, string(madmin.ReplicationService))
+		if err != nil {
+			return "", err
+		}
+		warning, err = validateReplicationTarget(ctx, targets, replicationRuleTargetArn(cfg, *rule), newReplicationTargetClient)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	// the rule prefix is taken from the client url
+	s3Client, err := newS3BucketClient(session, bucketName, opts.Prefix)
+	if err != nil {
+		LogError("error creating S3Client: %v", err)
+		return "", err
+	}
+	// create a mc S3Client interface implementation
+	// defining the client to be used
+	mcClient := mcClient{client: s3Client}
+
+	err2 := mcClient.setReplication(ctx, &cfg, opts)
+	if err2 != nil {
+		return "", err2.Cause
+	}
+	return warning, nil
+}
+
+func updateReplicationRuleResponse(session *models.Principal, params user_api.UpdateBucketReplicationRuleParams) (*models.ReplicationRuleUpdateResponse, *models.Error) {
+	if params.Body == nil {
+		return nil, prepareError(errReplicationBodyNotInRequest)
+	}
+	warning, err := editReplicationRule(params.HTTPRequest.Context(), session, params.BucketName, params.RuleID, func(rule replication.Rule) replication.Options {
+		return updateReplicationRuleOptions(rule, params.Body)
+	})
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return &models.ReplicationRuleUpdateResponse{Warning: warning}, nil
+}
+
+func setReplicationRuleStatusResponse(session *models.Principal, params user_api.SetBucketReplicationRuleStatusParams) (*models.ReplicationRuleUpdateResponse, *models.Error) {
+	if params.Body == nil {
+		return nil, prepareError(errReplicationBodyNotInRequest)
+	}
+	status := replicationOptionStatus(*params.Body.Status == models.BucketReplicationRuleStatusStatusEnabled)
+	warning, err := editReplicationRule(params.HTTPRequest.Context(), session, params.BucketName, params.RuleID, func(rule replication.Rule) replication.Options {
+		// keep the prefix of the rule, only the status changes
+		return replication.Options{
+			ID:         rule.ID,
+			Op:         replication.SetOption,
+			Prefix:     rule.Prefix(),
+			RuleStatus: status,
+		}
+	})
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return &models.ReplicationRuleUpdateResponse{Warning: warning}, nil
+}
+
+func setReplicationRulesOrder(ctx context.Context, session *models.Principal, bucketName string, ruleIDs []string) error {
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		LogError("error creating MinIO Client: %v", err)
+		return err
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minClient := minioClient{client: mClient}
+
+	cfg, err := minClient.getBucketReplication(ctx, bucketName)
+	if err != nil {
+		return err
+	}
+	if err = reorderReplicationRules(&cfg, ruleIDs); err != nil {
+		return err
+	}
+
+	s3Client, err := newS3BucketClient(session, bucketName, "")
+	if err != nil {
+		LogError("error creating S3Client: %v", err)
+		return err
+	}
+	// create a mc S3Client interface implementation
+	// defining the client to be used
+	mcClient := mcClient{client: s3Client}
+
+	// the import option saves the configuration as it is
+	err2 := mcClient.setReplication(ctx, &cfg, replication.Options{Op: replication.ImportOption})
+	if err2 != nil {
+		return err2.Cause
+	}
+	return nil
+}
+
+func setReplicationRulesOrderResponse(session *models.Principal, params user_api.SetBucketReplicationRulesOrderParams) *models.Error {
+	if params.Body == nil {
+		return prepareError(errReplicationBodyNotInRequest)
+	}
+	err := setReplicationRulesOrder(params.HTTPRequest.Context(), session, params.BucketName, params.Body.Rules)
+	if err != nil {
+		return prepareError(err)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/replication"
+	"github.com/stretchr/testify/assert"
+)
+
+var minioGetBucketVersioningMock func(ctx context.Context, bucketName string) (minio.BucketVersioningConfiguration, error)
+
+// mock function of getBucketVersioning()
+func (mc minioClientMock) getBucketVersioning(ctx context.Context, bucketName string) (minio.BucketVersioningConfiguration, error) {
+	return minioGetBucketVersioningMock(ctx, bucketName)
+}
+
+func testReplicationConfig() replication.Config {
+	return replication.Config{Rules: []replication.Rule{
+		{ID: "docs", Status: replication.Enabled, Priority: 1, Filter: replication.Filter{Prefix: "docs/"}, Destination: replication.Destination{Bucket: "arn:minio:replication::1:dest"}},
+		{ID: "logs", Status: replication.Enabled, Priority: 2, Filter: replication.Filter{Prefix: "logs/"}, Destination: replication.Destination{Bucket: "arn:minio:replication::1:dest"}},
+	}}
+}
+
+func TestUpdateReplicationRuleOptions(t *testing.T) {
+	assert := assert.New(t)
+	cfg := testReplicationConfig()
+	// Test-1 : the rule is edited in place keeping its id
+	opts := updateReplicationRuleOptions(cfg.Rules[0], &models.UpdateBucketReplicationRule{
+		Prefix:                  swag.String("documents/"),
+		Tags:                    swag.String("team=ops"),
+		Priority:                5,
+		StorageClass:            swag.String("STANDARD"),
+		DeleteMarkerReplication: swag.Bool(true),
+		DeletesReplication:      swag.Bool(false),
+		ExistingObjects:         swag.Bool(true),
+	})
+	assert.Empty(opts.RuleStatus)
+	if assert.NoError(cfg.EditRule(opts)) {
+		rule := cfg.Rules[0]
+		assert.Equal("docs", rule.ID)
+		assert.Equal(replication.Enabled, rule.Status)
+		assert.Equal(5, rule.Priority)
+		assert.Equal("documents/", rule.Prefix())
+		assert.Equal("team=ops", rule.Tags())
+		assert.Equal("STANDARD", rule.Destination.StorageClass)
+		assert.Equal(replication.Enabled, rule.DeleteMarkerReplication.Status)
+		assert.Equal(replication.Disabled, rule.DeleteReplication.Status)
+		assert.Equal(replication.Enabled, rule.ExistingObjectReplication.Status)
+		assert.Equal("logs/", cfg.Rules[1].Prefix())
+	}
+	// Test-2 : the status and the priority are kept unless set
+	opts = updateReplicationRuleOptions(cfg.Rules[1], &models.UpdateBucketReplicationRule{Status: models.UpdateBucketReplicationRuleStatusDisabled})
+	assert.Equal("disable", opts.RuleStatus)
+	if assert.NoError(cfg.EditRule(opts)) {
+		assert.Equal(replication.Disabled, cfg.Rules[1].Status)
+		assert.Equal(2, cfg.Rules[1].Priority)
+	}
+	// Test-3 : the settings left out keep their current values
+	opts = updateReplicationRuleOptions(cfg.Rules[0], &models.UpdateBucketReplicationRule{DeletesReplication: swag.Bool(true)})
+	if assert.NoError(cfg.EditRule(opts)) {
+		rule := cfg.Rules[0]
+		assert.Equal("documents/", rule.Prefix())
+		assert.Equal("team=ops", rule.Tags())
+		assert.Equal("STANDARD", rule.Destination.StorageClass)
+		assert.Equal(replication.Enabled, rule.DeleteMarkerReplication.Status)
+		assert.Equal(replication.Enabled, rule.DeleteReplication.Status)
+		assert.Equal(replication.Enabled, rule.ExistingObjectReplication.Status)
+	}
+	// Test-4 : priorities must be unique
+	opts = updateReplicationRuleOptions(cfg.Rules[1], &models.UpdateBucketReplicationRule{Priority: 5})
+	assert.Error(cfg.EditRule(opts))
+}
+
+func TestReorderReplicationRules(t *testing.T) {
+	assert := assert.New(t)
+	cfg := testReplicationConfig()
+	// Test-1 : the first rule gets the highest priority
+	if assert.NoError(reorderReplicationRules(&cfg, []string{"docs", "logs"})) {
+		assert.Equal(2, cfg.Rules[0].Priority)
+		assert.Equal(1, cfg.Rules[1].Priority)
+	}
+	// Test-2 : every rule must be listed once
+	assert.Equal(errInvalidReplicationOrder, reorderReplicationRules(&cfg, []string{"docs"}))
+	assert.Equal(errInvalidReplicationOrder, reorderReplicationRules(&cfg, []string{"docs", "docs"}))
+	assert.Equal(errInvalidReplicationOrder, reorderReplicationRules(&cfg, []string{"docs", "other"}))
+}
+
+func TestValidateReplicationTarget(t *testing.T) {
+	assert := assert.New(t)
+	targets := []madmin.BucketTarget{
+		{Arn: "arn:minio:replication::1:dest", TargetBucket: "dest", Credentials: &madmin.Credentials{AccessKey: "access", SecretKey: "secret"}},
+		{Arn: "arn:minio:replication::1:nosecret", TargetBucket: "dest", Credentials: &madmin.Credentials{AccessKey: "access"}},
+	}
+	newClient := func(target madmin.BucketTarget) (MinioClient, error) {
+		return minioClientMock{}, nil
+	}
+	var checked string
+	minioGetBucketVersioningMock = func(ctx context.Context, bucketName string) (minio.BucketVersioningConfiguration, error) {
+		checked = bucketName
+		return minio.BucketVersioningConfiguration{Status: "Enabled"}, nil
+	}
+	// Test-1 : versioned remote bucket
+	warning, err := validateReplicationTarget(context.Background(), targets, "arn:minio:replication::1:dest", newClient)
+	assert.NoError(err)
+	assert.Empty(warning)
+	assert.Equal("dest", checked)
+	// Test-2 : remote bucket without versioning
+	minioGetBucketVersioningMock = func(ctx context.Context, bucketName string) (minio.BucketVersioningConfiguration, error) {
+		return minio.BucketVersioningConfiguration{Status: "Suspended"}, nil
+	}
+	_, err = validateReplicationTarget(context.Background(), targets, "arn:minio:replication::1:dest", newClient)
+	assert.Equal(errRemoteBucketNotVersioned, err)
+	// Test-3 : errors reaching the remote bucket are returned
+	minioGetBucketVersioningMock = func(ctx context.Context, bucketName string) (minio.BucketVersioningConfiguration, error) {
+		return minio.BucketVersioningConfiguration{}, errors.New("connection refused")
+	}
+	_, err = validateReplicationTarget(context.Background(), targets, "arn:minio:replication::1:dest", newClient)
+	assert.Error(err)
+	// Test-4 : targets without a secret key can't be verified and get a warning
+	warning, err = validateReplicationTarget(context.Background(), targets, "arn:minio:replication::1:nosecret", newClient)
+	assert.NoError(err)
+	assert.Equal(replicationTargetNotVerified, warning)
+	// Test-5 : unknown targets
+	_, err = validateReplicationTarget(context.Background(), targets, "arn:minio:replication::1:gone", newClient)
+	assert.Equal(errReplicationTargetNotFound, err)
+}
@@ -18,11 +18,15 @@ package restapi
 
 import (
 	"context"
+	"fmt"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/minio/console/models"
 	"github.com/minio/console/restapi/operations"
+	"github.com/minio/madmin-go"
 
 	"github.com/minio/console/restapi/operations/admin_api"
 )
@@ -30,11 +34,34 @@ import (
 func registerServiceHandlers(api *operations.ConsoleAPI) {
 	// Restart Service
 	api.AdminAPIRestartServiceHandler = admin_api.RestartServiceHandlerFunc(func(params admin_api.RestartServiceParams, session *models.Principal) middleware.Responder {
-		if err := getRestartServiceResponse(session); err != nil {
+		if err := getRestartServiceResponse(session, params); err != nil {
 			return admin_api.NewRestartServiceDefault(int(err.Code)).WithPayload(err)
 		}
 		return admin_api.NewRestartServiceNoContent()
 	})
+	// Stop Service
+	api.AdminAPIStopServiceHandler = admin_api.StopServiceHandlerFunc(func(params admin_api.StopServiceParams, session *models.Principal) middleware.Responder {
+		if err := getStopServiceResponse(session, params); err != nil {
+			return admin_api.NewStopServiceDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewStopServiceNoContent()
+	})
+	// Update the MinIO servers
+	api.AdminAPIUpdateServerHandler = admin_api.UpdateServerHandlerFunc(func(params admin_api.UpdateServerParams, session *models.Principal) middleware.Responder {
+		resp, err := getUpdateServerResponse(session, params)
+		if err != nil {
+			return admin_api.NewUpdateServerDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewUpdateServerOK().WithPayload(resp)
+	})
+	// Status of the MinIO servers
+	api.AdminAPIServiceStatusHandler = admin_api.ServiceStatusHandlerFunc(func(params admin_api.ServiceStatusParams, session *models.Principal) middleware.Responder {
+		resp, err := getServiceStatusResponse(session, params)
+		if err != nil {
+			return admin_api.NewServiceStatusDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewServiceStatusOK().WithPayload(resp)
+	})
 }
 
 // serviceRestart - restarts the MinIO cluster
@@ -58,8 +85,9 @@ func serviceRestart(ctx context.Context, client MinioAdmin) error {
 	return nil
 }
 
-// getRestartServiceResponse performs serviceRestart()
-func getRestartServiceResponse(session *models.Principal) *models.Error {
+// getRestartServiceResponse performs serviceRestart(), the pre-flight check
+// only runs when requested to keep the behavior of the existing endpoint
+func getRestartServiceResponse(session *models.Principal, params admin_api.RestartServiceParams) *models.Error {
 	ctx := context.Background()
 	mAdmin, err := NewMinioAdminClient(session)
 	if err != nil {
@@ -69,8 +97,177 @@ func getRestartServiceResponse(session *models.Principal) *models.Error {
 	// defining the client to be used
 	adminClient := AdminClient{Client: mAdmin}
 
+	if params.Check != nil && *params.Check {
+		if err := servicePreflightCheck(ctx, adminClient); err != nil {
+			return prepareError(err)
+		}
+	}
 	if err := serviceRestart(ctx, adminClient); err != nil {
 		return prepareError(err)
 	}
 	return nil
 }
+
+// serverHealthIssues lists what makes restarting or updating the cluster risky,
+// servers that aren't online and drives that aren't ok or are healing
+func serverHealthIssues(info madmin.InfoMessage) []string {
+	issues := []string{}
+	for _, server := range info.Servers {
+		if server.State != string(madmin.ItemOnline) {
+			issues = append(issues, fmt.Sprintf("server %s is %s", server.Endpoint, server.State))
+			continue
+		}
+		for _, disk := range server.Disks {
+			switch {
+			case disk.State != madmin.DriveStateOk:
+				issues = append(issues, fmt.Sprintf("drive %s on %s is %s", disk.DrivePath, server.Endpoint, disk.State))
+			case disk.Healing:
+				issues = append(issues, fmt.Sprintf("drive %s on %s is healing", disk.DrivePath, server.Endpoint))
+			}
+		}
+	}
+	return issues
+}
+
+// servicePreflightCheck makes sure every server and drive is healthy before
+// the whole cluster is restarted, stopped or updated
+func servicePreflightCheck(ctx context.Context, client MinioAdmin) error {
+	info, err := client.serverInfo(ctx)
+	if err != nil {
+		return err
+	}
+	if issues := serverHealthIssues(info); len(issues) > 0 {
+		return fmt.Errorf("%w: %s", errServicePreflightFailed, strings.Join(issues, ", "))
+	}
+	return nil
+}
+
+// getStopServiceResponse stops the MinIO cluster after the pre-flight check
+func getStopServiceResponse(session *models.Principal, params admin_api.StopServiceParams) *models.Error {
+	ctx := context.Background()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return prepareError(err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+
+	if params.Force == nil || !*params.Force {
+		if err := servicePreflightCheck(ctx, adminClient); err != nil {
+			return prepareError(err)
+		}
+	}
+	if err := adminClient.serviceStop(ctx); err != nil {
+		return prepareError(err)
+	}
+	return nil
+}
+
+// serverUpdate updates and restarts the MinIO cluster like `mc admin update`,
+// from the given URL, the one configured for Console or the official releases
+func serverUpdate(ctx context.Context, client MinioAdmin, req *models.ServerUpdateRequest) (*models.ServerUpdateResponse, error) {
+	updateURL := strings.TrimSpace(req.URL)
+	if updateURL == "" {
+		updateURL = getMinIOUpdateURL()
+	}
+	if updateURL != "" {
+		u, err := url.Parse(updateURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, errInvalidUpdateURL
+		}
+	}
+	if !req.Force {
+		if err := servicePreflightCheck(ctx, client); err != nil {
+			return nil, err
+		}
+	}
+	status, err := client.serverUpdate(ctx, updateURL)
+	if err != nil {
+		return nil, err
+	}
+	return &models.ServerUpdateResponse{
+		CurrentVersion: status.CurrentVersion,
+		UpdatedVersion: status.UpdatedVersion,
+	}, nil
+}
+
+func getUpdateServerResponse(session *models.Principal, params admin_api.UpdateServerParams) (*models.ServerUpdateResponse, *models.Error) {
+	ctx := context.Background()
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+
+	resp, err := serverUpdate(ctx, adminClient, params.Body)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return resp, nil
+}
+
+// serviceStatus reports the version and uptime of every server, it's polled
+// after a restart or an update until the cluster is ready. An unreachable
+// cluster isn't an error, it's just not ready yet
+func serviceStatus(ctx context.Context, client MinioAdmin, expectedVersion string, restartedAfter *int64, now time.Time) *models.ServiceStatusResponse {
+	status := &models.ServiceStatusResponse{Servers: []*models.ServiceServerStatus{}}
+	info, err := client.serverInfo(ctx)
+	if err != nil {
+		status.Issues = []string{fmt.Sprintf("unable to reach the cluster: %v", err)}
+		return status
+	}
+	status.Issues = serverHealthIssues(info)
+	status.Healthy = len(status.Issues) == 0
+	status.TotalServers = int64(len(info.Servers))
+	status.Ready = len(info.Servers) > 0
+	for _, server := range info.Servers {
+		var offlineDrives int64
+		for _, disk := range server.Disks {
+			if disk.State != madmin.DriveStateOk {
+				offlineDrives++
+			}
+		}
+		status.Servers = append(status.Servers, &models.ServiceServerStatus{
+			Endpoint:      server.Endpoint,
+			State:         server.State,
+			Version:       server.Version,
+			Uptime:        server.Uptime,
+			OfflineDrives: offlineDrives,
+		})
+		online := server.State == string(madmin.ItemOnline)
+		if online {
+			status.OnlineServers++
+		}
+		switch {
+		case !online:
+			status.Ready = false
+		case expectedVersion != "" && server.Version != expectedVersion:
+			status.Ready = false
+		case restartedAfter != nil && now.Unix()-server.Uptime < *restartedAfter:
+			status.Ready = false
+		}
+	}
+	return status
+}
+
+func getServiceStatusResponse(session *models.Principal, params admin_api.ServiceStatusParams) (*models.ServiceStatusResponse, *models.Error) {
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+
+	expectedVersion := ""
+	if params.ExpectedVersion != nil {
+		expectedVersion = *params.ExpectedVersion
+	}
+	// don't wait on servers that are still down
+	ctx, cancel := context.WithTimeout(params.HTTPRequest.Context(), 10*time.Second)
+	defer cancel()
+	return serviceStatus(ctx, adminClient, expectedVersion, params.RestartedAfter, time.Now()), nil
+}
@@ -19,9 +19,11 @@ package restapi
 import (
 	"context"
 	"testing"
+	"time"
 
 	"errors"
 
+	"github.com/minio/console/models"
 	"github.com/minio/madmin-go"
 	"github.com/stretchr/testify/assert"
 )
@@ -29,11 +31,24 @@ import (
 // assigning mock at runtime instead of compile time
 var minioServiceRestartMock func(ctx context.Context) error
 
+var minioServiceStopMock func(ctx context.Context) error
+var minioServerUpdateMock func(ctx context.Context, updateURL string) (madmin.ServerUpdateStatus, error)
+
 // mock function of serviceRestart()
 func (ac adminClientMock) serviceRestart(ctx context.Context) error {
 	return minioServiceRestartMock(ctx)
 }
 
+// mock function of serviceStop()
+func (ac adminClientMock) serviceStop(ctx context.Context) error {
+	return minioServiceStopMock(ctx)
+}
+
+// mock function of serverUpdate()
+func (ac adminClientMock) serverUpdate(ctx context.Context, updateURL string) (madmin.ServerUpdateStatus, error) {
+	return minioServerUpdateMock(ctx, updateURL)
+}
+
 func TestServiceRestart(t *testing.T) {
 	assert := assert.New(t)
 	adminClient := adminClientMock{}
@@ -75,3 +90,89 @@ func TestServiceRestart(t *testing.T) {
 		assert.Equal("error on server info", err.Error())
 	}
 }
+
+func healthyServersInfo() madmin.InfoMessage {
+	return madmin.InfoMessage{Servers: []madmin.ServerProperties{
+		{Endpoint: "node1:9000", State: "online", Version: "2021-09-01T00-00-00Z", Uptime: 100, Disks: []madmin.Disk{{DrivePath: "/data1", State: "ok"}}},
+		{Endpoint: "node2:9000", State: "online", Version: "2021-09-01T00-00-00Z", Uptime: 50, Disks: []madmin.Disk{{DrivePath: "/data1", State: "ok"}}},
+	}}
+}
+
+func TestServicePreflightCheck(t *testing.T) {
+	assert := assert.New(t)
+	adminClient := adminClientMock{}
+	ctx := context.Background()
+	// Test-1 : every server and drive is healthy
+	minioServerInfoMock = func(ctx context.Context) (madmin.InfoMessage, error) {
+		return healthyServersInfo(), nil
+	}
+	assert.NoError(servicePreflightCheck(ctx, adminClient))
+	// Test-2 : offline servers, offline and healing drives
+	minioServerInfoMock = func(ctx context.Context) (madmin.InfoMessage, error) {
+		info := healthyServersInfo()
+		info.Servers[0].Disks = append(info.Servers[0].Disks, madmin.Disk{DrivePath: "/data2", State: "offline"}, madmin.Disk{DrivePath: "/data3", State: "ok", Healing: true})
+		info.Servers[1].State = "offline"
+		return info, nil
+	}
+	err := servicePreflightCheck(ctx, adminClient)
+	if assert.True(errors.Is(err, errServicePreflightFailed)) {
+		assert.Contains(err.Error(), "drive /data2 on node1:9000 is offline, drive /data3 on node1:9000 is healing, server node2:9000 is offline")
+	}
+}
+
+func TestServerUpdate(t *testing.T) {
+	assert := assert.New(t)
+	adminClient := adminClientMock{}
+	ctx := context.Background()
+	minioServerInfoMock = func(ctx context.Context) (madmin.InfoMessage, error) {
+		info := healthyServersInfo()
+		info.Servers[1].State = "offline"
+		return info, nil
+	}
+	var updateURL string
+	minioServerUpdateMock = func(ctx context.Context, u string) (madmin.ServerUpdateStatus, error) {
+		updateURL = u
+		return madmin.ServerUpdateStatus{CurrentVersion: "2021-08-01T00-00-00Z", UpdatedVersion: "2021-09-01T00-00-00Z"}, nil
+	}
+	// Test-1 : the pre-flight check stops the update unless forced
+	_, err := serverUpdate(ctx, adminClient, &models.ServerUpdateRequest{})
+	assert.True(errors.Is(err, errServicePreflightFailed))
+	resp, err := serverUpdate(ctx, adminClient, &models.ServerUpdateRequest{URL: "https://mirror/minio/release/linux-amd64/", Force: true})
+	if assert.NoError(err) {
+		assert.Equal("https://mirror/minio/release/linux-amd64/", updateURL)
+		assert.Equal("2021-09-01T00-00-00Z", resp.UpdatedVersion)
+	}
+	// Test-2 : only http and https URLs
+	_, err = serverUpdate(ctx, adminClient, &models.ServerUpdateRequest{URL: "file:///tmp/minio", Force: true})
+	assert.Equal(errInvalidUpdateURL, err)
+}
+
+func TestServiceStatus(t *testing.T) {
+	assert := assert.New(t)
+	adminClient := adminClientMock{}
+	ctx := context.Background()
+	now := time.Unix(1000, 0)
+	minioServerInfoMock = func(ctx context.Context) (madmin.InfoMessage, error) {
+		return healthyServersInfo(), nil
+	}
+	// Test-1 : every server is online with the expected version
+	status := serviceStatus(ctx, adminClient, "2021-09-01T00-00-00Z", nil, now)
+	assert.True(status.Ready)
+	assert.True(status.Healthy)
+	assert.Equal(int64(2), status.OnlineServers)
+	assert.Equal(int64(100), status.Servers[0].Uptime)
+	// Test-2 : a server started before the restart isn't ready
+	restartedAfter := int64(920)
+	assert.False(serviceStatus(ctx, adminClient, "", &restartedAfter, now).Ready)
+	restartedAfter = int64(900)
+	assert.True(serviceStatus(ctx, adminClient, "", &restartedAfter, now).Ready)
+	// Test-3 : servers running another version
+	assert.False(serviceStatus(ctx, adminClient, "2021-10-01T00-00-00Z", nil, now).Ready)
+	// Test-4 : the cluster is still down
+	minioServerInfoMock = func(ctx context.Context) (madmin.InfoMessage, error) {
+		return madmin.InfoMessage{}, errors.New("connection refused")
+	}
+	status = serviceStatus(ctx, adminClient, "", nil, now)
+	assert.False(status.Ready)
+	assert.Equal([]string{"unable to reach the cluster: connection refused"}, status.Issues)
+}
@@ -0,0 +1,314 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/admin_api"
+)
+
+func registerSiteReplicationHandlers(api *operations.ConsoleAPI) {
+	// get site replication info
+	api.AdminAPISiteReplicationInfoHandler = admin_api.SiteReplicationInfoHandlerFunc(func(params admin_api.SiteReplicationInfoParams, session *models.Principal) middleware.Responder {
+		info, err := getSiteReplicationInfoResponse(session, params)
+		if err != nil {
+			return admin_api.NewSiteReplicationInfoDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewSiteReplicationInfoOK().WithPayload(info)
+	})
+	// add sites to site replication
+	api.AdminAPISiteReplicationAddHandler = admin_api.SiteReplicationAddHandlerFunc(func(params admin_api.SiteReplicationAddParams, session *models.Principal) middleware.Responder {
+		status, err := getSiteReplicationAddResponse(session, params)
+		if err != nil {
+			return admin_api.NewSiteReplicationAddDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewSiteReplicationAddOK().WithPayload(status)
+	})
+	// edit a site
+	api.AdminAPISiteReplicationEditHandler = admin_api.SiteReplicationEditHandlerFunc(func(params admin_api.SiteReplicationEditParams, session *models.Principal) middleware.Responder {
+		status, err := getSiteReplicationEditResponse(session, params)
+		if err != nil {
+			return admin_api.NewSiteReplicationEditDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewSiteReplicationEditOK().WithPayload(status)
+	})
+	// remove sites from site replication
+	api.AdminAPISiteReplicationRemoveHandler = admin_api.SiteReplicationRemoveHandlerFunc(func(params admin_api.SiteReplicationRemoveParams, session *models.Principal) middleware.Responder {
+		status, err := getSiteReplicationRemoveResponse(session, params)
+		if err != nil {
+			return admin_api.NewSiteReplicationRemoveDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewSiteReplicationRemoveOK().WithPayload(status)
+	})
+	// get the sync status of the sites
+	api.AdminAPISiteReplicationStatusHandler = admin_api.SiteReplicationStatusHandlerFunc(func(params admin_api.SiteReplicationStatusParams, session *models.Principal) middleware.Responder {
+		status, err := getSiteReplicationStatusResponse(session, params)
+		if err != nil {
+			return admin_api.NewSiteReplicationStatusDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewSiteReplicationStatusOK().WithPayload(status)
+	})
+}
+
+// getSiteReplicationInfo returns the sites of the site replication
+func getSiteReplicationInfo(ctx context.Context, client MinioAdmin) (*models.SiteReplicationInfo, error) {
+	info, err := client.siteReplicationInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sites := []*models.PeerInfo{}
+	for _, site := range info.Sites {
+		sites = append(sites, &models.PeerInfo{
+			Name:         site.Name,
+			Endpoint:     site.Endpoint,
+			DeploymentID: site.DeploymentID,
+		})
+	}
+	return &models.SiteReplicationInfo{
+		Enabled:                 info.Enabled,
+		Name:                    info.Name,
+		ServiceAccountAccessKey: info.ServiceAccountAccessKey,
+		Sites:                   sites,
+	}, nil
+}
+
+func getSiteReplicationInfoResponse(session *models.Principal, params admin_api.SiteReplicationInfoParams) (*models.SiteReplicationInfo, *models.Error) {
+	adminClient, err := newSignedAdminClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	info, err := getSiteReplicationInfo(params.HTTPRequest.Context(), adminClient)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return info, nil
+}
+
+// addSiteReplicationSites links the deployments, the list must include this
+// deployment as well as the ones being added
+func addSiteReplicationSites(ctx context.Context, client MinioAdmin, sites []*models.PeerSite) (*models.SiteReplicationAddResponse, error) {
+	if len(sites) < 2 {
+		return nil, errSiteReplicationTooFewSites
+	}
+	var peers []PeerSite
+	for _, site := range sites {
+		peers = append(peers, PeerSite{
+			Name:      site.Name,
+			Endpoint:  *site.Endpoint,
+			AccessKey: *site.AccessKey,
+			SecretKey: *site.SecretKey,
+		})
+	}
+	status, err := client.siteReplicationAdd(ctx, peers)
+	if err != nil {
+		return nil, err
+	}
+	return &models.SiteReplicationAddResponse{
+		Success:                 status.Success,
+		Status:                  status.Status,
+		ErrorDetail:             status.ErrDetail,
+		InitialSyncErrorMessage: status.InitialSyncErrorMessage,
+	}, nil
+}
+
+func getSiteReplicationAddResponse(session *models.Principal, params admin_api.SiteReplicationAddParams) (*models.SiteReplicationAddResponse, *models.Error) {
+	if params.Body == nil {
+		return nil, prepareError(errSiteReplicationTooFewSites)
+	}
+	adminClient, err := newSignedAdminClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	status, err := addSiteReplicationSites(params.HTTPRequest.Context(), adminClient, params.Body.Sites)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return status, nil
+}
+
+// editSiteReplicationSite changes the endpoint of a site, the site is
+// identified by its deployment id
+func editSiteReplicationSite(ctx context.Context, client MinioAdmin, site *models.PeerInfo) (*models.SiteReplicationEditResponse, error) {
+	if site.DeploymentID == "" || site.Endpoint == "" {
+		return nil, errSiteReplicationNoDeployment
+	}
+	status, err := client.siteReplicationEdit(ctx, PeerInfo{
+		Name:         site.Name,
+		Endpoint:     site.Endpoint,
+		DeploymentID: site.DeploymentID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &models.SiteReplicationEditResponse{
+		Success:     status.Success,
+		Status:      status.Status,
+		ErrorDetail: status.ErrDetail,
+	}, nil
+}
+
+func getSiteReplicationEditResponse(session *models.Principal, params admin_api.SiteReplicationEditParams) (*models.SiteReplicationEditResponse, *models.Error) {
+	if params.Body == nil {
+		return nil, prepareError(errSiteReplicationNoDeployment)
+	}
+	adminClient, err := newSignedAdminClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	status, err := editSiteReplicationSite(params.HTTPRequest.Context(), adminClient, params.Body)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return status, nil
+}
+
+// removeSiteReplicationSites unlinks the named sites or every site
+func removeSiteReplicationSites(ctx context.Context, client MinioAdmin, req *models.SiteReplicationRemoveRequest) (*models.SiteReplicationRemoveResponse, error) {
+	if !req.All && len(req.Sites) == 0 {
+		return nil, errSiteReplicationNoSites
+	}
+	status, err := client.siteReplicationRemove(ctx, SRRemoveReq{SiteNames: req.Sites, RemoveAll: req.All})
+	if err != nil {
+		return nil, err
+	}
+	return &models.SiteReplicationRemoveResponse{
+		Status:      status.Status,
+		ErrorDetail: status.ErrDetail,
+	}, nil
+}
+
+func getSiteReplicationRemoveResponse(session *models.Principal, params admin_api.SiteReplicationRemoveParams) (*models.SiteReplicationRemoveResponse, *models.Error) {
+	if params.Body == nil {
+		return nil, prepareError(errSiteReplicationNoSites)
+	}
+	adminClient, err := newSignedAdminClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	status, err := removeSiteReplicationSites(params.HTTPRequest.Context(), adminClient, params.Body)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return status, nil
+}
+
+// siteReplicationEntities lists the state of every entity on each site, an
+// entity is in sync when every site has it without mismatches
+func siteReplicationEntities(stats map[string]map[string]SREntityStats, presentKey string) []*models.SiteReplicationEntityStatus {
+	var names []string
+	for name := range stats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	entities := []*models.SiteReplicationEntityStatus{}
+	for _, name := range names {
+		var deployments []string
+		for deploymentID := range stats[name] {
+			deployments = append(deployments, deploymentID)
+		}
+		sort.Strings(deployments)
+		entity := &models.SiteReplicationEntityStatus{Name: name, InSync: true}
+		for _, deploymentID := range deployments {
+			site := &models.SiteReplicationEntitySiteStatus{DeploymentID: deploymentID, Mismatches: []string{}}
+			for key, value := range stats[name][deploymentID] {
+				set, ok := value.(bool)
+				if !ok || !set {
+					continue
+				}
+				switch {
+				case strings.EqualFold(key, presentKey):
+					site.Present = true
+				case strings.HasSuffix(strings.ToLower(key), "mismatch"):
+					mismatch := key[:len(key)-len("mismatch")]
+					site.Mismatches = append(site.Mismatches, strings.ToLower(mismatch[:1])+mismatch[1:])
+				}
+			}
+			sort.Strings(site.Mismatches)
+			if !site.Present || len(site.Mismatches) > 0 {
+				entity.InSync = false
+			}
+			entity.Sites = append(entity.Sites, site)
+		}
+		entities = append(entities, entity)
+	}
+	return entities
+}
+
+// getSiteReplicationStatus returns the replicated counts of every site and the
+// sync state of the buckets, policies, users and groups
+func getSiteReplicationStatus(ctx context.Context, client MinioAdmin, opts SRStatusOptions) (*models.SiteReplicationStatusResponse, error) {
+	status, err := client.siteReplicationStatus(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	var deployments []string
+	for deploymentID := range status.Sites {
+		deployments = append(deployments, deploymentID)
+	}
+	sort.Slice(deployments, func(i, j int) bool {
+		return status.Sites[deployments[i]].Name < status.Sites[deployments[j]].Name
+	})
+	sites := []*models.SiteReplicationSiteStatus{}
+	for _, deploymentID := range deployments {
+		peer := status.Sites[deploymentID]
+		summary := status.StatsSummary[deploymentID]
+		sites = append(sites, &models.SiteReplicationSiteStatus{
+			Name:               peer.Name,
+			Endpoint:           peer.Endpoint,
+			DeploymentID:       deploymentID,
+			ReplicatedBuckets:  int64(summary.ReplicatedBuckets),
+			TotalBuckets:       int64(summary.TotalBucketsCount),
+			ReplicatedPolicies: int64(summary.ReplicatedIAMPolicies),
+			TotalPolicies:      int64(summary.TotalIAMPoliciesCount),
+			ReplicatedUsers:    int64(summary.ReplicatedUsers),
+			TotalUsers:         int64(summary.TotalUsersCount),
+			ReplicatedGroups:   int64(summary.ReplicatedGroups),
+			TotalGroups:        int64(summary.TotalGroupsCount),
+		})
+	}
+	return &models.SiteReplicationStatusResponse{
+		Enabled:  status.Enabled,
+		Sites:    sites,
+		Buckets:  siteReplicationEntities(status.BucketStats, "HasBucket"),
+		Policies: siteReplicationEntities(status.PolicyStats, "HasPolicy"),
+		Users:    siteReplicationEntities(status.UserStats, "HasUser"),
+		Groups:   siteReplicationEntities(status.GroupStats, "HasGroup"),
+	}, nil
+}
+
+func getSiteReplicationStatusResponse(session *models.Principal, params admin_api.SiteReplicationStatusParams) (*models.SiteReplicationStatusResponse, *models.Error) {
+	adminClient, err := newSignedAdminClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	status, err := getSiteReplicationStatus(params.HTTPRequest.Context(), adminClient, SRStatusOptions{
+		Buckets:  *params.Buckets,
+		Policies: *params.Policies,
+		Users:    *params.Users,
+		Groups:   *params.Groups,
+	})
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return status, nil
+}
@@ -0,0 +1,235 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/stretchr/testify/assert"
+)
+
+var minioSiteReplicationAddMock func(ctx context.Context, sites []PeerSite) (*ReplicateAddStatus, error)
+var minioSiteReplicationInfoMock func(ctx context.Context) (*SiteReplicationInfo, error)
+var minioSiteReplicationStatusMock func(ctx context.Context, opts SRStatusOptions) (*SRStatusInfo, error)
+var minioSiteReplicationEditMock func(ctx context.Context, site PeerInfo) (*ReplicateEditStatus, error)
+var minioSiteReplicationRemoveMock func(ctx context.Context, req SRRemoveReq) (*ReplicateRemoveStatus, error)
+
+// mock function of siteReplicationAdd()
+func (ac adminClientMock) siteReplicationAdd(ctx context.Context, sites []PeerSite) (*ReplicateAddStatus, error) {
+	return minioSiteReplicationAddMock(ctx, sites)
+}
+
+// mock function of siteReplicationInfo()
+func (ac adminClientMock) siteReplicationInfo(ctx context.Context) (*SiteReplicationInfo, error) {
+	return minioSiteReplicationInfoMock(ctx)
+}
+
+// mock function of siteReplicationStatus()
+func (ac adminClientMock) siteReplicationStatus(ctx context.Context, opts SRStatusOptions) (*SRStatusInfo, error) {
+	return minioSiteReplicationStatusMock(ctx, opts)
+}
+
+// mock function of siteReplicationEdit()
+func (ac adminClientMock) siteReplicationEdit(ctx context.Context, site PeerInfo) (*ReplicateEditStatus, error) {
+	return minioSiteReplicationEditMock(ctx, site)
+}
+
+// mock function of siteReplicationRemove()
+func (ac adminClientMock) siteReplicationRemove(ctx context.Context, req SRRemoveReq) (*ReplicateRemoveStatus, error) {
+	return minioSiteReplicationRemoveMock(ctx, req)
+}
+
+func TestAddSiteReplicationSites(t *testing.T) {
+	assert := assert.New(t)
+	adminClient := adminClientMock{}
+	endpoint1, endpoint2, accessKey, secretKey := "https://site1:9000", "https://site2:9000", "minio", "minio123"
+	var added []PeerSite
+	minioSiteReplicationAddMock = func(ctx context.Context, sites []PeerSite) (*ReplicateAddStatus, error) {
+		added = sites
+		return &ReplicateAddStatus{Success: true, Status: "Requested sites were configured for replication successfully."}, nil
+	}
+	// Test-1 : sites are added
+	status, err := addSiteReplicationSites(context.Background(), adminClient, []*models.PeerSite{
+		{Name: "site1", Endpoint: &endpoint1, AccessKey: &accessKey, SecretKey: &secretKey},
+		{Name: "site2", Endpoint: &endpoint2, AccessKey: &accessKey, SecretKey: &secretKey},
+	})
+	if assert.NoError(err) {
+		assert.True(status.Success)
+		assert.Equal(2, len(added))
+		assert.Equal("https://site2:9000", added[1].Endpoint)
+	}
+	// Test-2 : at least two sites are required
+	_, err = addSiteReplicationSites(context.Background(), adminClient, []*models.PeerSite{
+		{Name: "site2", Endpoint: &endpoint2, AccessKey: &accessKey, SecretKey: &secretKey},
+	})
+	assert.Equal(errSiteReplicationTooFewSites, err)
+}
+
+func TestEditAndRemoveSiteReplicationSites(t *testing.T) {
+	assert := assert.New(t)
+	adminClient := adminClientMock{}
+	var edited PeerInfo
+	minioSiteReplicationEditMock = func(ctx context.Context, site PeerInfo) (*ReplicateEditStatus, error) {
+		edited = site
+		return &ReplicateEditStatus{Success: true}, nil
+	}
+	var removed SRRemoveReq
+	minioSiteReplicationRemoveMock = func(ctx context.Context, req SRRemoveReq) (*ReplicateRemoveStatus, error) {
+		removed = req
+		return &ReplicateRemoveStatus{Status: "success"}, nil
+	}
+	// Test-1 : edit the endpoint of a site
+	status, err := editSiteReplicationSite(context.Background(), adminClient, &models.PeerInfo{DeploymentID: "dep2", Endpoint: "https://site2.example.com"})
+	if assert.NoError(err) {
+		assert.True(status.Success)
+		assert.Equal("dep2", edited.DeploymentID)
+	}
+	_, err = editSiteReplicationSite(context.Background(), adminClient, &models.PeerInfo{Endpoint: "https://site2.example.com"})
+	assert.Equal(errSiteReplicationNoDeployment, err)
+	// Test-2 : remove sites
+	removeStatus, err := removeSiteReplicationSites(context.Background(), adminClient, &models.SiteReplicationRemoveRequest{Sites: []string{"site2"}})
+	if assert.NoError(err) {
+		assert.Equal("success", removeStatus.Status)
+		assert.Equal([]string{"site2"}, removed.SiteNames)
+	}
+	_, err = removeSiteReplicationSites(context.Background(), adminClient, &models.SiteReplicationRemoveRequest{})
+	assert.Equal(errSiteReplicationNoSites, err)
+}
+
+func TestGetSiteReplicationStatus(t *testing.T) {
+	assert := assert.New(t)
+	adminClient := adminClientMock{}
+	var options SRStatusOptions
+	minioSiteReplicationStatusMock = func(ctx context.Context, opts SRStatusOptions) (*SRStatusInfo, error) {
+		options = opts
+		return &SRStatusInfo{
+			Enabled: true,
+			Sites: map[string]PeerInfo{
+				"dep2": {Name: "site2", Endpoint: "https://site2:9000", DeploymentID: "dep2"},
+				"dep1": {Name: "site1", Endpoint: "https://site1:9000", DeploymentID: "dep1"},
+			},
+			StatsSummary: map[string]SRSiteSummary{
+				"dep1": {ReplicatedBuckets: 2, TotalBucketsCount: 2, ReplicatedUsers: 1, TotalUsersCount: 1},
+				"dep2": {ReplicatedBuckets: 1, TotalBucketsCount: 2},
+			},
+			BucketStats: map[string]map[string]SREntityStats{
+				"photos": {
+					"dep1": {"DeploymentID": "dep1", "HasBucket": true},
+					"dep2": {"DeploymentID": "dep2", "HasBucket": true},
+				},
+				"logs": {
+					"dep1": {"DeploymentID": "dep1", "HasBucket": true, "TagMismatch": false},
+					"dep2": {"DeploymentID": "dep2", "HasBucket": true, "TagMismatch": true, "OLockConfigMismatch": true},
+				},
+			},
+			UserStats: map[string]map[string]SREntityStats{
+				"alice": {
+					"dep1": {"HasUser": true},
+					"dep2": {"HasUser": false},
+				},
+			},
+		}, nil
+	}
+	status, err := getSiteReplicationStatus(context.Background(), adminClient, SRStatusOptions{Buckets: true, Users: true})
+	if assert.NoError(err) {
+		assert.True(options.Buckets)
+		assert.False(options.Groups)
+		// Test-1 : sites are sorted by name with their counters
+		if assert.Equal(2, len(status.Sites)) {
+			assert.Equal("site1", status.Sites[0].Name)
+			assert.Equal(int64(1), status.Sites[1].ReplicatedBuckets)
+			assert.Equal(int64(2), status.Sites[1].TotalBuckets)
+		}
+		// Test-2 : mismatches make the entity out of sync
+		if assert.Equal(2, len(status.Buckets)) {
+			assert.Equal("logs", status.Buckets[0].Name)
+			assert.False(status.Buckets[0].InSync)
+			assert.Equal([]string{"oLockConfig", "tag"}, status.Buckets[0].Sites[1].Mismatches)
+			assert.Empty(status.Buckets[0].Sites[0].Mismatches)
+			assert.True(status.Buckets[1].InSync)
+		}
+		// Test-3 : entities missing on a site are out of sync
+		if assert.Equal(1, len(status.Users)) {
+			assert.False(status.Users[0].InSync)
+			assert.False(status.Users[0].Sites[1].Present)
+		}
+		assert.Empty(status.Groups)
+	}
+}
+
+func TestExecuteAdminRequest(t *testing.T) {
+	assert := assert.New(t)
+	var method, path, query, authorization string
+	var payload []PeerSite
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path, query, authorization = r.Method, r.URL.Path, r.URL.RawQuery, r.Header.Get("Authorization")
+		switch r.URL.Path {
+		case siteReplicationAPIPrefix + "/add":
+			data, err := madmin.DecryptData("secret", r.Body)
+			if err == nil {
+				err = json.Unmarshal(data, &payload)
+			}
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.Write([]byte(`{"success":true,"status":"configured"}`))
+		case siteReplicationAPIPrefix + "/status":
+			w.Write([]byte(`{"Enabled":true,"Sites":{"dep1":{"name":"site1","endpoint":"https://site1:9000","deploymentID":"dep1"}},"StatsSummary":{"dep1":{"replicatedBuckets":3}}}`))
+		default:
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"Code":"AccessDenied","Message":"Access Denied."}`))
+		}
+	}))
+	defer server.Close()
+	os.Setenv(ConsoleMinIOServer, server.URL)
+	defer os.Unsetenv(ConsoleMinIOServer)
+	adminClient := AdminClient{Creds: credentials.NewStaticV4("access", "secret", "")}
+	// Test-1 : payloads are encrypted with the secret key and the requests signed
+	status, err := adminClient.siteReplicationAdd(context.Background(), []PeerSite{{Name: "site2", Endpoint: "https://site2:9000", AccessKey: "minio", SecretKey: "minio123"}})
+	if assert.NoError(err) {
+		assert.True(status.Success)
+		assert.Equal(http.MethodPut, method)
+		assert.True(strings.HasPrefix(authorization, "AWS4-HMAC-SHA256 Credential=access/"))
+		if assert.Equal(1, len(payload)) {
+			assert.Equal("https://site2:9000", payload[0].Endpoint)
+		}
+	}
+	// Test-2 : status options and responses
+	srStatus, err := adminClient.siteReplicationStatus(context.Background(), SRStatusOptions{Buckets: true})
+	if assert.NoError(err) {
+		assert.Equal(siteReplicationAPIPrefix+"/status", path)
+		assert.Equal("buckets=true&groups=false&policies=false&users=false", query)
+		assert.Equal(3, srStatus.StatsSummary["dep1"].ReplicatedBuckets)
+		assert.Equal("site1", srStatus.Sites["dep1"].Name)
+	}
+	// Test-3 : admin API errors
+	_, err = adminClient.siteReplicationInfo(context.Background())
+	assert.Equal("AccessDenied", madmin.ToErrorResponse(err).Code)
+	// Test-4 : credentials are required
+	_, err = AdminClient{}.siteReplicationInfo(context.Background())
+	assert.Error(err)
+}
@@ -53,6 +53,7 @@ func registerUsersHandlers(api *operations.ConsoleAPI) {
 		if err != nil {
 			return admin_api.NewAddUserDefault(int(err.Code)).WithPayload(err)
 		}
+		publishConsoleEvent(session.AccountAccessKey, consoleEventUserAdded, userAddedEvent{AccessKey: userResponse.AccessKey, Groups: params.Body.Groups})
 		return admin_api.NewAddUserCreated().WithPayload(userResponse)
 	})
 	// Remove User
@@ -0,0 +1,838 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/admin_api"
+	"github.com/minio/pkg/env"
+	iampolicy "github.com/minio/pkg/iam/policy"
+	"github.com/rs/xid"
+)
+
+const webhooksFile = "webhooks.json"
+
+const webhookDeliveriesFile = "webhook-deliveries.json"
+
+// webhookDeliveriesLimit is the number of deliveries kept per webhook, the oldest ones are dropped first
+const webhookDeliveriesLimit = 100
+
+// webhookRequestTimeout bounds each attempt to deliver an event
+const webhookRequestTimeout = 10 * time.Second
+
+// webhookSignatureHeader carries the hex encoded HMAC-SHA256 of the body
+// keyed with the secret of the webhook, prefixed with sha256=
+const webhookSignatureHeader = "X-Console-Signature"
+
+const (
+	webhookEventHeader    = "X-Console-Event"
+	webhookDeliveryHeader = "X-Console-Delivery"
+)
+
+const (
+	consoleEventBucketCreated = "bucket.created"
+	consoleEventPolicyChanged = "policy.changed"
+	consoleEventUserAdded     = "user.added"
+	consoleEventHealCorrupted = "heal.corrupted"
+	// consoleEventWebhookTest is only sent to the webhook being tested
+	consoleEventWebhookTest = "webhook.test"
+)
+
+// consoleEventTypes are the events the webhooks can subscribe to
+var consoleEventTypes = []string{
+	consoleEventBucketCreated,
+	consoleEventPolicyChanged,
+	consoleEventUserAdded,
+	consoleEventHealCorrupted,
+}
+
+const (
+	webhookDeliveryPending   = "pending"
+	webhookDeliverySucceeded = "succeeded"
+	webhookDeliveryFailed    = "failed"
+)
+
+// webhookRetryBackoff is the wait before the first retry of a delivery, it
+// doubles on each retry
+var webhookRetryBackoff = 5 * time.Second
+
+func registerWebhooksHandlers(api *operations.ConsoleAPI) {
+	// list the webhooks
+	api.AdminAPIListWebhooksHandler = admin_api.ListWebhooksHandlerFunc(func(params admin_api.ListWebhooksParams, session *models.Principal) middleware.Responder {
+		webhooks, err := getListWebhooksResponse(session)
+		if err != nil {
+			return admin_api.NewListWebhooksDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewListWebhooksOK().WithPayload(webhooks)
+	})
+	// create a webhook
+	api.AdminAPICreateWebhookHandler = admin_api.CreateWebhookHandlerFunc(func(params admin_api.CreateWebhookParams, session *models.Principal) middleware.Responder {
+		webhook, err := getCreateWebhookResponse(session, params)
+		if err != nil {
+			return admin_api.NewCreateWebhookDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewCreateWebhookCreated().WithPayload(webhook)
+	})
+	// get a webhook
+	api.AdminAPIGetWebhookHandler = admin_api.GetWebhookHandlerFunc(func(params admin_api.GetWebhookParams, session *models.Principal) middleware.Responder {
+		webhook, err := getWebhookResponse(session, params.ID)
+		if err != nil {
+			return admin_api.NewGetWebhookDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewGetWebhookOK().WithPayload(webhook)
+	})
+	// update a webhook
+	api.AdminAPIUpdateWebhookHandler = admin_api.UpdateWebhookHandlerFunc(func(params admin_api.UpdateWebhookParams, session *models.Principal) middleware.Responder {
+		webhook, err := getUpdateWebhookResponse(session, params)
+		if err != nil {
+			return admin_api.NewUpdateWebhookDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewUpdateWebhookOK().WithPayload(webhook)
+	})
+	// delete a webhook
+	api.AdminAPIDeleteWebhookHandler = admin_api.DeleteWebhookHandlerFunc(func(params admin_api.DeleteWebhookParams, session *models.Principal) middleware.Responder {
+		if err := getDeleteWebhookResponse(session, params.ID); err != nil {
+			return admin_api.NewDeleteWebhookDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewDeleteWebhookNoContent()
+	})
+	// send a test event to a webhook
+	api.AdminAPITestWebhookHandler = admin_api.TestWebhookHandlerFunc(func(params admin_api.TestWebhookParams, session *models.Principal) middleware.Responder {
+		delivery, err := getTestWebhookResponse(session, params.ID)
+		if err != nil {
+			return admin_api.NewTestWebhookDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewTestWebhookAccepted().WithPayload(delivery)
+	})
+	// list the deliveries of a webhook
+	api.AdminAPIListWebhookDeliveriesHandler = admin_api.ListWebhookDeliveriesHandlerFunc(func(params admin_api.ListWebhookDeliveriesParams, session *models.Principal) middleware.Responder {
+		deliveries, err := getListWebhookDeliveriesResponse(session, params)
+		if err != nil {
+			return admin_api.NewListWebhookDeliveriesDefault(int(err.Code)).WithPayload(err)
+		}
+		return admin_api.NewListWebhookDeliveriesOK().WithPayload(deliveries)
+	})
+}
+
+// Webhook is an HTTP endpoint the Console events are posted to, Events
+// filters the events sent, all of them when empty
+type Webhook struct {
+	ID        string
+	Name      string
+	URL       string
+	Secret    string
+	Events    []string
+	Disabled  bool
+	Created   time.Time
+	CreatedBy string
+}
+
+// WebhookDelivery is the outcome of posting an event to a webhook
+type WebhookDelivery struct {
+	ID          string
+	WebhookID   string
+	EventID     string
+	Event       string
+	Status      string
+	Attempts    int32
+	StatusCode  int32
+	Error       string
+	Created     time.Time
+	LastAttempt time.Time
+}
+
+// ConsoleEvent is the body posted to the webhooks, Actor is the user that
+// made the change, empty for the changes made by Console itself
+type ConsoleEvent struct {
+	ID    string      `json:"id"`
+	Type  string      `json:"type"`
+	Time  time.Time   `json:"time"`
+	Actor string      `json:"actor,omitempty"`
+	Data  interface{} `json:"data"`
+}
+
+type bucketCreatedEvent struct {
+	Bucket     string `json:"bucket"`
+	Locking    bool   `json:"locking"`
+	Versioning bool   `json:"versioning"`
+}
+
+const (
+	policyChangedCreated      = "created"
+	policyChangedRemoved      = "removed"
+	policyChangedAttached     = "attached"
+	policyChangedBucketAccess = "bucket-access"
+)
+
+// policyChangedEvent describes a policy created, removed or attached to users
+// and groups, or the access policy of a bucket set
+type policyChangedEvent struct {
+	Action string   `json:"action"`
+	Policy string   `json:"policy,omitempty"`
+	Bucket string   `json:"bucket,omitempty"`
+	Access string   `json:"access,omitempty"`
+	Users  []string `json:"users,omitempty"`
+	Groups []string `json:"groups,omitempty"`
+}
+
+type userAddedEvent struct {
+	AccessKey string   `json:"accessKey"`
+	Groups    []string `json:"groups,omitempty"`
+}
+
+// newPolicyAttachedEvent returns the event of the policy attached to a user or a group
+func newPolicyAttachedEvent(policy string, entityType models.PolicyEntity, entityName string) policyChangedEvent {
+	event := policyChangedEvent{Action: policyChangedAttached, Policy: policy}
+	if entityType == models.PolicyEntityGroup {
+		event.Groups = []string{entityName}
+	} else {
+		event.Users = []string{entityName}
+	}
+	return event
+}
+
+// newPolicyAttachedMultipleEvent returns the event of the policy attached to users and groups
+func newPolicyAttachedMultipleEvent(policy string, users, groups []models.IamEntity) policyChangedEvent {
+	event := policyChangedEvent{Action: policyChangedAttached, Policy: policy}
+	for _, user := range users {
+		event.Users = append(event.Users, string(user))
+	}
+	for _, group := range groups {
+		event.Groups = append(event.Groups, string(group))
+	}
+	return event
+}
+
+// healCorruptedEvent lists up to healCorruptedEventObjectsLimit of the items
+// a heal found corrupted, Corrupted is the number of all of them
+type healCorruptedEvent struct {
+	Bucket    string   `json:"bucket,omitempty"`
+	Prefix    string   `json:"prefix,omitempty"`
+	DryRun    bool     `json:"dryRun"`
+	Corrupted int      `json:"corrupted"`
+	Objects   []string `json:"objects"`
+}
+
+const healCorruptedEventObjectsLimit = 100
+
+// webhookManager keeps the webhooks and their deliveries in files of the
+// Console data directory and posts the events to the webhooks
+type webhookManager struct {
+	sync.Mutex
+	webhooksFile   string
+	deliveriesFile string
+	loaded         bool
+	webhooks       []Webhook
+	deliveries     []WebhookDelivery
+	maxAttempts    int
+	// send posts the body of an event to the webhook, it returns the status
+	// code of the response, if any
+	send func(ctx context.Context, webhook Webhook, deliveryID, event string, body []byte) (int, error)
+}
+
+var globalWebhookManager = newWebhookManager(webhooksFile, webhookDeliveriesFile, getWebhooksMaxAttempts(), sendWebhookEvent)
+
+func newWebhookManager(webhooksFile, deliveriesFile string, maxAttempts int, send func(ctx context.Context, webhook Webhook, deliveryID, event string, body []byte) (int, error)) *webhookManager {
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	return &webhookManager{
+		webhooksFile:   webhooksFile,
+		deliveriesFile: deliveriesFile,
+		maxAttempts:    maxAttempts,
+		send:           send,
+	}
+}
+
+// getWebhooksMaxAttempts returns how many times a delivery is attempted, 5 by default
+func getWebhooksMaxAttempts() int {
+	attempts, err := strconv.Atoi(env.Get(ConsoleWebhooksMaxAttempts, "5"))
+	if err != nil || attempts < 1 {
+		return 5
+	}
+	return attempts
+}
+
+// load reads the webhooks and their deliveries the first time they are needed,
+// the deliveries pending when Console stopped are failed. Callers must hold the lock
+func (m *webhookManager) load() error {
+	if m.loaded {
+		return nil
+	}
+	var webhooks []Webhook
+	if err := readDataFile(m.webhooksFile, &webhooks); err != nil {
+		return err
+	}
+	var deliveries []WebhookDelivery
+	if err := readDataFile(m.deliveriesFile, &deliveries); err != nil {
+		return err
+	}
+	for i := range deliveries {
+		if deliveries[i].Status == webhookDeliveryPending {
+			deliveries[i].Status = webhookDeliveryFailed
+			deliveries[i].Error = "interrupted by a restart of Console"
+		}
+	}
+	m.webhooks, m.deliveries = webhooks, deliveries
+	m.loaded = true
+	return nil
+}
+
+// saveDeliveries writes the deliveries, callers must hold the lock
+func (m *webhookManager) saveDeliveries() error {
+	return writeDataFile(m.deliveriesFile, m.deliveries)
+}
+
+// find returns the index of the webhook, -1 when there is none, callers must hold the lock
+func (m *webhookManager) find(id string) int {
+	for i := range m.webhooks {
+		if m.webhooks[i].ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// validateWebhook checks the URL and the events of the webhook
+func validateWebhook(webhook Webhook) error {
+	if webhook.Name == "" {
+		return fmt.Errorf("%w: the name is required", errInvalidWebhook)
+	}
+	u, err := url.ParseRequestURI(webhook.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: the URL must be an http or https URL", errInvalidWebhook)
+	}
+	if webhook.Secret == "" {
+		return fmt.Errorf("%w: a secret is needed to sign the events", errInvalidWebhook)
+	}
+	for _, event := range webhook.Events {
+		if !hasConsoleEvent(consoleEventTypes, event) {
+			return fmt.Errorf("%w: unknown event %s", errInvalidWebhook, event)
+		}
+	}
+	return nil
+}
+
+// hasConsoleEvent returns whether event is one of events
+func hasConsoleEvent(events []string, event string) bool {
+	for _, e := range events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// subscribed returns whether the webhook is sent the events of the type
+func (w Webhook) subscribed(event string) bool {
+	return !w.Disabled && (len(w.Events) == 0 || hasConsoleEvent(w.Events, event))
+}
+
+func (m *webhookManager) list() ([]Webhook, error) {
+	m.Lock()
+	defer m.Unlock()
+	if err := m.load(); err != nil {
+		return nil, err
+	}
+	return append([]Webhook{}, m.webhooks...), nil
+}
+
+func (m *webhookManager) get(id string) (Webhook, error) {
+	m.Lock()
+	defer m.Unlock()
+	if err := m.load(); err != nil {
+		return Webhook{}, err
+	}
+	i := m.find(id)
+	if i < 0 {
+		return Webhook{}, errWebhookNotFound
+	}
+	return m.webhooks[i], nil
+}
+
+// create validates and stores a new webhook
+func (m *webhookManager) create(webhook Webhook, now time.Time) (Webhook, error) {
+	m.Lock()
+	defer m.Unlock()
+	if err := m.load(); err != nil {
+		return Webhook{}, err
+	}
+	if err := validateWebhook(webhook); err != nil {
+		return Webhook{}, err
+	}
+	webhook.ID = xid.New().String()
+	webhook.Created = now
+	webhooks := append(append([]Webhook{}, m.webhooks...), webhook)
+	if err := writeDataFile(m.webhooksFile, webhooks); err != nil {
+		return Webhook{}, err
+	}
+	m.webhooks = webhooks
+	return webhook, nil
+}
+
+// update replaces the webhook, an empty secret keeps the current one since
+// it's never returned by the API
+func (m *webhookManager) update(webhook Webhook) (Webhook, error) {
+	m.Lock()
+	defer m.Unlock()
+	if err := m.load(); err != nil {
+		return Webhook{}, err
+	}
+	i := m.find(webhook.ID)
+	if i < 0 {
+		return Webhook{}, errWebhookNotFound
+	}
+	current := m.webhooks[i]
+	if webhook.Secret == "" {
+		webhook.Secret = current.Secret
+	}
+	webhook.Created, webhook.CreatedBy = current.Created, current.CreatedBy
+	if err := validateWebhook(webhook); err != nil {
+		return Webhook{}, err
+	}
+	webhooks := append([]Webhook{}, m.webhooks...)
+	webhooks[i] = webhook
+	if err := writeDataFile(m.webhooksFile, webhooks); err != nil {
+		return Webhook{}, err
+	}
+	m.webhooks = webhooks
+	return webhook, nil
+}
+
+// remove deletes the webhook and its deliveries, the retries in progress stop
+func (m *webhookManager) remove(id string) error {
+	m.Lock()
+	defer m.Unlock()
+	if err := m.load(); err != nil {
+		return err
+	}
+	i := m.find(id)
+	if i < 0 {
+		return errWebhookNotFound
+	}
+	webhooks := append(append([]Webhook{}, m.webhooks[:i]...), m.webhooks[i+1:]...)
+	if err := writeDataFile(m.webhooksFile, webhooks); err != nil {
+		return err
+	}
+	m.webhooks = webhooks
+	var deliveries []WebhookDelivery
+	for _, delivery := range m.deliveries {
+		if delivery.WebhookID != id {
+			deliveries = append(deliveries, delivery)
+		}
+	}
+	m.deliveries = deliveries
+	return m.saveDeliveries()
+}
+
+// listDeliveries returns the latest deliveries of the webhook first, only
+// the ones with the given status when it's not empty
+func (m *webhookManager) listDeliveries(id, status string, limit int) ([]WebhookDelivery, error) {
+	m.Lock()
+	defer m.Unlock()
+	if err := m.load(); err != nil {
+		return nil, err
+	}
+	if m.find(id) < 0 {
+		return nil, errWebhookNotFound
+	}
+	deliveries := []WebhookDelivery{}
+	for i := len(m.deliveries) - 1; i >= 0 && (limit <= 0 || len(deliveries) < limit); i-- {
+		delivery := m.deliveries[i]
+		if delivery.WebhookID == id && (status == "" || delivery.Status == status) {
+			deliveries = append(deliveries, delivery)
+		}
+	}
+	return deliveries, nil
+}
+
+// trimDeliveries drops the oldest finished deliveries of the webhook beyond the limit, callers must hold the lock
+func (m *webhookManager) trimDeliveries(id string) {
+	count := 0
+	for _, delivery := range m.deliveries {
+		if delivery.WebhookID == id {
+			count++
+		}
+	}
+	var deliveries []WebhookDelivery
+	for _, delivery := range m.deliveries {
+		if delivery.WebhookID == id && count > webhookDeliveriesLimit && delivery.Status != webhookDeliveryPending {
+			count--
+			continue
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	m.deliveries = deliveries
+}
+
+// findDelivery returns the index of the delivery, -1 when there is none, callers must hold the lock
+func (m *webhookManager) findDelivery(id string) int {
+	for i := range m.deliveries {
+		if m.deliveries[i].ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// queue records a pending delivery of the event to the webhook and delivers
+// it in the background, callers must hold the lock
+func (m *webhookManager) queue(webhook Webhook, event ConsoleEvent, body []byte) WebhookDelivery {
+	delivery := WebhookDelivery{
+		ID:        xid.New().String(),
+		WebhookID: webhook.ID,
+		EventID:   event.ID,
+		Event:     event.Type,
+		Status:    webhookDeliveryPending,
+		Created:   event.Time,
+	}
+	m.deliveries = append(m.deliveries, delivery)
+	m.trimDeliveries(webhook.ID)
+	if err := m.saveDeliveries(); err != nil {
+		LogError("unable to store the delivery of event %s to webhook %s: %v", event.ID, webhook.Name, err)
+	}
+	go m.deliver(webhook.ID, delivery.ID, event.Type, body)
+	return delivery
+}
+
+// publish delivers the event to the webhooks subscribed to it, the errors
+// are logged since the change the event describes was already made
+func (m *webhookManager) publish(event ConsoleEvent) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		LogError("unable to serialize event %s: %v", event.Type, err)
+		return
+	}
+	m.Lock()
+	defer m.Unlock()
+	if err := m.load(); err != nil {
+		LogError("unable to load the webhooks: %v", err)
+		return
+	}
+	for _, webhook := range m.webhooks {
+		if webhook.subscribed(event.Type) {
+			m.queue(webhook, event, body)
+		}
+	}
+}
+
+// test delivers a test event to the webhook, even when it's disabled
+func (m *webhookManager) test(id, actor string, now time.Time) (WebhookDelivery, error) {
+	event := ConsoleEvent{
+		ID:    xid.New().String(),
+		Type:  consoleEventWebhookTest,
+		Time:  now.UTC(),
+		Actor: actor,
+		Data:  map[string]string{"webhook": id},
+	}
+	body, err := json.Marshal(event)
+	if err != nil {
+		return WebhookDelivery{}, err
+	}
+	m.Lock()
+	defer m.Unlock()
+	if err := m.load(); err != nil {
+		return WebhookDelivery{}, err
+	}
+	i := m.find(id)
+	if i < 0 {
+		return WebhookDelivery{}, errWebhookNotFound
+	}
+	return m.queue(m.webhooks[i], event, body), nil
+}
+
+// retryWebhookStatus returns whether a delivery that got the status code is retried,
+// the requests rejected by the webhook won't succeed on a retry
+func retryWebhookStatus(statusCode int) bool {
+	return statusCode == 0 || statusCode >= 500 || statusCode == http.StatusRequestTimeout || statusCode == http.StatusTooManyRequests
+}
+
+// deliver posts the body to the webhook until it succeeds, the webhook is
+// deleted or no longer subscribed to the event, or the attempts run out,
+// waiting longer after each failure. The webhook is read before each attempt
+// so the retries use its current URL and secret
+func (m *webhookManager) deliver(webhookID, deliveryID, event string, body []byte) {
+	backoff := webhookRetryBackoff
+	for attempt := 1; ; attempt++ {
+		m.Lock()
+		w := m.find(webhookID)
+		i := m.findDelivery(deliveryID)
+		if w < 0 || i < 0 {
+			m.Unlock()
+			return
+		}
+		webhook := m.webhooks[w]
+		if event != consoleEventWebhookTest && !webhook.subscribed(event) {
+			m.deliveries[i].Status = webhookDeliveryFailed
+			m.deliveries[i].Error = "the webhook is no longer subscribed to the event"
+			if err := m.saveDeliveries(); err != nil {
+				LogError("unable to store the delivery %s to webhook %s: %v", deliveryID, webhook.Name, err)
+			}
+			m.Unlock()
+			return
+		}
+		m.Unlock()
+		statusCode, err := m.send(context.Background(), webhook, deliveryID, event, body)
+		m.Lock()
+		i = m.findDelivery(deliveryID)
+		if i < 0 {
+			m.Unlock()
+			return
+		}
+		delivery := &m.deliveries[i]
+		delivery.Attempts = int32(attempt)
+		delivery.StatusCode = int32(statusCode)
+		delivery.LastAttempt = time.Now().UTC()
+		delivery.Error = ""
+		retry := false
+		switch {
+		case err == nil:
+			delivery.Status = webhookDeliverySucceeded
+		case attempt < m.maxAttempts && retryWebhookStatus(statusCode):
+			delivery.Error = err.Error()
+			retry = true
+		default:
+			delivery.Status = webhookDeliveryFailed
+			delivery.Error = err.Error()
+		}
+		if err := m.saveDeliveries(); err != nil {
+			LogError("unable to store the delivery %s to webhook %s: %v", deliveryID, webhook.Name, err)
+		}
+		m.Unlock()
+		if !retry {
+			return
+		}
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// signWebhookBody returns the HMAC-SHA256 signature of the body sent in the webhookSignatureHeader
+func signWebhookBody(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// sendWebhookEvent posts the signed body of an event to the webhook
+func sendWebhookEvent(ctx context.Context, webhook Webhook, deliveryID, event string, body []byte) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, webhookRequestTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhookEventHeader, event)
+	req.Header.Set(webhookDeliveryHeader, deliveryID)
+	req.Header.Set(webhookSignatureHeader, signWebhookBody(webhook.Secret, body))
+	client := &http.Client{Transport: GetConsoleSTSClient().Transport}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+// publishConsoleEvent sends the event to the webhooks subscribed to it, the
+// handlers call it once the change was made
+func publishConsoleEvent(actor, eventType string, data interface{}) {
+	globalWebhookManager.publish(ConsoleEvent{
+		ID:    xid.New().String(),
+		Type:  eventType,
+		Time:  time.Now().UTC(),
+		Actor: actor,
+		Data:  data,
+	})
+}
+
+// publishHealCorrupted sends a heal.corrupted event when the heal found corrupted items
+func publishHealCorrupted(actor, bucket, prefix string, dryRun bool, corrupted []string) {
+	if len(corrupted) == 0 {
+		return
+	}
+	event := healCorruptedEvent{
+		Bucket:    bucket,
+		Prefix:    prefix,
+		DryRun:    dryRun,
+		Corrupted: len(corrupted),
+		Objects:   corrupted,
+	}
+	if len(event.Objects) > healCorruptedEventObjectsLimit {
+		event.Objects = event.Objects[:healCorruptedEventObjectsLimit]
+	}
+	publishConsoleEvent(actor, consoleEventHealCorrupted, event)
+}
+
+func getWebhookModel(webhook Webhook) *models.Webhook {
+	return &models.Webhook{
+		ID:        webhook.ID,
+		Name:      webhook.Name,
+		URL:       webhook.URL,
+		HasSecret: webhook.Secret != "",
+		Events:    webhook.Events,
+		Disabled:  webhook.Disabled,
+		Created:   webhook.Created.UTC().Format(time.RFC3339),
+		CreatedBy: webhook.CreatedBy,
+	}
+}
+
+func getWebhookFromRequest(req *models.WebhookRequest) Webhook {
+	return Webhook{
+		Name:     swag.StringValue(req.Name),
+		URL:      swag.StringValue(req.URL),
+		Secret:   req.Secret,
+		Events:   req.Events,
+		Disabled: req.Disabled,
+	}
+}
+
+func getWebhookDeliveryModel(delivery WebhookDelivery) *models.WebhookDelivery {
+	model := &models.WebhookDelivery{
+		ID:         delivery.ID,
+		WebhookID:  delivery.WebhookID,
+		EventID:    delivery.EventID,
+		Event:      delivery.Event,
+		Status:     delivery.Status,
+		Attempts:   delivery.Attempts,
+		StatusCode: delivery.StatusCode,
+		Error:      delivery.Error,
+		Created:    delivery.Created.UTC().Format(time.RFC3339),
+	}
+	if !delivery.LastAttempt.IsZero() {
+		model.LastAttempt = delivery.LastAttempt.UTC().Format(time.RFC3339)
+	}
+	return model
+}
+
+// webhooksAllowed returns whether the session can manage the webhooks, they
+// are sent every Console event so the config update permission is needed
+func webhooksAllowed(session *models.Principal) bool {
+	return sessionAllowsAction(session, iampolicy.ConfigUpdateAdminAction)
+}
+
+func getListWebhooksResponse(session *models.Principal) (*models.WebhookList, *models.Error) {
+	if !webhooksAllowed(session) {
+		return nil, prepareError(errAccessDenied)
+	}
+	webhooks, err := globalWebhookManager.list()
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	list := &models.WebhookList{Webhooks: []*models.Webhook{}}
+	for _, webhook := range webhooks {
+		list.Webhooks = append(list.Webhooks, getWebhookModel(webhook))
+	}
+	return list, nil
+}
+
+func getCreateWebhookResponse(session *models.Principal, params admin_api.CreateWebhookParams) (*models.Webhook, *models.Error) {
+	if !webhooksAllowed(session) {
+		return nil, prepareError(errAccessDenied)
+	}
+	webhook := getWebhookFromRequest(params.Body)
+	webhook.CreatedBy = session.AccountAccessKey
+	webhook, err := globalWebhookManager.create(webhook, time.Now())
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return getWebhookModel(webhook), nil
+}
+
+func getWebhookResponse(session *models.Principal, id string) (*models.Webhook, *models.Error) {
+	if !webhooksAllowed(session) {
+		return nil, prepareError(errAccessDenied)
+	}
+	webhook, err := globalWebhookManager.get(id)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return getWebhookModel(webhook), nil
+}
+
+func getUpdateWebhookResponse(session *models.Principal, params admin_api.UpdateWebhookParams) (*models.Webhook, *models.Error) {
+	if !webhooksAllowed(session) {
+		return nil, prepareError(errAccessDenied)
+	}
+	webhook := getWebhookFromRequest(params.Body)
+	webhook.ID = params.ID
+	webhook, err := globalWebhookManager.update(webhook)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return getWebhookModel(webhook), nil
+}
+
+func getDeleteWebhookResponse(session *models.Principal, id string) *models.Error {
+	if !webhooksAllowed(session) {
+		return prepareError(errAccessDenied)
+	}
+	if err := globalWebhookManager.remove(id); err != nil {
+		return prepareError(err)
+	}
+	return nil
+}
+
+func getTestWebhookResponse(session *models.Principal, id string) (*models.WebhookDelivery, *models.Error) {
+	if !webhooksAllowed(session) {
+		return nil, prepareError(errAccessDenied)
+	}
+	delivery, err := globalWebhookManager.test(id, session.AccountAccessKey, time.Now())
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return getWebhookDeliveryModel(delivery), nil
+}
+
+func getListWebhookDeliveriesResponse(session *models.Principal, params admin_api.ListWebhookDeliveriesParams) (*models.WebhookDeliveryList, *models.Error) {
+	if !webhooksAllowed(session) {
+		return nil, prepareError(errAccessDenied)
+	}
+	limit := 50
+	if params.Limit != nil {
+		limit = int(*params.Limit)
+	}
+	deliveries, err := globalWebhookManager.listDeliveries(params.ID, swag.StringValue(params.Status), limit)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	list := &models.WebhookDeliveryList{Deliveries: []*models.WebhookDelivery{}}
+	for _, delivery := range deliveries {
+		list.Deliveries = append(list.Deliveries, getWebhookDeliveryModel(delivery))
+	}
+	return list, nil
+}
@@ -0,0 +1,243 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/minio/console/models"
+	"github.com/stretchr/testify/assert"
+)
+
+// waitWebhookDelivery waits until the latest delivery of the webhook finishes
+func waitWebhookDelivery(t *testing.T, m *webhookManager, id string) WebhookDelivery {
+	var delivery WebhookDelivery
+	assert.Eventually(t, func() bool {
+		deliveries, err := m.listDeliveries(id, "", 1)
+		if err != nil || len(deliveries) == 0 {
+			return false
+		}
+		delivery = deliveries[0]
+		return delivery.Status != webhookDeliveryPending
+	}, 5*time.Second, 10*time.Millisecond)
+	return delivery
+}
+
+func TestWebhookManager(t *testing.T) {
+	assert := assert.New(t)
+	defer useTempDataDir(t)()
+	webhookRetryBackoff = time.Millisecond
+	var mutex sync.Mutex
+	var sent []ConsoleEvent
+	var urls []string
+	// statusCodes are the responses of the next attempts, 200 when empty
+	var statusCodes []int
+	// onSend is called with the webhook of the next attempt
+	var onSend func(webhook Webhook)
+	send := func(ctx context.Context, webhook Webhook, deliveryID, event string, body []byte) (int, error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		var consoleEvent ConsoleEvent
+		if err := json.Unmarshal(body, &consoleEvent); err != nil {
+			return 0, err
+		}
+		sent = append(sent, consoleEvent)
+		urls = append(urls, webhook.URL)
+		if onSend != nil {
+			onSend(webhook)
+			onSend = nil
+		}
+		statusCode := http.StatusOK
+		if len(statusCodes) > 0 {
+			statusCode, statusCodes = statusCodes[0], statusCodes[1:]
+		}
+		if statusCode != http.StatusOK {
+			return statusCode, errors.New(http.StatusText(statusCode))
+		}
+		return statusCode, nil
+	}
+	m := newWebhookManager(webhooksFile, webhookDeliveriesFile, 3, send)
+	now := time.Date(2021, 6, 2, 10, 7, 0, 0, time.UTC)
+	// Test-1 : invalid webhooks
+	for _, webhook := range []Webhook{
+		{Name: "", URL: "http://localhost/hook", Secret: "secret"},
+		{Name: "hook", URL: "ftp://localhost/hook", Secret: "secret"},
+		{Name: "hook", URL: "http://localhost/hook"},
+		{Name: "hook", URL: "http://localhost/hook", Secret: "secret", Events: []string{"bucket.deleted"}},
+	} {
+		_, err := m.create(webhook, now)
+		assert.True(errors.Is(err, errInvalidWebhook))
+	}
+	// Test-2 : only the events of the filter are delivered
+	webhook, err := m.create(Webhook{Name: "hook", URL: "http://localhost/hook", Secret: "secret", Events: []string{consoleEventBucketCreated}}, now)
+	if !assert.NoError(err) {
+		return
+	}
+	m.publish(ConsoleEvent{ID: "1", Type: consoleEventUserAdded, Time: now, Actor: "admin"})
+	m.publish(ConsoleEvent{ID: "2", Type: consoleEventBucketCreated, Time: now, Actor: "admin", Data: bucketCreatedEvent{Bucket: "bucket"}})
+	delivery := waitWebhookDelivery(t, m, webhook.ID)
+	assert.Equal(webhookDeliverySucceeded, delivery.Status)
+	assert.Equal("2", delivery.EventID)
+	assert.Equal(int32(1), delivery.Attempts)
+	mutex.Lock()
+	if assert.Len(sent, 1) {
+		assert.Equal(consoleEventBucketCreated, sent[0].Type)
+		assert.Equal("admin", sent[0].Actor)
+		assert.Equal(map[string]interface{}{"bucket": "bucket", "locking": false, "versioning": false}, sent[0].Data)
+	}
+	// Test-3 : failed deliveries are retried
+	statusCodes = []int{http.StatusServiceUnavailable, http.StatusTooManyRequests}
+	mutex.Unlock()
+	m.publish(ConsoleEvent{ID: "3", Type: consoleEventBucketCreated, Time: now})
+	delivery = waitWebhookDelivery(t, m, webhook.ID)
+	assert.Equal(webhookDeliverySucceeded, delivery.Status)
+	assert.Equal(int32(3), delivery.Attempts)
+	assert.Equal("", delivery.Error)
+	// Test-4 : until the attempts run out
+	mutex.Lock()
+	statusCodes = []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway}
+	mutex.Unlock()
+	m.publish(ConsoleEvent{ID: "4", Type: consoleEventBucketCreated, Time: now})
+	delivery = waitWebhookDelivery(t, m, webhook.ID)
+	assert.Equal(webhookDeliveryFailed, delivery.Status)
+	assert.Equal(int32(3), delivery.Attempts)
+	assert.Equal(int32(http.StatusBadGateway), delivery.StatusCode)
+	// Test-5 : the requests rejected by the webhook aren't retried
+	mutex.Lock()
+	statusCodes = []int{http.StatusUnauthorized}
+	mutex.Unlock()
+	m.publish(ConsoleEvent{ID: "5", Type: consoleEventBucketCreated, Time: now})
+	delivery = waitWebhookDelivery(t, m, webhook.ID)
+	assert.Equal(webhookDeliveryFailed, delivery.Status)
+	assert.Equal(int32(1), delivery.Attempts)
+	failed, err := m.listDeliveries(webhook.ID, webhookDeliveryFailed, 0)
+	if assert.NoError(err) {
+		assert.Len(failed, 2)
+	}
+	// Test-6 : disabled webhooks are only sent the test events, the secret is kept
+	webhook.Disabled, webhook.Secret = true, ""
+	webhook, err = m.update(webhook)
+	if assert.NoError(err) {
+		assert.Equal("secret", webhook.Secret)
+	}
+	m.publish(ConsoleEvent{ID: "6", Type: consoleEventBucketCreated, Time: now})
+	test, err := m.test(webhook.ID, "admin", now)
+	if assert.NoError(err) {
+		assert.Equal(consoleEventWebhookTest, test.Event)
+	}
+	delivery = waitWebhookDelivery(t, m, webhook.ID)
+	assert.Equal(test.ID, delivery.ID)
+	all, err := m.listDeliveries(webhook.ID, "", 0)
+	if assert.NoError(err) {
+		assert.Len(all, 5)
+	}
+	// Test-7 : pending deliveries fail on a restart
+	m.Lock()
+	m.deliveries[0].Status = webhookDeliveryPending
+	assert.NoError(m.saveDeliveries())
+	m.Unlock()
+	reloaded := newWebhookManager(webhooksFile, webhookDeliveriesFile, 3, send)
+	failed, err = reloaded.listDeliveries(webhook.ID, webhookDeliveryFailed, 0)
+	if assert.NoError(err) {
+		assert.Len(failed, 3)
+	}
+	// Test-8 : deleting a webhook deletes its deliveries
+	assert.NoError(m.remove(webhook.ID))
+	_, err = m.listDeliveries(webhook.ID, "", 0)
+	assert.True(errors.Is(err, errWebhookNotFound))
+	_, err = m.test(webhook.ID, "admin", now)
+	assert.True(errors.Is(err, errWebhookNotFound))
+	// Test-9 : the retries use the current URL of the webhook
+	webhook, err = m.create(Webhook{Name: "hook", URL: "http://localhost/old", Secret: "secret"}, now)
+	if !assert.NoError(err) {
+		return
+	}
+	mutex.Lock()
+	urls = nil
+	statusCodes = []int{http.StatusServiceUnavailable}
+	onSend = func(current Webhook) {
+		current.URL = "http://localhost/new"
+		_, err := m.update(current)
+		assert.NoError(err)
+	}
+	mutex.Unlock()
+	m.publish(ConsoleEvent{ID: "9", Type: consoleEventUserAdded, Time: now})
+	delivery = waitWebhookDelivery(t, m, webhook.ID)
+	assert.Equal(webhookDeliverySucceeded, delivery.Status)
+	assert.Equal(int32(2), delivery.Attempts)
+	mutex.Lock()
+	assert.Equal([]string{"http://localhost/old", "http://localhost/new"}, urls)
+	// Test-10 : the retries stop once the webhook is disabled
+	statusCodes = []int{http.StatusServiceUnavailable}
+	onSend = func(current Webhook) {
+		current.Disabled = true
+		_, err := m.update(current)
+		assert.NoError(err)
+	}
+	mutex.Unlock()
+	m.publish(ConsoleEvent{ID: "10", Type: consoleEventUserAdded, Time: now})
+	delivery = waitWebhookDelivery(t, m, webhook.ID)
+	assert.Equal(webhookDeliveryFailed, delivery.Status)
+	assert.Equal(int32(1), delivery.Attempts)
+	assert.Equal("the webhook is no longer subscribed to the event", delivery.Error)
+}
+
+func TestWebhooksAllowed(t *testing.T) {
+	assert := assert.New(t)
+	// Test-1 : the webhooks need the config update permission
+	assert.True(webhooksAllowed(&models.Principal{Actions: []string{"admin:*"}}))
+	assert.True(webhooksAllowed(&models.Principal{Actions: []string{"admin:ConfigUpdate"}}))
+	assert.False(webhooksAllowed(&models.Principal{Actions: []string{"admin:ServerInfo"}}))
+	// Test-2 : the handlers refuse the other sessions
+	_, err := getListWebhooksResponse(&models.Principal{Actions: []string{"s3:*"}})
+	if assert.NotNil(err) {
+		assert.Equal(int32(403), err.Code)
+	}
+}
+
+func TestSendWebhookEvent(t *testing.T) {
+	assert := assert.New(t)
+	body := []byte(`{"id":"1","type":"user.added"}`)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received, _ := ioutil.ReadAll(r.Body)
+		assert.Equal(body, received)
+		assert.Equal(consoleEventUserAdded, r.Header.Get(webhookEventHeader))
+		assert.Equal("delivery", r.Header.Get(webhookDeliveryHeader))
+		if r.Header.Get(webhookSignatureHeader) != signWebhookBody("secret", received) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer server.Close()
+	// Test-1 : the body is signed with the secret
+	statusCode, err := sendWebhookEvent(context.Background(), Webhook{URL: server.URL, Secret: "secret"}, "delivery", consoleEventUserAdded, body)
+	assert.NoError(err)
+	assert.Equal(http.StatusOK, statusCode)
+	// Test-2 : the responses other than 2xx are errors
+	statusCode, err = sendWebhookEvent(context.Background(), Webhook{URL: server.URL, Secret: "other"}, "delivery", consoleEventUserAdded, body)
+	assert.Error(err)
+	assert.Equal(http.StatusUnauthorized, statusCode)
+	// Test-3 : the signature is the HMAC-SHA256 of the body
+	assert.Equal("sha256=f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8", signWebhookBody("key", []byte("The quick brown fox jumps over the lazy dog")))
+}
@@ -0,0 +1,65 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// The madmin version Console depends on can only create keys and get their
+// status, listing, importing and deleting keys is sent by Console itself with
+// the types of newer madmin releases. They map to ListKeys, ImportKey and
+// DeleteKey of madmin once it's bumped.
+
+const kmsAPIPrefix = "/minio/admin/v3/kms"
+
+// KMSKeyInfo describes a key of the KMS
+type KMSKeyInfo struct {
+	CreatedAt time.Time `json:"createdAt"`
+	CreatedBy string    `json:"createdBy"`
+	Name      string    `json:"name"`
+}
+
+// kmsImportKeyRequest is the body of an import, the key bytes are sent base64 encoded
+type kmsImportKeyRequest struct {
+	Bytes []byte `json:"bytes"`
+}
+
+func (ac AdminClient) kmsListKeys(ctx context.Context, pattern string) ([]KMSKeyInfo, error) {
+	query := url.Values{}
+	query.Set("pattern", pattern)
+	var keys []KMSKeyInfo
+	if err := ac.executeAdminRequest(ctx, http.MethodGet, kmsAPIPrefix+"/key/list", query, nil, false, &keys); err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+func (ac AdminClient) kmsImportKey(ctx context.Context, keyID string, content []byte) error {
+	query := url.Values{}
+	query.Set("key-id", keyID)
+	return ac.executeAdminRequest(ctx, http.MethodPost, kmsAPIPrefix+"/key/import", query, kmsImportKeyRequest{Bytes: content}, false, nil)
+}
+
+func (ac AdminClient) kmsDeleteKey(ctx context.Context, keyID string) error {
+	query := url.Values{}
+	query.Set("key-id", keyID)
+	return ac.executeAdminRequest(ctx, http.MethodDelete, kmsAPIPrefix+"/key/delete", query, nil, false, nil)
+}
@@ -0,0 +1,122 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// The madmin version Console depends on can only list the pools, the
+// decommission and rebalance calls are sent by Console itself with the types
+// of newer madmin releases. They map to StatusPool, DecommissionPool,
+// CancelDecommissionPool and RebalanceStatus of madmin once it's bumped.
+
+const poolsAPIPrefix = "/minio/admin/v3/pools"
+
+const rebalanceAPIPrefix = "/minio/admin/v3/rebalance"
+
+// PoolDecommissionInfo is the progress of the decommission of a pool
+type PoolDecommissionInfo struct {
+	StartTime                 time.Time `json:"startTime"`
+	StartSize                 int64     `json:"startSize"`
+	TotalSize                 int64     `json:"totalSize"`
+	CurrentSize               int64     `json:"currentSize"`
+	Complete                  bool      `json:"complete"`
+	Failed                    bool      `json:"failed"`
+	Canceled                  bool      `json:"canceled"`
+	ObjectsDecommissioned     int64     `json:"objectsDecommissioned"`
+	ObjectsDecommissionFailed int64     `json:"objectsDecommissionedFailed"`
+	BytesDone                 int64     `json:"bytesDecommissioned"`
+	BytesFailed               int64     `json:"bytesDecommissionedFailed"`
+}
+
+// PoolStatus describes a pool, the decommission info is only set once the
+// pool started being decommissioned
+type PoolStatus struct {
+	ID           int                   `json:"id"`
+	CmdLine      string                `json:"cmdline"`
+	LastUpdate   time.Time             `json:"lastUpdate"`
+	Decommission *PoolDecommissionInfo `json:"decommissionInfo,omitempty"`
+}
+
+// RebalPoolProgress is the progress of the rebalance of a pool
+type RebalPoolProgress struct {
+	NumObjects  uint64        `json:"objects"`
+	NumVersions uint64        `json:"versions"`
+	Bytes       uint64        `json:"bytes"`
+	Bucket      string        `json:"bucket"`
+	Object      string        `json:"object"`
+	Elapsed     time.Duration `json:"elapsed"`
+	ETA         time.Duration `json:"eta"`
+}
+
+// RebalPoolStatus is the rebalance status of a pool, the status is Started
+// while the pool is being rebalanced
+type RebalPoolStatus struct {
+	ID       int               `json:"id"`
+	Status   string            `json:"status"`
+	Used     float64           `json:"used"`
+	Progress RebalPoolProgress `json:"progress,omitempty"`
+}
+
+// RebalanceStatus is the status of the rebalance of the pools
+type RebalanceStatus struct {
+	ID        string
+	Pools     []RebalPoolStatus
+	StoppedAt time.Time
+}
+
+func (ac AdminClient) listPoolsStatus(ctx context.Context) ([]PoolStatus, error) {
+	var pools []PoolStatus
+	if err := ac.executeAdminRequest(ctx, http.MethodGet, poolsAPIPrefix+"/list", nil, nil, false, &pools); err != nil {
+		return nil, err
+	}
+	return pools, nil
+}
+
+func (ac AdminClient) statusPool(ctx context.Context, pool string) (*PoolStatus, error) {
+	query := url.Values{}
+	query.Set("pool", pool)
+	var status PoolStatus
+	if err := ac.executeAdminRequest(ctx, http.MethodGet, poolsAPIPrefix+"/status", query, nil, false, &status); err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+func (ac AdminClient) decommissionPool(ctx context.Context, pool string) error {
+	query := url.Values{}
+	query.Set("pool", pool)
+	return ac.executeAdminRequest(ctx, http.MethodPost, poolsAPIPrefix+"/decommission", query, nil, false, nil)
+}
+
+func (ac AdminClient) cancelDecommissionPool(ctx context.Context, pool string) error {
+	query := url.Values{}
+	query.Set("pool", pool)
+	return ac.executeAdminRequest(ctx, http.MethodPost, poolsAPIPrefix+"/cancel", query, nil, false, nil)
+}
+
+func (ac AdminClient) rebalanceStatus(ctx context.Context) (*RebalanceStatus, error) {
+	var status RebalanceStatus
+	if err := ac.executeAdminRequest(ctx, http.MethodGet, rebalanceAPIPrefix+"/status", nil, nil, false, &status); err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
@@ -0,0 +1,237 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/minio/madmin-go"
+	"github.com/minio/minio-go/v7/pkg/signer"
+)
+
+// The site replication types mirror the ones of newer madmin releases, the
+// madmin version Console depends on doesn't include the site replication API
+// yet so the calls are signed and sent by Console itself. Once madmin-go is
+// bumped the AdminClient methods call SiteReplicationAdd, SiteReplicationInfo,
+// SRStatusInfo, SiteReplicationEdit and SiteReplicationRemove instead, the
+// handlers only use them through the MinioAdmin interface, and
+// executeAdminRequest is removed along with the KMS and pools calls using it.
+
+// PeerSite is a deployment added to the site replication
+type PeerSite struct {
+	Name      string `json:"name,omitempty"`
+	Endpoint  string `json:"endpoints"`
+	AccessKey string `json:"accessKey"`
+	SecretKey string `json:"secretKey"`
+}
+
+// PeerInfo describes a site of the site replication
+type PeerInfo struct {
+	Endpoint     string `json:"endpoint"`
+	Name         string `json:"name"`
+	DeploymentID string `json:"deploymentID"`
+}
+
+// ReplicateAddStatus is the result of adding sites
+type ReplicateAddStatus struct {
+	Success                 bool   `json:"success"`
+	Status                  string `json:"status"`
+	ErrDetail               string `json:"errorDetail,omitempty"`
+	InitialSyncErrorMessage string `json:"initialSyncErrorMessage,omitempty"`
+}
+
+// ReplicateEditStatus is the result of editing a site
+type ReplicateEditStatus struct {
+	Success   bool   `json:"success"`
+	Status    string `json:"status"`
+	ErrDetail string `json:"errorDetail,omitempty"`
+}
+
+// SRRemoveReq lists the sites to remove from the site replication
+type SRRemoveReq struct {
+	SiteNames []string `json:"sites"`
+	RemoveAll bool     `json:"all"`
+}
+
+// ReplicateRemoveStatus is the result of removing sites
+type ReplicateRemoveStatus struct {
+	Status    string `json:"status"`
+	ErrDetail string `json:"errorDetail,omitempty"`
+}
+
+// SiteReplicationInfo is the site replication configuration
+type SiteReplicationInfo struct {
+	Enabled                 bool       `json:"enabled"`
+	Name                    string     `json:"name,omitempty"`
+	Sites                   []PeerInfo `json:"sites,omitempty"`
+	ServiceAccountAccessKey string     `json:"serviceAccountAccessKey,omitempty"`
+}
+
+// SRStatusOptions selects the entities included in the status
+type SRStatusOptions struct {
+	Buckets  bool
+	Policies bool
+	Users    bool
+	Groups   bool
+}
+
+// SRSiteSummary counts the entities replicated to a site
+type SRSiteSummary struct {
+	ReplicatedBuckets     int
+	ReplicatedIAMPolicies int
+	ReplicatedUsers       int
+	ReplicatedGroups      int
+	TotalBucketsCount     int
+	TotalIAMPoliciesCount int
+	TotalUsersCount       int
+	TotalGroupsCount      int
+}
+
+// SREntityStats holds the state of an entity on a site, the fields depend on
+// the kind of entity, e.g. HasBucket, TagMismatch or PolicyMismatch
+type SREntityStats map[string]interface{}
+
+// SRStatusInfo is the sync status of the sites, the stats are keyed by the
+// entity name and then by the deployment id of the sites
+type SRStatusInfo struct {
+	Enabled      bool
+	MaxBuckets   int
+	MaxUsers     int
+	MaxGroups    int
+	MaxPolicies  int
+	Sites        map[string]PeerInfo
+	StatsSummary map[string]SRSiteSummary
+	BucketStats  map[string]map[string]SREntityStats
+	PolicyStats  map[string]map[string]SREntityStats
+	UserStats    map[string]map[string]SREntityStats
+	GroupStats   map[string]map[string]SREntityStats
+}
+
+const siteReplicationAPIPrefix = "/minio/admin/v3/site-replication"
+
+// executeAdminRequest signs and sends a request to the MinIO admin API the same
+// way madmin does, the response is decoded into v. Sensitive payloads are
+// encrypted with the secret key as the admin API requires
+func (ac AdminClient) executeAdminRequest(ctx context.Context, method, path string, query url.Values, payload interface{}, encrypt bool, v interface{}) error {
+	if ac.Creds == nil {
+		return errors.New("the admin client has no credentials to sign the request")
+	}
+	value, err := ac.Creds.Get()
+	if err != nil {
+		return err
+	}
+	var content []byte
+	if payload != nil {
+		if content, err = json.Marshal(payload); err != nil {
+			return err
+		}
+		if encrypt {
+			if content, err = madmin.EncryptData(value.SecretAccessKey, content); err != nil {
+				return err
+			}
+		}
+	}
+	endpoint := getMinIOServer() + path
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, bytes.NewReader(content))
+	if err != nil {
+		return err
+	}
+	req.ContentLength = int64(len(content))
+	sum := sha256.Sum256(content)
+	req.Header.Set("X-Amz-Content-Sha256", hex.EncodeToString(sum[:]))
+	req = signer.SignV4(*req, value.AccessKeyID, value.SecretAccessKey, value.SessionToken, "")
+
+	client := &http.Client{Transport: GetConsoleSTSClient().Transport}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		var errResp madmin.ErrorResponse
+		if err = json.NewDecoder(resp.Body).Decode(&errResp); err != nil || errResp.Code == "" {
+			return madmin.ErrorResponse{Code: resp.Status, Message: fmt.Sprintf("unexpected response from MinIO: %s", resp.Status)}
+		}
+		return errResp
+	}
+	if v == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+func (ac AdminClient) siteReplicationAdd(ctx context.Context, sites []PeerSite) (*ReplicateAddStatus, error) {
+	var status ReplicateAddStatus
+	err := ac.executeAdminRequest(ctx, http.MethodPut, siteReplicationAPIPrefix+"/add", nil, sites, true, &status)
+	if err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+func (ac AdminClient) siteReplicationInfo(ctx context.Context) (*SiteReplicationInfo, error) {
+	var info SiteReplicationInfo
+	err := ac.executeAdminRequest(ctx, http.MethodGet, siteReplicationAPIPrefix+"/info", nil, nil, false, &info)
+	if err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
+
+func (ac AdminClient) siteReplicationStatus(ctx context.Context, opts SRStatusOptions) (*SRStatusInfo, error) {
+	query := url.Values{}
+	query.Set("buckets", strconv.FormatBool(opts.Buckets))
+	query.Set("policies", strconv.FormatBool(opts.Policies))
+	query.Set("users", strconv.FormatBool(opts.Users))
+	query.Set("groups", strconv.FormatBool(opts.Groups))
+	var status SRStatusInfo
+	err := ac.executeAdminRequest(ctx, http.MethodGet, siteReplicationAPIPrefix+"/status", query, nil, false, &status)
+	if err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+func (ac AdminClient) siteReplicationEdit(ctx context.Context, site PeerInfo) (*ReplicateEditStatus, error) {
+	var status ReplicateEditStatus
+	err := ac.executeAdminRequest(ctx, http.MethodPut, siteReplicationAPIPrefix+"/edit", nil, site, true, &status)
+	if err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+func (ac AdminClient) siteReplicationRemove(ctx context.Context, req SRRemoveReq) (*ReplicateRemoveStatus, error) {
+	var status ReplicateRemoveStatus
+	err := ac.executeAdminRequest(ctx, http.MethodPut, siteReplicationAPIPrefix+"/remove", nil, req, false, &status)
+	if err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
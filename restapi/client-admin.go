@@ -85,8 +85,14 @@ type MinioAdmin interface {
 	getConfigKV(ctx context.Context, key string) ([]byte, error)
 	helpConfigKV(ctx context.Context, subSys, key string, envOnly bool) (madmin.Help, error)
 	setConfigKV(ctx context.Context, kv string) (restart bool, err error)
+	delConfigKV(ctx context.Context, kv string) (err error)
+	getServerConfig(ctx context.Context) ([]byte, error)
+	setServerConfig(ctx context.Context, config []byte) error
 	serviceRestart(ctx context.Context) error
+	serviceStop(ctx context.Context) error
+	serverUpdate(ctx context.Context, updateURL string) (madmin.ServerUpdateStatus, error)
 	serverInfo(ctx context.Context) (madmin.InfoMessage, error)
+	dataUsageInfo(ctx context.Context) (madmin.DataUsageInfo, error)
 	startProfiling(ctx context.Context, profiler madmin.ProfilerType) ([]madmin.StartProfilingResult, error)
 	stopProfiling(ctx context.Context) (io.ReadCloser, error)
 	serviceTrace(ctx context.Context, threshold int64, s3, internal, storage, os, errTrace bool) <-chan madmin.ServiceTraceInfo
@@ -113,6 +119,28 @@ type MinioAdmin interface {
 	addTier(ctx context.Context, tier *madmin.TierConfig) error
 	// Edit Tier Credentials
 	editTierCreds(ctx context.Context, tierName string, creds madmin.TierCreds) error
+	// Bucket Quota
+	getBucketQuota(ctx context.Context, bucket string) (madmin.BucketQuota, error)
+	setBucketQuota(ctx context.Context, bucket string, quota *madmin.BucketQuota) error
+	// Site Replication
+	siteReplicationAdd(ctx context.Context, sites []PeerSite) (*ReplicateAddStatus, error)
+	siteReplicationInfo(ctx context.Context) (*SiteReplicationInfo, error)
+	siteReplicationStatus(ctx context.Context, opts SRStatusOptions) (*SRStatusInfo, error)
+	siteReplicationEdit(ctx context.Context, site PeerInfo) (*ReplicateEditStatus, error)
+	siteReplicationRemove(ctx context.Context, req SRRemoveReq) (*ReplicateRemoveStatus, error)
+	// KMS
+	kmsStatus(ctx context.Context) (madmin.KMSStatus, error)
+	createKey(ctx context.Context, keyID string) error
+	getKeyStatus(ctx context.Context, keyID string) (*madmin.KMSKeyStatus, error)
+	kmsListKeys(ctx context.Context, pattern string) ([]KMSKeyInfo, error)
+	kmsImportKey(ctx context.Context, keyID string, content []byte) error
+	kmsDeleteKey(ctx context.Context, keyID string) error
+	// Pools
+	listPoolsStatus(ctx context.Context) ([]PoolStatus, error)
+	statusPool(ctx context.Context, pool string) (*PoolStatus, error)
+	decommissionPool(ctx context.Context, pool string) error
+	cancelDecommissionPool(ctx context.Context, pool string) error
+	rebalanceStatus(ctx context.Context) (*RebalanceStatus, error)
 }
 
 // Interface implementation
@@ -121,6 +149,8 @@ type MinioAdmin interface {
 // from minIO api.
 type AdminClient struct {
 	Client *madmin.AdminClient
+	// Creds signs the admin API calls the madmin client doesn't implement yet
+	Creds *credentials.Credentials
 }
 
 func (ac AdminClient) changePassword(ctx context.Context, accessKey, secretKey string) error {
@@ -232,16 +262,46 @@ func (ac AdminClient) setConfigKV(ctx context.Context, kv string) (restart bool,
 	return ac.Client.SetConfigKV(ctx, kv)
 }
 
+// implements madmin.DelConfigKV()
+func (ac AdminClient) delConfigKV(ctx context.Context, kv string) (err error) {
+	return ac.Client.DelConfigKV(ctx, kv)
+}
+
+// implements madmin.GetConfig()
+func (ac AdminClient) getServerConfig(ctx context.Context) ([]byte, error) {
+	return ac.Client.GetConfig(ctx)
+}
+
+// implements madmin.SetConfig()
+func (ac AdminClient) setServerConfig(ctx context.Context, config []byte) error {
+	return ac.Client.SetConfig(ctx, bytes.NewReader(config))
+}
+
 // implements madmin.ServiceRestart()
 func (ac AdminClient) serviceRestart(ctx context.Context) (err error) {
 	return ac.Client.ServiceRestart(ctx)
 }
 
+// implements madmin.ServiceStop()
+func (ac AdminClient) serviceStop(ctx context.Context) (err error) {
+	return ac.Client.ServiceStop(ctx)
+}
+
+// implements madmin.ServerUpdate()
+func (ac AdminClient) serverUpdate(ctx context.Context, updateURL string) (madmin.ServerUpdateStatus, error) {
+	return ac.Client.ServerUpdate(ctx, updateURL)
+}
+
 // implements madmin.ServerInfo()
 func (ac AdminClient) serverInfo(ctx context.Context) (madmin.InfoMessage, error) {
 	return ac.Client.ServerInfo(ctx)
 }
 
+// implements madmin.DataUsageInfo()
+func (ac AdminClient) dataUsageInfo(ctx context.Context) (madmin.DataUsageInfo, error) {
+	return ac.Client.DataUsageInfo(ctx)
+}
+
 // implements madmin.StartProfiling()
 func (ac AdminClient) startProfiling(ctx context.Context, profiler madmin.ProfilerType) ([]madmin.StartProfilingResult, error) {
 	return ac.Client.StartProfiling(ctx, profiler)
@@ -392,6 +452,21 @@ func (ac AdminClient) editTierCreds(ctx context.Context, tierName string, creds
 	return ac.Client.EditTier(ctx, tierName, creds)
 }
 
+// implements madmin.KMSStatus()
+func (ac AdminClient) kmsStatus(ctx context.Context) (madmin.KMSStatus, error) {
+	return ac.Client.KMSStatus(ctx)
+}
+
+// implements madmin.CreateKey()
+func (ac AdminClient) createKey(ctx context.Context, keyID string) error {
+	return ac.Client.CreateKey(ctx, keyID)
+}
+
+// implements madmin.GetKeyStatus()
+func (ac AdminClient) getKeyStatus(ctx context.Context, keyID string) (*madmin.KMSKeyStatus, error) {
+	return ac.Client.GetKeyStatus(ctx, keyID)
+}
+
 func NewMinioAdminClient(sessionClaims *models.Principal) (*madmin.AdminClient, error) {
 	adminClient, err := newAdminFromClaims(sessionClaims)
 	if err != nil {
@@ -400,6 +475,19 @@ func NewMinioAdminClient(sessionClaims *models.Principal) (*madmin.AdminClient,
 	return adminClient, nil
 }
 
+// newSignedAdminClient creates an admin client that can also send the admin
+// calls madmin doesn't implement yet, they are signed with the credentials of
+// the session
+func newSignedAdminClient(session *models.Principal) (MinioAdmin, error) {
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, err
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	return AdminClient{Client: mAdmin, Creds: getConsoleCredentialsFromSession(session)}, nil
+}
+
 // newAdminFromClaims creates a minio admin from Decrypted claims using Assume role credentials
 func newAdminFromClaims(claims *models.Principal) (*madmin.AdminClient, error) {
 	tlsEnabled := getMinIOEndpointIsSecure()
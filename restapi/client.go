@@ -18,8 +18,15 @@ package restapi
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
 	"fmt"
 	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
@@ -40,6 +47,7 @@ import (
 	"github.com/minio/minio-go/v7/pkg/credentials"
 	"github.com/minio/minio-go/v7/pkg/lifecycle"
 	"github.com/minio/minio-go/v7/pkg/notification"
+	"github.com/minio/minio-go/v7/pkg/signer"
 	"github.com/minio/minio-go/v7/pkg/tags"
 )
 
@@ -75,6 +83,16 @@ type MinioClient interface {
 	getObjectLockConfig(ctx context.Context, bucketName string) (lock string, mode *minio.RetentionMode, validity *uint, unit *minio.ValidityUnit, err error)
 	getLifecycleRules(ctx context.Context, bucketName string) (lifecycle *lifecycle.Configuration, err error)
 	setBucketLifecycle(ctx context.Context, bucketName string, config *lifecycle.Configuration) error
+	copyObject(ctx context.Context, dst minio.CopyDestOptions, src minio.CopySrcOptions) (minio.UploadInfo, error)
+	composeObject(ctx context.Context, dst minio.CopyDestOptions, srcs []minio.CopySrcOptions) (minio.UploadInfo, error)
+	removeObject(ctx context.Context, bucketName, objectName string, opts minio.RemoveObjectOptions) error
+	getBucketReplication(ctx context.Context, bucketName string) (replication.Config, error)
+	getBucketVersioning(ctx context.Context, bucketName string) (minio.BucketVersioningConfiguration, error)
+	bucketExists(ctx context.Context, bucketName string) (bool, error)
+	getBucketReplicationMetrics(ctx context.Context, bucketName string) (replication.Metrics, error)
+	resetBucketReplication(ctx context.Context, bucketName string, olderThan time.Duration) (string, error)
+	resetBucketReplicationOnTarget(ctx context.Context, bucketName string, olderThan time.Duration, arn string) (string, error)
+	statObject(ctx context.Context, bucketName, objectName string, opts minio.StatObjectOptions) (minio.ObjectInfo, error)
 }
 
 // Interface implementation
@@ -83,6 +101,8 @@ type MinioClient interface {
 // from minIO api.
 type minioClient struct {
 	client *minio.Client
+	// creds signs the S3 calls the minio-go client doesn't implement yet
+	creds *credentials.Credentials
 }
 
 // implements minio.ListBuckets(ctx)
@@ -123,11 +143,97 @@ func (c minioClient) getBucketVersioning(ctx context.Context, bucketName string)
 	return c.client.GetBucketVersioning(ctx, bucketName)
 }
 
+// implements minio.BucketExists(ctx, bucketName)
+func (c minioClient) bucketExists(ctx context.Context, bucketName string) (bool, error) {
+	return c.client.BucketExists(ctx, bucketName)
+}
+
 // implements minio.getBucketVersioning(ctx, bucketName)
 func (c minioClient) getBucketReplication(ctx context.Context, bucketName string) (replication.Config, error) {
 	return c.client.GetBucketReplication(ctx, bucketName)
 }
 
+// implements minio.GetBucketReplicationMetrics(ctx, bucketName)
+func (c minioClient) getBucketReplicationMetrics(ctx context.Context, bucketName string) (replication.Metrics, error) {
+	return c.client.GetBucketReplicationMetrics(ctx, bucketName)
+}
+
+// implements minio.ResetBucketReplication(ctx, bucketName, olderThan)
+func (c minioClient) resetBucketReplication(ctx context.Context, bucketName string, olderThan time.Duration) (string, error) {
+	return c.client.ResetBucketReplication(ctx, bucketName, olderThan)
+}
+
+// resetBucketReplicationOnTarget resets the replication of a bucket on a single
+// target, the pinned minio-go ResetBucketReplication can't pick the target so
+// the request is signed the same way minio-go does
+func (c minioClient) resetBucketReplicationOnTarget(ctx context.Context, bucketName string, olderThan time.Duration, arn string) (string, error) {
+	if c.creds == nil {
+		return "", errors.New("the client has no credentials to sign the request")
+	}
+	value, err := c.creds.Get()
+	if err != nil {
+		return "", err
+	}
+	location, err := c.client.GetBucketLocation(ctx, bucketName)
+	if err != nil {
+		return "", err
+	}
+	query := url.Values{}
+	query.Set("replication-reset", "")
+	query.Set("reset-arn", arn)
+	if olderThan > 0 {
+		query.Set("older-than", olderThan.String())
+	}
+	endpoint := strings.TrimSuffix(getMinIOServer(), "/") + "/" + bucketName + "?" + query.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(nil)
+	req.Header.Set("X-Amz-Content-Sha256", hex.EncodeToString(sum[:]))
+	req = signer.SignV4(*req, value.AccessKeyID, value.SecretAccessKey, value.SessionToken, location)
+
+	client := &http.Client{Transport: GetConsoleSTSClient().Transport}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		errResp := minio.ErrorResponse{StatusCode: resp.StatusCode, BucketName: bucketName}
+		if err = xml.Unmarshal(body, &errResp); err != nil || errResp.Code == "" {
+			errResp.Code = resp.Status
+			errResp.Message = fmt.Sprintf("unexpected response from MinIO: %s", resp.Status)
+		}
+		return "", errResp
+	}
+	// MinIO answers with the reset of every target it resyncs
+	var info struct {
+		Targets []struct {
+			Arn     string
+			ResetID string
+		}
+	}
+	if err = json.Unmarshal(body, &info); err != nil {
+		return "", err
+	}
+	for _, target := range info.Targets {
+		if target.Arn == arn {
+			return target.ResetID, nil
+		}
+	}
+	return "", fmt.Errorf("the replication of %s was not reset on %s", bucketName, arn)
+}
+
+// implements minio.StatObject(ctx, bucketName, objectName, opts)
+func (c minioClient) statObject(ctx context.Context, bucketName, objectName string, opts minio.StatObjectOptions) (minio.ObjectInfo, error) {
+	return c.client.StatObject(ctx, bucketName, objectName, opts)
+}
+
 // implements minio.listObjects(ctx)
 func (c minioClient) listObjects(ctx context.Context, bucket string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
 	return c.client.ListObjects(ctx, bucket, opts)
@@ -196,6 +302,21 @@ func (c minioClient) setBucketLifecycle(ctx context.Context, bucketName string,
 	return c.client.SetBucketLifecycle(ctx, bucketName, config)
 }
 
+// implements minio.CopyObject(ctx, dst, src)
+func (c minioClient) copyObject(ctx context.Context, dst minio.CopyDestOptions, src minio.CopySrcOptions) (minio.UploadInfo, error) {
+	return c.client.CopyObject(ctx, dst, src)
+}
+
+// implements minio.ComposeObject(ctx, dst, srcs...) which copies objects larger than 5GiB in parts
+func (c minioClient) composeObject(ctx context.Context, dst minio.CopyDestOptions, srcs []minio.CopySrcOptions) (minio.UploadInfo, error) {
+	return c.client.ComposeObject(ctx, dst, srcs...)
+}
+
+// implements minio.RemoveObject(ctx, bucketName, objectName, opts)
+func (c minioClient) removeObject(ctx context.Context, bucketName, objectName string, opts minio.RemoveObjectOptions) error {
+	return c.client.RemoveObject(ctx, bucketName, objectName, opts)
+}
+
 // MCClient interface with all functions to be implemented
 // by mock when testing, it should include all mc/S3Client respective api calls
 // that are used within this project.
@@ -371,6 +492,18 @@ func newMinioClient(claims *models.Principal) (*minio.Client, error) {
 	return minioClient, nil
 }
 
+// newMinioClientFromCreds creates a MinIO client using custom credentials for connecting to a remote host
+func newMinioClientFromCreds(accessKey, secretKey, endpoint string, tlsEnabled bool) (*minio.Client, error) {
+	minioClient, err := minio.New(endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(accessKey, secretKey, ""),
+		Secure: tlsEnabled,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return minioClient, nil
+}
+
 // newS3BucketClient creates a new mc S3Client to talk to the server based on a bucket
 func newS3BucketClient(claims *models.Principal, bucketName string, prefix string) (*mc.S3Client, error) {
 	endpoint := getMinIOServer()
@@ -19,12 +19,15 @@ package restapi
 import (
 	"crypto/x509"
 	"net"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/minio/console/pkg/certs"
 	xcerts "github.com/minio/pkg/certs"
 	"github.com/minio/pkg/env"
+	"github.com/mitchellh/go-homedir"
 )
 
 var (
@@ -241,6 +244,12 @@ func getPrometheusJobID() string {
 	return env.Get(PrometheusJobID, "minio-job")
 }
 
+// getMinIOUpdateURL returns the URL the MinIO servers are updated from, the
+// official releases are used when it's empty
+func getMinIOUpdateURL() string {
+	return strings.TrimSpace(env.Get(ConsoleMinIOUpdateURL, ""))
+}
+
 // GetSubnetLicense returns the current subnet jwt license
 func GetSubnetLicense() string {
 	// if we have a license key in memory return that
@@ -260,3 +269,16 @@ var (
 	// GlobalTLSCertsManager custom TLS Manager for SNI support
 	GlobalTLSCertsManager *xcerts.Manager
 )
+
+// getConsoleDataDir returns the directory where Console keeps the state it manages locally,
+// by default ~/.console/data
+func getConsoleDataDir() string {
+	if dir := env.Get(ConsoleDataDir, ""); dir != "" {
+		return dir
+	}
+	homeDir, err := homedir.Dir()
+	if err != nil {
+		return filepath.Join(certs.DefaultConsoleConfigDir, "data")
+	}
+	return filepath.Join(homeDir, certs.DefaultConsoleConfigDir, "data")
+}
@@ -87,10 +87,24 @@ func configureAPI(api *operations.ConsoleAPI) http.Handler {
 	registersPoliciesHandler(api)
 	// Register configurations handlers
 	registerConfigHandlers(api)
+	// Register configuration history handlers
+	registerConfigHistoryHandlers(api)
+	// Register configuration targets handlers
+	registerConfigTargetsHandlers(api)
 	// Register bucket events handlers
 	registerBucketEventsHandlers(api)
 	// Register bucket lifecycle handlers
 	registerBucketsLifecycleHandlers(api)
+	// Register bucket lifecycle preview handlers
+	registerBucketLifecyclePreviewHandlers(api)
+	// Register bucket point in time restore handlers
+	registerBucketRestoreHandlers(api)
+	// Register bucket versions stats and cleanup handlers
+	registerBucketVersionsHandlers(api)
+	// Register bucket analytics handlers
+	registerBucketAnalyticsHandlers(api)
+	// Register bucket replication status handlers
+	registerBucketReplicationStatusHandlers(api)
 	// Register service handlers
 	registerServiceHandlers(api)
 	// Register profiling handlers
@@ -99,6 +113,20 @@ func configureAPI(api *operations.ConsoleAPI) http.Handler {
 	registerSessionHandlers(api)
 	// Register admin info handlers
 	registerAdminInfoHandlers(api)
+	// Register servers and drives handlers
+	registerNodesHandlers(api)
+	// Register pools, erasure sets, decommission and rebalance handlers
+	registerPoolsHandlers(api)
+	// Register capacity forecast handlers
+	registerCapacityHandlers(api)
+	// Register scheduled jobs handlers
+	registerJobsHandlers(api)
+	// Register Console webhooks handlers
+	registerWebhooksHandlers(api)
+	// Register dashboard widgets and dashboards handlers
+	registerDashboardsHandlers(api)
+	// Register alert rules and targets handlers
+	registerAlertsHandlers(api)
 	// Register admin arns handlers
 	registerAdminArnsHandlers(api)
 	// Register admin notification endpoints handlers
@@ -113,16 +141,40 @@ func configureAPI(api *operations.ConsoleAPI) http.Handler {
 	registerSubscriptionHandlers(api)
 	// Register Account handlers
 	registerAdminTiersHandlers(api)
+	// Register site replication handlers
+	registerSiteReplicationHandlers(api)
+	// Register KMS handlers
+	registerKMSHandlers(api)
 
 	// Operator Console
 
 	// Register Object's Handlers
 	registerObjectsHandlers(api)
+	// Register Object Lock's Handlers
+	registerObjectLockHandlers(api)
 	// Register Bucket Quota's Handlers
 	registerBucketQuotaHandlers(api)
 	// Register Account handlers
 	registerAccountHandlers(api)
 
+	// Evaluate the alert rules when Prometheus is configured
+	startAlertsEvaluation()
+
+	// Collect the MinIO metrics for the dashboard when Prometheus is not configured
+	startMetricsCollector()
+
+	// Run the scheduled jobs
+	startJobsScheduler()
+
+	// Notify the alert targets when buckets cross their soft quota thresholds
+	startSoftQuotaMonitor()
+
+	// Record the state transitions of the drives with the monitoring credentials
+	startDriveStateSampler()
+
+	// Record the daily usage of the capacity forecast with the monitoring credentials
+	startCapacitySampler()
+
 	api.PreServerShutdown = func() {}
 
 	api.ServerShutdown = func() {}
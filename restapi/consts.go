@@ -54,6 +54,20 @@ const (
 	ConsoleLogQueryURL                           = "CONSOLE_LOG_QUERY_URL"
 	ConsoleLogQueryAuthToken                     = "CONSOLE_LOG_QUERY_AUTH_TOKEN"
 	LogSearchQueryAuthToken                      = "LOGSEARCH_QUERY_AUTH_TOKEN"
+	ConsoleDataDir                               = "CONSOLE_DATA_DIR"
+	ConsoleMinIOUpdateURL                        = "CONSOLE_MINIO_UPDATE_URL"
+	ConsoleAlertsEvaluationInterval              = "CONSOLE_ALERTS_EVALUATION_INTERVAL"
+	ConsoleMetricsCollector                      = "CONSOLE_METRICS_COLLECTOR"
+	ConsoleMetricsCollectorInterval              = "CONSOLE_METRICS_COLLECTOR_INTERVAL"
+	ConsoleMetricsCollectorRetention             = "CONSOLE_METRICS_COLLECTOR_RETENTION"
+	ConsoleMetricsAuthToken                      = "CONSOLE_METRICS_AUTH_TOKEN"
+	ConsoleJobsAccessKey                         = "CONSOLE_JOBS_ACCESS_KEY"
+	ConsoleJobsSecretKey                         = "CONSOLE_JOBS_SECRET_KEY"
+	ConsoleJobsConcurrency                       = "CONSOLE_JOBS_CONCURRENCY"
+	ConsoleMonitorAccessKey                      = "CONSOLE_MONITOR_ACCESS_KEY"
+	ConsoleMonitorSecretKey                      = "CONSOLE_MONITOR_SECRET_KEY"
+	ConsoleWebhooksMaxAttempts                   = "CONSOLE_WEBHOOKS_MAX_ATTEMPTS"
+	ConsoleRestoreMaxObjects                     = "CONSOLE_RESTORE_MAX_OBJECTS"
 )
 
 // Image versions
@@ -115,18 +115,18 @@ func init() {
         }
       }
     },
-    "/admin/arns": {
+    "/admin/alerts": {
       "get": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Returns a list of active ARNs in the instance",
-        "operationId": "ArnList",
+        "summary": "List pending, firing and recently resolved alerts",
+        "operationId": "ListAlerts",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/arnsResponse"
+              "$ref": "#/definitions/alertList"
             }
           },
           "default": {
@@ -138,18 +138,18 @@ func init() {
         }
       }
     },
-    "/admin/info": {
+    "/admin/alerts/rules": {
       "get": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Returns information about the deployment",
-        "operationId": "AdminInfo",
+        "summary": "List alert rules",
+        "operationId": "ListAlertRules",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/adminInfoResponse"
+              "$ref": "#/definitions/alertRuleList"
             }
           },
           "default": {
@@ -159,45 +159,28 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/admin/info/widgets/{widgetId}": {
-      "get": {
+      },
+      "post": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Returns information about the deployment",
-        "operationId": "DashboardWidgetDetails",
+        "summary": "Create an alert rule",
+        "operationId": "CreateAlertRule",
         "parameters": [
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "widgetId",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "integer",
-            "name": "start",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "name": "end",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "step",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertRule"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/widgetDetails"
+              "$ref": "#/definitions/alertRule"
             }
           },
           "default": {
@@ -209,18 +192,26 @@ func init() {
         }
       }
     },
-    "/admin/notification_endpoints": {
+    "/admin/alerts/rules/{id}": {
       "get": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Returns a list of active notification endpoints",
-        "operationId": "NotificationEndpointList",
+        "summary": "Alert rule",
+        "operationId": "GetAlertRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/notifEndpointResponse"
+              "$ref": "#/definitions/alertRule"
             }
           },
           "default": {
@@ -231,28 +222,60 @@ func init() {
           }
         }
       },
-      "post": {
+      "put": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Allows to configure a new notification endpoint",
-        "operationId": "AddNotificationEndpoint",
+        "summary": "Update an alert rule",
+        "operationId": "UpdateAlertRule",
         "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/notificationEndpoint"
+              "$ref": "#/definitions/alertRule"
             }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/setNotificationEndpointResponse"
+              "$ref": "#/definitions/alertRule"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Delete an alert rule",
+        "operationId": "DeleteAlertRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -263,18 +286,18 @@ func init() {
         }
       }
     },
-    "/admin/tiers": {
+    "/admin/alerts/targets": {
       "get": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Returns a list of tiers for ilm",
-        "operationId": "TiersList",
+        "summary": "List alert notification targets",
+        "operationId": "ListAlertTargets",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/tierListResponse"
+              "$ref": "#/definitions/alertTargetList"
             }
           },
           "default": {
@@ -289,21 +312,24 @@ func init() {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Allows to configure a new tier",
-        "operationId": "AddTier",
+        "summary": "Add an alert notification target",
+        "operationId": "CreateAlertTarget",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/tier"
+              "$ref": "#/definitions/alertTarget"
             }
           }
         ],
         "responses": {
           "201": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertTarget"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -314,37 +340,34 @@ func init() {
         }
       }
     },
-    "/admin/tiers/{type}/{name}": {
-      "get": {
+    "/admin/alerts/targets/{name}": {
+      "put": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Get Tier",
-        "operationId": "GetTier",
+        "summary": "Update an alert notification target",
+        "operationId": "UpdateAlertTarget",
         "parameters": [
           {
-            "enum": [
-              "s3",
-              "gcs",
-              "azure"
-            ],
             "type": "string",
-            "name": "type",
+            "name": "name",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertTarget"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/tier"
+              "$ref": "#/definitions/alertTarget"
             }
           },
           "default": {
@@ -354,44 +377,23 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/admin/tiers/{type}/{name}/credentials": {
-      "put": {
+      },
+      "delete": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Edit Tier Credentials",
-        "operationId": "EditTierCredentials",
+        "summary": "Delete an alert notification target",
+        "operationId": "DeleteAlertTarget",
         "parameters": [
-          {
-            "enum": [
-              "s3",
-              "gcs",
-              "azure"
-            ],
-            "type": "string",
-            "name": "type",
-            "in": "path",
-            "required": true
-          },
           {
             "type": "string",
             "name": "name",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/tierCredentialsRequest"
-            }
           }
         ],
         "responses": {
-          "200": {
+          "204": {
             "description": "A successful response."
           },
           "default": {
@@ -403,38 +405,18 @@ func init() {
         }
       }
     },
-    "/bucket-policy/{bucket}": {
+    "/admin/arns": {
       "get": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "List Policies With Given Bucket",
-        "operationId": "ListPoliciesWithBucket",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
-          }
-        ],
+        "summary": "Returns a list of active ARNs in the instance",
+        "operationId": "ArnList",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listPoliciesResponse"
+              "$ref": "#/definitions/arnsResponse"
             }
           },
           "default": {
@@ -446,30 +428,24 @@ func init() {
         }
       }
     },
-    "/bucket-users/{bucket}": {
+    "/admin/capacity/forecast": {
       "get": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "List Users With Access to a Given Bucket",
-        "operationId": "ListUsersWithAccessToBucket",
+        "summary": "Forecast of the cluster capacity from the recorded usage",
+        "operationId": "CapacityForecast",
         "parameters": [
-          {
-            "type": "string",
-            "name": "bucket",
-            "in": "path",
-            "required": true
-          },
           {
             "type": "integer",
             "format": "int32",
-            "name": "offset",
+            "name": "days",
             "in": "query"
           },
           {
             "type": "integer",
             "format": "int32",
-            "name": "limit",
+            "name": "top",
             "in": "query"
           }
         ],
@@ -477,10 +453,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "$ref": "#/definitions/capacityForecastResponse"
             }
           },
           "default": {
@@ -492,37 +465,18 @@ func init() {
         }
       }
     },
-    "/buckets": {
+    "/admin/dashboard/widgets": {
       "get": {
         "tags": [
-          "UserAPI"
-        ],
-        "summary": "List Buckets",
-        "operationId": "ListBuckets",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "sort_by",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
-          }
+          "AdminAPI"
         ],
+        "summary": "List built-in and user defined dashboard widgets",
+        "operationId": "ListDashboardWidgets",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listBucketsResponse"
+              "$ref": "#/definitions/dashboardWidgetList"
             }
           },
           "default": {
@@ -535,23 +489,26 @@ func init() {
       },
       "post": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Make bucket",
-        "operationId": "MakeBucket",
+        "summary": "Create a dashboard widget",
+        "operationId": "CreateDashboardWidget",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/makeBucketRequest"
+              "$ref": "#/definitions/dashboardWidget"
             }
           }
         ],
         "responses": {
           "201": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/dashboardWidget"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -562,28 +519,27 @@ func init() {
         }
       }
     },
-    "/buckets-replication": {
-      "post": {
+    "/admin/dashboard/widgets/{widgetId}": {
+      "get": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Sets Multi Bucket Replication in multiple Buckets",
-        "operationId": "SetMultiBucketReplication",
+        "summary": "Dashboard widget definition",
+        "operationId": "GetDashboardWidget",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/multiBucketReplication"
-            }
+            "type": "integer",
+            "format": "int32",
+            "name": "widgetId",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/multiBucketResponseState"
+              "$ref": "#/definitions/dashboardWidget"
             }
           },
           "default": {
@@ -593,26 +549,36 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/buckets/{bucket_name}/encryption/disable": {
-      "post": {
+      },
+      "put": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Disable bucket encryption.",
-        "operationId": "DisableBucketEncryption",
+        "summary": "Update a user defined dashboard widget",
+        "operationId": "UpdateDashboardWidget",
         "parameters": [
           {
-            "type": "string",
-            "name": "bucket_name",
+            "type": "integer",
+            "format": "int32",
+            "name": "widgetId",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/dashboardWidget"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/dashboardWidget"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -621,33 +587,24 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/buckets/{bucket_name}/encryption/enable": {
-      "post": {
+      },
+      "delete": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Enable bucket encryption.",
-        "operationId": "EnableBucketEncryption",
+        "summary": "Delete a user defined dashboard widget",
+        "operationId": "DeleteDashboardWidget",
         "parameters": [
           {
-            "type": "string",
-            "name": "bucket_name",
+            "type": "integer",
+            "format": "int32",
+            "name": "widgetId",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/bucketEncryptionRequest"
-            }
           }
         ],
         "responses": {
-          "200": {
+          "204": {
             "description": "A successful response."
           },
           "default": {
@@ -659,26 +616,49 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/encryption/info": {
+    "/admin/dashboards": {
       "get": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Get bucket encryption information.",
-        "operationId": "GetBucketEncryptionInfo",
+        "summary": "List saved dashboards",
+        "operationId": "ListDashboards",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/dashboardList"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Save a dashboard",
+        "operationId": "CreateDashboard",
         "parameters": [
           {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/dashboard"
+            }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucketEncryptionInfo"
+              "$ref": "#/definitions/dashboard"
             }
           },
           "default": {
@@ -690,38 +670,26 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/events": {
+    "/admin/dashboards/{name}": {
       "get": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "List Bucket Events",
-        "operationId": "ListBucketEvents",
+        "summary": "Saved dashboard",
+        "operationId": "GetDashboard",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "name",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listBucketEventsResponse"
+              "$ref": "#/definitions/dashboard"
             }
           },
           "default": {
@@ -732,16 +700,16 @@ func init() {
           }
         }
       },
-      "post": {
+      "put": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Create Bucket Event",
-        "operationId": "CreateBucketEvent",
+        "summary": "Update a saved dashboard",
+        "operationId": "UpdateDashboard",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "name",
             "in": "path",
             "required": true
           },
@@ -750,13 +718,16 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/bucketEventRequest"
+              "$ref": "#/definitions/dashboard"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/dashboard"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -765,35 +736,19 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/buckets/{bucket_name}/events/{arn}": {
+      },
       "delete": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Delete Bucket Event",
-        "operationId": "DeleteBucketEvent",
+        "summary": "Delete a saved dashboard",
+        "operationId": "DeleteDashboard",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "arn",
+            "name": "name",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/notificationDeleteRequest"
-            }
           }
         ],
         "responses": {
@@ -809,26 +764,18 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/lifecycle": {
+    "/admin/info": {
       "get": {
         "tags": [
-          "UserAPI"
-        ],
-        "summary": "Bucket Lifecycle",
-        "operationId": "GetBucketLifecycle",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          }
+          "AdminAPI"
         ],
+        "summary": "Returns information about the deployment",
+        "operationId": "AdminInfo",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucketLifecycleResponse"
+              "$ref": "#/definitions/adminInfoResponse"
             }
           },
           "default": {
@@ -838,32 +785,46 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/admin/info/widgets/{widgetId}": {
+      "get": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Add Bucket Lifecycle",
-        "operationId": "AddBucketLifecycle",
+        "summary": "Returns information about the deployment",
+        "operationId": "DashboardWidgetDetails",
         "parameters": [
           {
-            "type": "string",
-            "name": "bucket_name",
+            "type": "integer",
+            "format": "int32",
+            "name": "widgetId",
             "in": "path",
             "required": true
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/addBucketLifecycle"
-            }
+            "type": "integer",
+            "name": "start",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "end",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "step",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/widgetDetails"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -874,38 +835,50 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/lifecycle/{lifecycle_id}": {
-      "put": {
+    "/admin/jobs": {
+      "get": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Update Lifecycle rule",
-        "operationId": "UpdateBucketLifecycle",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "lifecycle_id",
-            "in": "path",
-            "required": true
+        "summary": "List the scheduled jobs",
+        "operationId": "ListJobs",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/jobList"
+            }
           },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Create a scheduled job",
+        "operationId": "CreateJob",
+        "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/updateBucketLifecycle"
+              "$ref": "#/definitions/jobRequest"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "A successful response."
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/job"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -916,17 +889,17 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/object-locking": {
+    "/admin/jobs/{id}": {
       "get": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Returns the status of object locking support on the bucket",
-        "operationId": "GetBucketObjectLockingStatus",
+        "summary": "Get a scheduled job",
+        "operationId": "GetJob",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "id",
             "in": "path",
             "required": true
           }
@@ -935,7 +908,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucketObLockingResponse"
+              "$ref": "#/definitions/job"
             }
           },
           "default": {
@@ -945,43 +918,54 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/buckets/{bucket_name}/objects": {
-      "get": {
+      },
+      "delete": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "List Objects",
-        "operationId": "ListObjects",
+        "summary": "Delete a scheduled job and its runs",
+        "operationId": "DeleteJob",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "id",
             "in": "path",
             "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
           },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/jobs/{id}/pause": {
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Pause the schedule of a job",
+        "operationId": "PauseJob",
+        "parameters": [
           {
             "type": "string",
-            "name": "prefix",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "recursive",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "with_versions",
-            "in": "query"
+            "name": "id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listObjectsResponse"
+              "$ref": "#/definitions/job"
             }
           },
           "default": {
@@ -991,40 +975,29 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/admin/jobs/{id}/resume": {
+      "post": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Delete Object",
-        "operationId": "DeleteObject",
+        "summary": "Resume the schedule of a job",
+        "operationId": "ResumeJob",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "name": "path",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "recursive",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/job"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1035,40 +1008,26 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/download": {
-      "get": {
-        "produces": [
-          "application/octet-stream"
-        ],
+    "/admin/jobs/{id}/run": {
+      "post": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Download Object",
-        "operationId": "Download Object",
+        "summary": "Run a job now",
+        "operationId": "RunJob",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query"
           }
         ],
         "responses": {
-          "200": {
+          "202": {
             "description": "A successful response.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/jobRun"
             }
           },
           "default": {
@@ -1080,44 +1039,27 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/legalhold": {
-      "put": {
+    "/admin/jobs/{id}/runs": {
+      "get": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Put Object's legalhold status",
-        "operationId": "PutObjectLegalHold",
+        "summary": "List the runs of a job",
+        "operationId": "ListJobRuns",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/putObjectLegalHoldRequest"
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/jobRunList"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1128,44 +1070,33 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/retention": {
-      "put": {
+    "/admin/jobs/{id}/runs/{run}": {
+      "get": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Put Object's retention status",
-        "operationId": "PutObjectRetention",
+        "summary": "Get a run of a job with its logs",
+        "operationId": "GetJobRun",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "id",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query",
+            "name": "run",
+            "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/putObjectRetentionRequest"
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/jobRun"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1174,36 +1105,38 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/admin/jobs/{id}/runs/{run}/report": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Delete Object retention from an object",
-        "operationId": "DeleteObjectRetention",
+        "summary": "Download the report of a run",
+        "operationId": "DownloadJobRunReport",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "id",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query",
+            "name": "run",
+            "in": "path",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1214,35 +1147,17 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/share": {
+    "/admin/kms/keys": {
       "get": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Shares an Object on a url",
-        "operationId": "ShareObject",
+        "summary": "List KMS keys",
+        "operationId": "KMSListKeys",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "expires",
+            "name": "pattern",
             "in": "query"
           }
         ],
@@ -1250,7 +1165,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/kmsListKeysResponse"
             }
           },
           "default": {
@@ -1260,45 +1175,25 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/buckets/{bucket_name}/objects/tags": {
-      "put": {
+      },
+      "post": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Put Object's tags",
-        "operationId": "PutObjectTags",
+        "summary": "Create a KMS key",
+        "operationId": "KMSCreateKey",
         "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/putObjectTagsRequest"
+              "$ref": "#/definitions/kmsCreateKeyRequest"
             }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "A successful response."
           },
           "default": {
@@ -1310,31 +1205,27 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/objects/upload": {
-      "post": {
-        "consumes": [
-          "multipart/form-data"
-        ],
+    "/admin/kms/keys/{name}": {
+      "get": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Uploads an Object.",
+        "summary": "KMS key status",
+        "operationId": "KMSKeyStatus",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "name",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/kmsKeyStatusResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1343,29 +1234,25 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/buckets/{bucket_name}/replication": {
-      "get": {
+      },
+      "delete": {
+        "description": "The key is only deleted when no bucket encryption uses it, the session must see every bucket of the deployment to verify it",
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Bucket Replication",
-        "operationId": "GetBucketReplication",
+        "summary": "Delete a KMS key",
+        "operationId": "KMSDeleteKey",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "name",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/bucketReplicationResponse"
-            }
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -1376,29 +1263,31 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/replication/{rule_id}": {
-      "delete": {
+    "/admin/kms/keys/{name}/import": {
+      "post": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Bucket Replication Rule Delete",
-        "operationId": "DeleteBucketReplicationRule",
+        "summary": "Import a KMS key",
+        "operationId": "KMSImportKey",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
+            "name": "name",
             "in": "path",
             "required": true
           },
           {
-            "type": "string",
-            "name": "rule_id",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/kmsImportKeyRequest"
+            }
           }
         ],
         "responses": {
-          "204": {
+          "201": {
             "description": "A successful response."
           },
           "default": {
@@ -1410,26 +1299,41 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/retention": {
+    "/admin/kms/status": {
       "get": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Get Bucket's retention config",
-        "operationId": "GetBucketRetentionConfig",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
+        "summary": "KMS status",
+        "operationId": "KMSStatus",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/kmsStatusResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
+        }
+      }
+    },
+    "/admin/nodes": {
+      "get": {
+        "tags": [
+          "AdminAPI"
         ],
+        "summary": "Servers and drives of the cluster",
+        "operationId": "ListNodes",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/getBucketRetentionConfig"
+              "$ref": "#/definitions/nodesResponse"
             }
           },
           "default": {
@@ -1439,32 +1343,34 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/admin/nodes/drives/history": {
+      "get": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Set Bucket's retention config",
-        "operationId": "SetBucketRetentionConfig",
+        "summary": "Drive state transitions",
+        "operationId": "DriveStateHistory",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
+            "name": "drive",
+            "in": "query"
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/putBucketRetentionRequest"
-            }
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/driveHistoryResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1475,37 +1381,26 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/rewind/{date}": {
+    "/admin/nodes/{node}": {
       "get": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Get objects in a bucket for a rewind date",
-        "operationId": "GetBucketRewind",
+        "summary": "Server and drives details",
+        "operationId": "NodeInfo",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "date",
+            "name": "node",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/rewindResponse"
+              "$ref": "#/definitions/nodeInfo"
             }
           },
           "default": {
@@ -1517,26 +1412,18 @@ func init() {
         }
       }
     },
-    "/buckets/{bucket_name}/versioning": {
+    "/admin/notification_endpoints": {
       "get": {
         "tags": [
-          "UserAPI"
-        ],
-        "summary": "Bucket Versioning",
-        "operationId": "GetBucketVersioning",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          }
+          "AdminAPI"
         ],
+        "summary": "Returns a list of active notification endpoints",
+        "operationId": "NotificationEndpointList",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucketVersioningResponse"
+              "$ref": "#/definitions/notifEndpointResponse"
             }
           },
           "default": {
@@ -1547,31 +1434,28 @@ func init() {
           }
         }
       },
-      "put": {
+      "post": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Set Bucket Versioning",
-        "operationId": "SetBucketVersioning",
+        "summary": "Allows to configure a new notification endpoint",
+        "operationId": "AddNotificationEndpoint",
         "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/setBucketVersioning"
+              "$ref": "#/definitions/notificationEndpoint"
             }
           }
         ],
         "responses": {
           "201": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/setNotificationEndpointResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1582,26 +1466,18 @@ func init() {
         }
       }
     },
-    "/buckets/{name}": {
+    "/admin/pools": {
       "get": {
         "tags": [
-          "UserAPI"
-        ],
-        "summary": "Bucket Info",
-        "operationId": "BucketInfo",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          }
+          "AdminAPI"
         ],
+        "summary": "Pools and their decommission status",
+        "operationId": "ListPoolsStatus",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucket"
+              "$ref": "#/definitions/poolsStatusResponse"
             }
           },
           "default": {
@@ -1611,24 +1487,29 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/admin/pools/{pool}/decommission": {
+      "get": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Delete Bucket",
-        "operationId": "DeleteBucket",
+        "summary": "Decommission status of a pool",
+        "operationId": "PoolDecommissionStatus",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "pool",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/poolStatus"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -1637,29 +1518,29 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/buckets/{name}/quota": {
-      "get": {
+      },
+      "post": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Get Bucket Quota",
-        "operationId": "GetBucketQuota",
+        "summary": "Start decommissioning a pool",
+        "operationId": "StartPoolDecommission",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "pool",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "boolean",
+            "name": "force",
+            "in": "query"
           }
         ],
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/bucketQuota"
-            }
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -1669,34 +1550,23 @@ func init() {
           }
         }
       },
-      "put": {
+      "delete": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Bucket Quota",
-        "operationId": "SetBucketQuota",
+        "summary": "Cancel the decommission of a pool",
+        "operationId": "CancelPoolDecommission",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "pool",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/setBucketQuota"
-            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/bucket"
-            }
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -1707,34 +1577,18 @@ func init() {
         }
       }
     },
-    "/buckets/{name}/set-policy": {
-      "put": {
+    "/admin/rebalance": {
+      "get": {
         "tags": [
-          "UserAPI"
-        ],
-        "summary": "Bucket Set Policy",
-        "operationId": "BucketSetPolicy",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/setBucketPolicyRequest"
-            }
-          }
+          "AdminAPI"
         ],
+        "summary": "Progress of the pools rebalance",
+        "operationId": "RebalanceStatus",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/bucket"
+              "$ref": "#/definitions/rebalanceStatusResponse"
             }
           },
           "default": {
@@ -1746,32 +1600,49 @@ func init() {
         }
       }
     },
-    "/configs": {
+    "/admin/site-replication": {
       "get": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "List Configurations",
-        "operationId": "ListConfig",
-        "parameters": [
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
+        "summary": "Get site replication info",
+        "operationId": "SiteReplicationInfo",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/siteReplicationInfo"
+            }
           },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Edit a site of the site replication",
+        "operationId": "SiteReplicationEdit",
+        "parameters": [
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/peerInfo"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listConfigResponse"
+              "$ref": "#/definitions/siteReplicationEditResponse"
             }
           },
           "default": {
@@ -1781,28 +1652,28 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/configs/{name}": {
-      "get": {
+      },
+      "post": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Configuration info",
-        "operationId": "ConfigInfo",
+        "summary": "Add sites to site replication",
+        "operationId": "SiteReplicationAdd",
         "parameters": [
           {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/siteReplicationAddRequest"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/configuration"
+              "$ref": "#/definitions/siteReplicationAddResponse"
             }
           },
           "default": {
@@ -1813,25 +1684,19 @@ func init() {
           }
         }
       },
-      "put": {
+      "delete": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Set Configuration",
-        "operationId": "SetConfig",
+        "summary": "Remove sites from site replication",
+        "operationId": "SiteReplicationRemove",
         "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/setConfigRequest"
+              "$ref": "#/definitions/siteReplicationRemoveRequest"
             }
           }
         ],
@@ -1839,7 +1704,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/setConfigResponse"
+              "$ref": "#/definitions/siteReplicationRemoveResponse"
             }
           },
           "default": {
@@ -1851,24 +1716,36 @@ func init() {
         }
       }
     },
-    "/groups": {
+    "/admin/site-replication/status": {
       "get": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "List Groups",
-        "operationId": "ListGroups",
+        "summary": "Get the site replication sync status",
+        "operationId": "SiteReplicationStatus",
         "parameters": [
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
+            "type": "boolean",
+            "default": true,
+            "name": "buckets",
             "in": "query"
           },
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
+            "type": "boolean",
+            "default": true,
+            "name": "policies",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": true,
+            "name": "users",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": true,
+            "name": "groups",
             "in": "query"
           }
         ],
@@ -1876,7 +1753,30 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listGroupsResponse"
+              "$ref": "#/definitions/siteReplicationStatusResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/tiers": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Returns a list of tiers for ilm",
+        "operationId": "TiersList",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/tierListResponse"
             }
           },
           "default": {
@@ -1891,15 +1791,15 @@ func init() {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Add Group",
-        "operationId": "AddGroup",
+        "summary": "Allows to configure a new tier",
+        "operationId": "AddTier",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/addGroupRequest"
+              "$ref": "#/definitions/tier"
             }
           }
         ],
@@ -1916,14 +1816,25 @@ func init() {
         }
       }
     },
-    "/groups/{name}": {
+    "/admin/tiers/{type}/{name}": {
       "get": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Group info",
-        "operationId": "GroupInfo",
+        "summary": "Get Tier",
+        "operationId": "GetTier",
         "parameters": [
+          {
+            "enum": [
+              "s3",
+              "gcs",
+              "azure"
+            ],
+            "type": "string",
+            "name": "type",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "string",
             "name": "name",
@@ -1935,7 +1846,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/group"
+              "$ref": "#/definitions/tier"
             }
           },
           "default": {
@@ -1945,14 +1856,27 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/admin/tiers/{type}/{name}/credentials": {
       "put": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Update Group Members or Status",
-        "operationId": "UpdateGroup",
+        "summary": "Edit Tier Credentials",
+        "operationId": "EditTierCredentials",
         "parameters": [
+          {
+            "enum": [
+              "s3",
+              "gcs",
+              "azure"
+            ],
+            "type": "string",
+            "name": "type",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "string",
             "name": "name",
@@ -1964,16 +1888,13 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/updateGroupRequest"
+              "$ref": "#/definitions/tierCredentialsRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/group"
-            }
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -1982,24 +1903,21 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/admin/topology": {
+      "get": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Remove group",
-        "operationId": "RemoveGroup",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          }
-        ],
+        "summary": "Pools and erasure sets of the cluster",
+        "operationId": "ClusterTopology",
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/topologyResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -2010,28 +1928,18 @@ func init() {
         }
       }
     },
-    "/has-permission": {
-      "post": {
+    "/admin/webhooks": {
+      "get": {
         "tags": [
-          "UserAPI"
-        ],
-        "summary": "Checks whether the user can perform a series of actions",
-        "operationId": "HasPermissionTo",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/hasPermissionRequest"
-            }
-          }
+          "AdminAPI"
         ],
+        "summary": "List the Console webhooks",
+        "operationId": "ListWebhooks",
         "responses": {
-          "201": {
+          "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/hasPermissionResponse"
+              "$ref": "#/definitions/webhookList"
             }
           },
           "default": {
@@ -2041,30 +1949,28 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/list-external-buckets": {
+      },
       "post": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Lists an External list of buckets using custom credentials",
-        "operationId": "ListExternalBuckets",
+        "summary": "Register a webhook notified of Console events",
+        "operationId": "CreateWebhook",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/listExternalBucketsParams"
+              "$ref": "#/definitions/webhookRequest"
             }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listBucketsResponse"
+              "$ref": "#/definitions/webhook"
             }
           },
           "default": {
@@ -2076,19 +1982,26 @@ func init() {
         }
       }
     },
-    "/login": {
+    "/admin/webhooks/{id}": {
       "get": {
-        "security": [],
         "tags": [
-          "UserAPI"
+          "AdminAPI"
+        ],
+        "summary": "Get a webhook",
+        "operationId": "GetWebhook",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "Returns login strategy, form or sso.",
-        "operationId": "LoginDetail",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/loginDetails"
+              "$ref": "#/definitions/webhook"
             }
           },
           "default": {
@@ -2099,28 +2012,33 @@ func init() {
           }
         }
       },
-      "post": {
-        "security": [],
+      "put": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Login to Console",
-        "operationId": "Login",
+        "summary": "Update a webhook",
+        "operationId": "UpdateWebhook",
         "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/loginRequest"
+              "$ref": "#/definitions/webhookRequest"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "A successful login.",
+          "200": {
+            "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/loginResponse"
+              "$ref": "#/definitions/webhook"
             }
           },
           "default": {
@@ -2130,32 +2048,24 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/login/oauth2/auth": {
-      "post": {
-        "security": [],
+      },
+      "delete": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Identity Provider oauth2 callback endpoint.",
-        "operationId": "LoginOauth2Auth",
+        "summary": "Delete a webhook and its deliveries",
+        "operationId": "DeleteWebhook",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/loginOauth2AuthRequest"
-            }
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
-            "description": "A successful login.",
-            "schema": {
-              "$ref": "#/definitions/loginResponse"
-            }
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -2166,29 +2076,37 @@ func init() {
         }
       }
     },
-    "/login/operator": {
-      "post": {
-        "security": [],
+    "/admin/webhooks/{id}/deliveries": {
+      "get": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Login to Operator Console.",
-        "operationId": "LoginOperator",
+        "summary": "List the latest deliveries of a webhook",
+        "operationId": "ListWebhookDeliveries",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/loginOperatorRequest"
-            }
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
-            "description": "A successful login.",
+          "200": {
+            "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/loginResponse"
+              "$ref": "#/definitions/webhookDeliveryList"
             }
           },
           "default": {
@@ -2200,16 +2118,27 @@ func init() {
         }
       }
     },
-    "/logout": {
+    "/admin/webhooks/{id}/test": {
       "post": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
+        ],
+        "summary": "Send a test event to a webhook",
+        "operationId": "TestWebhook",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "Logout from Console.",
-        "operationId": "Logout",
         "responses": {
-          "200": {
-            "description": "A successful response."
+          "202": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/webhookDelivery"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -2220,51 +2149,73 @@ func init() {
         }
       }
     },
-    "/logs/search": {
+    "/bucket-policy/{bucket}": {
       "get": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Search the logs",
-        "operationId": "LogSearch",
+        "summary": "List Policies With Given Bucket",
+        "operationId": "ListPoliciesWithBucket",
         "parameters": [
           {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multi",
-            "description": "Filter Parameters",
-            "name": "fp",
-            "in": "query"
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "number",
+            "type": "integer",
             "format": "int32",
-            "default": 10,
-            "name": "pageSize",
+            "name": "offset",
             "in": "query"
           },
           {
-            "type": "number",
+            "type": "integer",
             "format": "int32",
-            "default": 0,
-            "name": "pageNo",
+            "name": "limit",
             "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listPoliciesResponse"
+            }
           },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/bucket-users/{bucket}": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List Users With Access to a Given Bucket",
+        "operationId": "ListUsersWithAccessToBucket",
+        "parameters": [
           {
-            "enum": [
-              "timeDesc",
-              "timeAsc"
-            ],
             "type": "string",
-            "default": "timeDesc",
-            "name": "order",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
             "in": "query"
           },
           {
-            "type": "string",
-            "name": "timeStart",
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
             "in": "query"
           }
         ],
@@ -2272,7 +2223,10 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/logSearchResponse"
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
             }
           },
           "default": {
@@ -2284,14 +2238,19 @@ func init() {
         }
       }
     },
-    "/policies": {
+    "/buckets": {
       "get": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "List Policies",
-        "operationId": "ListPolicies",
+        "summary": "List Buckets",
+        "operationId": "ListBuckets",
         "parameters": [
+          {
+            "type": "string",
+            "name": "sort_by",
+            "in": "query"
+          },
           {
             "type": "integer",
             "format": "int32",
@@ -2309,7 +2268,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listPoliciesResponse"
+              "$ref": "#/definitions/listBucketsResponse"
             }
           },
           "default": {
@@ -2322,26 +2281,23 @@ func init() {
       },
       "post": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "Add Policy",
-        "operationId": "AddPolicy",
+        "summary": "Make bucket",
+        "operationId": "MakeBucket",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/addPolicyRequest"
+              "$ref": "#/definitions/makeBucketRequest"
             }
           }
         ],
         "responses": {
           "201": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/policy"
-            }
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -2352,29 +2308,28 @@ func init() {
         }
       }
     },
-    "/policies/{policy}/groups": {
-      "get": {
+    "/buckets-replication": {
+      "post": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "List Groups for a Policy",
-        "operationId": "ListGroupsForPolicy",
+        "summary": "Sets Multi Bucket Replication in multiple Buckets",
+        "operationId": "SetMultiBucketReplication",
         "parameters": [
           {
-            "type": "string",
-            "name": "policy",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/multiBucketReplication"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "$ref": "#/definitions/multiBucketResponseState"
             }
           },
           "default": {
@@ -2386,29 +2341,32 @@ func init() {
         }
       }
     },
-    "/policies/{policy}/users": {
+    "/buckets/{bucket_name}/analytics": {
       "get": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "List Users for a Policy",
-        "operationId": "ListUsersForPolicy",
+        "summary": "Object count, size distribution, top prefixes and storage classes of a bucket",
+        "operationId": "GetBucketAnalytics",
         "parameters": [
           {
             "type": "string",
-            "name": "policy",
+            "name": "bucket_name",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "boolean",
+            "description": "list the bucket even when the data usage is available",
+            "name": "refresh",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "$ref": "#/definitions/bucketAnalytics"
             }
           },
           "default": {
@@ -2420,27 +2378,24 @@ func init() {
         }
       }
     },
-    "/policy": {
-      "get": {
+    "/buckets/{bucket_name}/encryption/disable": {
+      "post": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "Policy info",
-        "operationId": "PolicyInfo",
+        "summary": "Disable bucket encryption.",
+        "operationId": "DisableBucketEncryption",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
-            "in": "query",
+            "name": "bucket_name",
+            "in": "path",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/policy"
-            }
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -2449,23 +2404,33 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/buckets/{bucket_name}/encryption/enable": {
+      "post": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "Remove policy",
-        "operationId": "RemovePolicy",
+        "summary": "Enable bucket encryption.",
+        "operationId": "EnableBucketEncryption",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
-            "in": "query",
+            "name": "bucket_name",
+            "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketEncryptionRequest"
+            }
           }
         ],
         "responses": {
-          "204": {
+          "200": {
             "description": "A successful response."
           },
           "default": {
@@ -2477,28 +2442,26 @@ func init() {
         }
       }
     },
-    "/profiling/start": {
-      "post": {
+    "/buckets/{bucket_name}/encryption/info": {
+      "get": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "Start recording profile data",
-        "operationId": "ProfilingStart",
+        "summary": "Get bucket encryption information.",
+        "operationId": "GetBucketEncryptionInfo",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/profilingStartRequest"
-            }
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/startProfilingList"
+              "$ref": "#/definitions/bucketEncryptionInfo"
             }
           },
           "default": {
@@ -2510,44 +2473,38 @@ func init() {
         }
       }
     },
-    "/profiling/stop": {
-      "post": {
-        "produces": [
-          "application/octet-stream"
-        ],
+    "/buckets/{bucket_name}/events": {
+      "get": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "Stop and download profile data",
-        "operationId": "ProfilingStop",
-        "responses": {
-          "201": {
-            "description": "A successful response.",
-            "schema": {
-              "type": "file"
-            }
+        "summary": "List Bucket Events",
+        "operationId": "ListBucketEvents",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
           },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
           }
-        }
-      }
-    },
-    "/remote-buckets": {
-      "get": {
-        "tags": [
-          "UserAPI"
         ],
-        "summary": "List Remote Buckets",
-        "operationId": "ListRemoteBuckets",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listRemoteBucketsResponse"
+              "$ref": "#/definitions/listBucketEventsResponse"
             }
           },
           "default": {
@@ -2562,15 +2519,21 @@ func init() {
         "tags": [
           "UserAPI"
         ],
-        "summary": "Add Remote Bucket",
-        "operationId": "AddRemoteBucket",
+        "summary": "Create Bucket Event",
+        "operationId": "CreateBucketEvent",
         "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/createRemoteBucket"
+              "$ref": "#/definitions/bucketEventRequest"
             }
           }
         ],
@@ -2587,48 +2550,17 @@ func init() {
         }
       }
     },
-    "/remote-buckets/{name}": {
-      "get": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Remote Bucket Details",
-        "operationId": "RemoteBucketDetails",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/remoteBucket"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/remote-buckets/{source-bucket-name}/{arn}": {
+    "/buckets/{bucket_name}/events/{arn}": {
       "delete": {
         "tags": [
           "UserAPI"
         ],
-        "summary": "Delete Remote Bucket",
-        "operationId": "DeleteRemoteBucket",
+        "summary": "Delete Bucket Event",
+        "operationId": "DeleteBucketEvent",
         "parameters": [
           {
             "type": "string",
-            "name": "source-bucket-name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           },
@@ -2637,6 +2569,14 @@ func init() {
             "name": "arn",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/notificationDeleteRequest"
+            }
           }
         ],
         "responses": {
@@ -2652,32 +2592,26 @@ func init() {
         }
       }
     },
-    "/service-accounts": {
+    "/buckets/{bucket_name}/lifecycle": {
       "get": {
         "tags": [
           "UserAPI"
         ],
-        "summary": "List User's Service Accounts",
-        "operationId": "ListUserServiceAccounts",
+        "summary": "Bucket Lifecycle",
+        "operationId": "GetBucketLifecycle",
         "parameters": [
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/serviceAccounts"
+              "$ref": "#/definitions/bucketLifecycleResponse"
             }
           },
           "default": {
@@ -2692,24 +2626,27 @@ func init() {
         "tags": [
           "UserAPI"
         ],
-        "summary": "Create Service Account",
-        "operationId": "CreateServiceAccount",
+        "summary": "Add Bucket Lifecycle",
+        "operationId": "AddBucketLifecycle",
         "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/serviceAccountRequest"
+              "$ref": "#/definitions/addBucketLifecycle"
             }
           }
         ],
         "responses": {
           "201": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/serviceAccountCreds"
-            }
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -2720,24 +2657,40 @@ func init() {
         }
       }
     },
-    "/service-accounts/{access_key}": {
-      "delete": {
+    "/buckets/{bucket_name}/lifecycle-export": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
           "UserAPI"
         ],
-        "summary": "Delete Service Account",
-        "operationId": "DeleteServiceAccount",
+        "summary": "Export the Bucket Lifecycle configuration",
+        "operationId": "ExportBucketLifecycle",
         "parameters": [
           {
             "type": "string",
-            "name": "access_key",
+            "name": "bucket_name",
             "in": "path",
             "required": true
+          },
+          {
+            "enum": [
+              "xml",
+              "json"
+            ],
+            "type": "string",
+            "default": "xml",
+            "name": "format",
+            "in": "query"
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -2748,38 +2701,34 @@ func init() {
         }
       }
     },
-    "/service/restart": {
+    "/buckets/{bucket_name}/lifecycle-import": {
       "post": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "Restart Service",
-        "operationId": "RestartService",
-        "responses": {
-          "204": {
-            "description": "A successful response."
+        "summary": "Import a Bucket Lifecycle configuration replacing the current one",
+        "operationId": "ImportBucketLifecycle",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
           },
-          "default": {
-            "description": "Generic error response.",
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/bucketLifecycleImport"
             }
           }
-        }
-      }
-    },
-    "/session": {
-      "get": {
-        "tags": [
-          "UserAPI"
         ],
-        "summary": "Endpoint to check if your session is still valid",
-        "operationId": "SessionCheck",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/sessionResponse"
+              "$ref": "#/definitions/bucketLifecycleResponse"
             }
           },
           "default": {
@@ -2791,17 +2740,17 @@ func init() {
         }
       }
     },
-    "/set-policy-multi/{name}": {
-      "put": {
+    "/buckets/{bucket_name}/lifecycle-preview": {
+      "post": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "Set policy to multiple users/groups",
-        "operationId": "SetPolicyMultiple",
+        "summary": "Preview the objects a lifecycle rule or the current configuration would expire or transition",
+        "operationId": "PreviewBucketLifecycle",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           },
@@ -2810,13 +2759,16 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/setPolicyMultipleRequest"
+              "$ref": "#/definitions/lifecyclePreviewRequest"
             }
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/lifecyclePreviewResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -2827,31 +2779,37 @@ func init() {
         }
       }
     },
-    "/set-policy/{name}": {
+    "/buckets/{bucket_name}/lifecycle/{lifecycle_id}": {
       "put": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "Set policy",
-        "operationId": "SetPolicy",
+        "summary": "Update Lifecycle rule",
+        "operationId": "UpdateBucketLifecycle",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           },
           {
-            "name": "body",
-            "in": "body",
+            "type": "string",
+            "name": "lifecycle_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/setPolicyRequest"
+              "$ref": "#/definitions/updateBucketLifecycle"
             }
           }
         ],
         "responses": {
-          "204": {
+          "200": {
             "description": "A successful response."
           },
           "default": {
@@ -2861,21 +2819,30 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/subscription/info": {
-      "get": {
+      },
+      "delete": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
+        ],
+        "summary": "Delete Lifecycle rule",
+        "operationId": "DeleteBucketLifecycleRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "lifecycle_id",
+            "in": "path",
+            "required": true
+          }
         ],
-        "summary": "Subscription info",
-        "operationId": "SubscriptionInfo",
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/license"
-            }
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -2886,24 +2853,28 @@ func init() {
         }
       }
     },
-    "/users": {
+    "/buckets/{bucket_name}/locked-objects": {
       "get": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "List Users",
-        "operationId": "ListUsers",
+        "summary": "List the objects under retention or legal hold",
+        "operationId": "ListLockedObjects",
         "parameters": [
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
             "in": "query"
           },
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
+            "type": "boolean",
+            "name": "all_versions",
             "in": "query"
           }
         ],
@@ -2911,7 +2882,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listUsersResponse"
+              "$ref": "#/definitions/lockedObjectsReport"
             }
           },
           "default": {
@@ -2921,28 +2892,28 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/buckets/{bucket_name}/object-locking": {
+      "get": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "Add User",
-        "operationId": "AddUser",
+        "summary": "Returns the status of object locking support on the bucket",
+        "operationId": "GetBucketObjectLockingStatus",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/addUserRequest"
-            }
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/user"
+              "$ref": "#/definitions/bucketObLockingResponse"
             }
           },
           "default": {
@@ -2954,26 +2925,42 @@ func init() {
         }
       }
     },
-    "/users-groups-bulk": {
-      "put": {
+    "/buckets/{bucket_name}/objects": {
+      "get": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "Bulk functionality to Add Users to Groups",
-        "operationId": "BulkUpdateUsersGroups",
+        "summary": "List Objects",
+        "operationId": "ListObjects",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/bulkUserGroups"
-            }
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "recursive",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "with_versions",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listObjectsResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -2982,29 +2969,45 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/users/{name}": {
-      "get": {
+      },
+      "delete": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "Get User Info",
-        "operationId": "GetUserInfo",
+        "summary": "Delete Object",
+        "operationId": "DeleteObject",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "name": "path",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "recursive",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "bypass",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/user"
-            }
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -3013,34 +3016,42 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/buckets/{bucket_name}/objects/download": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "Update User Info",
-        "operationId": "UpdateUserInfo",
+        "summary": "Download Object",
+        "operationId": "Download Object",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/updateUser"
-            }
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/user"
+              "type": "file"
             }
           },
           "default": {
@@ -3050,23 +3061,45 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/buckets/{bucket_name}/objects/legalhold": {
+      "put": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "Remove user",
-        "operationId": "RemoveUser",
+        "summary": "Put Object's legalhold status",
+        "operationId": "PutObjectLegalHold",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putObjectLegalHoldRequest"
+            }
           }
         ],
         "responses": {
-          "204": {
+          "200": {
             "description": "A successful response."
           },
           "default": {
@@ -3078,17 +3111,17 @@ func init() {
         }
       }
     },
-    "/users/{name}/groups": {
+    "/buckets/{bucket_name}/objects/lock": {
       "put": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "Update Groups for a user",
-        "operationId": "UpdateUserGroups",
+        "summary": "Apply retention or legal hold to the objects under a prefix",
+        "operationId": "BulkObjectLock",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
           },
@@ -3097,7 +3130,7 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/updateUserGroups"
+              "$ref": "#/definitions/bulkObjectLockRequest"
             }
           }
         ],
@@ -3105,7 +3138,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/user"
+              "$ref": "#/definitions/bulkObjectLockSummary"
             }
           },
           "default": {
@@ -3117,27 +3150,82 @@ func init() {
         }
       }
     },
-    "/users/{name}/service-accounts": {
-      "get": {
+    "/buckets/{bucket_name}/objects/retention": {
+      "put": {
         "tags": [
-          "AdminAPI"
+          "UserAPI"
         ],
-        "summary": "returns a list of service accounts for a user",
-        "operationId": "ListAUserServiceAccounts",
+        "summary": "Put Object's retention status",
+        "operationId": "PutObjectRetention",
         "parameters": [
           {
             "type": "string",
-            "name": "name",
+            "name": "bucket_name",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putObjectRetentionRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response.",
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
             "schema": {
-              "$ref": "#/definitions/serviceAccounts"
+              "$ref": "#/definitions/error"
             }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Delete Object retention from an object",
+        "operationId": "DeleteObjectRetention",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -3147,2264 +3235,14293 @@ func init() {
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "accountChangePasswordRequest": {
-      "type": "object",
-      "required": [
-        "current_secret_key",
-        "new_secret_key"
-      ],
-      "properties": {
-        "current_secret_key": {
-          "type": "string"
-        },
-        "new_secret_key": {
-          "type": "string"
-        }
-      }
-    },
-    "addBucketLifecycle": {
-      "type": "object",
-      "properties": {
-        "disable": {
-          "description": "Non required, toggle to disable or enable rule",
-          "type": "boolean"
-        },
-        "expired_object_delete_marker": {
-          "description": "Non required, toggle to disable or enable rule",
-          "type": "boolean"
-        },
-        "expiry_date": {
-          "description": "Required in case of expiry_days or transition fields are not set. it defines an expiry date for ILM",
-          "type": "string"
-        },
-        "expiry_days": {
-          "description": "Required in case of expiry_date or transition fields are not set. it defines an expiry days for ILM",
-          "type": "integer",
-          "format": "int32",
-          "default": 0
-        },
-        "noncurrentversion_expiration_days": {
-          "description": "Non required, can be set in case of expiration is enabled",
-          "type": "integer",
-          "format": "int32",
-          "default": 0
-        },
-        "noncurrentversion_transition_days": {
-          "description": "Non required, can be set in case of transition is enabled",
-          "type": "integer",
-          "format": "int32",
-          "default": 0
-        },
-        "noncurrentversion_transition_storage_class": {
-          "description": "Non required, can be set in case of transition is enabled",
-          "type": "string"
-        },
-        "prefix": {
-          "description": "Non required field, it matches a prefix to perform ILM operations on it",
-          "type": "string"
-        },
-        "storage_class": {
-          "description": "Required only in case of transition is set. it refers to a tier",
-          "type": "string"
-        },
-        "tags": {
-          "description": "Non required field, tags to match ILM files",
-          "type": "string"
-        },
-        "transition_date": {
-          "description": "Required in case of transition_days or expiry fields are not set. it defines a transition date for ILM",
-          "type": "string"
-        },
-        "transition_days": {
-          "description": "Required in case of transition_date or expiry fields are not set. it defines a transition days for ILM",
-          "type": "integer",
-          "format": "int32",
-          "default": 0
-        }
-      }
     },
-    "addBucketReplication": {
-      "type": "object",
-      "properties": {
-        "arn": {
-          "type": "string"
-        },
-        "destination_bucket": {
-          "type": "string"
+    "/buckets/{bucket_name}/objects/share": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Shares an Object on a url",
+        "operationId": "ShareObject",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "expires",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "addGroupRequest": {
-      "type": "object",
-      "required": [
-        "group",
-        "members"
-      ],
-      "properties": {
-        "group": {
-          "type": "string"
-        },
-        "members": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/buckets/{bucket_name}/objects/tags": {
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Put Object's tags",
+        "operationId": "PutObjectTags",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putObjectTagsRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "addPolicyRequest": {
-      "type": "object",
-      "required": [
-        "name",
-        "policy"
-      ],
-      "properties": {
-        "name": {
-          "type": "string"
-        },
-        "policy": {
-          "type": "string"
+    "/buckets/{bucket_name}/objects/upload": {
+      "post": {
+        "consumes": [
+          "multipart/form-data"
+        ],
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Uploads an Object.",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "addUserRequest": {
-      "type": "object",
-      "required": [
-        "accessKey",
-        "secretKey",
-        "groups"
-      ],
-      "properties": {
-        "accessKey": {
-          "type": "string"
-        },
-        "groups": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/buckets/{bucket_name}/replication": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Bucket Replication",
+        "operationId": "GetBucketReplication",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketReplicationResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "secretKey": {
-          "type": "string"
         }
       }
     },
-    "adminInfoResponse": {
-      "type": "object",
-      "properties": {
-        "buckets": {
-          "type": "integer"
-        },
-        "objects": {
-          "type": "integer"
-        },
-        "usage": {
-          "type": "integer"
-        },
-        "widgets": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/widget"
+    "/buckets/{bucket_name}/replication-failed": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "List objects which failed to replicate",
+        "operationId": "ListBucketReplicationFailed",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketReplicationFailedObjects"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "arnsResponse": {
-      "type": "object",
-      "properties": {
-        "arns": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/buckets/{bucket_name}/replication-metrics": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Bucket Replication Metrics",
+        "operationId": "GetBucketReplicationMetrics",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketReplicationMetrics"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "bucket": {
-      "type": "object",
-      "required": [
-        "name"
-      ],
-      "properties": {
-        "access": {
-          "$ref": "#/definitions/bucketAccess"
-        },
-        "creation_date": {
-          "type": "string"
-        },
-        "name": {
-          "type": "string",
-          "minLength": 3
-        },
-        "size": {
-          "type": "integer",
-          "format": "int64"
+    "/buckets/{bucket_name}/replication-order": {
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Reorder Bucket Replication Rules",
+        "operationId": "SetBucketReplicationRulesOrder",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketReplicationRulesOrder"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "bucketAccess": {
-      "type": "string",
-      "default": "PRIVATE",
-      "enum": [
-        "PRIVATE",
-        "PUBLIC",
-        "CUSTOM"
-      ]
+    "/buckets/{bucket_name}/replication-resync": {
+      "post": {
+        "description": "A single target can't be resynced, the resync always covers all the targets of the bucket",
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Resync existing objects to every replication target of the bucket",
+        "operationId": "ResyncBucketReplication",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/replicationResyncRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/replicationResyncResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
     },
-    "bucketEncryptionInfo": {
-      "type": "object",
-      "properties": {
-        "algorithm": {
-          "type": "string"
-        },
-        "kmsMasterKeyID": {
-          "type": "string"
+    "/buckets/{bucket_name}/replication-retry": {
+      "post": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Retry failed replications",
+        "operationId": "RetryBucketReplication",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/replicationRetryRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/replicationRetryResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "bucketEncryptionRequest": {
-      "type": "object",
-      "properties": {
-        "encType": {
-          "$ref": "#/definitions/bucketEncryptionType"
-        },
-        "kmsKeyID": {
-          "type": "string"
+    "/buckets/{bucket_name}/replication/{rule_id}": {
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Update Bucket Replication Rule",
+        "operationId": "UpdateBucketReplicationRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "rule_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/updateBucketReplicationRule"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/replicationRuleUpdateResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "bucketEncryptionType": {
-      "type": "string",
-      "default": "sse-s3",
-      "enum": [
-        "sse-s3",
-        "sse-kms"
-      ]
-    },
-    "bucketEventRequest": {
-      "type": "object",
-      "required": [
-        "configuration"
-      ],
-      "properties": {
-        "configuration": {
-          "$ref": "#/definitions/notificationConfig"
-        },
-        "ignoreExisting": {
-          "type": "boolean"
+      },
+      "delete": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Bucket Replication Rule Delete",
+        "operationId": "DeleteBucketReplicationRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "rule_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "bucketLifecycleResponse": {
-      "type": "object",
-      "properties": {
-        "lifecycle": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/objectBucketLifecycle"
+    "/buckets/{bucket_name}/replication/{rule_id}/status": {
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Enable or disable a Bucket Replication Rule",
+        "operationId": "SetBucketReplicationRuleStatus",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "rule_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketReplicationRuleStatus"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/replicationRuleUpdateResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "bucketObLockingResponse": {
-      "type": "object",
-      "properties": {
-        "object_locking_enabled": {
-          "type": "boolean"
+    "/buckets/{bucket_name}/restore": {
+      "post": {
+        "description": "The summary lists the first 1000 objects, the restore websocket reports every object of larger restores",
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Restore a bucket or prefix to a point in time",
+        "operationId": "RestoreBucket",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/restoreRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/restoreSummary"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "bucketObject": {
-      "type": "object",
-      "properties": {
-        "content_type": {
-          "type": "string"
-        },
-        "expiration": {
-          "type": "string"
-        },
-        "expiration_rule_id": {
-          "type": "string"
-        },
-        "is_delete_marker": {
-          "type": "boolean"
-        },
-        "is_latest": {
-          "type": "boolean"
-        },
-        "last_modified": {
-          "type": "string"
-        },
-        "legal_hold_status": {
-          "type": "string"
-        },
-        "name": {
-          "type": "string"
-        },
-        "retention_mode": {
-          "type": "string"
-        },
-        "retention_until_date": {
-          "type": "string"
-        },
-        "size": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "tags": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
-          }
-        },
-        "user_tags": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
+    "/buckets/{bucket_name}/retention": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Get Bucket's retention config",
+        "operationId": "GetBucketRetentionConfig",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/getBucketRetentionConfig"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Set Bucket's retention config",
+        "operationId": "SetBucketRetentionConfig",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putBucketRetentionRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "version_id": {
-          "type": "string"
         }
       }
     },
-    "bucketQuota": {
-      "type": "object",
-      "properties": {
-        "quota": {
-          "type": "integer"
-        },
-        "type": {
-          "type": "string",
-          "enum": [
-            "hard",
-            "fifo"
-          ]
+    "/buckets/{bucket_name}/rewind/{date}": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Get objects in a bucket for a rewind date",
+        "operationId": "GetBucketRewind",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "date",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/rewindResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "bucketReplicationDestination": {
-      "type": "object",
-      "properties": {
-        "bucket": {
-          "type": "string"
+    "/buckets/{bucket_name}/versioning": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Bucket Versioning",
+        "operationId": "GetBucketVersioning",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketVersioningResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Set Bucket Versioning",
+        "operationId": "SetBucketVersioning",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setBucketVersioning"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "bucketReplicationResponse": {
-      "type": "object",
-      "properties": {
-        "rules": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/bucketReplicationRule"
+    "/buckets/{bucket_name}/versions-cleanup": {
+      "post": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Remove noncurrent versions and orphaned delete markers",
+        "operationId": "CleanupBucketVersions",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/versionsCleanupRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/versionsCleanupSummary"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "bucketReplicationRule": {
-      "type": "object",
-      "properties": {
-        "bandwidth": {
-          "type": "string"
-        },
-        "delete_marker_replication": {
-          "type": "boolean"
-        },
-        "deletes_replication": {
-          "type": "boolean"
-        },
-        "destination": {
-          "$ref": "#/definitions/bucketReplicationDestination"
-        },
-        "healthCheckPeriod": {
-          "type": "integer"
-        },
-        "id": {
-          "type": "string"
-        },
-        "metadata_replication": {
-          "type": "boolean"
-        },
-        "prefix": {
-          "type": "string"
-        },
-        "priority": {
-          "type": "integer",
-          "format": "int32"
-        },
-        "status": {
-          "type": "string",
-          "enum": [
-            "Enabled",
-            "Disabled"
-          ]
-        },
-        "syncMode": {
-          "type": "string",
-          "default": "async",
-          "enum": [
-            "async",
-            "sync"
-          ]
-        },
-        "tags": {
-          "type": "string"
+    "/buckets/{bucket_name}/versions-stats": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Version counts and noncurrent size per prefix",
+        "operationId": "GetBucketVersionsStats",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/versionsStatsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "bucketVersioningResponse": {
-      "type": "object",
-      "properties": {
-        "is_versioned": {
-          "type": "boolean"
+    "/buckets/{name}": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Bucket Info",
+        "operationId": "BucketInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucket"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "bulkUserGroups": {
-      "type": "object",
-      "required": [
-        "users",
-        "groups"
-      ],
-      "properties": {
-        "groups": {
-          "type": "array",
-          "items": {
-            "type": "string"
+      },
+      "delete": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Delete Bucket",
+        "operationId": "DeleteBucket",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
           }
-        },
-        "users": {
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "changeUserPasswordRequest": {
-      "type": "object",
-      "required": [
-        "selectedUser",
-        "newSecretKey"
-      ],
-      "properties": {
-        "newSecretKey": {
-          "type": "string"
-        },
-        "selectedUser": {
-          "type": "string"
+    "/buckets/{name}/quota": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Get Bucket Quota",
+        "operationId": "GetBucketQuota",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketQuota"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "configDescription": {
-      "type": "object",
-      "properties": {
-        "description": {
-          "type": "string"
-        },
-        "key": {
-          "type": "string"
+      },
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Bucket Quota",
+        "operationId": "SetBucketQuota",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setBucketQuota"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucket"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "configuration": {
-      "type": "object",
-      "properties": {
-        "key_values": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/configurationKV"
+    "/buckets/{name}/set-policy": {
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Bucket Set Policy",
+        "operationId": "BucketSetPolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setBucketPolicyRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucket"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "name": {
-          "type": "string"
         }
       }
     },
-    "configurationKV": {
-      "type": "object",
-      "properties": {
-        "key": {
-          "type": "string"
-        },
-        "value": {
-          "type": "string"
+    "/buckets/{name}/soft-quota": {
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Set the soft quota of a bucket",
+        "operationId": "SetBucketSoftQuota",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketSoftQuota"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "createRemoteBucket": {
-      "required": [
-        "accessKey",
-        "secretKey",
-        "targetURL",
-        "sourceBucket",
-        "targetBucket"
-      ],
-      "properties": {
-        "accessKey": {
-          "type": "string",
-          "minLength": 3
-        },
-        "bandwidth": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "healthCheckPeriod": {
-          "type": "integer",
-          "format": "int32"
-        },
-        "region": {
-          "type": "string"
-        },
-        "secretKey": {
-          "type": "string",
-          "minLength": 8
-        },
-        "sourceBucket": {
-          "type": "string"
-        },
-        "syncMode": {
-          "type": "string",
-          "default": "async",
-          "enum": [
-            "async",
-            "sync"
-          ]
-        },
-        "targetBucket": {
-          "type": "string"
-        },
-        "targetURL": {
-          "type": "string"
+      },
+      "delete": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Remove the soft quota of a bucket",
+        "operationId": "DeleteBucketSoftQuota",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "directCSIDriveInfo": {
-      "type": "object",
-      "properties": {
-        "allocated": {
-          "type": "number",
-          "format": "int64"
-        },
-        "capacity": {
-          "type": "number",
-          "format": "int64"
-        },
-        "drive": {
-          "type": "string"
-        },
-        "message": {
-          "type": "string"
-        },
-        "node": {
-          "type": "string"
-        },
-        "status": {
-          "type": "string"
-        },
-        "volumes": {
-          "type": "number",
-          "format": "int64"
+    "/configs": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List Configurations",
+        "operationId": "ListConfig",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listConfigResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "directCSIVolumeInfo": {
-      "type": "object",
-      "properties": {
-        "capacity": {
-          "type": "number",
-          "format": "int64"
-        },
-        "drive": {
-          "type": "string"
-        },
-        "node": {
-          "type": "string"
-        },
-        "volume": {
-          "type": "string"
+    "/configs-export": {
+      "get": {
+        "description": "The values of the secrets like passwords and tokens are masked",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Export the full server configuration",
+        "operationId": "ExportConfig",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "error": {
-      "type": "object",
-      "required": [
-        "message"
-      ],
-      "properties": {
-        "code": {
-          "type": "integer",
-          "format": "int32"
-        },
-        "message": {
-          "type": "string"
+    "/configs-history": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List the configuration revisions",
+        "operationId": "ListConfigHistory",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/configHistoryResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Take a snapshot of the configuration",
+        "operationId": "CreateConfigSnapshot",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/configSnapshotRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/configRevision"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "expirationResponse": {
-      "type": "object",
-      "properties": {
-        "date": {
-          "type": "string"
-        },
-        "days": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "delete_marker": {
-          "type": "boolean"
-        }
-      }
-    },
-    "formatConfiguration": {
-      "type": "object",
-      "required": [
-        "drives",
-        "force"
-      ],
-      "properties": {
-        "drives": {
-          "type": "array",
-          "minLength": 1,
-          "items": {
-            "type": "string"
+    "/configs-history/diff": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Compare two configuration revisions",
+        "operationId": "DiffConfigRevisions",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "from",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "to",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/configDiffResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "force": {
-          "type": "boolean"
-        }
-      }
-    },
-    "getBucketRetentionConfig": {
-      "type": "object",
-      "properties": {
-        "mode": {
-          "$ref": "#/definitions/objectRetentionMode"
-        },
-        "unit": {
-          "$ref": "#/definitions/objectRetentionUnit"
-        },
-        "validity": {
-          "type": "integer",
-          "format": "int32"
         }
       }
     },
-    "getDirectCSIDriveListResponse": {
-      "type": "object",
-      "properties": {
-        "drives": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/directCSIDriveInfo"
+    "/configs-history/{id}/restore": {
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Restore the configuration of a revision",
+        "operationId": "RestoreConfigRevision",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/configRestoreResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "getDirectCSIVolumeListResponse": {
-      "type": "object",
-      "properties": {
-        "volumes": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/directCSIVolumeInfo"
+    "/configs/{name}": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Configuration info",
+        "operationId": "ConfigInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/configuration"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      }
-    },
-    "group": {
-      "type": "object",
-      "properties": {
-        "members": {
-          "type": "array",
-          "items": {
-            "type": "string"
+      },
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Set Configuration",
+        "operationId": "SetConfig",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setConfigRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/setConfigResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "name": {
-          "type": "string"
-        },
-        "policy": {
-          "type": "string"
-        },
-        "status": {
-          "type": "string"
         }
       }
     },
-    "hasPermissionRequest": {
-      "type": "object",
-      "properties": {
-        "actions": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/policyArgs"
+    "/configs/{name}/reset": {
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Reset configuration keys to their defaults",
+        "operationId": "ResetConfig",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/resetConfigRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/setConfigResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "hasPermissionResponse": {
-      "type": "object",
-      "properties": {
-        "permissions": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/permissionAction"
+    "/configs/{name}/targets": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List the targets of a configuration subsystem",
+        "operationId": "ListConfigTargets",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/configTargetsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "iamEntity": {
-      "type": "string",
-      "pattern": "^[\\w+=,.@-]{1,64}$"
-    },
-    "idpConfiguration": {
-      "type": "object",
-      "properties": {
-        "active_directory": {
-          "type": "object",
-          "required": [
-            "url",
-            "username_format",
-            "user_search_filter"
-          ],
-          "properties": {
-            "group_name_attribute": {
-              "type": "string"
-            },
-            "group_search_base_dn": {
-              "type": "string"
-            },
-            "group_search_filter": {
-              "type": "string"
-            },
-            "server_insecure": {
-              "type": "boolean"
-            },
-            "skip_tls_verification": {
-              "type": "boolean"
-            },
-            "url": {
-              "type": "string"
-            },
-            "user_search_filter": {
-              "type": "string"
-            },
-            "username_format": {
-              "type": "string"
+    "/configs/{name}/targets/{target}": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Get a configuration target",
+        "operationId": "GetConfigTarget",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "target",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/configTarget"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           }
-        },
-        "keys": {
-          "type": "array",
-          "items": {
-            "type": "object",
-            "required": [
-              "access_key",
-              "secret_key"
-            ],
-            "properties": {
-              "access_key": {
-                "type": "string"
-              },
-              "secret_key": {
-                "type": "string"
-              }
+        }
+      },
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Set a configuration target",
+        "operationId": "SetConfigTarget",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "target",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setConfigTargetRequest"
             }
           }
-        },
-        "oidc": {
-          "type": "object",
-          "required": [
-            "url",
-            "client_id",
-            "secret_id"
-          ],
-          "properties": {
-            "client_id": {
-              "type": "string"
-            },
-            "secret_id": {
-              "type": "string"
-            },
-            "url": {
-              "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/setConfigResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           }
         }
-      }
-    },
-    "keyPairConfiguration": {
-      "type": "object",
-      "required": [
-        "crt",
-        "key"
-      ],
-      "properties": {
-        "crt": {
-          "type": "string"
-        },
-        "key": {
-          "type": "string"
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Delete a configuration target",
+        "operationId": "DeleteConfigTarget",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "target",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/setConfigResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "license": {
-      "type": "object",
-      "properties": {
-        "account_id": {
-          "type": "integer"
-        },
-        "email": {
-          "type": "string"
-        },
-        "expires_at": {
-          "type": "string"
-        },
-        "organization": {
-          "type": "string"
-        },
-        "plan": {
-          "type": "string"
-        },
-        "storage_capacity": {
-          "type": "integer"
+    "/groups": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List Groups",
+        "operationId": "ListGroups",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listGroupsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Add Group",
+        "operationId": "AddGroup",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/addGroupRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "lifecycleTag": {
-      "type": "object",
-      "properties": {
-        "key": {
-          "type": "string"
-        },
-        "value": {
-          "type": "string"
-        }
-      }
-    },
-    "listBucketEventsResponse": {
-      "type": "object",
-      "properties": {
-        "events": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/notificationConfig"
+    "/groups/{name}": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Group info",
+        "operationId": "GroupInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
           }
-        },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "total number of bucket events"
-        }
-      }
-    },
-    "listBucketsResponse": {
-      "type": "object",
-      "properties": {
-        "buckets": {
-          "type": "array",
-          "title": "list of resulting buckets",
-          "items": {
-            "$ref": "#/definitions/bucket"
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/group"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "number of buckets accessible to the user"
         }
-      }
-    },
-    "listConfigResponse": {
-      "type": "object",
-      "properties": {
-        "configurations": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/configDescription"
+      },
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Update Group Members or Status",
+        "operationId": "UpdateGroup",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/updateGroupRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/group"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "total number of configurations"
         }
-      }
-    },
-    "listExternalBucketsParams": {
-      "required": [
-        "accessKey",
-        "secretKey",
-        "targetURL",
-        "useTLS"
-      ],
-      "properties": {
-        "accessKey": {
-          "type": "string",
-          "minLength": 3
-        },
-        "region": {
-          "type": "string"
-        },
-        "secretKey": {
-          "type": "string",
-          "minLength": 8
-        },
-        "targetURL": {
-          "type": "string"
-        },
-        "useTLS": {
-          "type": "boolean"
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Remove group",
+        "operationId": "RemoveGroup",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "listGroupsResponse": {
-      "type": "object",
-      "properties": {
-        "groups": {
-          "type": "array",
-          "title": "list of groups",
-          "items": {
-            "type": "string"
+    "/has-permission": {
+      "post": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Checks whether the user can perform a series of actions",
+        "operationId": "HasPermissionTo",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/hasPermissionRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/hasPermissionResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "total number of groups"
         }
       }
     },
-    "listObjectsResponse": {
-      "type": "object",
-      "properties": {
-        "objects": {
-          "type": "array",
-          "title": "list of resulting objects",
-          "items": {
-            "$ref": "#/definitions/bucketObject"
+    "/list-external-buckets": {
+      "post": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Lists an External list of buckets using custom credentials",
+        "operationId": "ListExternalBuckets",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/listExternalBucketsParams"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listBucketsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "number of objects"
         }
       }
     },
-    "listPoliciesResponse": {
-      "type": "object",
-      "properties": {
-        "policies": {
-          "type": "array",
-          "title": "list of policies",
-          "items": {
-            "$ref": "#/definitions/policy"
-          }
-        },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "total number of policies"
-        }
-      }
-    },
-    "listRemoteBucketsResponse": {
-      "type": "object",
-      "properties": {
-        "buckets": {
-          "type": "array",
-          "title": "list of remote buckets",
-          "items": {
-            "$ref": "#/definitions/remoteBucket"
+    "/login": {
+      "get": {
+        "security": [],
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Returns login strategy, form or sso.",
+        "operationId": "LoginDetail",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/loginDetails"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "number of remote buckets accessible to user"
         }
-      }
-    },
-    "listUsersResponse": {
-      "type": "object",
-      "properties": {
-        "users": {
-          "type": "array",
-          "title": "list of resulting users",
-          "items": {
-            "$ref": "#/definitions/user"
+      },
+      "post": {
+        "security": [],
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Login to Console",
+        "operationId": "Login",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/loginRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful login.",
+            "schema": {
+              "$ref": "#/definitions/loginResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "logSearchConfiguration": {
-      "type": "object",
-      "properties": {
-        "image": {
-          "type": "string"
-        },
-        "postgres_image": {
-          "type": "string"
-        },
-        "storageClass": {
-          "type": "string",
-          "default": ""
-        },
-        "storageSize": {
-          "type": "number",
-          "default": 5
-        }
-      }
-    },
-    "logSearchResponse": {
-      "type": "object",
-      "properties": {
-        "results": {
-          "type": "object",
-          "title": "list of log search responses"
-        }
-      }
-    },
-    "loginDetails": {
-      "type": "object",
-      "properties": {
-        "loginStrategy": {
-          "type": "string",
-          "enum": [
-            "form",
-            "redirect",
-            "service-account"
-          ]
-        },
-        "redirect": {
-          "type": "string"
-        }
-      }
-    },
-    "loginOauth2AuthRequest": {
-      "type": "object",
-      "required": [
-        "state",
-        "code"
-      ],
-      "properties": {
-        "code": {
-          "type": "string"
-        },
-        "state": {
-          "type": "string"
-        }
-      }
-    },
-    "loginOperatorRequest": {
-      "type": "object",
-      "required": [
-        "jwt"
-      ],
-      "properties": {
-        "jwt": {
-          "type": "string"
+    "/login/oauth2/auth": {
+      "post": {
+        "security": [],
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Identity Provider oauth2 callback endpoint.",
+        "operationId": "LoginOauth2Auth",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/loginOauth2AuthRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful login.",
+            "schema": {
+              "$ref": "#/definitions/loginResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "loginRequest": {
-      "type": "object",
-      "required": [
-        "accessKey",
-        "secretKey"
-      ],
-      "properties": {
-        "accessKey": {
-          "type": "string"
-        },
-        "secretKey": {
-          "type": "string"
+    "/login/operator": {
+      "post": {
+        "security": [],
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Login to Operator Console.",
+        "operationId": "LoginOperator",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/loginOperatorRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful login.",
+            "schema": {
+              "$ref": "#/definitions/loginResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "loginResponse": {
-      "type": "object",
-      "properties": {
-        "sessionId": {
-          "type": "string"
+    "/logout": {
+      "post": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Logout from Console.",
+        "operationId": "Logout",
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "makeBucketRequest": {
-      "type": "object",
-      "required": [
-        "name"
-      ],
-      "properties": {
-        "locking": {
-          "type": "boolean"
-        },
-        "name": {
-          "type": "string"
-        },
-        "quota": {
-          "$ref": "#/definitions/setBucketQuota"
+    "/logs/search": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Search the logs",
+        "operationId": "LogSearch",
+        "parameters": [
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Filter Parameters",
+            "name": "fp",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int32",
+            "default": 10,
+            "name": "pageSize",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int32",
+            "default": 0,
+            "name": "pageNo",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "timeDesc",
+              "timeAsc"
+            ],
+            "type": "string",
+            "default": "timeDesc",
+            "name": "order",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "timeStart",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "timeEnd",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "reqinfo",
+              "raw",
+              "statsinfo"
+            ],
+            "type": "string",
+            "default": "reqinfo",
+            "description": "Query type",
+            "name": "q",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "object",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "apiName",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int32",
+            "name": "statusCode",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "User or access key that performed the request",
+            "name": "accessKey",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int64",
+            "description": "Minimum time to response in milliseconds",
+            "name": "responseTimeMin",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int64",
+            "description": "Maximum time to response in milliseconds",
+            "name": "responseTimeMax",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int32",
+            "default": 60,
+            "description": "Interval in seconds of the aggregated stats",
+            "name": "interval",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/logSearchResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/logs/search/export": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Export the results of a log search",
+        "operationId": "LogSearchExport",
+        "parameters": [
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Filter Parameters",
+            "name": "fp",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "timeDesc",
+              "timeAsc"
+            ],
+            "type": "string",
+            "default": "timeDesc",
+            "name": "order",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "timeStart",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "timeEnd",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "reqinfo",
+              "raw"
+            ],
+            "type": "string",
+            "default": "reqinfo",
+            "description": "Query type",
+            "name": "q",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "object",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "apiName",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int32",
+            "name": "statusCode",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "User or access key that performed the request",
+            "name": "accessKey",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int64",
+            "description": "Minimum time to response in milliseconds",
+            "name": "responseTimeMin",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int64",
+            "description": "Maximum time to response in milliseconds",
+            "name": "responseTimeMax",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "csv",
+              "json"
+            ],
+            "type": "string",
+            "default": "csv",
+            "name": "format",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/policies": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List Policies",
+        "operationId": "ListPolicies",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listPoliciesResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Add Policy",
+        "operationId": "AddPolicy",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/addPolicyRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/policy"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/policies/{policy}/groups": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List Groups for a Policy",
+        "operationId": "ListGroupsForPolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "policy",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/policies/{policy}/users": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List Users for a Policy",
+        "operationId": "ListUsersForPolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "policy",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/policy": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Policy info",
+        "operationId": "PolicyInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/policy"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Remove policy",
+        "operationId": "RemovePolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/profiling/captures": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List the profiling captures kept by Console",
+        "operationId": "ListProfilingCaptures",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/profilingCaptureList"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/profiling/captures/{capture_id}": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Download a stored profiling capture",
+        "operationId": "DownloadProfilingCapture",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "capture_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/profiling/captures/{capture_id}/summary": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Top functions per node from the CPU profile of a capture",
+        "operationId": "ProfilingCaptureSummary",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "capture_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "top",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/profilingSummary"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/profiling/start": {
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Start recording profile data",
+        "operationId": "ProfilingStart",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/profilingStartRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/startProfilingList"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/profiling/stop": {
+      "post": {
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Stop and download profile data",
+        "operationId": "ProfilingStop",
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/quotas": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Quota, usage and soft quota of every bucket",
+        "operationId": "ListBucketQuotas",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketQuotaOverview"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Set the quota of the buckets matching a name pattern",
+        "operationId": "SetBucketQuotas",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketQuotaBulkRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketQuotaBulkResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/remote-buckets": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "List Remote Buckets",
+        "operationId": "ListRemoteBuckets",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listRemoteBucketsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Add Remote Bucket",
+        "operationId": "AddRemoteBucket",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createRemoteBucket"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/remote-buckets/{name}": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Remote Bucket Details",
+        "operationId": "RemoteBucketDetails",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/remoteBucket"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/remote-buckets/{source-bucket-name}/{arn}": {
+      "delete": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Delete Remote Bucket",
+        "operationId": "DeleteRemoteBucket",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "source-bucket-name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "arn",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/service-accounts": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "List User's Service Accounts",
+        "operationId": "ListUserServiceAccounts",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccounts"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Create Service Account",
+        "operationId": "CreateServiceAccount",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/serviceAccountRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccountCreds"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/service-accounts/{access_key}": {
+      "delete": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Delete Service Account",
+        "operationId": "DeleteServiceAccount",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "access_key",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/service/restart": {
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Restart Service",
+        "operationId": "RestartService",
+        "parameters": [
+          {
+            "type": "boolean",
+            "description": "Make sure every server and drive is healthy before restarting",
+            "name": "check",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/service/status": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Status of the MinIO servers",
+        "operationId": "ServiceStatus",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "expected_version",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "restarted_after",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceStatusResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/service/stop": {
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Stop Service",
+        "operationId": "StopService",
+        "parameters": [
+          {
+            "type": "boolean",
+            "name": "force",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/service/update": {
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Update the MinIO servers",
+        "operationId": "UpdateServer",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/serverUpdateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serverUpdateResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/session": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Endpoint to check if your session is still valid",
+        "operationId": "SessionCheck",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/sessionResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/set-policy-multi/{name}": {
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Set policy to multiple users/groups",
+        "operationId": "SetPolicyMultiple",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setPolicyMultipleRequest"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/set-policy/{name}": {
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Set policy",
+        "operationId": "SetPolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setPolicyRequest"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/subscription/info": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Subscription info",
+        "operationId": "SubscriptionInfo",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/license"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/users": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List Users",
+        "operationId": "ListUsers",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listUsersResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Add User",
+        "operationId": "AddUser",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/addUserRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/users-groups-bulk": {
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Bulk functionality to Add Users to Groups",
+        "operationId": "BulkUpdateUsersGroups",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bulkUserGroups"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/users/{name}": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Get User Info",
+        "operationId": "GetUserInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Update User Info",
+        "operationId": "UpdateUserInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/updateUser"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Remove user",
+        "operationId": "RemoveUser",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/users/{name}/groups": {
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Update Groups for a user",
+        "operationId": "UpdateUserGroups",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/updateUserGroups"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/users/{name}/service-accounts": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "returns a list of service accounts for a user",
+        "operationId": "ListAUserServiceAccounts",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccounts"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "accountChangePasswordRequest": {
+      "type": "object",
+      "required": [
+        "current_secret_key",
+        "new_secret_key"
+      ],
+      "properties": {
+        "current_secret_key": {
+          "type": "string"
+        },
+        "new_secret_key": {
+          "type": "string"
+        }
+      }
+    },
+    "addBucketLifecycle": {
+      "type": "object",
+      "properties": {
+        "abort_incomplete_multipart_days": {
+          "description": "Non required, days after which incomplete multipart uploads are aborted, it can't be set along with tags",
+          "type": "integer",
+          "format": "int32"
+        },
+        "disable": {
+          "description": "Non required, toggle to disable or enable rule",
+          "type": "boolean"
+        },
+        "expired_object_delete_marker": {
+          "description": "Non required, toggle to disable or enable rule",
+          "type": "boolean"
+        },
+        "expiry_date": {
+          "description": "Required in case of expiry_days or transition fields are not set. it defines an expiry date for ILM",
+          "type": "string"
+        },
+        "expiry_days": {
+          "description": "Required in case of expiry_date or transition fields are not set. it defines an expiry days for ILM",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        },
+        "noncurrentversion_expiration_days": {
+          "description": "Non required, can be set in case of expiration is enabled",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        },
+        "noncurrentversion_transition_days": {
+          "description": "Non required, can be set in case of transition is enabled",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        },
+        "noncurrentversion_transition_storage_class": {
+          "description": "Non required, can be set in case of transition is enabled",
+          "type": "string"
+        },
+        "prefix": {
+          "description": "Non required field, it matches a prefix to perform ILM operations on it",
+          "type": "string"
+        },
+        "storage_class": {
+          "description": "Required only in case of transition is set. it refers to a tier",
+          "type": "string"
+        },
+        "tags": {
+          "description": "Non required field, tags to match ILM files, several tags are separated by \u0026 (e.g. key1=value1\u0026key2=value2)",
+          "type": "string"
+        },
+        "transition_date": {
+          "description": "Required in case of transition_days or expiry fields are not set. it defines a transition date for ILM",
+          "type": "string"
+        },
+        "transition_days": {
+          "description": "Required in case of transition_date or expiry fields are not set. it defines a transition days for ILM",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        }
+      }
+    },
+    "addBucketReplication": {
+      "type": "object",
+      "properties": {
+        "arn": {
+          "type": "string"
+        },
+        "destination_bucket": {
+          "type": "string"
+        }
+      }
+    },
+    "addGroupRequest": {
+      "type": "object",
+      "required": [
+        "group",
+        "members"
+      ],
+      "properties": {
+        "group": {
+          "type": "string"
+        },
+        "members": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "addPolicyRequest": {
+      "type": "object",
+      "required": [
+        "name",
+        "policy"
+      ],
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
+        }
+      }
+    },
+    "addUserRequest": {
+      "type": "object",
+      "required": [
+        "accessKey",
+        "secretKey",
+        "groups"
+      ],
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "secretKey": {
+          "type": "string"
+        }
+      }
+    },
+    "adminInfoResponse": {
+      "type": "object",
+      "properties": {
+        "buckets": {
+          "type": "integer"
+        },
+        "objects": {
+          "type": "integer"
+        },
+        "usage": {
+          "type": "integer"
+        },
+        "widgets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/widget"
+          }
+        }
+      }
+    },
+    "alert": {
+      "type": "object",
+      "properties": {
+        "activeAt": {
+          "type": "string"
+        },
+        "firedAt": {
+          "type": "string"
+        },
+        "labels": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "resolvedAt": {
+          "type": "string"
+        },
+        "ruleId": {
+          "type": "string"
+        },
+        "ruleName": {
+          "type": "string"
+        },
+        "severity": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "firing",
+            "resolved"
+          ]
+        },
+        "value": {
+          "type": "number"
+        }
+      }
+    },
+    "alertList": {
+      "type": "object",
+      "properties": {
+        "alerts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/alert"
+          }
+        }
+      }
+    },
+    "alertRule": {
+      "type": "object",
+      "required": [
+        "name",
+        "expr"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "disabled": {
+          "type": "boolean"
+        },
+        "expr": {
+          "type": "string",
+          "title": "PromQL expression, ${jobid} is replaced with the Prometheus job id"
+        },
+        "for": {
+          "type": "integer",
+          "format": "int64",
+          "title": "seconds the condition must hold before the alert fires"
+        },
+        "id": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "operator": {
+          "type": "string",
+          "enum": [
+            "gt",
+            "ge",
+            "lt",
+            "le",
+            "eq",
+            "ne"
+          ]
+        },
+        "severity": {
+          "type": "string",
+          "enum": [
+            "info",
+            "warning",
+            "critical"
+          ]
+        },
+        "threshold": {
+          "type": "number"
+        }
+      }
+    },
+    "alertRuleList": {
+      "type": "object",
+      "properties": {
+        "rules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/alertRule"
+          }
+        }
+      }
+    },
+    "alertTarget": {
+      "type": "object",
+      "required": [
+        "name",
+        "type"
+      ],
+      "properties": {
+        "authToken": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "smtpFrom": {
+          "type": "string"
+        },
+        "smtpHost": {
+          "type": "string"
+        },
+        "smtpPassword": {
+          "type": "string"
+        },
+        "smtpPort": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "smtpTo": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "smtpUsername": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "webhook",
+            "smtp"
+          ]
+        },
+        "url": {
+          "type": "string"
+        }
+      }
+    },
+    "alertTargetList": {
+      "type": "object",
+      "properties": {
+        "targets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/alertTarget"
+          }
+        }
+      }
+    },
+    "arnsResponse": {
+      "type": "object",
+      "properties": {
+        "arns": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "bucket": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "access": {
+          "$ref": "#/definitions/bucketAccess"
+        },
+        "creation_date": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string",
+          "minLength": 3
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "bucketAccess": {
+      "type": "string",
+      "default": "PRIVATE",
+      "enum": [
+        "PRIVATE",
+        "PUBLIC",
+        "CUSTOM"
+      ]
+    },
+    "bucketAnalytics": {
+      "type": "object",
+      "properties": {
+        "bucket": {
+          "type": "string"
+        },
+        "delete_markers": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "error": {
+          "type": "string"
+        },
+        "histogram": {
+          "type": "array",
+          "title": "object sizes histogram, the data usage doesn't split the sizes above 512MiB",
+          "items": {
+            "$ref": "#/definitions/sizeHistogramBucket"
+          }
+        },
+        "objects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "scanned": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "source": {
+          "type": "string",
+          "title": "where the values come from, data-usage, listing or data-usage-and-listing when the totals and histogram come from the data usage and the rest from a listing"
+        },
+        "status": {
+          "type": "string",
+          "title": "status of the listing, queued, running, ready or failed"
+        },
+        "storage_classes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/storageClassUsage"
+          }
+        },
+        "top_prefixes_by_count": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/prefixUsage"
+          }
+        },
+        "top_prefixes_by_size": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/prefixUsage"
+          }
+        },
+        "truncated": {
+          "type": "boolean"
+        },
+        "updated": {
+          "type": "string"
+        },
+        "versions": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "bucketEncryptionInfo": {
+      "type": "object",
+      "properties": {
+        "algorithm": {
+          "type": "string"
+        },
+        "kmsMasterKeyID": {
+          "type": "string"
+        }
+      }
+    },
+    "bucketEncryptionRequest": {
+      "type": "object",
+      "properties": {
+        "encType": {
+          "$ref": "#/definitions/bucketEncryptionType"
+        },
+        "kmsKeyID": {
+          "type": "string"
+        }
+      }
+    },
+    "bucketEncryptionType": {
+      "type": "string",
+      "default": "sse-s3",
+      "enum": [
+        "sse-s3",
+        "sse-kms"
+      ]
+    },
+    "bucketEventRequest": {
+      "type": "object",
+      "required": [
+        "configuration"
+      ],
+      "properties": {
+        "configuration": {
+          "$ref": "#/definitions/notificationConfig"
+        },
+        "ignoreExisting": {
+          "type": "boolean"
+        }
+      }
+    },
+    "bucketGrowth": {
+      "type": "object",
+      "properties": {
+        "bucket": {
+          "type": "string"
+        },
+        "growth_per_day": {
+          "type": "number",
+          "format": "double"
+        },
+        "growth_percentage": {
+          "type": "number",
+          "format": "double"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "bucketLifecycleImport": {
+      "type": "object",
+      "required": [
+        "format",
+        "config"
+      ],
+      "properties": {
+        "config": {
+          "description": "the lifecycle configuration as exported by Console or mc ilm export",
+          "type": "string"
+        },
+        "format": {
+          "type": "string",
+          "enum": [
+            "xml",
+            "json"
+          ]
+        }
+      }
+    },
+    "bucketLifecycleResponse": {
+      "type": "object",
+      "properties": {
+        "lifecycle": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/objectBucketLifecycle"
+          }
+        }
+      }
+    },
+    "bucketObLockingResponse": {
+      "type": "object",
+      "properties": {
+        "object_locking_enabled": {
+          "type": "boolean"
+        }
+      }
+    },
+    "bucketObject": {
+      "type": "object",
+      "properties": {
+        "content_type": {
+          "type": "string"
+        },
+        "expiration": {
+          "type": "string"
+        },
+        "expiration_rule_id": {
+          "type": "string"
+        },
+        "is_delete_marker": {
+          "type": "boolean"
+        },
+        "is_latest": {
+          "type": "boolean"
+        },
+        "last_modified": {
+          "type": "string"
+        },
+        "legal_hold_status": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "retention_mode": {
+          "type": "string"
+        },
+        "retention_until_date": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "tags": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "user_tags": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "version_id": {
+          "type": "string"
+        }
+      }
+    },
+    "bucketQuota": {
+      "type": "object",
+      "properties": {
+        "quota": {
+          "type": "integer"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "hard",
+            "fifo"
+          ]
+        }
+      }
+    },
+    "bucketQuotaBulkRequest": {
+      "type": "object",
+      "required": [
+        "pattern"
+      ],
+      "properties": {
+        "pattern": {
+          "description": "shell pattern matching the bucket names, e.g. logs-*",
+          "type": "string"
+        },
+        "quota": {
+          "$ref": "#/definitions/setBucketQuota"
+        },
+        "softQuota": {
+          "$ref": "#/definitions/bucketSoftQuota"
+        }
+      }
+    },
+    "bucketQuotaBulkResponse": {
+      "type": "object",
+      "properties": {
+        "buckets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucketQuotaBulkResult"
+          }
+        }
+      }
+    },
+    "bucketQuotaBulkResult": {
+      "type": "object",
+      "properties": {
+        "bucket": {
+          "type": "string"
+        },
+        "error": {
+          "type": "string"
+        }
+      }
+    },
+    "bucketQuotaOverview": {
+      "type": "object",
+      "properties": {
+        "buckets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucketQuotaUsage"
+          }
+        }
+      }
+    },
+    "bucketQuotaUsage": {
+      "type": "object",
+      "properties": {
+        "bucket": {
+          "type": "string"
+        },
+        "percentage": {
+          "description": "usage percentage of the quota, zero when the bucket has no quota",
+          "type": "number",
+          "format": "double"
+        },
+        "quota": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "quotaType": {
+          "type": "string"
+        },
+        "softQuota": {
+          "$ref": "#/definitions/bucketSoftQuota"
+        },
+        "softQuotaPercentage": {
+          "type": "number",
+          "format": "double"
+        },
+        "softQuotaThreshold": {
+          "description": "highest threshold of the soft quota crossed by the usage",
+          "type": "integer",
+          "format": "int32"
+        },
+        "usage": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "bucketReplicationDestination": {
+      "type": "object",
+      "properties": {
+        "bucket": {
+          "type": "string"
+        }
+      }
+    },
+    "bucketReplicationFailedObjects": {
+      "type": "object",
+      "properties": {
+        "objects": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/replicationFailedObject"
+          }
+        },
+        "truncated": {
+          "type": "boolean"
+        }
+      }
+    },
+    "bucketReplicationMetrics": {
+      "type": "object",
+      "properties": {
+        "bandwidth": {
+          "type": "number",
+          "format": "double"
+        },
+        "failedCount": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "failedSize": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "pendingCount": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "pendingSize": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "replicaSize": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "replicatedSize": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "targets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/replicationTargetMetrics"
+          }
+        }
+      }
+    },
+    "bucketReplicationResponse": {
+      "type": "object",
+      "properties": {
+        "rules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucketReplicationRule"
+          }
+        }
+      }
+    },
+    "bucketReplicationRule": {
+      "type": "object",
+      "properties": {
+        "bandwidth": {
+          "type": "string"
+        },
+        "delete_marker_replication": {
+          "type": "boolean"
+        },
+        "deletes_replication": {
+          "type": "boolean"
+        },
+        "destination": {
+          "$ref": "#/definitions/bucketReplicationDestination"
+        },
+        "healthCheckPeriod": {
+          "type": "integer"
+        },
+        "id": {
+          "type": "string"
+        },
+        "metadata_replication": {
+          "type": "boolean"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "priority": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "Enabled",
+            "Disabled"
+          ]
+        },
+        "syncMode": {
+          "type": "string",
+          "default": "async",
+          "enum": [
+            "async",
+            "sync"
+          ]
+        },
+        "tags": {
+          "type": "string"
+        }
+      }
+    },
+    "bucketReplicationRuleStatus": {
+      "type": "object",
+      "required": [
+        "status"
+      ],
+      "properties": {
+        "status": {
+          "type": "string",
+          "enum": [
+            "Enabled",
+            "Disabled"
+          ]
+        }
+      }
+    },
+    "bucketReplicationRulesOrder": {
+      "type": "object",
+      "required": [
+        "rules"
+      ],
+      "properties": {
+        "rules": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "bucketSoftQuota": {
+      "type": "object",
+      "required": [
+        "limit"
+      ],
+      "properties": {
+        "limit": {
+          "description": "size in bytes the thresholds are relative to, it isn't enforced by MinIO",
+          "type": "integer",
+          "format": "int64"
+        },
+        "thresholds": {
+          "description": "usage percentages of the limit that send a notification when crossed, 80, 90 and 100 when not set",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int32"
+          }
+        }
+      }
+    },
+    "bucketVersioningResponse": {
+      "type": "object",
+      "properties": {
+        "is_versioned": {
+          "type": "boolean"
+        }
+      }
+    },
+    "bulkObjectLockRequest": {
+      "type": "object",
+      "properties": {
+        "all_versions": {
+          "type": "boolean"
+        },
+        "expires": {
+          "type": "string"
+        },
+        "governance_bypass": {
+          "type": "boolean"
+        },
+        "legal_hold": {
+          "$ref": "#/definitions/objectLegalHoldStatus"
+        },
+        "mode": {
+          "$ref": "#/definitions/objectRetentionMode"
+        },
+        "prefix": {
+          "type": "string"
+        }
+      }
+    },
+    "bulkObjectLockResult": {
+      "type": "object",
+      "properties": {
+        "error": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "versionID": {
+          "type": "string"
+        }
+      }
+    },
+    "bulkObjectLockSummary": {
+      "type": "object",
+      "properties": {
+        "failed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "failures": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bulkObjectLockResult"
+          }
+        },
+        "processed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "updated": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "bulkUserGroups": {
+      "type": "object",
+      "required": [
+        "users",
+        "groups"
+      ],
+      "properties": {
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "users": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "capacityForecastResponse": {
+      "type": "object",
+      "properties": {
+        "buckets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucketGrowth"
+          }
+        },
+        "growth_per_day": {
+          "type": "number",
+          "format": "double"
+        },
+        "history": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/capacityUsageSample"
+          }
+        },
+        "samples": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "thresholds": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/capacityThresholdForecast"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "usage_percentage": {
+          "type": "number",
+          "format": "double"
+        },
+        "used": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "capacityThresholdForecast": {
+      "type": "object",
+      "properties": {
+        "date": {
+          "type": "string"
+        },
+        "days_left": {
+          "type": "integer",
+          "format": "int64",
+          "title": "days until the usage reaches the percentage, -1 when the usage isn't growing"
+        },
+        "percentage": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "reached": {
+          "type": "boolean"
+        }
+      }
+    },
+    "capacityUsageSample": {
+      "type": "object",
+      "properties": {
+        "date": {
+          "type": "string"
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "used": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "changeUserPasswordRequest": {
+      "type": "object",
+      "required": [
+        "selectedUser",
+        "newSecretKey"
+      ],
+      "properties": {
+        "newSecretKey": {
+          "type": "string"
+        },
+        "selectedUser": {
+          "type": "string"
+        }
+      }
+    },
+    "configChange": {
+      "type": "object",
+      "properties": {
+        "after": {
+          "type": "string"
+        },
+        "before": {
+          "type": "string"
+        },
+        "key": {
+          "type": "string"
+        },
+        "subsystem": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "added",
+            "removed",
+            "modified"
+          ]
+        }
+      }
+    },
+    "configDescription": {
+      "type": "object",
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "key": {
+          "type": "string"
+        }
+      }
+    },
+    "configDiffResponse": {
+      "type": "object",
+      "properties": {
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/configChange"
+          }
+        },
+        "from": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "to": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "configHistoryResponse": {
+      "type": "object",
+      "properties": {
+        "revisions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/configRevision"
+          }
+        }
+      }
+    },
+    "configKeySchema": {
+      "type": "object",
+      "properties": {
+        "default": {
+          "type": "string"
+        },
+        "defaultUnknown": {
+          "type": "boolean",
+          "title": "the MinIO help doesn't give the default of the key"
+        },
+        "description": {
+          "type": "string"
+        },
+        "key": {
+          "type": "string"
+        },
+        "optional": {
+          "type": "boolean"
+        },
+        "sensitive": {
+          "type": "boolean"
+        },
+        "type": {
+          "type": "string"
+        },
+        "values": {
+          "type": "array",
+          "title": "allowed values when the key is an enumeration",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "configRestoreResponse": {
+      "type": "object",
+      "properties": {
+        "restart": {
+          "type": "boolean"
+        },
+        "revision": {
+          "$ref": "#/definitions/configRevision"
+        }
+      }
+    },
+    "configRevision": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "snapshot",
+            "set",
+            "restore",
+            "external"
+          ]
+        },
+        "changes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "comment": {
+          "type": "string"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "subsystem": {
+          "type": "string"
+        },
+        "time": {
+          "type": "string"
+        },
+        "user": {
+          "type": "string"
+        }
+      }
+    },
+    "configSnapshotRequest": {
+      "type": "object",
+      "properties": {
+        "comment": {
+          "type": "string"
+        }
+      }
+    },
+    "configTarget": {
+      "type": "object",
+      "properties": {
+        "enabled": {
+          "type": "boolean"
+        },
+        "key_values": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/configurationKV"
+          }
+        },
+        "name": {
+          "type": "string",
+          "title": "name of the target, empty for the default one"
+        },
+        "status": {
+          "type": "string",
+          "title": "online or offline as reported by the server, empty when unknown"
+        }
+      }
+    },
+    "configTargetsResponse": {
+      "type": "object",
+      "properties": {
+        "multiple_targets": {
+          "type": "boolean",
+          "title": "whether the subsystem supports named targets"
+        },
+        "targets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/configTarget"
+          }
+        }
+      }
+    },
+    "configuration": {
+      "type": "object",
+      "properties": {
+        "key_schema": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/configKeySchema"
+          }
+        },
+        "key_values": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/configurationKV"
+          }
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "configurationKV": {
+      "type": "object",
+      "properties": {
+        "key": {
+          "type": "string"
+        },
+        "value": {
+          "type": "string"
+        }
+      }
+    },
+    "createRemoteBucket": {
+      "required": [
+        "accessKey",
+        "secretKey",
+        "targetURL",
+        "sourceBucket",
+        "targetBucket"
+      ],
+      "properties": {
+        "accessKey": {
+          "type": "string",
+          "minLength": 3
+        },
+        "bandwidth": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "healthCheckPeriod": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "region": {
+          "type": "string"
+        },
+        "secretKey": {
+          "type": "string",
+          "minLength": 8
+        },
+        "sourceBucket": {
+          "type": "string"
+        },
+        "syncMode": {
+          "type": "string",
+          "default": "async",
+          "enum": [
+            "async",
+            "sync"
+          ]
+        },
+        "targetBucket": {
+          "type": "string"
+        },
+        "targetURL": {
+          "type": "string"
+        }
+      }
+    },
+    "dashboard": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "panels": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/dashboardPanel"
+          }
+        }
+      }
+    },
+    "dashboardGridPos": {
+      "type": "object",
+      "properties": {
+        "h": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "w": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "x": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "y": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "dashboardList": {
+      "type": "object",
+      "properties": {
+        "dashboards": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/dashboard"
+          }
+        }
+      }
+    },
+    "dashboardPanel": {
+      "type": "object",
+      "required": [
+        "widgetId"
+      ],
+      "properties": {
+        "gridPos": {
+          "$ref": "#/definitions/dashboardGridPos"
+        },
+        "widgetId": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "dashboardWidget": {
+      "type": "object",
+      "required": [
+        "title",
+        "type",
+        "targets"
+      ],
+      "properties": {
+        "builtIn": {
+          "type": "boolean"
+        },
+        "gridPos": {
+          "$ref": "#/definitions/dashboardGridPos"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "maxDataPoints": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "options": {
+          "$ref": "#/definitions/dashboardWidgetOptions"
+        },
+        "targets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/dashboardWidgetTarget"
+          }
+        },
+        "title": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        },
+        "unit": {
+          "type": "string"
+        },
+        "variables": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "dashboardWidgetList": {
+      "type": "object",
+      "properties": {
+        "widgets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/dashboardWidget"
+          }
+        }
+      }
+    },
+    "dashboardWidgetOptions": {
+      "type": "object",
+      "properties": {
+        "reduceOptions": {
+          "$ref": "#/definitions/dashboardWidgetReduceOptions"
+        }
+      }
+    },
+    "dashboardWidgetReduceOptions": {
+      "type": "object",
+      "properties": {
+        "calcs": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "dashboardWidgetTarget": {
+      "type": "object",
+      "required": [
+        "expr"
+      ],
+      "properties": {
+        "expr": {
+          "type": "string"
+        },
+        "interval": {
+          "type": "string"
+        },
+        "legendFormat": {
+          "type": "string"
+        },
+        "step": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "directCSIDriveInfo": {
+      "type": "object",
+      "properties": {
+        "allocated": {
+          "type": "number",
+          "format": "int64"
+        },
+        "capacity": {
+          "type": "number",
+          "format": "int64"
+        },
+        "drive": {
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        },
+        "node": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        },
+        "volumes": {
+          "type": "number",
+          "format": "int64"
+        }
+      }
+    },
+    "directCSIVolumeInfo": {
+      "type": "object",
+      "properties": {
+        "capacity": {
+          "type": "number",
+          "format": "int64"
+        },
+        "drive": {
+          "type": "string"
+        },
+        "node": {
+          "type": "string"
+        },
+        "volume": {
+          "type": "string"
+        }
+      }
+    },
+    "driveHistoryResponse": {
+      "type": "object",
+      "properties": {
+        "events": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/driveStateEvent"
+          }
+        },
+        "flapping": {
+          "type": "array",
+          "title": "drives that changed state several times during the last day",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "driveInfo": {
+      "type": "object",
+      "properties": {
+        "available_space": {
+          "type": "integer",
+          "format": "uint64"
+        },
+        "disk_index": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "healing": {
+          "type": "boolean"
+        },
+        "model": {
+          "type": "string"
+        },
+        "path": {
+          "type": "string"
+        },
+        "pool_index": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "root_disk": {
+          "type": "boolean"
+        },
+        "set_index": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "state": {
+          "type": "string"
+        },
+        "total_space": {
+          "type": "integer",
+          "format": "uint64"
+        },
+        "used_space": {
+          "type": "integer",
+          "format": "uint64"
+        },
+        "uuid": {
+          "type": "string"
+        }
+      }
+    },
+    "driveStateEvent": {
+      "type": "object",
+      "properties": {
+        "drive": {
+          "type": "string"
+        },
+        "from": {
+          "type": "string"
+        },
+        "path": {
+          "type": "string"
+        },
+        "server": {
+          "type": "string"
+        },
+        "time": {
+          "type": "string"
+        },
+        "to": {
+          "type": "string"
+        }
+      }
+    },
+    "erasureSet": {
+      "type": "object",
+      "properties": {
+        "available_space": {
+          "type": "integer",
+          "format": "uint64"
+        },
+        "drives": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/driveInfo"
+          }
+        },
+        "healing_drives": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "offline_drives": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "online_drives": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "parity": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "pool_index": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "set_index": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "state": {
+          "type": "string",
+          "title": "healthy, degraded, read-only or unavailable"
+        },
+        "total_space": {
+          "type": "integer",
+          "format": "uint64"
+        },
+        "used_space": {
+          "type": "integer",
+          "format": "uint64"
+        }
+      }
+    },
+    "error": {
+      "type": "object",
+      "required": [
+        "message"
+      ],
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "message": {
+          "type": "string"
+        }
+      }
+    },
+    "expirationResponse": {
+      "type": "object",
+      "properties": {
+        "date": {
+          "type": "string"
+        },
+        "days": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "delete_marker": {
+          "type": "boolean"
+        }
+      }
+    },
+    "formatConfiguration": {
+      "type": "object",
+      "required": [
+        "drives",
+        "force"
+      ],
+      "properties": {
+        "drives": {
+          "type": "array",
+          "minLength": 1,
+          "items": {
+            "type": "string"
+          }
+        },
+        "force": {
+          "type": "boolean"
+        }
+      }
+    },
+    "getBucketRetentionConfig": {
+      "type": "object",
+      "properties": {
+        "mode": {
+          "$ref": "#/definitions/objectRetentionMode"
+        },
+        "unit": {
+          "$ref": "#/definitions/objectRetentionUnit"
+        },
+        "validity": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "getDirectCSIDriveListResponse": {
+      "type": "object",
+      "properties": {
+        "drives": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/directCSIDriveInfo"
+          }
+        }
+      }
+    },
+    "getDirectCSIVolumeListResponse": {
+      "type": "object",
+      "properties": {
+        "volumes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/directCSIVolumeInfo"
+          }
+        }
+      }
+    },
+    "group": {
+      "type": "object",
+      "properties": {
+        "members": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "hasPermissionRequest": {
+      "type": "object",
+      "properties": {
+        "actions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policyArgs"
+          }
+        }
+      }
+    },
+    "hasPermissionResponse": {
+      "type": "object",
+      "properties": {
+        "permissions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/permissionAction"
+          }
+        }
+      }
+    },
+    "iamEntity": {
+      "type": "string",
+      "pattern": "^[\\w+=,.@-]{1,64}$"
+    },
+    "idpConfiguration": {
+      "type": "object",
+      "properties": {
+        "active_directory": {
+          "type": "object",
+          "required": [
+            "url",
+            "username_format",
+            "user_search_filter"
+          ],
+          "properties": {
+            "group_name_attribute": {
+              "type": "string"
+            },
+            "group_search_base_dn": {
+              "type": "string"
+            },
+            "group_search_filter": {
+              "type": "string"
+            },
+            "server_insecure": {
+              "type": "boolean"
+            },
+            "skip_tls_verification": {
+              "type": "boolean"
+            },
+            "url": {
+              "type": "string"
+            },
+            "user_search_filter": {
+              "type": "string"
+            },
+            "username_format": {
+              "type": "string"
+            }
+          }
+        },
+        "keys": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "required": [
+              "access_key",
+              "secret_key"
+            ],
+            "properties": {
+              "access_key": {
+                "type": "string"
+              },
+              "secret_key": {
+                "type": "string"
+              }
+            }
+          }
+        },
+        "oidc": {
+          "type": "object",
+          "required": [
+            "url",
+            "client_id",
+            "secret_id"
+          ],
+          "properties": {
+            "client_id": {
+              "type": "string"
+            },
+            "secret_id": {
+              "type": "string"
+            },
+            "url": {
+              "type": "string"
+            }
+          }
+        }
+      }
+    },
+    "job": {
+      "type": "object",
+      "properties": {
+        "created": {
+          "type": "string"
+        },
+        "created_by": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "last_run": {
+          "$ref": "#/definitions/jobRun"
+        },
+        "name": {
+          "type": "string"
+        },
+        "next_run": {
+          "type": "string"
+        },
+        "params": {
+          "$ref": "#/definitions/jobParams"
+        },
+        "paused": {
+          "type": "boolean"
+        },
+        "schedule": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        }
+      }
+    },
+    "jobList": {
+      "type": "object",
+      "properties": {
+        "jobs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/job"
+          }
+        }
+      }
+    },
+    "jobParams": {
+      "type": "object",
+      "properties": {
+        "bucket": {
+          "type": "string"
+        },
+        "deadline": {
+          "type": "integer",
+          "format": "int64",
+          "title": "seconds the health info collection can take"
+        },
+        "deep_scan": {
+          "type": "boolean"
+        },
+        "dry_run": {
+          "type": "boolean"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "recursive": {
+          "type": "boolean"
+        }
+      }
+    },
+    "jobRequest": {
+      "type": "object",
+      "required": [
+        "name",
+        "type",
+        "schedule"
+      ],
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "params": {
+          "$ref": "#/definitions/jobParams"
+        },
+        "paused": {
+          "type": "boolean"
+        },
+        "schedule": {
+          "type": "string",
+          "title": "cron expression in UTC, like \"0 3 * * *\" or @daily"
+        },
+        "type": {
+          "type": "string",
+          "title": "heal, health-info or usage-snapshot"
+        }
+      }
+    },
+    "jobRun": {
+      "type": "object",
+      "properties": {
+        "error": {
+          "type": "string"
+        },
+        "finished": {
+          "type": "string"
+        },
+        "has_report": {
+          "type": "boolean"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "job_id": {
+          "type": "string"
+        },
+        "logs": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "queued": {
+          "type": "string"
+        },
+        "started": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "title": "queued, running, succeeded or failed"
+        },
+        "summary": {
+          "type": "string"
+        },
+        "trigger": {
+          "type": "string",
+          "title": "schedule or manual"
+        },
+        "triggered_by": {
+          "type": "string"
+        }
+      }
+    },
+    "jobRunList": {
+      "type": "object",
+      "properties": {
+        "runs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/jobRun"
+          }
+        }
+      }
+    },
+    "keyPairConfiguration": {
+      "type": "object",
+      "required": [
+        "crt",
+        "key"
+      ],
+      "properties": {
+        "crt": {
+          "type": "string"
+        },
+        "key": {
+          "type": "string"
+        }
+      }
+    },
+    "kmsCreateKeyRequest": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "key": {
+          "type": "string"
+        }
+      }
+    },
+    "kmsEndpoint": {
+      "type": "object",
+      "properties": {
+        "status": {
+          "type": "string"
+        },
+        "url": {
+          "type": "string"
+        }
+      }
+    },
+    "kmsImportKeyRequest": {
+      "type": "object",
+      "required": [
+        "bytes"
+      ],
+      "properties": {
+        "bytes": {
+          "type": "string"
+        }
+      }
+    },
+    "kmsKeyInfo": {
+      "type": "object",
+      "properties": {
+        "createdAt": {
+          "type": "string"
+        },
+        "createdBy": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "kmsKeyStatusResponse": {
+      "type": "object",
+      "properties": {
+        "decryptionErr": {
+          "type": "string"
+        },
+        "encryptionErr": {
+          "type": "string"
+        },
+        "keyID": {
+          "type": "string"
+        }
+      }
+    },
+    "kmsListKeysResponse": {
+      "type": "object",
+      "properties": {
+        "results": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/kmsKeyInfo"
+          }
+        }
+      }
+    },
+    "kmsStatusResponse": {
+      "type": "object",
+      "properties": {
+        "defaultKeyID": {
+          "type": "string"
+        },
+        "endpoints": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/kmsEndpoint"
+          }
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "license": {
+      "type": "object",
+      "properties": {
+        "account_id": {
+          "type": "integer"
+        },
+        "email": {
+          "type": "string"
+        },
+        "expires_at": {
+          "type": "string"
+        },
+        "organization": {
+          "type": "string"
+        },
+        "plan": {
+          "type": "string"
+        },
+        "storage_capacity": {
+          "type": "integer"
+        }
+      }
+    },
+    "lifecyclePreviewGroup": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "expire",
+            "transition"
+          ]
+        },
+        "objects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "tier": {
+          "type": "string"
+        }
+      }
+    },
+    "lifecyclePreviewRequest": {
+      "type": "object",
+      "properties": {
+        "rule": {
+          "$ref": "#/definitions/addBucketLifecycle"
+        }
+      }
+    },
+    "lifecyclePreviewResponse": {
+      "type": "object",
+      "properties": {
+        "scannedObjects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "truncated": {
+          "type": "boolean"
+        },
+        "windows": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/lifecyclePreviewWindow"
+          }
+        }
+      }
+    },
+    "lifecyclePreviewWindow": {
+      "type": "object",
+      "properties": {
+        "days": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "expireObjects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "expireSize": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/lifecyclePreviewGroup"
+          }
+        },
+        "transitionObjects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "transitionSize": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "lifecycleTag": {
+      "type": "object",
+      "properties": {
+        "key": {
+          "type": "string"
+        },
+        "value": {
+          "type": "string"
+        }
+      }
+    },
+    "listBucketEventsResponse": {
+      "type": "object",
+      "properties": {
+        "events": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/notificationConfig"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "total number of bucket events"
+        }
+      }
+    },
+    "listBucketsResponse": {
+      "type": "object",
+      "properties": {
+        "buckets": {
+          "type": "array",
+          "title": "list of resulting buckets",
+          "items": {
+            "$ref": "#/definitions/bucket"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "number of buckets accessible to the user"
+        }
+      }
+    },
+    "listConfigResponse": {
+      "type": "object",
+      "properties": {
+        "configurations": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/configDescription"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "total number of configurations"
+        }
+      }
+    },
+    "listExternalBucketsParams": {
+      "required": [
+        "accessKey",
+        "secretKey",
+        "targetURL",
+        "useTLS"
+      ],
+      "properties": {
+        "accessKey": {
+          "type": "string",
+          "minLength": 3
+        },
+        "region": {
+          "type": "string"
+        },
+        "secretKey": {
+          "type": "string",
+          "minLength": 8
+        },
+        "targetURL": {
+          "type": "string"
+        },
+        "useTLS": {
+          "type": "boolean"
+        }
+      }
+    },
+    "listGroupsResponse": {
+      "type": "object",
+      "properties": {
+        "groups": {
+          "type": "array",
+          "title": "list of groups",
+          "items": {
+            "type": "string"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "total number of groups"
+        }
+      }
+    },
+    "listObjectsResponse": {
+      "type": "object",
+      "properties": {
+        "objects": {
+          "type": "array",
+          "title": "list of resulting objects",
+          "items": {
+            "$ref": "#/definitions/bucketObject"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "number of objects"
+        }
+      }
+    },
+    "listPoliciesResponse": {
+      "type": "object",
+      "properties": {
+        "policies": {
+          "type": "array",
+          "title": "list of policies",
+          "items": {
+            "$ref": "#/definitions/policy"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "total number of policies"
+        }
+      }
+    },
+    "listRemoteBucketsResponse": {
+      "type": "object",
+      "properties": {
+        "buckets": {
+          "type": "array",
+          "title": "list of remote buckets",
+          "items": {
+            "$ref": "#/definitions/remoteBucket"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "number of remote buckets accessible to user"
+        }
+      }
+    },
+    "listUsersResponse": {
+      "type": "object",
+      "properties": {
+        "users": {
+          "type": "array",
+          "title": "list of resulting users",
+          "items": {
+            "$ref": "#/definitions/user"
+          }
+        }
+      }
+    },
+    "lockedObject": {
+      "type": "object",
+      "properties": {
+        "isLatest": {
+          "type": "boolean"
+        },
+        "legalHold": {
+          "type": "boolean"
+        },
+        "mode": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "retainUntilDate": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "versionID": {
+          "type": "string"
+        }
+      }
+    },
+    "lockedObjectsReport": {
+      "type": "object",
+      "properties": {
+        "objects": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/lockedObject"
+          }
+        },
+        "scanned": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "truncated": {
+          "type": "boolean"
+        }
+      }
+    },
+    "logSearchConfiguration": {
+      "type": "object",
+      "properties": {
+        "image": {
+          "type": "string"
+        },
+        "postgres_image": {
+          "type": "string"
+        },
+        "storageClass": {
+          "type": "string",
+          "default": ""
+        },
+        "storageSize": {
+          "type": "number",
+          "default": 5
+        }
+      }
+    },
+    "logSearchResponse": {
+      "type": "object",
+      "properties": {
+        "results": {
+          "type": "object",
+          "title": "list of log search responses"
+        },
+        "stats": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/logSearchStat"
+          }
+        },
+        "truncated": {
+          "type": "boolean"
+        }
+      }
+    },
+    "logSearchStat": {
+      "type": "object",
+      "properties": {
+        "apiName": {
+          "type": "string"
+        },
+        "avgResponseTime": {
+          "type": "number"
+        },
+        "count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "errors": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "time": {
+          "type": "string"
+        }
+      }
+    },
+    "loginDetails": {
+      "type": "object",
+      "properties": {
+        "loginStrategy": {
+          "type": "string",
+          "enum": [
+            "form",
+            "redirect",
+            "service-account"
+          ]
+        },
+        "redirect": {
+          "type": "string"
+        }
+      }
+    },
+    "loginOauth2AuthRequest": {
+      "type": "object",
+      "required": [
+        "state",
+        "code"
+      ],
+      "properties": {
+        "code": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string"
+        }
+      }
+    },
+    "loginOperatorRequest": {
+      "type": "object",
+      "required": [
+        "jwt"
+      ],
+      "properties": {
+        "jwt": {
+          "type": "string"
+        }
+      }
+    },
+    "loginRequest": {
+      "type": "object",
+      "required": [
+        "accessKey",
+        "secretKey"
+      ],
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "secretKey": {
+          "type": "string"
+        }
+      }
+    },
+    "loginResponse": {
+      "type": "object",
+      "properties": {
+        "sessionId": {
+          "type": "string"
+        }
+      }
+    },
+    "makeBucketRequest": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "locking": {
+          "type": "boolean"
+        },
+        "name": {
+          "type": "string"
+        },
+        "quota": {
+          "$ref": "#/definitions/setBucketQuota"
+        },
+        "retention": {
+          "$ref": "#/definitions/putBucketRetentionRequest"
+        },
+        "versioning": {
+          "type": "boolean"
+        }
+      }
+    },
+    "multiBucketReplication": {
+      "required": [
+        "accessKey",
+        "secretKey",
+        "targetURL",
+        "bucketsRelation"
+      ],
+      "properties": {
+        "accessKey": {
+          "type": "string",
+          "minLength": 3
+        },
+        "bandwidth": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "bucketsRelation": {
+          "type": "array",
+          "minLength": 1,
+          "items": {
+            "$ref": "#/definitions/multiBucketsRelation"
+          }
+        },
+        "healthCheckPeriod": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "region": {
+          "type": "string"
+        },
+        "replicateDeleteMarkers": {
+          "type": "boolean"
+        },
+        "replicateDeletes": {
+          "type": "boolean"
+        },
+        "replicateMetadata": {
+          "type": "boolean"
+        },
+        "secretKey": {
+          "type": "string",
+          "minLength": 8
+        },
+        "syncMode": {
+          "type": "string",
+          "default": "async",
+          "enum": [
+            "async",
+            "sync"
+          ]
+        },
+        "tags": {
+          "type": "string"
+        },
+        "targetURL": {
+          "type": "string"
+        }
+      }
+    },
+    "multiBucketResponseItem": {
+      "type": "object",
+      "properties": {
+        "errorString": {
+          "type": "string"
+        },
+        "originBucket": {
+          "type": "string"
+        },
+        "targetBucket": {
+          "type": "string"
+        }
+      }
+    },
+    "multiBucketResponseState": {
+      "type": "object",
+      "properties": {
+        "replicationState": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/multiBucketResponseItem"
+          }
+        }
+      }
+    },
+    "multiBucketsRelation": {
+      "type": "object",
+      "properties": {
+        "destinationBucket": {
+          "type": "string"
+        },
+        "originBucket": {
+          "type": "string"
+        }
+      }
+    },
+    "nodeInfo": {
+      "type": "object",
+      "properties": {
+        "available_space": {
+          "type": "integer",
+          "format": "uint64"
+        },
+        "commit_id": {
+          "type": "string"
+        },
+        "drives": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/driveInfo"
+          }
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "healing_drives": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "network": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/nodeNetworkStatus"
+          }
+        },
+        "offline_drives": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "online_drives": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "pool_index": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "state": {
+          "type": "string"
+        },
+        "total_space": {
+          "type": "integer",
+          "format": "uint64"
+        },
+        "uptime": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "used_space": {
+          "type": "integer",
+          "format": "uint64"
+        },
+        "version": {
+          "type": "string"
+        }
+      }
+    },
+    "nodeNetworkStatus": {
+      "type": "object",
+      "properties": {
+        "endpoint": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string"
+        }
+      }
+    },
+    "nodesResponse": {
+      "type": "object",
+      "properties": {
+        "available_space": {
+          "type": "integer",
+          "format": "uint64"
+        },
+        "servers": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/nodeInfo"
+          }
+        },
+        "total_space": {
+          "type": "integer",
+          "format": "uint64"
+        },
+        "used_space": {
+          "type": "integer",
+          "format": "uint64"
+        }
+      }
+    },
+    "nofiticationService": {
+      "type": "string",
+      "enum": [
+        "webhook",
+        "amqp",
+        "kafka",
+        "mqtt",
+        "nats",
+        "nsq",
+        "mysql",
+        "postgres",
+        "elasticsearch",
+        "redis"
+      ]
+    },
+    "noncurrentTransitionResponse": {
+      "type": "object",
+      "properties": {
+        "days": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "storage_class": {
+          "type": "string"
+        }
+      }
+    },
+    "notifEndpointResponse": {
+      "type": "object",
+      "properties": {
+        "notification_endpoints": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/notificationEndpointItem"
+          }
+        }
+      }
+    },
+    "notificationConfig": {
+      "type": "object",
+      "required": [
+        "arn"
+      ],
+      "properties": {
+        "arn": {
+          "type": "string"
+        },
+        "events": {
+          "type": "array",
+          "title": "filter specific type of event. Defaults to all event (default: '[put,delete,get]')",
+          "items": {
+            "$ref": "#/definitions/notificationEventType"
+          }
+        },
+        "id": {
+          "type": "string"
+        },
+        "prefix": {
+          "type": "string",
+          "title": "filter event associated to the specified prefix"
+        },
+        "suffix": {
+          "type": "string",
+          "title": "filter event associated to the specified suffix"
+        }
+      }
+    },
+    "notificationDeleteRequest": {
+      "type": "object",
+      "required": [
+        "events",
+        "prefix",
+        "suffix"
+      ],
+      "properties": {
+        "events": {
+          "type": "array",
+          "title": "filter specific type of event. Defaults to all event (default: '[put,delete,get]')",
+          "minLength": 1,
+          "items": {
+            "$ref": "#/definitions/notificationEventType"
+          }
+        },
+        "prefix": {
+          "type": "string",
+          "title": "filter event associated to the specified prefix"
+        },
+        "suffix": {
+          "type": "string",
+          "title": "filter event associated to the specified suffix"
+        }
+      }
+    },
+    "notificationEndpoint": {
+      "type": "object",
+      "required": [
+        "service",
+        "account_id",
+        "properties"
+      ],
+      "properties": {
+        "account_id": {
+          "type": "string"
+        },
+        "properties": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "service": {
+          "$ref": "#/definitions/nofiticationService"
+        }
+      }
+    },
+    "notificationEndpointItem": {
+      "type": "object",
+      "properties": {
+        "account_id": {
+          "type": "string"
+        },
+        "service": {
+          "$ref": "#/definitions/nofiticationService"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "notificationEventType": {
+      "type": "string",
+      "enum": [
+        "put",
+        "delete",
+        "get"
+      ]
+    },
+    "objectBucketLifecycle": {
+      "type": "object",
+      "properties": {
+        "abort_incomplete_multipart_days": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "expiration": {
+          "$ref": "#/definitions/expirationResponse"
+        },
+        "id": {
+          "type": "string"
+        },
+        "noncurrent_expiration_days": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "noncurrent_transition": {
+          "$ref": "#/definitions/noncurrentTransitionResponse"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        },
+        "tags": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/lifecycleTag"
+          }
+        },
+        "transition": {
+          "$ref": "#/definitions/transitionResponse"
+        }
+      }
+    },
+    "objectLegalHoldStatus": {
+      "type": "string",
+      "enum": [
+        "enabled",
+        "disabled"
+      ]
+    },
+    "objectRetentionMode": {
+      "type": "string",
+      "enum": [
+        "governance",
+        "compliance"
+      ]
+    },
+    "objectRetentionUnit": {
+      "type": "string",
+      "enum": [
+        "days",
+        "years"
+      ]
+    },
+    "peerInfo": {
+      "type": "object",
+      "properties": {
+        "deploymentID": {
+          "type": "string"
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "peerSite": {
+      "type": "object",
+      "required": [
+        "endpoint",
+        "accessKey",
+        "secretKey"
+      ],
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "secretKey": {
+          "type": "string"
+        }
+      }
+    },
+    "permissionAction": {
+      "type": "object",
+      "properties": {
+        "can": {
+          "type": "boolean"
+        },
+        "id": {
+          "type": "string"
+        }
+      }
+    },
+    "policy": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
+        }
+      }
+    },
+    "policyArgs": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "type": "string"
+        },
+        "bucket_name": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        }
+      }
+    },
+    "policyEntity": {
+      "type": "string",
+      "default": "user",
+      "enum": [
+        "user",
+        "group"
+      ]
+    },
+    "poolDecommissionInfo": {
+      "type": "object",
+      "properties": {
+        "bytes_decommission_failed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "bytes_decommissioned": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "canceled": {
+          "type": "boolean"
+        },
+        "complete": {
+          "type": "boolean"
+        },
+        "current_size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "failed": {
+          "type": "boolean"
+        },
+        "objects_decommission_failed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "objects_decommissioned": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "percentage": {
+          "type": "number",
+          "format": "double"
+        },
+        "start_size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "start_time": {
+          "type": "string"
+        },
+        "total_size": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "poolStatus": {
+      "type": "object",
+      "properties": {
+        "cmdline": {
+          "type": "string"
+        },
+        "decommission": {
+          "$ref": "#/definitions/poolDecommissionInfo"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "last_update": {
+          "type": "string"
+        }
+      }
+    },
+    "poolTopology": {
+      "type": "object",
+      "properties": {
+        "available_space": {
+          "type": "integer",
+          "format": "uint64"
+        },
+        "index": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "sets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/erasureSet"
+          }
+        },
+        "state": {
+          "type": "string"
+        },
+        "total_space": {
+          "type": "integer",
+          "format": "uint64"
+        },
+        "used_space": {
+          "type": "integer",
+          "format": "uint64"
+        }
+      }
+    },
+    "poolsStatusResponse": {
+      "type": "object",
+      "properties": {
+        "pools": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/poolStatus"
+          }
+        }
+      }
+    },
+    "prefixUsage": {
+      "type": "object",
+      "properties": {
+        "objects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "versions": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "principal": {
+      "type": "object",
+      "properties": {
+        "STSAccessKeyID": {
+          "type": "string"
+        },
+        "STSSecretAccessKey": {
+          "type": "string"
+        },
+        "STSSessionToken": {
+          "type": "string"
+        },
+        "accountAccessKey": {
+          "type": "string"
+        },
+        "actions": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "profilerType": {
+      "type": "string",
+      "enum": [
+        "cpu",
+        "mem",
+        "block",
+        "mutex",
+        "trace",
+        "threads",
+        "goroutines"
+      ]
+    },
+    "profilingCapture": {
+      "type": "object",
+      "properties": {
+        "error": {
+          "type": "string"
+        },
+        "finishedAt": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "nodes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "startedAt": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed"
+          ]
+        },
+        "types": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "profilingCaptureList": {
+      "type": "object",
+      "properties": {
+        "captures": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/profilingCapture"
+          }
+        }
+      }
+    },
+    "profilingFunction": {
+      "type": "object",
+      "properties": {
+        "cum": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "cumPercent": {
+          "type": "number"
+        },
+        "flat": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "flatPercent": {
+          "type": "number"
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "profilingNodeSummary": {
+      "type": "object",
+      "properties": {
+        "functions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/profilingFunction"
+          }
+        },
+        "node": {
+          "type": "string"
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "unit": {
+          "type": "string"
+        }
+      }
+    },
+    "profilingStartRequest": {
+      "type": "object",
+      "title": "one of type or types is required",
+      "properties": {
+        "duration": {
+          "type": "integer",
+          "format": "int64",
+          "title": "seconds after which the capture is stopped automatically"
+        },
+        "nodes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "type": {
+          "$ref": "#/definitions/profilerType"
+        },
+        "types": {
+          "type": "array",
+          "title": "profilers to run, type is still accepted for a single profiler",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "profilingSummary": {
+      "type": "object",
+      "properties": {
+        "captureId": {
+          "type": "string"
+        },
+        "nodes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/profilingNodeSummary"
+          }
+        }
+      }
+    },
+    "prometheusConfiguration": {
+      "type": "object",
+      "properties": {
+        "image": {
+          "type": "string"
+        },
+        "storageClass": {
+          "type": "string",
+          "default": ""
+        },
+        "storageSize": {
+          "type": "number",
+          "default": 5
+        }
+      }
+    },
+    "putBucketRetentionRequest": {
+      "type": "object",
+      "required": [
+        "mode",
+        "unit",
+        "validity"
+      ],
+      "properties": {
+        "mode": {
+          "$ref": "#/definitions/objectRetentionMode"
+        },
+        "unit": {
+          "$ref": "#/definitions/objectRetentionUnit"
+        },
+        "validity": {
+          "type": "integer",
+          "format": "int32"
+        }
+      }
+    },
+    "putObjectLegalHoldRequest": {
+      "type": "object",
+      "required": [
+        "status"
+      ],
+      "properties": {
+        "status": {
+          "$ref": "#/definitions/objectLegalHoldStatus"
+        }
+      }
+    },
+    "putObjectRetentionRequest": {
+      "type": "object",
+      "required": [
+        "mode",
+        "expires"
+      ],
+      "properties": {
+        "expires": {
+          "type": "string"
+        },
+        "governance_bypass": {
+          "type": "boolean"
+        },
+        "mode": {
+          "$ref": "#/definitions/objectRetentionMode"
+        }
+      }
+    },
+    "putObjectTagsRequest": {
+      "type": "object",
+      "properties": {
+        "tags": {
+          "additionalProperties": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "rebalancePoolStatus": {
+      "type": "object",
+      "properties": {
+        "bucket": {
+          "type": "string"
+        },
+        "bytes": {
+          "type": "integer",
+          "format": "uint64"
+        },
+        "elapsed": {
+          "type": "integer",
+          "format": "int64",
+          "title": "seconds since the rebalance of the pool started"
+        },
+        "eta": {
+          "type": "integer",
+          "format": "int64",
+          "title": "estimated seconds until the rebalance of the pool completes"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "object": {
+          "type": "string"
+        },
+        "objects": {
+          "type": "integer",
+          "format": "uint64"
+        },
+        "status": {
+          "type": "string"
+        },
+        "used": {
+          "type": "number",
+          "format": "double"
+        },
+        "versions": {
+          "type": "integer",
+          "format": "uint64"
+        }
+      }
+    },
+    "rebalanceStatusResponse": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "string"
+        },
+        "pools": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/rebalancePoolStatus"
+          }
+        },
+        "running": {
+          "type": "boolean"
+        },
+        "stopped_at": {
+          "type": "string"
+        }
+      }
+    },
+    "remoteBucket": {
+      "type": "object",
+      "required": [
+        "accessKey",
+        "sourceBucket",
+        "remoteARN"
+      ],
+      "properties": {
+        "accessKey": {
+          "type": "string",
+          "minLength": 3
+        },
+        "bandwidth": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "healthCheckPeriod": {
+          "type": "integer"
+        },
+        "remoteARN": {
+          "type": "string"
+        },
+        "secretKey": {
+          "type": "string",
+          "minLength": 8
+        },
+        "service": {
+          "type": "string",
+          "enum": [
+            "replication"
+          ]
+        },
+        "sourceBucket": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        },
+        "syncMode": {
+          "type": "string"
+        },
+        "targetBucket": {
+          "type": "string"
+        },
+        "targetURL": {
+          "type": "string"
+        }
+      }
+    },
+    "replicationFailedObject": {
+      "type": "object",
+      "properties": {
+        "last_modified": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "reason": {
+          "type": "string"
+        },
+        "rule_id": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "target": {
+          "type": "string"
+        },
+        "version_id": {
+          "type": "string"
+        }
+      }
+    },
+    "replicationResyncRequest": {
+      "type": "object",
+      "properties": {
+        "arn": {
+          "type": "string"
+        },
+        "olderThan": {
+          "type": "string"
+        }
+      }
+    },
+    "replicationResyncResponse": {
+      "type": "object",
+      "properties": {
+        "resetID": {
+          "type": "string"
+        }
+      }
+    },
+    "replicationRetryRequest": {
+      "type": "object",
+      "properties": {
+        "objects": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "prefix": {
+          "type": "string"
+        }
+      }
+    },
+    "replicationRetryResponse": {
+      "type": "object",
+      "properties": {
+        "failed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "objects": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/replicationRetryResult"
+          }
+        },
+        "queued": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "skipped": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "replicationRetryResult": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "reason": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "queued",
+            "skipped",
+            "failed"
+          ]
+        },
+        "version_id": {
+          "type": "string"
+        }
+      }
+    },
+    "replicationRuleUpdateResponse": {
+      "type": "object",
+      "properties": {
+        "warning": {
+          "type": "string"
+        }
+      }
+    },
+    "replicationTargetMetrics": {
+      "type": "object",
+      "properties": {
+        "arn": {
+          "type": "string"
+        },
+        "bandwidthLimit": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "bucket": {
+          "type": "string"
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "healthCheckPeriod": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "latencyMs": {
+          "type": "number",
+          "format": "double"
+        },
+        "resetBeforeDate": {
+          "type": "string"
+        },
+        "resetID": {
+          "type": "string"
+        },
+        "secure": {
+          "type": "boolean"
+        },
+        "storageClass": {
+          "type": "string"
+        },
+        "syncMode": {
+          "type": "string",
+          "enum": [
+            "async",
+            "sync"
+          ]
+        }
+      }
+    },
+    "resetConfigRequest": {
+      "type": "object",
+      "properties": {
+        "arn_resource_id": {
+          "type": "string",
+          "title": "Used if configuration is an event notification's target"
+        },
+        "keys": {
+          "type": "array",
+          "title": "keys to reset, the whole configuration is reset when empty",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "restoreObject": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "restore",
+            "remove",
+            "skip"
+          ]
+        },
+        "error": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "reason": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "planned",
+            "restored",
+            "removed",
+            "skipped",
+            "failed"
+          ]
+        },
+        "versionId": {
+          "type": "string"
+        }
+      }
+    },
+    "restoreRequest": {
+      "type": "object",
+      "required": [
+        "date"
+      ],
+      "properties": {
+        "date": {
+          "type": "string"
+        },
+        "dryRun": {
+          "type": "boolean"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "removeNewer": {
+          "type": "boolean"
+        }
+      }
+    },
+    "restoreSummary": {
+      "type": "object",
+      "properties": {
+        "dryRun": {
+          "type": "boolean"
+        },
+        "failed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "limitReached": {
+          "type": "boolean"
+        },
+        "objects": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/restoreObject"
+          }
+        },
+        "removed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "restored": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "skipped": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "truncated": {
+          "type": "boolean"
+        }
+      }
+    },
+    "resultTarget": {
+      "type": "object",
+      "properties": {
+        "legendFormat": {
+          "type": "string"
+        },
+        "result": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/widgetResult"
+          }
+        },
+        "resultType": {
+          "type": "string"
+        }
+      }
+    },
+    "rewindItem": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "type": "string"
+        },
+        "delete_flag": {
+          "type": "boolean"
+        },
+        "last_modified": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "version_id": {
+          "type": "string"
+        }
+      }
+    },
+    "rewindResponse": {
+      "type": "object",
+      "properties": {
+        "objects": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/rewindItem"
+          }
+        }
+      }
+    },
+    "serverUpdateRequest": {
+      "type": "object",
+      "properties": {
+        "force": {
+          "type": "boolean",
+          "title": "skip the pre-flight health check"
+        },
+        "url": {
+          "type": "string",
+          "title": "update URL, the Console configured one or the official release when empty"
+        }
+      }
+    },
+    "serverUpdateResponse": {
+      "type": "object",
+      "properties": {
+        "current_version": {
+          "type": "string"
+        },
+        "updated_version": {
+          "type": "string"
+        }
+      }
+    },
+    "serviceAccountCreds": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "secretKey": {
+          "type": "string"
+        }
+      }
+    },
+    "serviceAccountRequest": {
+      "type": "object",
+      "properties": {
+        "policy": {
+          "type": "string",
+          "title": "policy to be applied to the Service Account if any"
+        }
+      }
+    },
+    "serviceAccounts": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      }
+    },
+    "serviceServerStatus": {
+      "type": "object",
+      "properties": {
+        "endpoint": {
+          "type": "string"
+        },
+        "offline_drives": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "state": {
+          "type": "string"
+        },
+        "uptime": {
+          "type": "integer",
+          "format": "int64",
+          "title": "seconds since the server started"
+        },
+        "version": {
+          "type": "string"
+        }
+      }
+    },
+    "serviceStatusResponse": {
+      "type": "object",
+      "properties": {
+        "healthy": {
+          "type": "boolean",
+          "title": "whether the pre-flight health check passes"
+        },
+        "issues": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "online_servers": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "ready": {
+          "type": "boolean",
+          "title": "every server is online, restarted and running the expected version when requested"
+        },
+        "servers": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/serviceServerStatus"
+          }
+        },
+        "total_servers": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "sessionResponse": {
+      "type": "object",
+      "properties": {
+        "distributedMode": {
+          "type": "boolean"
+        },
+        "features": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "operator": {
+          "type": "boolean"
+        },
+        "pages": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "ok"
+          ]
+        }
+      }
+    },
+    "setBucketPolicyRequest": {
+      "type": "object",
+      "required": [
+        "access"
+      ],
+      "properties": {
+        "access": {
+          "$ref": "#/definitions/bucketAccess"
+        }
+      }
+    },
+    "setBucketQuota": {
+      "type": "object",
+      "required": [
+        "enabled"
+      ],
+      "properties": {
+        "amount": {
+          "type": "integer"
+        },
+        "enabled": {
+          "type": "boolean"
+        },
+        "quota_type": {
+          "type": "string",
+          "enum": [
+            "fifo",
+            "hard"
+          ]
+        }
+      }
+    },
+    "setBucketVersioning": {
+      "type": "object",
+      "properties": {
+        "versioning": {
+          "type": "boolean"
+        }
+      }
+    },
+    "setConfigRequest": {
+      "type": "object",
+      "required": [
+        "key_values"
+      ],
+      "properties": {
+        "arn_resource_id": {
+          "type": "string",
+          "title": "Used if configuration is an event notification's target"
+        },
+        "key_values": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/configurationKV"
+          }
+        }
+      }
+    },
+    "setConfigResponse": {
+      "type": "object",
+      "properties": {
+        "restart": {
+          "description": "Returns wheter server needs to restart to apply changes or not",
+          "type": "boolean"
+        }
+      }
+    },
+    "setConfigTargetRequest": {
+      "type": "object",
+      "required": [
+        "key_values"
+      ],
+      "properties": {
+        "key_values": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/configurationKV"
+          }
+        }
+      }
+    },
+    "setNotificationEndpointResponse": {
+      "type": "object",
+      "required": [
+        "service",
+        "account_id",
+        "properties"
+      ],
+      "properties": {
+        "account_id": {
+          "type": "string"
+        },
+        "properties": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "restart": {
+          "type": "boolean"
+        },
+        "service": {
+          "$ref": "#/definitions/nofiticationService"
+        }
+      }
+    },
+    "setPolicyMultipleRequest": {
+      "type": "object",
+      "properties": {
+        "groups": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/iamEntity"
+          }
+        },
+        "users": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/iamEntity"
+          }
+        }
+      }
+    },
+    "setPolicyRequest": {
+      "type": "object",
+      "required": [
+        "entityType",
+        "entityName"
+      ],
+      "properties": {
+        "entityName": {
+          "type": "string"
+        },
+        "entityType": {
+          "$ref": "#/definitions/policyEntity"
+        }
+      }
+    },
+    "siteReplicationAddRequest": {
+      "type": "object",
+      "required": [
+        "sites"
+      ],
+      "properties": {
+        "sites": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/peerSite"
+          }
+        }
+      }
+    },
+    "siteReplicationAddResponse": {
+      "type": "object",
+      "properties": {
+        "errorDetail": {
+          "type": "string"
+        },
+        "initialSyncErrorMessage": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        },
+        "success": {
+          "type": "boolean"
+        }
+      }
+    },
+    "siteReplicationEditResponse": {
+      "type": "object",
+      "properties": {
+        "errorDetail": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        },
+        "success": {
+          "type": "boolean"
+        }
+      }
+    },
+    "siteReplicationEntitySiteStatus": {
+      "type": "object",
+      "properties": {
+        "deploymentID": {
+          "type": "string"
+        },
+        "mismatches": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "present": {
+          "type": "boolean"
+        }
+      }
+    },
+    "siteReplicationEntityStatus": {
+      "type": "object",
+      "properties": {
+        "inSync": {
+          "type": "boolean"
+        },
+        "name": {
+          "type": "string"
+        },
+        "sites": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/siteReplicationEntitySiteStatus"
+          }
+        }
+      }
+    },
+    "siteReplicationInfo": {
+      "type": "object",
+      "properties": {
+        "enabled": {
+          "type": "boolean"
+        },
+        "name": {
+          "type": "string"
+        },
+        "serviceAccountAccessKey": {
+          "type": "string"
+        },
+        "sites": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/peerInfo"
+          }
+        }
+      }
+    },
+    "siteReplicationRemoveRequest": {
+      "type": "object",
+      "properties": {
+        "all": {
+          "type": "boolean"
+        },
+        "sites": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "siteReplicationRemoveResponse": {
+      "type": "object",
+      "properties": {
+        "errorDetail": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "siteReplicationSiteStatus": {
+      "type": "object",
+      "properties": {
+        "deploymentID": {
+          "type": "string"
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "replicatedBuckets": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "replicatedGroups": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "replicatedPolicies": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "replicatedUsers": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "totalBuckets": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "totalGroups": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "totalPolicies": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "totalUsers": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "siteReplicationStatusResponse": {
+      "type": "object",
+      "properties": {
+        "buckets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/siteReplicationEntityStatus"
+          }
+        },
+        "enabled": {
+          "type": "boolean"
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/siteReplicationEntityStatus"
+          }
+        },
+        "policies": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/siteReplicationEntityStatus"
+          }
+        },
+        "sites": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/siteReplicationSiteStatus"
+          }
+        },
+        "users": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/siteReplicationEntityStatus"
+          }
+        }
+      }
+    },
+    "sizeHistogramBucket": {
+      "type": "object",
+      "properties": {
+        "label": {
+          "type": "string"
+        },
+        "max": {
+          "type": "integer",
+          "format": "int64",
+          "title": "upper bound of the sizes, 0 when there is none"
+        },
+        "min": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "objects": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "startProfilingItem": {
+      "type": "object",
+      "properties": {
+        "error": {
+          "type": "string"
+        },
+        "nodeName": {
+          "type": "string"
+        },
+        "success": {
+          "type": "boolean"
+        }
+      }
+    },
+    "startProfilingList": {
+      "type": "object",
+      "properties": {
+        "startResults": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/startProfilingItem"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "number of start results"
+        }
+      }
+    },
+    "storageClassUsage": {
+      "type": "object",
+      "properties": {
+        "objects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "storage_class": {
+          "type": "string"
+        }
+      }
+    },
+    "tier": {
+      "type": "object",
+      "properties": {
+        "azure": {
+          "type": "object",
+          "$ref": "#/definitions/tier_azure"
+        },
+        "gcs": {
+          "type": "object",
+          "$ref": "#/definitions/tier_gcs"
+        },
+        "s3": {
+          "type": "object",
+          "$ref": "#/definitions/tier_s3"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "s3",
+            "gcs",
+            "azure",
+            "unsupported"
+          ]
+        }
+      }
+    },
+    "tierCredentialsRequest": {
+      "type": "object",
+      "properties": {
+        "access_key": {
+          "type": "string"
+        },
+        "creds": {
+          "description": "a base64 encoded value",
+          "type": "string"
+        },
+        "secret_key": {
+          "type": "string"
+        }
+      }
+    },
+    "tierListResponse": {
+      "type": "object",
+      "properties": {
+        "items": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/tier"
+          }
+        }
+      }
+    },
+    "tier_azure": {
+      "type": "object",
+      "properties": {
+        "accountkey": {
+          "type": "string"
+        },
+        "accountname": {
+          "type": "string"
+        },
+        "bucket": {
+          "type": "string"
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "region": {
+          "type": "string"
+        }
+      }
+    },
+    "tier_gcs": {
+      "type": "object",
+      "properties": {
+        "bucket": {
+          "type": "string"
+        },
+        "creds": {
+          "type": "string"
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "region": {
+          "type": "string"
+        }
+      }
+    },
+    "tier_s3": {
+      "type": "object",
+      "properties": {
+        "accesskey": {
+          "type": "string"
+        },
+        "bucket": {
+          "type": "string"
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "region": {
+          "type": "string"
+        },
+        "secretkey": {
+          "type": "string"
+        },
+        "storageclass": {
+          "type": "string"
+        }
+      }
+    },
+    "tlsConfiguration": {
+      "type": "object",
+      "properties": {
+        "ca_certificates": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "console": {
+          "type": "object",
+          "$ref": "#/definitions/keyPairConfiguration"
+        },
+        "console_ca_certificates": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "minio": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/keyPairConfiguration"
+          }
+        }
+      }
+    },
+    "topologyResponse": {
+      "type": "object",
+      "properties": {
+        "pools": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/poolTopology"
+          }
+        },
+        "rrs_parity": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "standard_parity": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "unassigned_drives": {
+          "type": "array",
+          "title": "drives not assigned to an erasure set yet",
+          "items": {
+            "$ref": "#/definitions/driveInfo"
+          }
+        }
+      }
+    },
+    "transitionResponse": {
+      "type": "object",
+      "properties": {
+        "date": {
+          "type": "string"
+        },
+        "days": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "storage_class": {
+          "type": "string"
+        }
+      }
+    },
+    "updateBucketLifecycle": {
+      "type": "object",
+      "properties": {
+        "disable": {
+          "type": "boolean"
+        },
+        "tags": {
+          "type": "string"
+        }
+      }
+    },
+    "updateBucketReplicationRule": {
+      "type": "object",
+      "title": "the settings left out keep their current values",
+      "properties": {
+        "delete_marker_replication": {
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "deletes_replication": {
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "existing_objects": {
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "metadata_replication": {
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "prefix": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "priority": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "Enabled",
+            "Disabled"
+          ]
+        },
+        "storageClass": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "tags": {
+          "type": "string",
+          "x-nullable": true
+        }
+      }
+    },
+    "updateGroupRequest": {
+      "type": "object",
+      "required": [
+        "members",
+        "status"
+      ],
+      "properties": {
+        "members": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "updateUser": {
+      "type": "object",
+      "required": [
+        "status",
+        "groups"
+      ],
+      "properties": {
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "updateUserGroups": {
+      "type": "object",
+      "required": [
+        "groups"
+      ],
+      "properties": {
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "user": {
+      "type": "object",
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "memberOf": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "policy": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "versionsCleanupItem": {
+      "type": "object",
+      "properties": {
+        "deleteMarker": {
+          "type": "boolean"
+        },
+        "error": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "versionID": {
+          "type": "string"
+        }
+      }
+    },
+    "versionsCleanupRequest": {
+      "type": "object",
+      "properties": {
+        "dryRun": {
+          "type": "boolean"
+        },
+        "keepLatest": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "olderThanDays": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "purgeDeleteMarkers": {
+          "type": "boolean"
+        }
+      }
+    },
+    "versionsCleanupSummary": {
+      "type": "object",
+      "properties": {
+        "dryRun": {
+          "type": "boolean"
+        },
+        "failed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "items": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/versionsCleanupItem"
+          }
+        },
+        "removedDeleteMarkers": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "removedSize": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "removedVersions": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "scanned": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "truncated": {
+          "type": "boolean"
+        }
+      }
+    },
+    "versionsPrefixStats": {
+      "type": "object",
+      "properties": {
+        "deleteMarkers": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "noncurrentSize": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "noncurrentVersions": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "objects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "orphanedDeleteMarkers": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "versions": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "versionsStatsResponse": {
+      "type": "object",
+      "properties": {
+        "prefixes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/versionsPrefixStats"
+          }
+        },
+        "scanned": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "truncated": {
+          "type": "boolean"
+        }
+      }
+    },
+    "webhook": {
+      "type": "object",
+      "properties": {
+        "created": {
+          "type": "string"
+        },
+        "created_by": {
+          "type": "string"
+        },
+        "disabled": {
+          "type": "boolean"
+        },
+        "events": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "has_secret": {
+          "type": "boolean"
+        },
+        "id": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "url": {
+          "type": "string"
+        }
+      }
+    },
+    "webhookDelivery": {
+      "type": "object",
+      "properties": {
+        "attempts": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "created": {
+          "type": "string"
+        },
+        "error": {
+          "type": "string"
+        },
+        "event": {
+          "type": "string"
+        },
+        "event_id": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "last_attempt": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "title": "pending, succeeded or failed"
+        },
+        "status_code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "webhook_id": {
+          "type": "string"
+        }
+      }
+    },
+    "webhookDeliveryList": {
+      "type": "object",
+      "properties": {
+        "deliveries": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/webhookDelivery"
+          }
+        }
+      }
+    },
+    "webhookList": {
+      "type": "object",
+      "properties": {
+        "webhooks": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/webhook"
+          }
+        }
+      }
+    },
+    "webhookRequest": {
+      "type": "object",
+      "required": [
+        "name",
+        "url"
+      ],
+      "properties": {
+        "disabled": {
+          "type": "boolean"
+        },
+        "events": {
+          "type": "array",
+          "title": "bucket.created, policy.changed, user.added or heal.corrupted, every event when empty",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "secret": {
+          "type": "string",
+          "title": "key of the HMAC-SHA256 signature of the events, the current one is kept when empty"
+        },
+        "url": {
+          "type": "string"
+        }
+      }
+    },
+    "widget": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "integer",
+          "format": "int32"
         },
-        "retention": {
-          "$ref": "#/definitions/putBucketRetentionRequest"
+        "options": {
+          "type": "object",
+          "properties": {
+            "reduceOptions": {
+              "type": "object",
+              "properties": {
+                "calcs": {
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                }
+              }
+            }
+          }
         },
-        "versioning": {
-          "type": "boolean"
+        "targets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/resultTarget"
+          }
+        },
+        "title": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        }
+      }
+    },
+    "widgetDetails": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "options": {
+          "type": "object",
+          "properties": {
+            "reduceOptions": {
+              "type": "object",
+              "properties": {
+                "calcs": {
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                }
+              }
+            }
+          }
+        },
+        "targets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/resultTarget"
+          }
+        },
+        "title": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        }
+      }
+    },
+    "widgetResult": {
+      "type": "object",
+      "properties": {
+        "metric": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "values": {
+          "type": "array",
+          "items": {}
+        }
+      }
+    }
+  },
+  "securityDefinitions": {
+    "key": {
+      "type": "oauth2",
+      "flow": "accessCode",
+      "authorizationUrl": "http://min.io",
+      "tokenUrl": "http://min.io"
+    }
+  },
+  "security": [
+    {
+      "key": []
+    }
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "http",
+    "ws"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "title": "MinIO Console Server",
+    "version": "0.1.0"
+  },
+  "basePath": "/api/v1",
+  "paths": {
+    "/account/change-password": {
+      "post": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Change password of currently logged in user.",
+        "operationId": "AccountChangePassword",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/accountChangePasswordRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful login.",
+            "schema": {
+              "$ref": "#/definitions/loginResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/account/change-user-password": {
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Change password of currently logged in user.",
+        "operationId": "ChangeUserPassword",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/changeUserPasswordRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Password successfully changed."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/alerts": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List pending, firing and recently resolved alerts",
+        "operationId": "ListAlerts",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertList"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/alerts/rules": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List alert rules",
+        "operationId": "ListAlertRules",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertRuleList"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Create an alert rule",
+        "operationId": "CreateAlertRule",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertRule"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertRule"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/alerts/rules/{id}": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Alert rule",
+        "operationId": "GetAlertRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertRule"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Update an alert rule",
+        "operationId": "UpdateAlertRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertRule"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertRule"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Delete an alert rule",
+        "operationId": "DeleteAlertRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/alerts/targets": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List alert notification targets",
+        "operationId": "ListAlertTargets",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertTargetList"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Add an alert notification target",
+        "operationId": "CreateAlertTarget",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertTarget"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertTarget"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/alerts/targets/{name}": {
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Update an alert notification target",
+        "operationId": "UpdateAlertTarget",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/alertTarget"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/alertTarget"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Delete an alert notification target",
+        "operationId": "DeleteAlertTarget",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/arns": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Returns a list of active ARNs in the instance",
+        "operationId": "ArnList",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/arnsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/capacity/forecast": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Forecast of the cluster capacity from the recorded usage",
+        "operationId": "CapacityForecast",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "days",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "top",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/capacityForecastResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/dashboard/widgets": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List built-in and user defined dashboard widgets",
+        "operationId": "ListDashboardWidgets",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/dashboardWidgetList"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Create a dashboard widget",
+        "operationId": "CreateDashboardWidget",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/dashboardWidget"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/dashboardWidget"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/dashboard/widgets/{widgetId}": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Dashboard widget definition",
+        "operationId": "GetDashboardWidget",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "widgetId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/dashboardWidget"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Update a user defined dashboard widget",
+        "operationId": "UpdateDashboardWidget",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "widgetId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/dashboardWidget"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/dashboardWidget"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Delete a user defined dashboard widget",
+        "operationId": "DeleteDashboardWidget",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "widgetId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/dashboards": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List saved dashboards",
+        "operationId": "ListDashboards",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/dashboardList"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Save a dashboard",
+        "operationId": "CreateDashboard",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/dashboard"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/dashboard"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/dashboards/{name}": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Saved dashboard",
+        "operationId": "GetDashboard",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/dashboard"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Update a saved dashboard",
+        "operationId": "UpdateDashboard",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/dashboard"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/dashboard"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Delete a saved dashboard",
+        "operationId": "DeleteDashboard",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/info": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Returns information about the deployment",
+        "operationId": "AdminInfo",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/adminInfoResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/info/widgets/{widgetId}": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Returns information about the deployment",
+        "operationId": "DashboardWidgetDetails",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "widgetId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "name": "start",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "name": "end",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "step",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/widgetDetails"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/jobs": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List the scheduled jobs",
+        "operationId": "ListJobs",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/jobList"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Create a scheduled job",
+        "operationId": "CreateJob",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/jobRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/job"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/jobs/{id}": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Get a scheduled job",
+        "operationId": "GetJob",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/job"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Delete a scheduled job and its runs",
+        "operationId": "DeleteJob",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/jobs/{id}/pause": {
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Pause the schedule of a job",
+        "operationId": "PauseJob",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/job"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/jobs/{id}/resume": {
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Resume the schedule of a job",
+        "operationId": "ResumeJob",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/job"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/jobs/{id}/run": {
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Run a job now",
+        "operationId": "RunJob",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/jobRun"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/jobs/{id}/runs": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List the runs of a job",
+        "operationId": "ListJobRuns",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/jobRunList"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/jobs/{id}/runs/{run}": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Get a run of a job with its logs",
+        "operationId": "GetJobRun",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "run",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/jobRun"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/jobs/{id}/runs/{run}/report": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Download the report of a run",
+        "operationId": "DownloadJobRunReport",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "run",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/kms/keys": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List KMS keys",
+        "operationId": "KMSListKeys",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "pattern",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/kmsListKeysResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Create a KMS key",
+        "operationId": "KMSCreateKey",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/kmsCreateKeyRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/kms/keys/{name}": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "KMS key status",
+        "operationId": "KMSKeyStatus",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/kmsKeyStatusResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "The key is only deleted when no bucket encryption uses it, the session must see every bucket of the deployment to verify it",
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Delete a KMS key",
+        "operationId": "KMSDeleteKey",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/kms/keys/{name}/import": {
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Import a KMS key",
+        "operationId": "KMSImportKey",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/kmsImportKeyRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/kms/status": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "KMS status",
+        "operationId": "KMSStatus",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/kmsStatusResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/nodes": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Servers and drives of the cluster",
+        "operationId": "ListNodes",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/nodesResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/nodes/drives/history": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Drive state transitions",
+        "operationId": "DriveStateHistory",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "drive",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/driveHistoryResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/nodes/{node}": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Server and drives details",
+        "operationId": "NodeInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "node",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/nodeInfo"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/notification_endpoints": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Returns a list of active notification endpoints",
+        "operationId": "NotificationEndpointList",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/notifEndpointResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Allows to configure a new notification endpoint",
+        "operationId": "AddNotificationEndpoint",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/notificationEndpoint"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/setNotificationEndpointResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/pools": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Pools and their decommission status",
+        "operationId": "ListPoolsStatus",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/poolsStatusResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/pools/{pool}/decommission": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Decommission status of a pool",
+        "operationId": "PoolDecommissionStatus",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "pool",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/poolStatus"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Start decommissioning a pool",
+        "operationId": "StartPoolDecommission",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "pool",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "name": "force",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Cancel the decommission of a pool",
+        "operationId": "CancelPoolDecommission",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "pool",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/rebalance": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Progress of the pools rebalance",
+        "operationId": "RebalanceStatus",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/rebalanceStatusResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/site-replication": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Get site replication info",
+        "operationId": "SiteReplicationInfo",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/siteReplicationInfo"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Edit a site of the site replication",
+        "operationId": "SiteReplicationEdit",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/peerInfo"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/siteReplicationEditResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Add sites to site replication",
+        "operationId": "SiteReplicationAdd",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/siteReplicationAddRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/siteReplicationAddResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Remove sites from site replication",
+        "operationId": "SiteReplicationRemove",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/siteReplicationRemoveRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/siteReplicationRemoveResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/site-replication/status": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Get the site replication sync status",
+        "operationId": "SiteReplicationStatus",
+        "parameters": [
+          {
+            "type": "boolean",
+            "default": true,
+            "name": "buckets",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": true,
+            "name": "policies",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": true,
+            "name": "users",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": true,
+            "name": "groups",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/siteReplicationStatusResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/tiers": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Returns a list of tiers for ilm",
+        "operationId": "TiersList",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/tierListResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Allows to configure a new tier",
+        "operationId": "AddTier",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/tier"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/tiers/{type}/{name}": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Get Tier",
+        "operationId": "GetTier",
+        "parameters": [
+          {
+            "enum": [
+              "s3",
+              "gcs",
+              "azure"
+            ],
+            "type": "string",
+            "name": "type",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/tier"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/tiers/{type}/{name}/credentials": {
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Edit Tier Credentials",
+        "operationId": "EditTierCredentials",
+        "parameters": [
+          {
+            "enum": [
+              "s3",
+              "gcs",
+              "azure"
+            ],
+            "type": "string",
+            "name": "type",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/tierCredentialsRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/topology": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Pools and erasure sets of the cluster",
+        "operationId": "ClusterTopology",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/topologyResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/webhooks": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List the Console webhooks",
+        "operationId": "ListWebhooks",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/webhookList"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Register a webhook notified of Console events",
+        "operationId": "CreateWebhook",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/webhookRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/webhook"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/webhooks/{id}": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Get a webhook",
+        "operationId": "GetWebhook",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/webhook"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Update a webhook",
+        "operationId": "UpdateWebhook",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/webhookRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/webhook"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Delete a webhook and its deliveries",
+        "operationId": "DeleteWebhook",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/webhooks/{id}/deliveries": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List the latest deliveries of a webhook",
+        "operationId": "ListWebhookDeliveries",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/webhookDeliveryList"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/admin/webhooks/{id}/test": {
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Send a test event to a webhook",
+        "operationId": "TestWebhook",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/webhookDelivery"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/bucket-policy/{bucket}": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List Policies With Given Bucket",
+        "operationId": "ListPoliciesWithBucket",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listPoliciesResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/bucket-users/{bucket}": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List Users With Access to a Given Bucket",
+        "operationId": "ListUsersWithAccessToBucket",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "List Buckets",
+        "operationId": "ListBuckets",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "sort_by",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listBucketsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Make bucket",
+        "operationId": "MakeBucket",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/makeBucketRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets-replication": {
+      "post": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Sets Multi Bucket Replication in multiple Buckets",
+        "operationId": "SetMultiBucketReplication",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/multiBucketReplication"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/multiBucketResponseState"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/analytics": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Object count, size distribution, top prefixes and storage classes of a bucket",
+        "operationId": "GetBucketAnalytics",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "description": "list the bucket even when the data usage is available",
+            "name": "refresh",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketAnalytics"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/encryption/disable": {
+      "post": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Disable bucket encryption.",
+        "operationId": "DisableBucketEncryption",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/encryption/enable": {
+      "post": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Enable bucket encryption.",
+        "operationId": "EnableBucketEncryption",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketEncryptionRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/encryption/info": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Get bucket encryption information.",
+        "operationId": "GetBucketEncryptionInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketEncryptionInfo"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/events": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "List Bucket Events",
+        "operationId": "ListBucketEvents",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listBucketEventsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Create Bucket Event",
+        "operationId": "CreateBucketEvent",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketEventRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/events/{arn}": {
+      "delete": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Delete Bucket Event",
+        "operationId": "DeleteBucketEvent",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "arn",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/notificationDeleteRequest"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/lifecycle": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Bucket Lifecycle",
+        "operationId": "GetBucketLifecycle",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketLifecycleResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Add Bucket Lifecycle",
+        "operationId": "AddBucketLifecycle",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/addBucketLifecycle"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/lifecycle-export": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Export the Bucket Lifecycle configuration",
+        "operationId": "ExportBucketLifecycle",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "xml",
+              "json"
+            ],
+            "type": "string",
+            "default": "xml",
+            "name": "format",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/lifecycle-import": {
+      "post": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Import a Bucket Lifecycle configuration replacing the current one",
+        "operationId": "ImportBucketLifecycle",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketLifecycleImport"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketLifecycleResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/lifecycle-preview": {
+      "post": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Preview the objects a lifecycle rule or the current configuration would expire or transition",
+        "operationId": "PreviewBucketLifecycle",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/lifecyclePreviewRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/lifecyclePreviewResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/lifecycle/{lifecycle_id}": {
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Update Lifecycle rule",
+        "operationId": "UpdateBucketLifecycle",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "lifecycle_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/updateBucketLifecycle"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Delete Lifecycle rule",
+        "operationId": "DeleteBucketLifecycleRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "lifecycle_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/locked-objects": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "List the objects under retention or legal hold",
+        "operationId": "ListLockedObjects",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "all_versions",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/lockedObjectsReport"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/object-locking": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Returns the status of object locking support on the bucket",
+        "operationId": "GetBucketObjectLockingStatus",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketObLockingResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/objects": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "List Objects",
+        "operationId": "ListObjects",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "recursive",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "with_versions",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listObjectsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Delete Object",
+        "operationId": "DeleteObject",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "path",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "recursive",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "bypass",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/objects/download": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Download Object",
+        "operationId": "Download Object",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/objects/legalhold": {
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Put Object's legalhold status",
+        "operationId": "PutObjectLegalHold",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putObjectLegalHoldRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/objects/lock": {
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Apply retention or legal hold to the objects under a prefix",
+        "operationId": "BulkObjectLock",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bulkObjectLockRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bulkObjectLockSummary"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/objects/retention": {
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Put Object's retention status",
+        "operationId": "PutObjectRetention",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putObjectRetentionRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Delete Object retention from an object",
+        "operationId": "DeleteObjectRetention",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/objects/share": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Shares an Object on a url",
+        "operationId": "ShareObject",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "expires",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/objects/tags": {
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Put Object's tags",
+        "operationId": "PutObjectTags",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "version_id",
+            "in": "query",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putObjectTagsRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/objects/upload": {
+      "post": {
+        "consumes": [
+          "multipart/form-data"
+        ],
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Uploads an Object.",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/replication": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Bucket Replication",
+        "operationId": "GetBucketReplication",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketReplicationResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/replication-failed": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "List objects which failed to replicate",
+        "operationId": "ListBucketReplicationFailed",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketReplicationFailedObjects"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/replication-metrics": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Bucket Replication Metrics",
+        "operationId": "GetBucketReplicationMetrics",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketReplicationMetrics"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/replication-order": {
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Reorder Bucket Replication Rules",
+        "operationId": "SetBucketReplicationRulesOrder",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketReplicationRulesOrder"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/replication-resync": {
+      "post": {
+        "description": "A single target can't be resynced, the resync always covers all the targets of the bucket",
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Resync existing objects to every replication target of the bucket",
+        "operationId": "ResyncBucketReplication",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/replicationResyncRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/replicationResyncResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/replication-retry": {
+      "post": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Retry failed replications",
+        "operationId": "RetryBucketReplication",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/replicationRetryRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/replicationRetryResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/replication/{rule_id}": {
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Update Bucket Replication Rule",
+        "operationId": "UpdateBucketReplicationRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "rule_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/updateBucketReplicationRule"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/replicationRuleUpdateResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Bucket Replication Rule Delete",
+        "operationId": "DeleteBucketReplicationRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "rule_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/replication/{rule_id}/status": {
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Enable or disable a Bucket Replication Rule",
+        "operationId": "SetBucketReplicationRuleStatus",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "rule_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketReplicationRuleStatus"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/replicationRuleUpdateResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/restore": {
+      "post": {
+        "description": "The summary lists the first 1000 objects, the restore websocket reports every object of larger restores",
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Restore a bucket or prefix to a point in time",
+        "operationId": "RestoreBucket",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/restoreRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/restoreSummary"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/retention": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Get Bucket's retention config",
+        "operationId": "GetBucketRetentionConfig",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/getBucketRetentionConfig"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Set Bucket's retention config",
+        "operationId": "SetBucketRetentionConfig",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putBucketRetentionRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/rewind/{date}": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Get objects in a bucket for a rewind date",
+        "operationId": "GetBucketRewind",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "date",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/rewindResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "multiBucketReplication": {
-      "required": [
-        "accessKey",
-        "secretKey",
-        "targetURL",
-        "bucketsRelation"
-      ],
-      "properties": {
-        "accessKey": {
-          "type": "string",
-          "minLength": 3
-        },
-        "bandwidth": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "bucketsRelation": {
-          "type": "array",
-          "minLength": 1,
-          "items": {
-            "$ref": "#/definitions/multiBucketsRelation"
+    "/buckets/{bucket_name}/versioning": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Bucket Versioning",
+        "operationId": "GetBucketVersioning",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketVersioningResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Set Bucket Versioning",
+        "operationId": "SetBucketVersioning",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setBucketVersioning"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "healthCheckPeriod": {
-          "type": "integer",
-          "format": "int32"
-        },
-        "prefix": {
-          "type": "string"
-        },
-        "region": {
-          "type": "string"
-        },
-        "replicateDeleteMarkers": {
-          "type": "boolean"
-        },
-        "replicateDeletes": {
-          "type": "boolean"
-        },
-        "replicateMetadata": {
-          "type": "boolean"
-        },
-        "secretKey": {
-          "type": "string",
-          "minLength": 8
-        },
-        "syncMode": {
-          "type": "string",
-          "default": "async",
-          "enum": [
-            "async",
-            "sync"
-          ]
-        },
-        "tags": {
-          "type": "string"
-        },
-        "targetURL": {
-          "type": "string"
         }
       }
     },
-    "multiBucketResponseItem": {
-      "type": "object",
-      "properties": {
-        "errorString": {
-          "type": "string"
-        },
-        "originBucket": {
-          "type": "string"
-        },
-        "targetBucket": {
-          "type": "string"
+    "/buckets/{bucket_name}/versions-cleanup": {
+      "post": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Remove noncurrent versions and orphaned delete markers",
+        "operationId": "CleanupBucketVersions",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/versionsCleanupRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/versionsCleanupSummary"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{bucket_name}/versions-stats": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Version counts and noncurrent size per prefix",
+        "operationId": "GetBucketVersionsStats",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "bucket_name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/versionsStatsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/buckets/{name}": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Bucket Info",
+        "operationId": "BucketInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucket"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Delete Bucket",
+        "operationId": "DeleteBucket",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "multiBucketResponseState": {
-      "type": "object",
-      "properties": {
-        "replicationState": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/multiBucketResponseItem"
+    "/buckets/{name}/quota": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Get Bucket Quota",
+        "operationId": "GetBucketQuota",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketQuota"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      }
-    },
-    "multiBucketsRelation": {
-      "type": "object",
-      "properties": {
-        "destinationBucket": {
-          "type": "string"
-        },
-        "originBucket": {
-          "type": "string"
+      },
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Bucket Quota",
+        "operationId": "SetBucketQuota",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setBucketQuota"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucket"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "nofiticationService": {
-      "type": "string",
-      "enum": [
-        "webhook",
-        "amqp",
-        "kafka",
-        "mqtt",
-        "nats",
-        "nsq",
-        "mysql",
-        "postgres",
-        "elasticsearch",
-        "redis"
-      ]
-    },
-    "notifEndpointResponse": {
-      "type": "object",
-      "properties": {
-        "notification_endpoints": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/notificationEndpointItem"
+    "/buckets/{name}/set-policy": {
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Bucket Set Policy",
+        "operationId": "BucketSetPolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setBucketPolicyRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucket"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "notificationConfig": {
-      "type": "object",
-      "required": [
-        "arn"
-      ],
-      "properties": {
-        "arn": {
-          "type": "string"
-        },
-        "events": {
-          "type": "array",
-          "title": "filter specific type of event. Defaults to all event (default: '[put,delete,get]')",
-          "items": {
-            "$ref": "#/definitions/notificationEventType"
+    "/buckets/{name}/soft-quota": {
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Set the soft quota of a bucket",
+        "operationId": "SetBucketSoftQuota",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketSoftQuota"
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Remove the soft quota of a bucket",
+        "operationId": "DeleteBucketSoftQuota",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "id": {
-          "type": "string"
-        },
-        "prefix": {
-          "type": "string",
-          "title": "filter event associated to the specified prefix"
-        },
-        "suffix": {
-          "type": "string",
-          "title": "filter event associated to the specified suffix"
         }
       }
     },
-    "notificationDeleteRequest": {
-      "type": "object",
-      "required": [
-        "events",
-        "prefix",
-        "suffix"
-      ],
-      "properties": {
-        "events": {
-          "type": "array",
-          "title": "filter specific type of event. Defaults to all event (default: '[put,delete,get]')",
-          "minLength": 1,
-          "items": {
-            "$ref": "#/definitions/notificationEventType"
+    "/configs": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List Configurations",
+        "operationId": "ListConfig",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listConfigResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "prefix": {
-          "type": "string",
-          "title": "filter event associated to the specified prefix"
-        },
-        "suffix": {
-          "type": "string",
-          "title": "filter event associated to the specified suffix"
         }
       }
     },
-    "notificationEndpoint": {
-      "type": "object",
-      "required": [
-        "service",
-        "account_id",
-        "properties"
-      ],
-      "properties": {
-        "account_id": {
-          "type": "string"
-        },
-        "properties": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
+    "/configs-export": {
+      "get": {
+        "description": "The values of the secrets like passwords and tokens are masked",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Export the full server configuration",
+        "operationId": "ExportConfig",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "service": {
-          "$ref": "#/definitions/nofiticationService"
         }
       }
     },
-    "notificationEndpointItem": {
-      "type": "object",
-      "properties": {
-        "account_id": {
-          "type": "string"
-        },
-        "service": {
-          "$ref": "#/definitions/nofiticationService"
-        },
-        "status": {
-          "type": "string"
+    "/configs-history": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List the configuration revisions",
+        "operationId": "ListConfigHistory",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/configHistoryResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Take a snapshot of the configuration",
+        "operationId": "CreateConfigSnapshot",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/configSnapshotRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/configRevision"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "notificationEventType": {
-      "type": "string",
-      "enum": [
-        "put",
-        "delete",
-        "get"
-      ]
-    },
-    "objectBucketLifecycle": {
-      "type": "object",
-      "properties": {
-        "expiration": {
-          "$ref": "#/definitions/expirationResponse"
-        },
-        "id": {
-          "type": "string"
-        },
-        "prefix": {
-          "type": "string"
-        },
-        "status": {
-          "type": "string"
-        },
-        "tags": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/lifecycleTag"
+    "/configs-history/diff": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Compare two configuration revisions",
+        "operationId": "DiffConfigRevisions",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "from",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "to",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/configDiffResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "transition": {
-          "$ref": "#/definitions/transitionResponse"
         }
       }
     },
-    "objectLegalHoldStatus": {
-      "type": "string",
-      "enum": [
-        "enabled",
-        "disabled"
-      ]
-    },
-    "objectRetentionMode": {
-      "type": "string",
-      "enum": [
-        "governance",
-        "compliance"
-      ]
-    },
-    "objectRetentionUnit": {
-      "type": "string",
-      "enum": [
-        "days",
-        "years"
-      ]
-    },
-    "permissionAction": {
-      "type": "object",
-      "properties": {
-        "can": {
-          "type": "boolean"
-        },
-        "id": {
-          "type": "string"
+    "/configs-history/{id}/restore": {
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Restore the configuration of a revision",
+        "operationId": "RestoreConfigRevision",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/configRestoreResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "policy": {
-      "type": "object",
-      "properties": {
-        "name": {
-          "type": "string"
-        },
-        "policy": {
-          "type": "string"
+    "/configs/{name}": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Configuration info",
+        "operationId": "ConfigInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/configuration"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "policyArgs": {
-      "type": "object",
-      "properties": {
-        "action": {
-          "type": "string"
-        },
-        "bucket_name": {
-          "type": "string"
-        },
-        "id": {
-          "type": "string"
+      },
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Set Configuration",
+        "operationId": "SetConfig",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setConfigRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/setConfigResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "policyEntity": {
-      "type": "string",
-      "default": "user",
-      "enum": [
-        "user",
-        "group"
-      ]
-    },
-    "principal": {
-      "type": "object",
-      "properties": {
-        "STSAccessKeyID": {
-          "type": "string"
-        },
-        "STSSecretAccessKey": {
-          "type": "string"
-        },
-        "STSSessionToken": {
-          "type": "string"
-        },
-        "accountAccessKey": {
-          "type": "string"
-        },
-        "actions": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/configs/{name}/reset": {
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Reset configuration keys to their defaults",
+        "operationId": "ResetConfig",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/resetConfigRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/setConfigResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "profilerType": {
-      "type": "string",
-      "enum": [
-        "cpu",
-        "mem",
-        "block",
-        "mutex",
-        "trace",
-        "threads",
-        "goroutines"
-      ]
-    },
-    "profilingStartRequest": {
-      "type": "object",
-      "required": [
-        "type"
-      ],
-      "properties": {
-        "type": {
-          "$ref": "#/definitions/profilerType"
+    "/configs/{name}/targets": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List the targets of a configuration subsystem",
+        "operationId": "ListConfigTargets",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/configTargetsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "prometheusConfiguration": {
-      "type": "object",
-      "properties": {
-        "image": {
-          "type": "string"
-        },
-        "storageClass": {
-          "type": "string",
-          "default": ""
-        },
-        "storageSize": {
-          "type": "number",
-          "default": 5
+    "/configs/{name}/targets/{target}": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Get a configuration target",
+        "operationId": "GetConfigTarget",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "target",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/configTarget"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "putBucketRetentionRequest": {
-      "type": "object",
-      "required": [
-        "mode",
-        "unit",
-        "validity"
-      ],
-      "properties": {
-        "mode": {
-          "$ref": "#/definitions/objectRetentionMode"
-        },
-        "unit": {
-          "$ref": "#/definitions/objectRetentionUnit"
-        },
-        "validity": {
-          "type": "integer",
-          "format": "int32"
+      },
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Set a configuration target",
+        "operationId": "SetConfigTarget",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "target",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setConfigTargetRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/setConfigResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Delete a configuration target",
+        "operationId": "DeleteConfigTarget",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "target",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/setConfigResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "putObjectLegalHoldRequest": {
-      "type": "object",
-      "required": [
-        "status"
-      ],
-      "properties": {
-        "status": {
-          "$ref": "#/definitions/objectLegalHoldStatus"
+    "/groups": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List Groups",
+        "operationId": "ListGroups",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listGroupsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "putObjectRetentionRequest": {
-      "type": "object",
-      "required": [
-        "mode",
-        "expires"
-      ],
-      "properties": {
-        "expires": {
-          "type": "string"
-        },
-        "governance_bypass": {
-          "type": "boolean"
-        },
-        "mode": {
-          "$ref": "#/definitions/objectRetentionMode"
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Add Group",
+        "operationId": "AddGroup",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/addGroupRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "putObjectTagsRequest": {
-      "type": "object",
-      "properties": {
-        "tags": {
-          "additionalProperties": {
-            "type": "string"
+    "/groups/{name}": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Group info",
+        "operationId": "GroupInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/group"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      }
-    },
-    "remoteBucket": {
-      "type": "object",
-      "required": [
-        "accessKey",
-        "sourceBucket",
-        "remoteARN"
-      ],
-      "properties": {
-        "accessKey": {
-          "type": "string",
-          "minLength": 3
-        },
-        "bandwidth": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "healthCheckPeriod": {
-          "type": "integer"
-        },
-        "remoteARN": {
-          "type": "string"
-        },
-        "secretKey": {
-          "type": "string",
-          "minLength": 8
-        },
-        "service": {
-          "type": "string",
-          "enum": [
-            "replication"
-          ]
-        },
-        "sourceBucket": {
-          "type": "string"
-        },
-        "status": {
-          "type": "string"
-        },
-        "syncMode": {
-          "type": "string"
-        },
-        "targetBucket": {
-          "type": "string"
-        },
-        "targetURL": {
-          "type": "string"
+      },
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Update Group Members or Status",
+        "operationId": "UpdateGroup",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/updateGroupRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/group"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "resultTarget": {
-      "type": "object",
-      "properties": {
-        "legendFormat": {
-          "type": "string"
-        },
-        "result": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/widgetResult"
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Remove group",
+        "operationId": "RemoveGroup",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "resultType": {
-          "type": "string"
         }
       }
     },
-    "rewindItem": {
-      "type": "object",
-      "properties": {
-        "action": {
-          "type": "string"
-        },
-        "delete_flag": {
-          "type": "boolean"
-        },
-        "last_modified": {
-          "type": "string"
-        },
-        "name": {
-          "type": "string"
-        },
-        "size": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "version_id": {
-          "type": "string"
+    "/has-permission": {
+      "post": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Checks whether the user can perform a series of actions",
+        "operationId": "HasPermissionTo",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/hasPermissionRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/hasPermissionResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "rewindResponse": {
-      "type": "object",
-      "properties": {
-        "objects": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/rewindItem"
+    "/list-external-buckets": {
+      "post": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Lists an External list of buckets using custom credentials",
+        "operationId": "ListExternalBuckets",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/listExternalBucketsParams"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listBucketsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "serviceAccountCreds": {
-      "type": "object",
-      "properties": {
-        "accessKey": {
-          "type": "string"
-        },
-        "secretKey": {
-          "type": "string"
+    "/login": {
+      "get": {
+        "security": [],
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Returns login strategy, form or sso.",
+        "operationId": "LoginDetail",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/loginDetails"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "serviceAccountRequest": {
-      "type": "object",
-      "properties": {
-        "policy": {
-          "type": "string",
-          "title": "policy to be applied to the Service Account if any"
+      },
+      "post": {
+        "security": [],
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Login to Console",
+        "operationId": "Login",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/loginRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful login.",
+            "schema": {
+              "$ref": "#/definitions/loginResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "serviceAccounts": {
-      "type": "array",
-      "items": {
-        "type": "string"
-      }
-    },
-    "sessionResponse": {
-      "type": "object",
-      "properties": {
-        "distributedMode": {
-          "type": "boolean"
-        },
-        "features": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/login/oauth2/auth": {
+      "post": {
+        "security": [],
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Identity Provider oauth2 callback endpoint.",
+        "operationId": "LoginOauth2Auth",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/loginOauth2AuthRequest"
+            }
           }
-        },
-        "operator": {
-          "type": "boolean"
-        },
-        "pages": {
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful login.",
+            "schema": {
+              "$ref": "#/definitions/loginResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "status": {
-          "type": "string",
-          "enum": [
-            "ok"
-          ]
         }
       }
     },
-    "setBucketPolicyRequest": {
-      "type": "object",
-      "required": [
-        "access"
-      ],
-      "properties": {
-        "access": {
-          "$ref": "#/definitions/bucketAccess"
+    "/login/operator": {
+      "post": {
+        "security": [],
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Login to Operator Console.",
+        "operationId": "LoginOperator",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/loginOperatorRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful login.",
+            "schema": {
+              "$ref": "#/definitions/loginResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "setBucketQuota": {
-      "type": "object",
-      "required": [
-        "enabled"
-      ],
-      "properties": {
-        "amount": {
-          "type": "integer"
-        },
-        "enabled": {
-          "type": "boolean"
-        },
-        "quota_type": {
-          "type": "string",
-          "enum": [
-            "fifo",
-            "hard"
-          ]
+    "/logout": {
+      "post": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Logout from Console.",
+        "operationId": "Logout",
+        "responses": {
+          "200": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "setBucketVersioning": {
-      "type": "object",
-      "properties": {
-        "versioning": {
-          "type": "boolean"
+    "/logs/search": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Search the logs",
+        "operationId": "LogSearch",
+        "parameters": [
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Filter Parameters",
+            "name": "fp",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int32",
+            "default": 10,
+            "name": "pageSize",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int32",
+            "default": 0,
+            "name": "pageNo",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "timeDesc",
+              "timeAsc"
+            ],
+            "type": "string",
+            "default": "timeDesc",
+            "name": "order",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "timeStart",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "timeEnd",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "reqinfo",
+              "raw",
+              "statsinfo"
+            ],
+            "type": "string",
+            "default": "reqinfo",
+            "description": "Query type",
+            "name": "q",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "object",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "apiName",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int32",
+            "name": "statusCode",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "User or access key that performed the request",
+            "name": "accessKey",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int64",
+            "description": "Minimum time to response in milliseconds",
+            "name": "responseTimeMin",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int64",
+            "description": "Maximum time to response in milliseconds",
+            "name": "responseTimeMax",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int32",
+            "default": 60,
+            "description": "Interval in seconds of the aggregated stats",
+            "name": "interval",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/logSearchResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "setConfigRequest": {
-      "type": "object",
-      "required": [
-        "key_values"
-      ],
-      "properties": {
-        "arn_resource_id": {
-          "type": "string",
-          "title": "Used if configuration is an event notification's target"
-        },
-        "key_values": {
-          "type": "array",
-          "minItems": 1,
-          "items": {
-            "$ref": "#/definitions/configurationKV"
+    "/logs/search/export": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Export the results of a log search",
+        "operationId": "LogSearchExport",
+        "parameters": [
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Filter Parameters",
+            "name": "fp",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "timeDesc",
+              "timeAsc"
+            ],
+            "type": "string",
+            "default": "timeDesc",
+            "name": "order",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "timeStart",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "timeEnd",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "reqinfo",
+              "raw"
+            ],
+            "type": "string",
+            "default": "reqinfo",
+            "description": "Query type",
+            "name": "q",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "object",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "apiName",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int32",
+            "name": "statusCode",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "User or access key that performed the request",
+            "name": "accessKey",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int64",
+            "description": "Minimum time to response in milliseconds",
+            "name": "responseTimeMin",
+            "in": "query"
+          },
+          {
+            "type": "number",
+            "format": "int64",
+            "description": "Maximum time to response in milliseconds",
+            "name": "responseTimeMax",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "csv",
+              "json"
+            ],
+            "type": "string",
+            "default": "csv",
+            "name": "format",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "setConfigResponse": {
-      "type": "object",
-      "properties": {
-        "restart": {
-          "description": "Returns wheter server needs to restart to apply changes or not",
-          "type": "boolean"
+    "/policies": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List Policies",
+        "operationId": "ListPolicies",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listPoliciesResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "setNotificationEndpointResponse": {
-      "type": "object",
-      "required": [
-        "service",
-        "account_id",
-        "properties"
-      ],
-      "properties": {
-        "account_id": {
-          "type": "string"
-        },
-        "properties": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Add Policy",
+        "operationId": "AddPolicy",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/addPolicyRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/policy"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "restart": {
-          "type": "boolean"
-        },
-        "service": {
-          "$ref": "#/definitions/nofiticationService"
         }
       }
     },
-    "setPolicyMultipleRequest": {
-      "type": "object",
-      "properties": {
-        "groups": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/iamEntity"
+    "/policies/{policy}/groups": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List Groups for a Policy",
+        "operationId": "ListGroupsForPolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "policy",
+            "in": "path",
+            "required": true
           }
-        },
-        "users": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/iamEntity"
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "setPolicyRequest": {
-      "type": "object",
-      "required": [
-        "entityType",
-        "entityName"
-      ],
-      "properties": {
-        "entityName": {
-          "type": "string"
-        },
-        "entityType": {
-          "$ref": "#/definitions/policyEntity"
+    "/policies/{policy}/users": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List Users for a Policy",
+        "operationId": "ListUsersForPolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "policy",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "startProfilingItem": {
-      "type": "object",
-      "properties": {
-        "error": {
-          "type": "string"
-        },
-        "nodeName": {
-          "type": "string"
-        },
-        "success": {
-          "type": "boolean"
+    "/policy": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Policy info",
+        "operationId": "PolicyInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/policy"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "startProfilingList": {
-      "type": "object",
-      "properties": {
-        "startResults": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/startProfilingItem"
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Remove policy",
+        "operationId": "RemovePolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "number of start results"
         }
       }
     },
-    "tier": {
-      "type": "object",
-      "properties": {
-        "azure": {
-          "type": "object",
-          "$ref": "#/definitions/tier_azure"
-        },
-        "gcs": {
-          "type": "object",
-          "$ref": "#/definitions/tier_gcs"
-        },
-        "s3": {
-          "type": "object",
-          "$ref": "#/definitions/tier_s3"
-        },
-        "type": {
-          "type": "string",
-          "enum": [
-            "s3",
-            "gcs",
-            "azure",
-            "unsupported"
-          ]
+    "/profiling/captures": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List the profiling captures kept by Console",
+        "operationId": "ListProfilingCaptures",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/profilingCaptureList"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "tierCredentialsRequest": {
-      "type": "object",
-      "properties": {
-        "access_key": {
-          "type": "string"
-        },
-        "creds": {
-          "description": "a base64 encoded value",
-          "type": "string"
-        },
-        "secret_key": {
-          "type": "string"
+    "/profiling/captures/{capture_id}": {
+      "get": {
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Download a stored profiling capture",
+        "operationId": "DownloadProfilingCapture",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "capture_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "tierListResponse": {
-      "type": "object",
-      "properties": {
-        "items": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/tier"
+    "/profiling/captures/{capture_id}/summary": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Top functions per node from the CPU profile of a capture",
+        "operationId": "ProfilingCaptureSummary",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "capture_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "top",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/profilingSummary"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "tier_azure": {
-      "type": "object",
-      "properties": {
-        "accountkey": {
-          "type": "string"
-        },
-        "accountname": {
-          "type": "string"
-        },
-        "bucket": {
-          "type": "string"
-        },
-        "endpoint": {
-          "type": "string"
-        },
-        "name": {
-          "type": "string"
-        },
-        "prefix": {
-          "type": "string"
-        },
-        "region": {
-          "type": "string"
+    "/profiling/start": {
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Start recording profile data",
+        "operationId": "ProfilingStart",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/profilingStartRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/startProfilingList"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "tier_gcs": {
-      "type": "object",
-      "properties": {
-        "bucket": {
-          "type": "string"
-        },
-        "creds": {
-          "type": "string"
-        },
-        "endpoint": {
-          "type": "string"
-        },
-        "name": {
-          "type": "string"
-        },
-        "prefix": {
-          "type": "string"
-        },
-        "region": {
-          "type": "string"
+    "/profiling/stop": {
+      "post": {
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Stop and download profile data",
+        "operationId": "ProfilingStop",
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "tier_s3": {
-      "type": "object",
-      "properties": {
-        "accesskey": {
-          "type": "string"
-        },
-        "bucket": {
-          "type": "string"
-        },
-        "endpoint": {
-          "type": "string"
-        },
-        "name": {
-          "type": "string"
-        },
-        "prefix": {
-          "type": "string"
-        },
-        "region": {
-          "type": "string"
-        },
-        "secretkey": {
-          "type": "string"
-        },
-        "storageclass": {
-          "type": "string"
+    "/quotas": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Quota, usage and soft quota of every bucket",
+        "operationId": "ListBucketQuotas",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketQuotaOverview"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Set the quota of the buckets matching a name pattern",
+        "operationId": "SetBucketQuotas",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketQuotaBulkRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketQuotaBulkResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "tlsConfiguration": {
-      "type": "object",
-      "properties": {
-        "ca_certificates": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/remote-buckets": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "List Remote Buckets",
+        "operationId": "ListRemoteBuckets",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listRemoteBucketsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "console": {
-          "type": "object",
-          "$ref": "#/definitions/keyPairConfiguration"
-        },
-        "console_ca_certificates": {
-          "type": "array",
-          "items": {
-            "type": "string"
+        }
+      },
+      "post": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Add Remote Bucket",
+        "operationId": "AddRemoteBucket",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createRemoteBucket"
+            }
           }
-        },
-        "minio": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/keyPairConfiguration"
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "transitionResponse": {
-      "type": "object",
-      "properties": {
-        "date": {
-          "type": "string"
-        },
-        "days": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "storage_class": {
-          "type": "string"
+    "/remote-buckets/{name}": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Remote Bucket Details",
+        "operationId": "RemoteBucketDetails",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/remoteBucket"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "updateBucketLifecycle": {
-      "type": "object",
-      "properties": {
-        "disable": {
-          "type": "boolean"
-        },
-        "tags": {
-          "type": "string"
+    "/remote-buckets/{source-bucket-name}/{arn}": {
+      "delete": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Delete Remote Bucket",
+        "operationId": "DeleteRemoteBucket",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "source-bucket-name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "arn",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "updateGroupRequest": {
-      "type": "object",
-      "required": [
-        "members",
-        "status"
-      ],
-      "properties": {
-        "members": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/service-accounts": {
+      "get": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "List User's Service Accounts",
+        "operationId": "ListUserServiceAccounts",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccounts"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "status": {
-          "type": "string"
         }
-      }
-    },
-    "updateUser": {
-      "type": "object",
-      "required": [
-        "status",
-        "groups"
-      ],
-      "properties": {
-        "groups": {
-          "type": "array",
-          "items": {
-            "type": "string"
+      },
+      "post": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Create Service Account",
+        "operationId": "CreateServiceAccount",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/serviceAccountRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccountCreds"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "status": {
-          "type": "string"
         }
       }
     },
-    "updateUserGroups": {
-      "type": "object",
-      "required": [
-        "groups"
-      ],
-      "properties": {
-        "groups": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/service-accounts/{access_key}": {
+      "delete": {
+        "tags": [
+          "UserAPI"
+        ],
+        "summary": "Delete Service Account",
+        "operationId": "DeleteServiceAccount",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "access_key",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "user": {
-      "type": "object",
-      "properties": {
-        "accessKey": {
-          "type": "string"
-        },
-        "memberOf": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/service/restart": {
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Restart Service",
+        "operationId": "RestartService",
+        "parameters": [
+          {
+            "type": "boolean",
+            "description": "Make sure every server and drive is healthy before restarting",
+            "name": "check",
+            "in": "query"
           }
-        },
-        "policy": {
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "status": {
-          "type": "string"
         }
       }
     },
-    "widget": {
-      "type": "object",
-      "properties": {
-        "id": {
-          "type": "integer",
-          "format": "int32"
-        },
-        "options": {
-          "type": "object",
-          "properties": {
-            "reduceOptions": {
-              "type": "object",
-              "properties": {
-                "calcs": {
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  }
-                }
-              }
-            }
+    "/service/status": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Status of the MinIO servers",
+        "operationId": "ServiceStatus",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "expected_version",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "name": "restarted_after",
+            "in": "query"
           }
-        },
-        "targets": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/resultTarget"
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceStatusResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "title": {
-          "type": "string"
-        },
-        "type": {
-          "type": "string"
         }
       }
     },
-    "widgetDetails": {
-      "type": "object",
-      "properties": {
-        "id": {
-          "type": "integer",
-          "format": "int32"
-        },
-        "options": {
-          "type": "object",
-          "properties": {
-            "reduceOptions": {
-              "type": "object",
-              "properties": {
-                "calcs": {
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  }
-                }
-              }
-            }
+    "/service/stop": {
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Stop Service",
+        "operationId": "StopService",
+        "parameters": [
+          {
+            "type": "boolean",
+            "name": "force",
+            "in": "query"
           }
-        },
-        "targets": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/resultTarget"
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "title": {
-          "type": "string"
-        },
-        "type": {
-          "type": "string"
         }
       }
     },
-    "widgetResult": {
-      "type": "object",
-      "properties": {
-        "metric": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
-          }
-        },
-        "values": {
-          "type": "array",
-          "items": {}
-        }
-      }
-    }
-  },
-  "securityDefinitions": {
-    "key": {
-      "type": "oauth2",
-      "flow": "accessCode",
-      "authorizationUrl": "http://min.io",
-      "tokenUrl": "http://min.io"
-    }
-  },
-  "security": [
-    {
-      "key": []
-    }
-  ]
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "http",
-    "ws"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "title": "MinIO Console Server",
-    "version": "0.1.0"
-  },
-  "basePath": "/api/v1",
-  "paths": {
-    "/account/change-password": {
+    "/service/update": {
       "post": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "Change password of currently logged in user.",
-        "operationId": "AccountChangePassword",
+        "summary": "Update the MinIO servers",
+        "operationId": "UpdateServer",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/accountChangePasswordRequest"
+              "$ref": "#/definitions/serverUpdateRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serverUpdateResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           }
+        }
+      }
+    },
+    "/session": {
+      "get": {
+        "tags": [
+          "UserAPI"
         ],
+        "summary": "Endpoint to check if your session is still valid",
+        "operationId": "SessionCheck",
         "responses": {
-          "201": {
-            "description": "A successful login.",
+          "200": {
+            "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/loginResponse"
+              "$ref": "#/definitions/sessionResponse"
             }
           },
           "default": {
@@ -5416,26 +17533,32 @@ func init() {
         }
       }
     },
-    "/account/change-user-password": {
-      "post": {
+    "/set-policy-multi/{name}": {
+      "put": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Change password of currently logged in user.",
-        "operationId": "ChangeUserPassword",
+        "summary": "Set policy to multiple users/groups",
+        "operationId": "SetPolicyMultiple",
         "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/changeUserPasswordRequest"
+              "$ref": "#/definitions/setPolicyMultipleRequest"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Password successfully changed."
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -5446,19 +17569,32 @@ func init() {
         }
       }
     },
-    "/admin/arns": {
-      "get": {
+    "/set-policy/{name}": {
+      "put": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Returns a list of active ARNs in the instance",
-        "operationId": "ArnList",
-        "responses": {
-          "200": {
-            "description": "A successful response.",
+        "summary": "Set policy",
+        "operationId": "SetPolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/arnsResponse"
+              "$ref": "#/definitions/setPolicyRequest"
             }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -5469,18 +17605,18 @@ func init() {
         }
       }
     },
-    "/admin/info": {
+    "/subscription/info": {
       "get": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Returns information about the deployment",
-        "operationId": "AdminInfo",
+        "summary": "Subscription info",
+        "operationId": "SubscriptionInfo",
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/adminInfoResponse"
+              "$ref": "#/definitions/license"
             }
           },
           "default": {
@@ -5492,35 +17628,24 @@ func init() {
         }
       }
     },
-    "/admin/info/widgets/{widgetId}": {
+    "/users": {
       "get": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Returns information about the deployment",
-        "operationId": "DashboardWidgetDetails",
+        "summary": "List Users",
+        "operationId": "ListUsers",
         "parameters": [
           {
             "type": "integer",
             "format": "int32",
-            "name": "widgetId",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "integer",
-            "name": "start",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "name": "end",
+            "name": "offset",
             "in": "query"
           },
           {
             "type": "integer",
             "format": "int32",
-            "name": "step",
+            "name": "limit",
             "in": "query"
           }
         ],
@@ -5528,30 +17653,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/widgetDetails"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/admin/notification_endpoints": {
-      "get": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "Returns a list of active notification endpoints",
-        "operationId": "NotificationEndpointList",
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/notifEndpointResponse"
+              "$ref": "#/definitions/listUsersResponse"
             }
           },
           "default": {
@@ -5566,15 +17668,15 @@ func init() {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Allows to configure a new notification endpoint",
-        "operationId": "AddNotificationEndpoint",
+        "summary": "Add User",
+        "operationId": "AddUser",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/notificationEndpoint"
+              "$ref": "#/definitions/addUserRequest"
             }
           }
         ],
@@ -5582,7 +17684,7 @@ func init() {
           "201": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/setNotificationEndpointResponse"
+              "$ref": "#/definitions/user"
             }
           },
           "default": {
@@ -5594,46 +17696,25 @@ func init() {
         }
       }
     },
-    "/admin/tiers": {
-      "get": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "Returns a list of tiers for ilm",
-        "operationId": "TiersList",
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/tierListResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      },
-      "post": {
+    "/users-groups-bulk": {
+      "put": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Allows to configure a new tier",
-        "operationId": "AddTier",
+        "summary": "Bulk functionality to Add Users to Groups",
+        "operationId": "BulkUpdateUsersGroups",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/tier"
+              "$ref": "#/definitions/bulkUserGroups"
             }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "A successful response."
           },
           "default": {
@@ -5645,25 +17726,14 @@ func init() {
         }
       }
     },
-    "/admin/tiers/{type}/{name}": {
+    "/users/{name}": {
       "get": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Get Tier",
-        "operationId": "GetTier",
+        "summary": "Get User Info",
+        "operationId": "GetUserInfo",
         "parameters": [
-          {
-            "enum": [
-              "s3",
-              "gcs",
-              "azure"
-            ],
-            "type": "string",
-            "name": "type",
-            "in": "path",
-            "required": true
-          },
           {
             "type": "string",
             "name": "name",
@@ -5675,7 +17745,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/tier"
+              "$ref": "#/definitions/user"
             }
           },
           "default": {
@@ -5685,27 +17755,14 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/admin/tiers/{type}/{name}/credentials": {
+      },
       "put": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Edit Tier Credentials",
-        "operationId": "EditTierCredentials",
+        "summary": "Update User Info",
+        "operationId": "UpdateUserInfo",
         "parameters": [
-          {
-            "enum": [
-              "s3",
-              "gcs",
-              "azure"
-            ],
-            "type": "string",
-            "name": "type",
-            "in": "path",
-            "required": true
-          },
           {
             "type": "string",
             "name": "name",
@@ -5717,13 +17774,16 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/tierCredentialsRequest"
+              "$ref": "#/definitions/updateUser"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "A successful response."
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -5732,41 +17792,24 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/bucket-policy/{bucket}": {
-      "get": {
+      },
+      "delete": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "List Policies With Given Bucket",
-        "operationId": "ListPoliciesWithBucket",
+        "summary": "Remove user",
+        "operationId": "RemoveUser",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket",
+            "name": "name",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
           }
         ],
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/listPoliciesResponse"
-            }
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -5777,41 +17820,34 @@ func init() {
         }
       }
     },
-    "/bucket-users/{bucket}": {
-      "get": {
+    "/users/{name}/groups": {
+      "put": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "List Users With Access to a Given Bucket",
-        "operationId": "ListUsersWithAccessToBucket",
+        "summary": "Update Groups for a user",
+        "operationId": "UpdateUserGroups",
         "parameters": [
           {
             "type": "string",
-            "name": "bucket",
+            "name": "name",
             "in": "path",
             "required": true
           },
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/updateUserGroups"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "$ref": "#/definitions/user"
             }
           },
           "default": {
@@ -5823,37 +17859,26 @@ func init() {
         }
       }
     },
-    "/buckets": {
+    "/users/{name}/service-accounts": {
       "get": {
         "tags": [
-          "UserAPI"
+          "AdminAPI"
         ],
-        "summary": "List Buckets",
-        "operationId": "ListBuckets",
+        "summary": "returns a list of service accounts for a user",
+        "operationId": "ListAUserServiceAccounts",
         "parameters": [
           {
             "type": "string",
-            "name": "sort_by",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
+            "name": "name",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listBucketsResponse"
+              "$ref": "#/definitions/serviceAccounts"
             }
           },
           "default": {
@@ -5863,2685 +17888,2205 @@ func init() {
             }
           }
         }
-      },
-      "post": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Make bucket",
-        "operationId": "MakeBucket",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/makeBucketRequest"
+      }
+    }
+  },
+  "definitions": {
+    "IdpConfigurationActiveDirectory": {
+      "type": "object",
+      "required": [
+        "url",
+        "username_format",
+        "user_search_filter"
+      ],
+      "properties": {
+        "group_name_attribute": {
+          "type": "string"
+        },
+        "group_search_base_dn": {
+          "type": "string"
+        },
+        "group_search_filter": {
+          "type": "string"
+        },
+        "server_insecure": {
+          "type": "boolean"
+        },
+        "skip_tls_verification": {
+          "type": "boolean"
+        },
+        "url": {
+          "type": "string"
+        },
+        "user_search_filter": {
+          "type": "string"
+        },
+        "username_format": {
+          "type": "string"
+        }
+      }
+    },
+    "IdpConfigurationKeysItems0": {
+      "type": "object",
+      "required": [
+        "access_key",
+        "secret_key"
+      ],
+      "properties": {
+        "access_key": {
+          "type": "string"
+        },
+        "secret_key": {
+          "type": "string"
+        }
+      }
+    },
+    "IdpConfigurationOidc": {
+      "type": "object",
+      "required": [
+        "url",
+        "client_id",
+        "secret_id"
+      ],
+      "properties": {
+        "client_id": {
+          "type": "string"
+        },
+        "secret_id": {
+          "type": "string"
+        },
+        "url": {
+          "type": "string"
+        }
+      }
+    },
+    "WidgetDetailsOptions": {
+      "type": "object",
+      "properties": {
+        "reduceOptions": {
+          "type": "object",
+          "properties": {
+            "calcs": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
             }
           }
-        ],
-        "responses": {
-          "201": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
+        }
+      }
+    },
+    "WidgetDetailsOptionsReduceOptions": {
+      "type": "object",
+      "properties": {
+        "calcs": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "WidgetOptions": {
+      "type": "object",
+      "properties": {
+        "reduceOptions": {
+          "type": "object",
+          "properties": {
+            "calcs": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
             }
           }
         }
       }
     },
-    "/buckets-replication": {
-      "post": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Sets Multi Bucket Replication in multiple Buckets",
-        "operationId": "SetMultiBucketReplication",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/multiBucketReplication"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/multiBucketResponseState"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "WidgetOptionsReduceOptions": {
+      "type": "object",
+      "properties": {
+        "calcs": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "accountChangePasswordRequest": {
+      "type": "object",
+      "required": [
+        "current_secret_key",
+        "new_secret_key"
+      ],
+      "properties": {
+        "current_secret_key": {
+          "type": "string"
+        },
+        "new_secret_key": {
+          "type": "string"
+        }
+      }
+    },
+    "addBucketLifecycle": {
+      "type": "object",
+      "properties": {
+        "abort_incomplete_multipart_days": {
+          "description": "Non required, days after which incomplete multipart uploads are aborted, it can't be set along with tags",
+          "type": "integer",
+          "format": "int32"
+        },
+        "disable": {
+          "description": "Non required, toggle to disable or enable rule",
+          "type": "boolean"
+        },
+        "expired_object_delete_marker": {
+          "description": "Non required, toggle to disable or enable rule",
+          "type": "boolean"
+        },
+        "expiry_date": {
+          "description": "Required in case of expiry_days or transition fields are not set. it defines an expiry date for ILM",
+          "type": "string"
+        },
+        "expiry_days": {
+          "description": "Required in case of expiry_date or transition fields are not set. it defines an expiry days for ILM",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        },
+        "noncurrentversion_expiration_days": {
+          "description": "Non required, can be set in case of expiration is enabled",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        },
+        "noncurrentversion_transition_days": {
+          "description": "Non required, can be set in case of transition is enabled",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        },
+        "noncurrentversion_transition_storage_class": {
+          "description": "Non required, can be set in case of transition is enabled",
+          "type": "string"
+        },
+        "prefix": {
+          "description": "Non required field, it matches a prefix to perform ILM operations on it",
+          "type": "string"
+        },
+        "storage_class": {
+          "description": "Required only in case of transition is set. it refers to a tier",
+          "type": "string"
+        },
+        "tags": {
+          "description": "Non required field, tags to match ILM files, several tags are separated by \u0026 (e.g. key1=value1\u0026key2=value2)",
+          "type": "string"
+        },
+        "transition_date": {
+          "description": "Required in case of transition_days or expiry fields are not set. it defines a transition date for ILM",
+          "type": "string"
+        },
+        "transition_days": {
+          "description": "Required in case of transition_date or expiry fields are not set. it defines a transition days for ILM",
+          "type": "integer",
+          "format": "int32",
+          "default": 0
+        }
+      }
+    },
+    "addBucketReplication": {
+      "type": "object",
+      "properties": {
+        "arn": {
+          "type": "string"
+        },
+        "destination_bucket": {
+          "type": "string"
+        }
+      }
+    },
+    "addGroupRequest": {
+      "type": "object",
+      "required": [
+        "group",
+        "members"
+      ],
+      "properties": {
+        "group": {
+          "type": "string"
+        },
+        "members": {
+          "type": "array",
+          "items": {
+            "type": "string"
           }
         }
       }
     },
-    "/buckets/{bucket_name}/encryption/disable": {
-      "post": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Disable bucket encryption.",
-        "operationId": "DisableBucketEncryption",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "addPolicyRequest": {
+      "type": "object",
+      "required": [
+        "name",
+        "policy"
+      ],
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
         }
       }
     },
-    "/buckets/{bucket_name}/encryption/enable": {
-      "post": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Enable bucket encryption.",
-        "operationId": "EnableBucketEncryption",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/bucketEncryptionRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "addUserRequest": {
+      "type": "object",
+      "required": [
+        "accessKey",
+        "secretKey",
+        "groups"
+      ],
+      "properties": {
+        "accessKey": {
+          "type": "string"
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
           }
+        },
+        "secretKey": {
+          "type": "string"
         }
       }
     },
-    "/buckets/{bucket_name}/encryption/info": {
-      "get": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Get bucket encryption information.",
-        "operationId": "GetBucketEncryptionInfo",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/bucketEncryptionInfo"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "adminInfoResponse": {
+      "type": "object",
+      "properties": {
+        "buckets": {
+          "type": "integer"
+        },
+        "objects": {
+          "type": "integer"
+        },
+        "usage": {
+          "type": "integer"
+        },
+        "widgets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/widget"
           }
         }
       }
     },
-    "/buckets/{bucket_name}/events": {
-      "get": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "List Bucket Events",
-        "operationId": "ListBucketEvents",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/listBucketEventsResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      },
-      "post": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Create Bucket Event",
-        "operationId": "CreateBucketEvent",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/bucketEventRequest"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "alert": {
+      "type": "object",
+      "properties": {
+        "activeAt": {
+          "type": "string"
+        },
+        "firedAt": {
+          "type": "string"
+        },
+        "labels": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
           }
+        },
+        "resolvedAt": {
+          "type": "string"
+        },
+        "ruleId": {
+          "type": "string"
+        },
+        "ruleName": {
+          "type": "string"
+        },
+        "severity": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "firing",
+            "resolved"
+          ]
+        },
+        "value": {
+          "type": "number"
         }
       }
     },
-    "/buckets/{bucket_name}/events/{arn}": {
-      "delete": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Delete Bucket Event",
-        "operationId": "DeleteBucketEvent",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "arn",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/notificationDeleteRequest"
-            }
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "alertList": {
+      "type": "object",
+      "properties": {
+        "alerts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/alert"
           }
         }
       }
     },
-    "/buckets/{bucket_name}/lifecycle": {
-      "get": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Bucket Lifecycle",
-        "operationId": "GetBucketLifecycle",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/bucketLifecycleResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "alertRule": {
+      "type": "object",
+      "required": [
+        "name",
+        "expr"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "disabled": {
+          "type": "boolean"
+        },
+        "expr": {
+          "type": "string",
+          "title": "PromQL expression, ${jobid} is replaced with the Prometheus job id"
+        },
+        "for": {
+          "type": "integer",
+          "format": "int64",
+          "title": "seconds the condition must hold before the alert fires"
+        },
+        "id": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "operator": {
+          "type": "string",
+          "enum": [
+            "gt",
+            "ge",
+            "lt",
+            "le",
+            "eq",
+            "ne"
+          ]
+        },
+        "severity": {
+          "type": "string",
+          "enum": [
+            "info",
+            "warning",
+            "critical"
+          ]
+        },
+        "threshold": {
+          "type": "number"
         }
-      },
-      "post": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Add Bucket Lifecycle",
-        "operationId": "AddBucketLifecycle",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/addBucketLifecycle"
-            }
+      }
+    },
+    "alertRuleList": {
+      "type": "object",
+      "properties": {
+        "rules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/alertRule"
           }
-        ],
-        "responses": {
-          "201": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+        }
+      }
+    },
+    "alertTarget": {
+      "type": "object",
+      "required": [
+        "name",
+        "type"
+      ],
+      "properties": {
+        "authToken": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "smtpFrom": {
+          "type": "string"
+        },
+        "smtpHost": {
+          "type": "string"
+        },
+        "smtpPassword": {
+          "type": "string"
+        },
+        "smtpPort": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "smtpTo": {
+          "type": "array",
+          "items": {
+            "type": "string"
           }
+        },
+        "smtpUsername": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "webhook",
+            "smtp"
+          ]
+        },
+        "url": {
+          "type": "string"
         }
       }
     },
-    "/buckets/{bucket_name}/lifecycle/{lifecycle_id}": {
-      "put": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Update Lifecycle rule",
-        "operationId": "UpdateBucketLifecycle",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "lifecycle_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/updateBucketLifecycle"
-            }
+    "alertTargetList": {
+      "type": "object",
+      "properties": {
+        "targets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/alertTarget"
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+        }
+      }
+    },
+    "arnsResponse": {
+      "type": "object",
+      "properties": {
+        "arns": {
+          "type": "array",
+          "items": {
+            "type": "string"
           }
         }
       }
     },
-    "/buckets/{bucket_name}/object-locking": {
-      "get": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Returns the status of object locking support on the bucket",
-        "operationId": "GetBucketObjectLockingStatus",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
+    "bucket": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "access": {
+          "$ref": "#/definitions/bucketAccess"
+        },
+        "creation_date": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string",
+          "minLength": 3
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "bucketAccess": {
+      "type": "string",
+      "default": "PRIVATE",
+      "enum": [
+        "PRIVATE",
+        "PUBLIC",
+        "CUSTOM"
+      ]
+    },
+    "bucketAnalytics": {
+      "type": "object",
+      "properties": {
+        "bucket": {
+          "type": "string"
+        },
+        "delete_markers": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "error": {
+          "type": "string"
+        },
+        "histogram": {
+          "type": "array",
+          "title": "object sizes histogram, the data usage doesn't split the sizes above 512MiB",
+          "items": {
+            "$ref": "#/definitions/sizeHistogramBucket"
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/bucketObLockingResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+        },
+        "objects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "scanned": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "source": {
+          "type": "string",
+          "title": "where the values come from, data-usage, listing or data-usage-and-listing when the totals and histogram come from the data usage and the rest from a listing"
+        },
+        "status": {
+          "type": "string",
+          "title": "status of the listing, queued, running, ready or failed"
+        },
+        "storage_classes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/storageClassUsage"
           }
+        },
+        "top_prefixes_by_count": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/prefixUsage"
+          }
+        },
+        "top_prefixes_by_size": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/prefixUsage"
+          }
+        },
+        "truncated": {
+          "type": "boolean"
+        },
+        "updated": {
+          "type": "string"
+        },
+        "versions": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "/buckets/{bucket_name}/objects": {
-      "get": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "List Objects",
-        "operationId": "ListObjects",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "recursive",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "with_versions",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/listObjectsResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "bucketEncryptionInfo": {
+      "type": "object",
+      "properties": {
+        "algorithm": {
+          "type": "string"
+        },
+        "kmsMasterKeyID": {
+          "type": "string"
         }
-      },
-      "delete": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Delete Object",
-        "operationId": "DeleteObject",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "path",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "recursive",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+      }
+    },
+    "bucketEncryptionRequest": {
+      "type": "object",
+      "properties": {
+        "encType": {
+          "$ref": "#/definitions/bucketEncryptionType"
+        },
+        "kmsKeyID": {
+          "type": "string"
         }
       }
     },
-    "/buckets/{bucket_name}/objects/download": {
-      "get": {
-        "produces": [
-          "application/octet-stream"
-        ],
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Download Object",
-        "operationId": "Download Object",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "type": "file"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "bucketEncryptionType": {
+      "type": "string",
+      "default": "sse-s3",
+      "enum": [
+        "sse-s3",
+        "sse-kms"
+      ]
+    },
+    "bucketEventRequest": {
+      "type": "object",
+      "required": [
+        "configuration"
+      ],
+      "properties": {
+        "configuration": {
+          "$ref": "#/definitions/notificationConfig"
+        },
+        "ignoreExisting": {
+          "type": "boolean"
         }
       }
     },
-    "/buckets/{bucket_name}/objects/legalhold": {
-      "put": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Put Object's legalhold status",
-        "operationId": "PutObjectLegalHold",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/putObjectLegalHoldRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "bucketGrowth": {
+      "type": "object",
+      "properties": {
+        "bucket": {
+          "type": "string"
+        },
+        "growth_per_day": {
+          "type": "number",
+          "format": "double"
+        },
+        "growth_percentage": {
+          "type": "number",
+          "format": "double"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "/buckets/{bucket_name}/objects/retention": {
-      "put": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Put Object's retention status",
-        "operationId": "PutObjectRetention",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/putObjectRetentionRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "bucketLifecycleImport": {
+      "type": "object",
+      "required": [
+        "format",
+        "config"
+      ],
+      "properties": {
+        "config": {
+          "description": "the lifecycle configuration as exported by Console or mc ilm export",
+          "type": "string"
+        },
+        "format": {
+          "type": "string",
+          "enum": [
+            "xml",
+            "json"
+          ]
         }
-      },
-      "delete": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Delete Object retention from an object",
-        "operationId": "DeleteObjectRetention",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+      }
+    },
+    "bucketLifecycleResponse": {
+      "type": "object",
+      "properties": {
+        "lifecycle": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/objectBucketLifecycle"
           }
         }
       }
     },
-    "/buckets/{bucket_name}/objects/share": {
-      "get": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Shares an Object on a url",
-        "operationId": "ShareObject",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "expires",
-            "in": "query"
+    "bucketObLockingResponse": {
+      "type": "object",
+      "properties": {
+        "object_locking_enabled": {
+          "type": "boolean"
+        }
+      }
+    },
+    "bucketObject": {
+      "type": "object",
+      "properties": {
+        "content_type": {
+          "type": "string"
+        },
+        "expiration": {
+          "type": "string"
+        },
+        "expiration_rule_id": {
+          "type": "string"
+        },
+        "is_delete_marker": {
+          "type": "boolean"
+        },
+        "is_latest": {
+          "type": "boolean"
+        },
+        "last_modified": {
+          "type": "string"
+        },
+        "legal_hold_status": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "retention_mode": {
+          "type": "string"
+        },
+        "retention_until_date": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "tags": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "type": "string"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+        },
+        "user_tags": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
           }
+        },
+        "version_id": {
+          "type": "string"
         }
       }
     },
-    "/buckets/{bucket_name}/objects/tags": {
-      "put": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Put Object's tags",
-        "operationId": "PutObjectTags",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "version_id",
-            "in": "query",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/putObjectTagsRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "bucketQuota": {
+      "type": "object",
+      "properties": {
+        "quota": {
+          "type": "integer"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "hard",
+            "fifo"
+          ]
         }
       }
     },
-    "/buckets/{bucket_name}/objects/upload": {
-      "post": {
-        "consumes": [
-          "multipart/form-data"
-        ],
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Uploads an Object.",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "bucketQuotaBulkRequest": {
+      "type": "object",
+      "required": [
+        "pattern"
+      ],
+      "properties": {
+        "pattern": {
+          "description": "shell pattern matching the bucket names, e.g. logs-*",
+          "type": "string"
+        },
+        "quota": {
+          "$ref": "#/definitions/setBucketQuota"
+        },
+        "softQuota": {
+          "$ref": "#/definitions/bucketSoftQuota"
         }
       }
     },
-    "/buckets/{bucket_name}/replication": {
-      "get": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Bucket Replication",
-        "operationId": "GetBucketReplication",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/bucketReplicationResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "bucketQuotaBulkResponse": {
+      "type": "object",
+      "properties": {
+        "buckets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucketQuotaBulkResult"
           }
         }
       }
     },
-    "/buckets/{bucket_name}/replication/{rule_id}": {
-      "delete": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Bucket Replication Rule Delete",
-        "operationId": "DeleteBucketReplicationRule",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "rule_id",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "bucketQuotaBulkResult": {
+      "type": "object",
+      "properties": {
+        "bucket": {
+          "type": "string"
+        },
+        "error": {
+          "type": "string"
         }
       }
     },
-    "/buckets/{bucket_name}/retention": {
-      "get": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Get Bucket's retention config",
-        "operationId": "GetBucketRetentionConfig",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/getBucketRetentionConfig"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "bucketQuotaOverview": {
+      "type": "object",
+      "properties": {
+        "buckets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucketQuotaUsage"
           }
         }
-      },
-      "put": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Set Bucket's retention config",
-        "operationId": "SetBucketRetentionConfig",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/putBucketRetentionRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+      }
+    },
+    "bucketQuotaUsage": {
+      "type": "object",
+      "properties": {
+        "bucket": {
+          "type": "string"
+        },
+        "percentage": {
+          "description": "usage percentage of the quota, zero when the bucket has no quota",
+          "type": "number",
+          "format": "double"
+        },
+        "quota": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "quotaType": {
+          "type": "string"
+        },
+        "softQuota": {
+          "$ref": "#/definitions/bucketSoftQuota"
+        },
+        "softQuotaPercentage": {
+          "type": "number",
+          "format": "double"
+        },
+        "softQuotaThreshold": {
+          "description": "highest threshold of the soft quota crossed by the usage",
+          "type": "integer",
+          "format": "int32"
+        },
+        "usage": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "/buckets/{bucket_name}/rewind/{date}": {
-      "get": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Get objects in a bucket for a rewind date",
-        "operationId": "GetBucketRewind",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "date",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "prefix",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/rewindResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "bucketReplicationDestination": {
+      "type": "object",
+      "properties": {
+        "bucket": {
+          "type": "string"
         }
       }
     },
-    "/buckets/{bucket_name}/versioning": {
-      "get": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Bucket Versioning",
-        "operationId": "GetBucketVersioning",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/bucketVersioningResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "bucketReplicationFailedObjects": {
+      "type": "object",
+      "properties": {
+        "objects": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/replicationFailedObject"
           }
+        },
+        "truncated": {
+          "type": "boolean"
         }
-      },
-      "put": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Set Bucket Versioning",
-        "operationId": "SetBucketVersioning",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "bucket_name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/setBucketVersioning"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+      }
+    },
+    "bucketReplicationMetrics": {
+      "type": "object",
+      "properties": {
+        "bandwidth": {
+          "type": "number",
+          "format": "double"
+        },
+        "failedCount": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "failedSize": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "pendingCount": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "pendingSize": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "replicaSize": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "replicatedSize": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "targets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/replicationTargetMetrics"
           }
         }
       }
     },
-    "/buckets/{name}": {
-      "get": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Bucket Info",
-        "operationId": "BucketInfo",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/bucket"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "bucketReplicationResponse": {
+      "type": "object",
+      "properties": {
+        "rules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucketReplicationRule"
           }
         }
-      },
-      "delete": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Delete Bucket",
-        "operationId": "DeleteBucket",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+      }
+    },
+    "bucketReplicationRule": {
+      "type": "object",
+      "properties": {
+        "bandwidth": {
+          "type": "string"
+        },
+        "delete_marker_replication": {
+          "type": "boolean"
+        },
+        "deletes_replication": {
+          "type": "boolean"
+        },
+        "destination": {
+          "$ref": "#/definitions/bucketReplicationDestination"
+        },
+        "healthCheckPeriod": {
+          "type": "integer"
+        },
+        "id": {
+          "type": "string"
+        },
+        "metadata_replication": {
+          "type": "boolean"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "priority": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "Enabled",
+            "Disabled"
+          ]
+        },
+        "syncMode": {
+          "type": "string",
+          "default": "async",
+          "enum": [
+            "async",
+            "sync"
+          ]
+        },
+        "tags": {
+          "type": "string"
+        }
+      }
+    },
+    "bucketReplicationRuleStatus": {
+      "type": "object",
+      "required": [
+        "status"
+      ],
+      "properties": {
+        "status": {
+          "type": "string",
+          "enum": [
+            "Enabled",
+            "Disabled"
+          ]
         }
       }
     },
-    "/buckets/{name}/quota": {
-      "get": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Get Bucket Quota",
-        "operationId": "GetBucketQuota",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/bucketQuota"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "bucketReplicationRulesOrder": {
+      "type": "object",
+      "required": [
+        "rules"
+      ],
+      "properties": {
+        "rules": {
+          "type": "array",
+          "items": {
+            "type": "string"
           }
         }
-      },
-      "put": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Bucket Quota",
-        "operationId": "SetBucketQuota",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/setBucketQuota"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/bucket"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+      }
+    },
+    "bucketSoftQuota": {
+      "type": "object",
+      "required": [
+        "limit"
+      ],
+      "properties": {
+        "limit": {
+          "description": "size in bytes the thresholds are relative to, it isn't enforced by MinIO",
+          "type": "integer",
+          "format": "int64"
+        },
+        "thresholds": {
+          "description": "usage percentages of the limit that send a notification when crossed, 80, 90 and 100 when not set",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int32"
           }
         }
       }
     },
-    "/buckets/{name}/set-policy": {
-      "put": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Bucket Set Policy",
-        "operationId": "BucketSetPolicy",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/setBucketPolicyRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/bucket"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "bucketVersioningResponse": {
+      "type": "object",
+      "properties": {
+        "is_versioned": {
+          "type": "boolean"
         }
       }
     },
-    "/configs": {
-      "get": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "List Configurations",
-        "operationId": "ListConfig",
-        "parameters": [
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/listConfigResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "bulkObjectLockRequest": {
+      "type": "object",
+      "properties": {
+        "all_versions": {
+          "type": "boolean"
+        },
+        "expires": {
+          "type": "string"
+        },
+        "governance_bypass": {
+          "type": "boolean"
+        },
+        "legal_hold": {
+          "$ref": "#/definitions/objectLegalHoldStatus"
+        },
+        "mode": {
+          "$ref": "#/definitions/objectRetentionMode"
+        },
+        "prefix": {
+          "type": "string"
         }
       }
     },
-    "/configs/{name}": {
-      "get": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "Configuration info",
-        "operationId": "ConfigInfo",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/configuration"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "bulkObjectLockResult": {
+      "type": "object",
+      "properties": {
+        "error": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "versionID": {
+          "type": "string"
         }
-      },
-      "put": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "Set Configuration",
-        "operationId": "SetConfig",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/setConfigRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/setConfigResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+      }
+    },
+    "bulkObjectLockSummary": {
+      "type": "object",
+      "properties": {
+        "failed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "failures": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bulkObjectLockResult"
           }
+        },
+        "processed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "updated": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "/groups": {
-      "get": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "List Groups",
-        "operationId": "ListGroups",
-        "parameters": [
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
+    "bulkUserGroups": {
+      "type": "object",
+      "required": [
+        "users",
+        "groups"
+      ],
+      "properties": {
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/listGroupsResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+        },
+        "users": {
+          "type": "array",
+          "items": {
+            "type": "string"
           }
         }
-      },
-      "post": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "Add Group",
-        "operationId": "AddGroup",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/addGroupRequest"
-            }
+      }
+    },
+    "capacityForecastResponse": {
+      "type": "object",
+      "properties": {
+        "buckets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucketGrowth"
           }
-        ],
-        "responses": {
-          "201": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+        },
+        "growth_per_day": {
+          "type": "number",
+          "format": "double"
+        },
+        "history": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/capacityUsageSample"
+          }
+        },
+        "samples": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "thresholds": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/capacityThresholdForecast"
           }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "usage_percentage": {
+          "type": "number",
+          "format": "double"
+        },
+        "used": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "/groups/{name}": {
-      "get": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "Group info",
-        "operationId": "GroupInfo",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/group"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "capacityThresholdForecast": {
+      "type": "object",
+      "properties": {
+        "date": {
+          "type": "string"
+        },
+        "days_left": {
+          "type": "integer",
+          "format": "int64",
+          "title": "days until the usage reaches the percentage, -1 when the usage isn't growing"
+        },
+        "percentage": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "reached": {
+          "type": "boolean"
         }
-      },
-      "put": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "Update Group Members or Status",
-        "operationId": "UpdateGroup",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/updateGroupRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/group"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+      }
+    },
+    "capacityUsageSample": {
+      "type": "object",
+      "properties": {
+        "date": {
+          "type": "string"
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "used": {
+          "type": "integer",
+          "format": "int64"
         }
-      },
-      "delete": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "Remove group",
-        "operationId": "RemoveGroup",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+      }
+    },
+    "changeUserPasswordRequest": {
+      "type": "object",
+      "required": [
+        "selectedUser",
+        "newSecretKey"
+      ],
+      "properties": {
+        "newSecretKey": {
+          "type": "string"
+        },
+        "selectedUser": {
+          "type": "string"
+        }
+      }
+    },
+    "configChange": {
+      "type": "object",
+      "properties": {
+        "after": {
+          "type": "string"
+        },
+        "before": {
+          "type": "string"
+        },
+        "key": {
+          "type": "string"
+        },
+        "subsystem": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "added",
+            "removed",
+            "modified"
+          ]
         }
       }
     },
-    "/has-permission": {
-      "post": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Checks whether the user can perform a series of actions",
-        "operationId": "HasPermissionTo",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/hasPermissionRequest"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/hasPermissionResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "configDescription": {
+      "type": "object",
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "key": {
+          "type": "string"
         }
       }
     },
-    "/list-external-buckets": {
-      "post": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Lists an External list of buckets using custom credentials",
-        "operationId": "ListExternalBuckets",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/listExternalBucketsParams"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/listBucketsResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "configDiffResponse": {
+      "type": "object",
+      "properties": {
+        "changes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/configChange"
           }
+        },
+        "from": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "to": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "/login": {
-      "get": {
-        "security": [],
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Returns login strategy, form or sso.",
-        "operationId": "LoginDetail",
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/loginDetails"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "configHistoryResponse": {
+      "type": "object",
+      "properties": {
+        "revisions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/configRevision"
           }
         }
-      },
-      "post": {
-        "security": [],
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Login to Console",
-        "operationId": "Login",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/loginRequest"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "A successful login.",
-            "schema": {
-              "$ref": "#/definitions/loginResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+      }
+    },
+    "configKeySchema": {
+      "type": "object",
+      "properties": {
+        "default": {
+          "type": "string"
+        },
+        "defaultUnknown": {
+          "type": "boolean",
+          "title": "the MinIO help doesn't give the default of the key"
+        },
+        "description": {
+          "type": "string"
+        },
+        "key": {
+          "type": "string"
+        },
+        "optional": {
+          "type": "boolean"
+        },
+        "sensitive": {
+          "type": "boolean"
+        },
+        "type": {
+          "type": "string"
+        },
+        "values": {
+          "type": "array",
+          "title": "allowed values when the key is an enumeration",
+          "items": {
+            "type": "string"
           }
         }
       }
     },
-    "/login/oauth2/auth": {
-      "post": {
-        "security": [],
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Identity Provider oauth2 callback endpoint.",
-        "operationId": "LoginOauth2Auth",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/loginOauth2AuthRequest"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "A successful login.",
-            "schema": {
-              "$ref": "#/definitions/loginResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "configRestoreResponse": {
+      "type": "object",
+      "properties": {
+        "restart": {
+          "type": "boolean"
+        },
+        "revision": {
+          "$ref": "#/definitions/configRevision"
         }
       }
     },
-    "/login/operator": {
-      "post": {
-        "security": [],
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Login to Operator Console.",
-        "operationId": "LoginOperator",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/loginOperatorRequest"
-            }
+    "configRevision": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "snapshot",
+            "set",
+            "restore",
+            "external"
+          ]
+        },
+        "changes": {
+          "type": "array",
+          "items": {
+            "type": "string"
           }
-        ],
-        "responses": {
-          "201": {
-            "description": "A successful login.",
-            "schema": {
-              "$ref": "#/definitions/loginResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+        },
+        "comment": {
+          "type": "string"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "subsystem": {
+          "type": "string"
+        },
+        "time": {
+          "type": "string"
+        },
+        "user": {
+          "type": "string"
+        }
+      }
+    },
+    "configSnapshotRequest": {
+      "type": "object",
+      "properties": {
+        "comment": {
+          "type": "string"
+        }
+      }
+    },
+    "configTarget": {
+      "type": "object",
+      "properties": {
+        "enabled": {
+          "type": "boolean"
+        },
+        "key_values": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/configurationKV"
           }
+        },
+        "name": {
+          "type": "string",
+          "title": "name of the target, empty for the default one"
+        },
+        "status": {
+          "type": "string",
+          "title": "online or offline as reported by the server, empty when unknown"
         }
       }
     },
-    "/logout": {
-      "post": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Logout from Console.",
-        "operationId": "Logout",
-        "responses": {
-          "200": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "configTargetsResponse": {
+      "type": "object",
+      "properties": {
+        "multiple_targets": {
+          "type": "boolean",
+          "title": "whether the subsystem supports named targets"
+        },
+        "targets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/configTarget"
           }
         }
       }
     },
-    "/logs/search": {
-      "get": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Search the logs",
-        "operationId": "LogSearch",
-        "parameters": [
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multi",
-            "description": "Filter Parameters",
-            "name": "fp",
-            "in": "query"
-          },
-          {
-            "type": "number",
-            "format": "int32",
-            "default": 10,
-            "name": "pageSize",
-            "in": "query"
-          },
-          {
-            "type": "number",
-            "format": "int32",
-            "default": 0,
-            "name": "pageNo",
-            "in": "query"
-          },
-          {
-            "enum": [
-              "timeDesc",
-              "timeAsc"
-            ],
-            "type": "string",
-            "default": "timeDesc",
-            "name": "order",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "name": "timeStart",
-            "in": "query"
+    "configuration": {
+      "type": "object",
+      "properties": {
+        "key_schema": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/configKeySchema"
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/logSearchResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+        },
+        "key_values": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/configurationKV"
           }
+        },
+        "name": {
+          "type": "string"
         }
       }
     },
-    "/policies": {
-      "get": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "List Policies",
-        "operationId": "ListPolicies",
-        "parameters": [
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/listPoliciesResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      },
-      "post": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "Add Policy",
-        "operationId": "AddPolicy",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/addPolicyRequest"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/policy"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "configurationKV": {
+      "type": "object",
+      "properties": {
+        "key": {
+          "type": "string"
+        },
+        "value": {
+          "type": "string"
         }
       }
     },
-    "/policies/{policy}/groups": {
-      "get": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "List Groups for a Policy",
-        "operationId": "ListGroupsForPolicy",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "policy",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "createRemoteBucket": {
+      "required": [
+        "accessKey",
+        "secretKey",
+        "targetURL",
+        "sourceBucket",
+        "targetBucket"
+      ],
+      "properties": {
+        "accessKey": {
+          "type": "string",
+          "minLength": 3
+        },
+        "bandwidth": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "healthCheckPeriod": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "region": {
+          "type": "string"
+        },
+        "secretKey": {
+          "type": "string",
+          "minLength": 8
+        },
+        "sourceBucket": {
+          "type": "string"
+        },
+        "syncMode": {
+          "type": "string",
+          "default": "async",
+          "enum": [
+            "async",
+            "sync"
+          ]
+        },
+        "targetBucket": {
+          "type": "string"
+        },
+        "targetURL": {
+          "type": "string"
         }
       }
     },
-    "/policies/{policy}/users": {
-      "get": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "List Users for a Policy",
-        "operationId": "ListUsersForPolicy",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "policy",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "dashboard": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "panels": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/dashboardPanel"
           }
         }
       }
     },
-    "/policy": {
-      "get": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "Policy info",
-        "operationId": "PolicyInfo",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "query",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/policy"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      },
-      "delete": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "Remove policy",
-        "operationId": "RemovePolicy",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "query",
-            "required": true
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "dashboardGridPos": {
+      "type": "object",
+      "properties": {
+        "h": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "w": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "x": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "y": {
+          "type": "integer",
+          "format": "int32"
         }
       }
     },
-    "/profiling/start": {
-      "post": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "Start recording profile data",
-        "operationId": "ProfilingStart",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/profilingStartRequest"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/startProfilingList"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "dashboardList": {
+      "type": "object",
+      "properties": {
+        "dashboards": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/dashboard"
           }
         }
       }
     },
-    "/profiling/stop": {
-      "post": {
-        "produces": [
-          "application/octet-stream"
-        ],
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "Stop and download profile data",
-        "operationId": "ProfilingStop",
-        "responses": {
-          "201": {
-            "description": "A successful response.",
-            "schema": {
-              "type": "file"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "dashboardPanel": {
+      "type": "object",
+      "required": [
+        "widgetId"
+      ],
+      "properties": {
+        "gridPos": {
+          "$ref": "#/definitions/dashboardGridPos"
+        },
+        "widgetId": {
+          "type": "integer",
+          "format": "int32"
         }
       }
     },
-    "/remote-buckets": {
-      "get": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "List Remote Buckets",
-        "operationId": "ListRemoteBuckets",
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/listRemoteBucketsResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "dashboardWidget": {
+      "type": "object",
+      "required": [
+        "title",
+        "type",
+        "targets"
+      ],
+      "properties": {
+        "builtIn": {
+          "type": "boolean"
+        },
+        "gridPos": {
+          "$ref": "#/definitions/dashboardGridPos"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "maxDataPoints": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "options": {
+          "$ref": "#/definitions/dashboardWidgetOptions"
+        },
+        "targets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/dashboardWidgetTarget"
           }
-        }
-      },
-      "post": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Add Remote Bucket",
-        "operationId": "AddRemoteBucket",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/createRemoteBucket"
-            }
+        },
+        "title": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        },
+        "unit": {
+          "type": "string"
+        },
+        "variables": {
+          "type": "array",
+          "items": {
+            "type": "string"
           }
-        ],
-        "responses": {
-          "201": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+        }
+      }
+    },
+    "dashboardWidgetList": {
+      "type": "object",
+      "properties": {
+        "widgets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/dashboardWidget"
           }
         }
       }
     },
-    "/remote-buckets/{name}": {
-      "get": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Remote Bucket Details",
-        "operationId": "RemoteBucketDetails",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/remoteBucket"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "dashboardWidgetOptions": {
+      "type": "object",
+      "properties": {
+        "reduceOptions": {
+          "$ref": "#/definitions/dashboardWidgetReduceOptions"
         }
       }
     },
-    "/remote-buckets/{source-bucket-name}/{arn}": {
-      "delete": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Delete Remote Bucket",
-        "operationId": "DeleteRemoteBucket",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "source-bucket-name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "name": "arn",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "dashboardWidgetReduceOptions": {
+      "type": "object",
+      "properties": {
+        "calcs": {
+          "type": "array",
+          "items": {
+            "type": "string"
           }
         }
       }
     },
-    "/service-accounts": {
-      "get": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "List User's Service Accounts",
-        "operationId": "ListUserServiceAccounts",
-        "parameters": [
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/serviceAccounts"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "dashboardWidgetTarget": {
+      "type": "object",
+      "required": [
+        "expr"
+      ],
+      "properties": {
+        "expr": {
+          "type": "string"
+        },
+        "interval": {
+          "type": "string"
+        },
+        "legendFormat": {
+          "type": "string"
+        },
+        "step": {
+          "type": "integer",
+          "format": "int32"
         }
-      },
-      "post": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Create Service Account",
-        "operationId": "CreateServiceAccount",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/serviceAccountRequest"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/serviceAccountCreds"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+      }
+    },
+    "directCSIDriveInfo": {
+      "type": "object",
+      "properties": {
+        "allocated": {
+          "type": "number",
+          "format": "int64"
+        },
+        "capacity": {
+          "type": "number",
+          "format": "int64"
+        },
+        "drive": {
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        },
+        "node": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        },
+        "volumes": {
+          "type": "number",
+          "format": "int64"
         }
       }
     },
-    "/service-accounts/{access_key}": {
-      "delete": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Delete Service Account",
-        "operationId": "DeleteServiceAccount",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "access_key",
-            "in": "path",
-            "required": true
+    "directCSIVolumeInfo": {
+      "type": "object",
+      "properties": {
+        "capacity": {
+          "type": "number",
+          "format": "int64"
+        },
+        "drive": {
+          "type": "string"
+        },
+        "node": {
+          "type": "string"
+        },
+        "volume": {
+          "type": "string"
+        }
+      }
+    },
+    "driveHistoryResponse": {
+      "type": "object",
+      "properties": {
+        "events": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/driveStateEvent"
           }
-        ],
-        "responses": {
-          "204": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+        },
+        "flapping": {
+          "type": "array",
+          "title": "drives that changed state several times during the last day",
+          "items": {
+            "type": "string"
           }
         }
       }
     },
-    "/service/restart": {
-      "post": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "Restart Service",
-        "operationId": "RestartService",
-        "responses": {
-          "204": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "driveInfo": {
+      "type": "object",
+      "properties": {
+        "available_space": {
+          "type": "integer",
+          "format": "uint64"
+        },
+        "disk_index": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "healing": {
+          "type": "boolean"
+        },
+        "model": {
+          "type": "string"
+        },
+        "path": {
+          "type": "string"
+        },
+        "pool_index": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "root_disk": {
+          "type": "boolean"
+        },
+        "set_index": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "state": {
+          "type": "string"
+        },
+        "total_space": {
+          "type": "integer",
+          "format": "uint64"
+        },
+        "used_space": {
+          "type": "integer",
+          "format": "uint64"
+        },
+        "uuid": {
+          "type": "string"
         }
       }
     },
-    "/session": {
-      "get": {
-        "tags": [
-          "UserAPI"
-        ],
-        "summary": "Endpoint to check if your session is still valid",
-        "operationId": "SessionCheck",
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/sessionResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "driveStateEvent": {
+      "type": "object",
+      "properties": {
+        "drive": {
+          "type": "string"
+        },
+        "from": {
+          "type": "string"
+        },
+        "path": {
+          "type": "string"
+        },
+        "server": {
+          "type": "string"
+        },
+        "time": {
+          "type": "string"
+        },
+        "to": {
+          "type": "string"
         }
       }
     },
-    "/set-policy-multi/{name}": {
-      "put": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "Set policy to multiple users/groups",
-        "operationId": "SetPolicyMultiple",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/setPolicyMultipleRequest"
-            }
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "erasureSet": {
+      "type": "object",
+      "properties": {
+        "available_space": {
+          "type": "integer",
+          "format": "uint64"
+        },
+        "drives": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/driveInfo"
           }
+        },
+        "healing_drives": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "offline_drives": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "online_drives": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "parity": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "pool_index": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "set_index": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "state": {
+          "type": "string",
+          "title": "healthy, degraded, read-only or unavailable"
+        },
+        "total_space": {
+          "type": "integer",
+          "format": "uint64"
+        },
+        "used_space": {
+          "type": "integer",
+          "format": "uint64"
         }
       }
     },
-    "/set-policy/{name}": {
-      "put": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "Set policy",
-        "operationId": "SetPolicy",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/setPolicyRequest"
-            }
-          }
-        ],
-        "responses": {
-          "204": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "error": {
+      "type": "object",
+      "required": [
+        "message"
+      ],
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "message": {
+          "type": "string"
         }
       }
     },
-    "/subscription/info": {
-      "get": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "Subscription info",
-        "operationId": "SubscriptionInfo",
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/license"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "expirationResponse": {
+      "type": "object",
+      "properties": {
+        "date": {
+          "type": "string"
+        },
+        "days": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "delete_marker": {
+          "type": "boolean"
         }
       }
     },
-    "/users": {
-      "get": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "List Users",
-        "operationId": "ListUsers",
-        "parameters": [
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/listUsersResponse"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      },
-      "post": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "Add User",
-        "operationId": "AddUser",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/addUserRequest"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/user"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "formatConfiguration": {
+      "type": "object",
+      "required": [
+        "drives",
+        "force"
+      ],
+      "properties": {
+        "drives": {
+          "type": "array",
+          "minLength": 1,
+          "items": {
+            "type": "string"
           }
+        },
+        "force": {
+          "type": "boolean"
         }
       }
     },
-    "/users-groups-bulk": {
-      "put": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "Bulk functionality to Add Users to Groups",
-        "operationId": "BulkUpdateUsersGroups",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/bulkUserGroups"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "getBucketRetentionConfig": {
+      "type": "object",
+      "properties": {
+        "mode": {
+          "$ref": "#/definitions/objectRetentionMode"
+        },
+        "unit": {
+          "$ref": "#/definitions/objectRetentionUnit"
+        },
+        "validity": {
+          "type": "integer",
+          "format": "int32"
         }
       }
     },
-    "/users/{name}": {
-      "get": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "Get User Info",
-        "operationId": "GetUserInfo",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/user"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "getDirectCSIDriveListResponse": {
+      "type": "object",
+      "properties": {
+        "drives": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/directCSIDriveInfo"
           }
         }
-      },
-      "put": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "Update User Info",
-        "operationId": "UpdateUserInfo",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/updateUser"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/user"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+      }
+    },
+    "getDirectCSIVolumeListResponse": {
+      "type": "object",
+      "properties": {
+        "volumes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/directCSIVolumeInfo"
           }
         }
-      },
-      "delete": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "Remove user",
-        "operationId": "RemoveUser",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
+      }
+    },
+    "group": {
+      "type": "object",
+      "properties": {
+        "members": {
+          "type": "array",
+          "items": {
+            "type": "string"
           }
-        ],
-        "responses": {
-          "204": {
-            "description": "A successful response."
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+        },
+        "name": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "hasPermissionRequest": {
+      "type": "object",
+      "properties": {
+        "actions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policyArgs"
           }
         }
       }
     },
-    "/users/{name}/groups": {
-      "put": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "Update Groups for a user",
-        "operationId": "UpdateUserGroups",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/updateUserGroups"
-            }
+    "hasPermissionResponse": {
+      "type": "object",
+      "properties": {
+        "permissions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/permissionAction"
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/user"
+        }
+      }
+    },
+    "iamEntity": {
+      "type": "string",
+      "pattern": "^[\\w+=,.@-]{1,64}$"
+    },
+    "idpConfiguration": {
+      "type": "object",
+      "properties": {
+        "active_directory": {
+          "type": "object",
+          "required": [
+            "url",
+            "username_format",
+            "user_search_filter"
+          ],
+          "properties": {
+            "group_name_attribute": {
+              "type": "string"
+            },
+            "group_search_base_dn": {
+              "type": "string"
+            },
+            "group_search_filter": {
+              "type": "string"
+            },
+            "server_insecure": {
+              "type": "boolean"
+            },
+            "skip_tls_verification": {
+              "type": "boolean"
+            },
+            "url": {
+              "type": "string"
+            },
+            "user_search_filter": {
+              "type": "string"
+            },
+            "username_format": {
+              "type": "string"
             }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
+          }
+        },
+        "keys": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/IdpConfigurationKeysItems0"
+          }
+        },
+        "oidc": {
+          "type": "object",
+          "required": [
+            "url",
+            "client_id",
+            "secret_id"
+          ],
+          "properties": {
+            "client_id": {
+              "type": "string"
+            },
+            "secret_id": {
+              "type": "string"
+            },
+            "url": {
+              "type": "string"
             }
           }
         }
       }
     },
-    "/users/{name}/service-accounts": {
-      "get": {
-        "tags": [
-          "AdminAPI"
-        ],
-        "summary": "returns a list of service accounts for a user",
-        "operationId": "ListAUserServiceAccounts",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "name",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/serviceAccounts"
-            }
-          },
-          "default": {
-            "description": "Generic error response.",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "job": {
+      "type": "object",
+      "properties": {
+        "created": {
+          "type": "string"
+        },
+        "created_by": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "last_run": {
+          "$ref": "#/definitions/jobRun"
+        },
+        "name": {
+          "type": "string"
+        },
+        "next_run": {
+          "type": "string"
+        },
+        "params": {
+          "$ref": "#/definitions/jobParams"
+        },
+        "paused": {
+          "type": "boolean"
+        },
+        "schedule": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        }
+      }
+    },
+    "jobList": {
+      "type": "object",
+      "properties": {
+        "jobs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/job"
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "IdpConfigurationActiveDirectory": {
+    },
+    "jobParams": {
+      "type": "object",
+      "properties": {
+        "bucket": {
+          "type": "string"
+        },
+        "deadline": {
+          "type": "integer",
+          "format": "int64",
+          "title": "seconds the health info collection can take"
+        },
+        "deep_scan": {
+          "type": "boolean"
+        },
+        "dry_run": {
+          "type": "boolean"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "recursive": {
+          "type": "boolean"
+        }
+      }
+    },
+    "jobRequest": {
       "type": "object",
       "required": [
-        "url",
-        "username_format",
-        "user_search_filter"
+        "name",
+        "type",
+        "schedule"
       ],
       "properties": {
-        "group_name_attribute": {
+        "name": {
           "type": "string"
         },
-        "group_search_base_dn": {
+        "params": {
+          "$ref": "#/definitions/jobParams"
+        },
+        "paused": {
+          "type": "boolean"
+        },
+        "schedule": {
+          "type": "string",
+          "title": "cron expression in UTC, like \"0 3 * * *\" or @daily"
+        },
+        "type": {
+          "type": "string",
+          "title": "heal, health-info or usage-snapshot"
+        }
+      }
+    },
+    "jobRun": {
+      "type": "object",
+      "properties": {
+        "error": {
           "type": "string"
         },
-        "group_search_filter": {
+        "finished": {
           "type": "string"
         },
-        "server_insecure": {
+        "has_report": {
           "type": "boolean"
         },
-        "skip_tls_verification": {
-          "type": "boolean"
+        "id": {
+          "type": "integer",
+          "format": "int64"
         },
-        "url": {
+        "job_id": {
           "type": "string"
         },
-        "user_search_filter": {
+        "logs": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "queued": {
           "type": "string"
         },
-        "username_format": {
+        "started": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "title": "queued, running, succeeded or failed"
+        },
+        "summary": {
+          "type": "string"
+        },
+        "trigger": {
+          "type": "string",
+          "title": "schedule or manual"
+        },
+        "triggered_by": {
           "type": "string"
         }
       }
     },
-    "IdpConfigurationKeysItems0": {
+    "jobRunList": {
+      "type": "object",
+      "properties": {
+        "runs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/jobRun"
+          }
+        }
+      }
+    },
+    "keyPairConfiguration": {
       "type": "object",
       "required": [
-        "access_key",
-        "secret_key"
+        "crt",
+        "key"
       ],
       "properties": {
-        "access_key": {
+        "crt": {
           "type": "string"
         },
-        "secret_key": {
+        "key": {
           "type": "string"
         }
       }
     },
-    "IdpConfigurationOidc": {
+    "kmsCreateKeyRequest": {
       "type": "object",
       "required": [
-        "url",
-        "client_id",
-        "secret_id"
+        "key"
       ],
       "properties": {
-        "client_id": {
+        "key": {
           "type": "string"
-        },
-        "secret_id": {
+        }
+      }
+    },
+    "kmsEndpoint": {
+      "type": "object",
+      "properties": {
+        "status": {
           "type": "string"
         },
         "url": {
@@ -8549,553 +20094,544 @@ func init() {
         }
       }
     },
-    "WidgetDetailsOptions": {
+    "kmsImportKeyRequest": {
       "type": "object",
+      "required": [
+        "bytes"
+      ],
       "properties": {
-        "reduceOptions": {
-          "type": "object",
-          "properties": {
-            "calcs": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            }
-          }
+        "bytes": {
+          "type": "string"
         }
       }
     },
-    "WidgetDetailsOptionsReduceOptions": {
+    "kmsKeyInfo": {
       "type": "object",
       "properties": {
-        "calcs": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "createdAt": {
+          "type": "string"
+        },
+        "createdBy": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
         }
       }
     },
-    "WidgetOptions": {
+    "kmsKeyStatusResponse": {
       "type": "object",
       "properties": {
-        "reduceOptions": {
-          "type": "object",
-          "properties": {
-            "calcs": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            }
+        "decryptionErr": {
+          "type": "string"
+        },
+        "encryptionErr": {
+          "type": "string"
+        },
+        "keyID": {
+          "type": "string"
+        }
+      }
+    },
+    "kmsListKeysResponse": {
+      "type": "object",
+      "properties": {
+        "results": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/kmsKeyInfo"
           }
         }
       }
     },
-    "WidgetOptionsReduceOptions": {
+    "kmsStatusResponse": {
       "type": "object",
       "properties": {
-        "calcs": {
+        "defaultKeyID": {
+          "type": "string"
+        },
+        "endpoints": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/kmsEndpoint"
           }
+        },
+        "name": {
+          "type": "string"
         }
       }
     },
-    "accountChangePasswordRequest": {
+    "license": {
       "type": "object",
-      "required": [
-        "current_secret_key",
-        "new_secret_key"
-      ],
       "properties": {
-        "current_secret_key": {
+        "account_id": {
+          "type": "integer"
+        },
+        "email": {
           "type": "string"
         },
-        "new_secret_key": {
+        "expires_at": {
+          "type": "string"
+        },
+        "organization": {
+          "type": "string"
+        },
+        "plan": {
           "type": "string"
+        },
+        "storage_capacity": {
+          "type": "integer"
         }
       }
     },
-    "addBucketLifecycle": {
+    "lifecyclePreviewGroup": {
       "type": "object",
       "properties": {
-        "disable": {
-          "description": "Non required, toggle to disable or enable rule",
-          "type": "boolean"
-        },
-        "expired_object_delete_marker": {
-          "description": "Non required, toggle to disable or enable rule",
-          "type": "boolean"
-        },
-        "expiry_date": {
-          "description": "Required in case of expiry_days or transition fields are not set. it defines an expiry date for ILM",
-          "type": "string"
-        },
-        "expiry_days": {
-          "description": "Required in case of expiry_date or transition fields are not set. it defines an expiry days for ILM",
-          "type": "integer",
-          "format": "int32",
-          "default": 0
-        },
-        "noncurrentversion_expiration_days": {
-          "description": "Non required, can be set in case of expiration is enabled",
-          "type": "integer",
-          "format": "int32",
-          "default": 0
+        "action": {
+          "type": "string",
+          "enum": [
+            "expire",
+            "transition"
+          ]
         },
-        "noncurrentversion_transition_days": {
-          "description": "Non required, can be set in case of transition is enabled",
+        "objects": {
           "type": "integer",
-          "format": "int32",
-          "default": 0
-        },
-        "noncurrentversion_transition_storage_class": {
-          "description": "Non required, can be set in case of transition is enabled",
-          "type": "string"
+          "format": "int64"
         },
         "prefix": {
-          "description": "Non required field, it matches a prefix to perform ILM operations on it",
-          "type": "string"
-        },
-        "storage_class": {
-          "description": "Required only in case of transition is set. it refers to a tier",
           "type": "string"
         },
-        "tags": {
-          "description": "Non required field, tags to match ILM files",
-          "type": "string"
+        "size": {
+          "type": "integer",
+          "format": "int64"
         },
-        "transition_date": {
-          "description": "Required in case of transition_days or expiry fields are not set. it defines a transition date for ILM",
+        "tier": {
           "type": "string"
-        },
-        "transition_days": {
-          "description": "Required in case of transition_date or expiry fields are not set. it defines a transition days for ILM",
-          "type": "integer",
-          "format": "int32",
-          "default": 0
         }
       }
     },
-    "addBucketReplication": {
+    "lifecyclePreviewRequest": {
       "type": "object",
       "properties": {
-        "arn": {
-          "type": "string"
+        "rule": {
+          "$ref": "#/definitions/addBucketLifecycle"
+        }
+      }
+    },
+    "lifecyclePreviewResponse": {
+      "type": "object",
+      "properties": {
+        "scannedObjects": {
+          "type": "integer",
+          "format": "int64"
         },
-        "destination_bucket": {
-          "type": "string"
+        "truncated": {
+          "type": "boolean"
+        },
+        "windows": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/lifecyclePreviewWindow"
+          }
         }
       }
     },
-    "addGroupRequest": {
+    "lifecyclePreviewWindow": {
       "type": "object",
-      "required": [
-        "group",
-        "members"
-      ],
       "properties": {
-        "group": {
-          "type": "string"
+        "days": {
+          "type": "integer",
+          "format": "int64"
         },
-        "members": {
+        "expireObjects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "expireSize": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "groups": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/lifecyclePreviewGroup"
           }
+        },
+        "transitionObjects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "transitionSize": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "addPolicyRequest": {
+    "lifecycleTag": {
       "type": "object",
-      "required": [
-        "name",
-        "policy"
-      ],
       "properties": {
-        "name": {
+        "key": {
           "type": "string"
         },
-        "policy": {
+        "value": {
           "type": "string"
         }
       }
     },
-    "addUserRequest": {
+    "listBucketEventsResponse": {
       "type": "object",
-      "required": [
-        "accessKey",
-        "secretKey",
-        "groups"
-      ],
       "properties": {
-        "accessKey": {
-          "type": "string"
-        },
-        "groups": {
+        "events": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/notificationConfig"
           }
         },
-        "secretKey": {
-          "type": "string"
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "total number of bucket events"
         }
       }
     },
-    "adminInfoResponse": {
+    "listBucketsResponse": {
       "type": "object",
       "properties": {
         "buckets": {
-          "type": "integer"
-        },
-        "objects": {
-          "type": "integer"
-        },
-        "usage": {
-          "type": "integer"
-        },
-        "widgets": {
           "type": "array",
+          "title": "list of resulting buckets",
           "items": {
-            "$ref": "#/definitions/widget"
+            "$ref": "#/definitions/bucket"
           }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "number of buckets accessible to the user"
         }
       }
     },
-    "arnsResponse": {
+    "listConfigResponse": {
       "type": "object",
       "properties": {
-        "arns": {
+        "configurations": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/configDescription"
           }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "total number of configurations"
         }
       }
     },
-    "bucket": {
-      "type": "object",
+    "listExternalBucketsParams": {
       "required": [
-        "name"
+        "accessKey",
+        "secretKey",
+        "targetURL",
+        "useTLS"
       ],
       "properties": {
-        "access": {
-          "$ref": "#/definitions/bucketAccess"
+        "accessKey": {
+          "type": "string",
+          "minLength": 3
         },
-        "creation_date": {
+        "region": {
           "type": "string"
         },
-        "name": {
+        "secretKey": {
           "type": "string",
-          "minLength": 3
+          "minLength": 8
         },
-        "size": {
-          "type": "integer",
-          "format": "int64"
+        "targetURL": {
+          "type": "string"
+        },
+        "useTLS": {
+          "type": "boolean"
         }
       }
     },
-    "bucketAccess": {
-      "type": "string",
-      "default": "PRIVATE",
-      "enum": [
-        "PRIVATE",
-        "PUBLIC",
-        "CUSTOM"
-      ]
-    },
-    "bucketEncryptionInfo": {
+    "listGroupsResponse": {
       "type": "object",
       "properties": {
-        "algorithm": {
-          "type": "string"
+        "groups": {
+          "type": "array",
+          "title": "list of groups",
+          "items": {
+            "type": "string"
+          }
         },
-        "kmsMasterKeyID": {
-          "type": "string"
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "total number of groups"
         }
       }
     },
-    "bucketEncryptionRequest": {
+    "listObjectsResponse": {
       "type": "object",
       "properties": {
-        "encType": {
-          "$ref": "#/definitions/bucketEncryptionType"
+        "objects": {
+          "type": "array",
+          "title": "list of resulting objects",
+          "items": {
+            "$ref": "#/definitions/bucketObject"
+          }
         },
-        "kmsKeyID": {
-          "type": "string"
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "number of objects"
         }
       }
     },
-    "bucketEncryptionType": {
-      "type": "string",
-      "default": "sse-s3",
-      "enum": [
-        "sse-s3",
-        "sse-kms"
-      ]
-    },
-    "bucketEventRequest": {
+    "listPoliciesResponse": {
       "type": "object",
-      "required": [
-        "configuration"
-      ],
       "properties": {
-        "configuration": {
-          "$ref": "#/definitions/notificationConfig"
+        "policies": {
+          "type": "array",
+          "title": "list of policies",
+          "items": {
+            "$ref": "#/definitions/policy"
+          }
         },
-        "ignoreExisting": {
-          "type": "boolean"
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "total number of policies"
         }
       }
     },
-    "bucketLifecycleResponse": {
+    "listRemoteBucketsResponse": {
       "type": "object",
       "properties": {
-        "lifecycle": {
+        "buckets": {
           "type": "array",
+          "title": "list of remote buckets",
           "items": {
-            "$ref": "#/definitions/objectBucketLifecycle"
+            "$ref": "#/definitions/remoteBucket"
           }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "number of remote buckets accessible to user"
         }
       }
     },
-    "bucketObLockingResponse": {
+    "listUsersResponse": {
       "type": "object",
       "properties": {
-        "object_locking_enabled": {
-          "type": "boolean"
+        "users": {
+          "type": "array",
+          "title": "list of resulting users",
+          "items": {
+            "$ref": "#/definitions/user"
+          }
         }
       }
     },
-    "bucketObject": {
+    "lockedObject": {
       "type": "object",
       "properties": {
-        "content_type": {
-          "type": "string"
-        },
-        "expiration": {
-          "type": "string"
-        },
-        "expiration_rule_id": {
-          "type": "string"
-        },
-        "is_delete_marker": {
+        "isLatest": {
           "type": "boolean"
         },
-        "is_latest": {
+        "legalHold": {
           "type": "boolean"
         },
-        "last_modified": {
-          "type": "string"
-        },
-        "legal_hold_status": {
+        "mode": {
           "type": "string"
         },
         "name": {
           "type": "string"
         },
-        "retention_mode": {
-          "type": "string"
-        },
-        "retention_until_date": {
+        "retainUntilDate": {
           "type": "string"
         },
         "size": {
           "type": "integer",
           "format": "int64"
         },
-        "tags": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
-          }
-        },
-        "user_tags": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
-          }
-        },
-        "version_id": {
+        "versionID": {
           "type": "string"
         }
       }
     },
-    "bucketQuota": {
+    "lockedObjectsReport": {
       "type": "object",
       "properties": {
-        "quota": {
-          "type": "integer"
+        "objects": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/lockedObject"
+          }
         },
-        "type": {
-          "type": "string",
-          "enum": [
-            "hard",
-            "fifo"
-          ]
+        "scanned": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "truncated": {
+          "type": "boolean"
         }
       }
     },
-    "bucketReplicationDestination": {
+    "logSearchConfiguration": {
       "type": "object",
       "properties": {
-        "bucket": {
+        "image": {
+          "type": "string"
+        },
+        "postgres_image": {
           "type": "string"
+        },
+        "storageClass": {
+          "type": "string",
+          "default": ""
+        },
+        "storageSize": {
+          "type": "number",
+          "default": 5
         }
       }
     },
-    "bucketReplicationResponse": {
+    "logSearchResponse": {
       "type": "object",
       "properties": {
-        "rules": {
+        "results": {
+          "type": "object",
+          "title": "list of log search responses"
+        },
+        "stats": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/bucketReplicationRule"
+            "$ref": "#/definitions/logSearchStat"
           }
+        },
+        "truncated": {
+          "type": "boolean"
         }
       }
     },
-    "bucketReplicationRule": {
+    "logSearchStat": {
       "type": "object",
       "properties": {
-        "bandwidth": {
-          "type": "string"
-        },
-        "delete_marker_replication": {
-          "type": "boolean"
-        },
-        "deletes_replication": {
-          "type": "boolean"
-        },
-        "destination": {
-          "$ref": "#/definitions/bucketReplicationDestination"
-        },
-        "healthCheckPeriod": {
-          "type": "integer"
-        },
-        "id": {
+        "apiName": {
           "type": "string"
         },
-        "metadata_replication": {
-          "type": "boolean"
-        },
-        "prefix": {
-          "type": "string"
+        "avgResponseTime": {
+          "type": "number"
         },
-        "priority": {
+        "count": {
           "type": "integer",
-          "format": "int32"
-        },
-        "status": {
-          "type": "string",
-          "enum": [
-            "Enabled",
-            "Disabled"
-          ]
+          "format": "int64"
         },
-        "syncMode": {
-          "type": "string",
-          "default": "async",
-          "enum": [
-            "async",
-            "sync"
-          ]
+        "errors": {
+          "type": "integer",
+          "format": "int64"
         },
-        "tags": {
+        "time": {
           "type": "string"
         }
       }
     },
-    "bucketVersioningResponse": {
+    "loginDetails": {
       "type": "object",
       "properties": {
-        "is_versioned": {
-          "type": "boolean"
+        "loginStrategy": {
+          "type": "string",
+          "enum": [
+            "form",
+            "redirect",
+            "service-account"
+          ]
+        },
+        "redirect": {
+          "type": "string"
         }
       }
     },
-    "bulkUserGroups": {
+    "loginOauth2AuthRequest": {
       "type": "object",
       "required": [
-        "users",
-        "groups"
+        "state",
+        "code"
       ],
       "properties": {
-        "groups": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "code": {
+          "type": "string"
         },
-        "users": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "state": {
+          "type": "string"
         }
       }
     },
-    "changeUserPasswordRequest": {
+    "loginOperatorRequest": {
       "type": "object",
       "required": [
-        "selectedUser",
-        "newSecretKey"
+        "jwt"
       ],
       "properties": {
-        "newSecretKey": {
-          "type": "string"
-        },
-        "selectedUser": {
+        "jwt": {
           "type": "string"
         }
       }
     },
-    "configDescription": {
+    "loginRequest": {
       "type": "object",
+      "required": [
+        "accessKey",
+        "secretKey"
+      ],
       "properties": {
-        "description": {
+        "accessKey": {
           "type": "string"
         },
-        "key": {
+        "secretKey": {
           "type": "string"
         }
       }
     },
-    "configuration": {
+    "loginResponse": {
       "type": "object",
       "properties": {
-        "key_values": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/configurationKV"
-          }
-        },
-        "name": {
+        "sessionId": {
           "type": "string"
         }
       }
     },
-    "configurationKV": {
+    "makeBucketRequest": {
       "type": "object",
+      "required": [
+        "name"
+      ],
       "properties": {
-        "key": {
-          "type": "string"
+        "locking": {
+          "type": "boolean"
         },
-        "value": {
+        "name": {
           "type": "string"
+        },
+        "quota": {
+          "$ref": "#/definitions/setBucketQuota"
+        },
+        "retention": {
+          "$ref": "#/definitions/putBucketRetentionRequest"
+        },
+        "versioning": {
+          "type": "boolean"
         }
       }
     },
-    "createRemoteBucket": {
+    "multiBucketReplication": {
       "required": [
         "accessKey",
         "secretKey",
         "targetURL",
-        "sourceBucket",
-        "targetBucket"
+        "bucketsRelation"
       ],
       "properties": {
         "accessKey": {
@@ -9106,20 +20642,36 @@ func init() {
           "type": "integer",
           "format": "int64"
         },
+        "bucketsRelation": {
+          "type": "array",
+          "minLength": 1,
+          "items": {
+            "$ref": "#/definitions/multiBucketsRelation"
+          }
+        },
         "healthCheckPeriod": {
           "type": "integer",
           "format": "int32"
         },
+        "prefix": {
+          "type": "string"
+        },
         "region": {
           "type": "string"
         },
+        "replicateDeleteMarkers": {
+          "type": "boolean"
+        },
+        "replicateDeletes": {
+          "type": "boolean"
+        },
+        "replicateMetadata": {
+          "type": "boolean"
+        },
         "secretKey": {
           "type": "string",
           "minLength": 8
         },
-        "sourceBucket": {
-          "type": "string"
-        },
         "syncMode": {
           "type": "string",
           "default": "async",
@@ -9128,7 +20680,7 @@ func init() {
             "sync"
           ]
         },
-        "targetBucket": {
+        "tags": {
           "type": "string"
         },
         "targetURL": {
@@ -9136,1051 +20688,1161 @@ func init() {
         }
       }
     },
-    "directCSIDriveInfo": {
+    "multiBucketResponseItem": {
       "type": "object",
       "properties": {
-        "allocated": {
-          "type": "number",
-          "format": "int64"
-        },
-        "capacity": {
-          "type": "number",
-          "format": "int64"
-        },
-        "drive": {
-          "type": "string"
-        },
-        "message": {
+        "errorString": {
           "type": "string"
         },
-        "node": {
+        "originBucket": {
           "type": "string"
         },
-        "status": {
+        "targetBucket": {
           "type": "string"
-        },
-        "volumes": {
-          "type": "number",
-          "format": "int64"
         }
       }
     },
-    "directCSIVolumeInfo": {
+    "multiBucketResponseState": {
       "type": "object",
       "properties": {
-        "capacity": {
-          "type": "number",
-          "format": "int64"
-        },
-        "drive": {
-          "type": "string"
-        },
-        "node": {
-          "type": "string"
-        },
-        "volume": {
-          "type": "string"
+        "replicationState": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/multiBucketResponseItem"
+          }
         }
       }
     },
-    "error": {
+    "multiBucketsRelation": {
       "type": "object",
-      "required": [
-        "message"
-      ],
       "properties": {
-        "code": {
-          "type": "integer",
-          "format": "int32"
+        "destinationBucket": {
+          "type": "string"
         },
-        "message": {
+        "originBucket": {
           "type": "string"
         }
       }
     },
-    "expirationResponse": {
+    "nodeInfo": {
       "type": "object",
       "properties": {
-        "date": {
-          "type": "string"
-        },
-        "days": {
+        "available_space": {
           "type": "integer",
-          "format": "int64"
+          "format": "uint64"
+        },
+        "commit_id": {
+          "type": "string"
         },
-        "delete_marker": {
-          "type": "boolean"
-        }
-      }
-    },
-    "formatConfiguration": {
-      "type": "object",
-      "required": [
-        "drives",
-        "force"
-      ],
-      "properties": {
         "drives": {
           "type": "array",
-          "minLength": 1,
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/driveInfo"
           }
         },
-        "force": {
-          "type": "boolean"
-        }
-      }
-    },
-    "getBucketRetentionConfig": {
-      "type": "object",
-      "properties": {
-        "mode": {
-          "$ref": "#/definitions/objectRetentionMode"
-        },
-        "unit": {
-          "$ref": "#/definitions/objectRetentionUnit"
+        "endpoint": {
+          "type": "string"
         },
-        "validity": {
+        "healing_drives": {
           "type": "integer",
-          "format": "int32"
-        }
-      }
-    },
-    "getDirectCSIDriveListResponse": {
-      "type": "object",
-      "properties": {
-        "drives": {
+          "format": "int64"
+        },
+        "network": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/directCSIDriveInfo"
+            "$ref": "#/definitions/nodeNetworkStatus"
           }
+        },
+        "offline_drives": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "online_drives": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "pool_index": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "state": {
+          "type": "string"
+        },
+        "total_space": {
+          "type": "integer",
+          "format": "uint64"
+        },
+        "uptime": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "used_space": {
+          "type": "integer",
+          "format": "uint64"
+        },
+        "version": {
+          "type": "string"
         }
       }
     },
-    "getDirectCSIVolumeListResponse": {
+    "nodeNetworkStatus": {
       "type": "object",
       "properties": {
-        "volumes": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/directCSIVolumeInfo"
-          }
+        "endpoint": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string"
         }
       }
     },
-    "group": {
+    "nodesResponse": {
       "type": "object",
       "properties": {
-        "members": {
+        "available_space": {
+          "type": "integer",
+          "format": "uint64"
+        },
+        "servers": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/nodeInfo"
           }
         },
-        "name": {
-          "type": "string"
-        },
-        "policy": {
-          "type": "string"
+        "total_space": {
+          "type": "integer",
+          "format": "uint64"
         },
-        "status": {
-          "type": "string"
+        "used_space": {
+          "type": "integer",
+          "format": "uint64"
         }
       }
     },
-    "hasPermissionRequest": {
+    "nofiticationService": {
+      "type": "string",
+      "enum": [
+        "webhook",
+        "amqp",
+        "kafka",
+        "mqtt",
+        "nats",
+        "nsq",
+        "mysql",
+        "postgres",
+        "elasticsearch",
+        "redis"
+      ]
+    },
+    "noncurrentTransitionResponse": {
       "type": "object",
       "properties": {
-        "actions": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/policyArgs"
-          }
+        "days": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "storage_class": {
+          "type": "string"
         }
       }
     },
-    "hasPermissionResponse": {
+    "notifEndpointResponse": {
       "type": "object",
       "properties": {
-        "permissions": {
+        "notification_endpoints": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/permissionAction"
+            "$ref": "#/definitions/notificationEndpointItem"
           }
         }
       }
     },
-    "iamEntity": {
-      "type": "string",
-      "pattern": "^[\\w+=,.@-]{1,64}$"
-    },
-    "idpConfiguration": {
+    "notificationConfig": {
       "type": "object",
+      "required": [
+        "arn"
+      ],
       "properties": {
-        "active_directory": {
-          "type": "object",
-          "required": [
-            "url",
-            "username_format",
-            "user_search_filter"
-          ],
-          "properties": {
-            "group_name_attribute": {
-              "type": "string"
-            },
-            "group_search_base_dn": {
-              "type": "string"
-            },
-            "group_search_filter": {
-              "type": "string"
-            },
-            "server_insecure": {
-              "type": "boolean"
-            },
-            "skip_tls_verification": {
-              "type": "boolean"
-            },
-            "url": {
-              "type": "string"
-            },
-            "user_search_filter": {
-              "type": "string"
-            },
-            "username_format": {
-              "type": "string"
-            }
-          }
+        "arn": {
+          "type": "string"
         },
-        "keys": {
+        "events": {
           "type": "array",
+          "title": "filter specific type of event. Defaults to all event (default: '[put,delete,get]')",
           "items": {
-            "$ref": "#/definitions/IdpConfigurationKeysItems0"
+            "$ref": "#/definitions/notificationEventType"
           }
         },
-        "oidc": {
-          "type": "object",
-          "required": [
-            "url",
-            "client_id",
-            "secret_id"
-          ],
-          "properties": {
-            "client_id": {
-              "type": "string"
-            },
-            "secret_id": {
-              "type": "string"
-            },
-            "url": {
-              "type": "string"
-            }
-          }
+        "id": {
+          "type": "string"
+        },
+        "prefix": {
+          "type": "string",
+          "title": "filter event associated to the specified prefix"
+        },
+        "suffix": {
+          "type": "string",
+          "title": "filter event associated to the specified suffix"
         }
       }
     },
-    "keyPairConfiguration": {
+    "notificationDeleteRequest": {
       "type": "object",
       "required": [
-        "crt",
-        "key"
+        "events",
+        "prefix",
+        "suffix"
       ],
       "properties": {
-        "crt": {
-          "type": "string"
+        "events": {
+          "type": "array",
+          "title": "filter specific type of event. Defaults to all event (default: '[put,delete,get]')",
+          "minLength": 1,
+          "items": {
+            "$ref": "#/definitions/notificationEventType"
+          }
         },
-        "key": {
-          "type": "string"
+        "prefix": {
+          "type": "string",
+          "title": "filter event associated to the specified prefix"
+        },
+        "suffix": {
+          "type": "string",
+          "title": "filter event associated to the specified suffix"
         }
       }
     },
-    "license": {
+    "notificationEndpoint": {
       "type": "object",
+      "required": [
+        "service",
+        "account_id",
+        "properties"
+      ],
       "properties": {
         "account_id": {
-          "type": "integer"
-        },
-        "email": {
-          "type": "string"
-        },
-        "expires_at": {
-          "type": "string"
-        },
-        "organization": {
           "type": "string"
         },
-        "plan": {
-          "type": "string"
+        "properties": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
         },
-        "storage_capacity": {
-          "type": "integer"
+        "service": {
+          "$ref": "#/definitions/nofiticationService"
         }
       }
     },
-    "lifecycleTag": {
+    "notificationEndpointItem": {
       "type": "object",
       "properties": {
-        "key": {
+        "account_id": {
           "type": "string"
         },
-        "value": {
+        "service": {
+          "$ref": "#/definitions/nofiticationService"
+        },
+        "status": {
           "type": "string"
         }
       }
     },
-    "listBucketEventsResponse": {
+    "notificationEventType": {
+      "type": "string",
+      "enum": [
+        "put",
+        "delete",
+        "get"
+      ]
+    },
+    "objectBucketLifecycle": {
       "type": "object",
       "properties": {
-        "events": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/notificationConfig"
-          }
+        "abort_incomplete_multipart_days": {
+          "type": "integer",
+          "format": "int64"
         },
-        "total": {
+        "expiration": {
+          "$ref": "#/definitions/expirationResponse"
+        },
+        "id": {
+          "type": "string"
+        },
+        "noncurrent_expiration_days": {
           "type": "integer",
-          "format": "int64",
-          "title": "total number of bucket events"
-        }
-      }
-    },
-    "listBucketsResponse": {
-      "type": "object",
-      "properties": {
-        "buckets": {
+          "format": "int64"
+        },
+        "noncurrent_transition": {
+          "$ref": "#/definitions/noncurrentTransitionResponse"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        },
+        "tags": {
           "type": "array",
-          "title": "list of resulting buckets",
           "items": {
-            "$ref": "#/definitions/bucket"
+            "$ref": "#/definitions/lifecycleTag"
           }
         },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "number of buckets accessible to the user"
+        "transition": {
+          "$ref": "#/definitions/transitionResponse"
         }
       }
     },
-    "listConfigResponse": {
+    "objectLegalHoldStatus": {
+      "type": "string",
+      "enum": [
+        "enabled",
+        "disabled"
+      ]
+    },
+    "objectRetentionMode": {
+      "type": "string",
+      "enum": [
+        "governance",
+        "compliance"
+      ]
+    },
+    "objectRetentionUnit": {
+      "type": "string",
+      "enum": [
+        "days",
+        "years"
+      ]
+    },
+    "peerInfo": {
       "type": "object",
       "properties": {
-        "configurations": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/configDescription"
-          }
+        "deploymentID": {
+          "type": "string"
         },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "total number of configurations"
+        "endpoint": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
         }
       }
     },
-    "listExternalBucketsParams": {
+    "peerSite": {
+      "type": "object",
       "required": [
+        "endpoint",
         "accessKey",
-        "secretKey",
-        "targetURL",
-        "useTLS"
+        "secretKey"
       ],
       "properties": {
         "accessKey": {
-          "type": "string",
-          "minLength": 3
+          "type": "string"
         },
-        "region": {
+        "endpoint": {
+          "type": "string"
+        },
+        "name": {
           "type": "string"
         },
         "secretKey": {
-          "type": "string",
-          "minLength": 8
+          "type": "string"
+        }
+      }
+    },
+    "permissionAction": {
+      "type": "object",
+      "properties": {
+        "can": {
+          "type": "boolean"
         },
-        "targetURL": {
+        "id": {
+          "type": "string"
+        }
+      }
+    },
+    "policy": {
+      "type": "object",
+      "properties": {
+        "name": {
           "type": "string"
         },
-        "useTLS": {
-          "type": "boolean"
+        "policy": {
+          "type": "string"
         }
       }
     },
-    "listGroupsResponse": {
+    "policyArgs": {
       "type": "object",
       "properties": {
-        "groups": {
-          "type": "array",
-          "title": "list of groups",
-          "items": {
-            "type": "string"
-          }
+        "action": {
+          "type": "string"
         },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "total number of groups"
+        "bucket_name": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
         }
       }
     },
-    "listObjectsResponse": {
+    "policyEntity": {
+      "type": "string",
+      "default": "user",
+      "enum": [
+        "user",
+        "group"
+      ]
+    },
+    "poolDecommissionInfo": {
       "type": "object",
       "properties": {
-        "objects": {
-          "type": "array",
-          "title": "list of resulting objects",
-          "items": {
-            "$ref": "#/definitions/bucketObject"
-          }
+        "bytes_decommission_failed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "bytes_decommissioned": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "canceled": {
+          "type": "boolean"
+        },
+        "complete": {
+          "type": "boolean"
+        },
+        "current_size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "failed": {
+          "type": "boolean"
+        },
+        "objects_decommission_failed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "objects_decommissioned": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "percentage": {
+          "type": "number",
+          "format": "double"
+        },
+        "start_size": {
+          "type": "integer",
+          "format": "int64"
         },
-        "total": {
+        "start_time": {
+          "type": "string"
+        },
+        "total_size": {
           "type": "integer",
-          "format": "int64",
-          "title": "number of objects"
+          "format": "int64"
         }
       }
     },
-    "listPoliciesResponse": {
+    "poolStatus": {
       "type": "object",
       "properties": {
-        "policies": {
-          "type": "array",
-          "title": "list of policies",
-          "items": {
-            "$ref": "#/definitions/policy"
-          }
+        "cmdline": {
+          "type": "string"
         },
-        "total": {
+        "decommission": {
+          "$ref": "#/definitions/poolDecommissionInfo"
+        },
+        "id": {
           "type": "integer",
-          "format": "int64",
-          "title": "total number of policies"
+          "format": "int64"
+        },
+        "last_update": {
+          "type": "string"
         }
       }
     },
-    "listRemoteBucketsResponse": {
+    "poolTopology": {
       "type": "object",
       "properties": {
-        "buckets": {
+        "available_space": {
+          "type": "integer",
+          "format": "uint64"
+        },
+        "index": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "sets": {
           "type": "array",
-          "title": "list of remote buckets",
           "items": {
-            "$ref": "#/definitions/remoteBucket"
+            "$ref": "#/definitions/erasureSet"
           }
         },
-        "total": {
+        "state": {
+          "type": "string"
+        },
+        "total_space": {
           "type": "integer",
-          "format": "int64",
-          "title": "number of remote buckets accessible to user"
+          "format": "uint64"
+        },
+        "used_space": {
+          "type": "integer",
+          "format": "uint64"
         }
       }
     },
-    "listUsersResponse": {
+    "poolsStatusResponse": {
       "type": "object",
       "properties": {
-        "users": {
+        "pools": {
           "type": "array",
-          "title": "list of resulting users",
           "items": {
-            "$ref": "#/definitions/user"
+            "$ref": "#/definitions/poolStatus"
           }
         }
       }
     },
-    "logSearchConfiguration": {
+    "prefixUsage": {
       "type": "object",
       "properties": {
-        "image": {
-          "type": "string"
+        "objects": {
+          "type": "integer",
+          "format": "int64"
         },
-        "postgres_image": {
+        "prefix": {
           "type": "string"
         },
-        "storageClass": {
-          "type": "string",
-          "default": ""
+        "size": {
+          "type": "integer",
+          "format": "int64"
         },
-        "storageSize": {
-          "type": "number",
-          "default": 5
-        }
-      }
-    },
-    "logSearchResponse": {
-      "type": "object",
-      "properties": {
-        "results": {
-          "type": "object",
-          "title": "list of log search responses"
+        "versions": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "loginDetails": {
+    "principal": {
       "type": "object",
       "properties": {
-        "loginStrategy": {
-          "type": "string",
-          "enum": [
-            "form",
-            "redirect",
-            "service-account"
-          ]
+        "STSAccessKeyID": {
+          "type": "string"
         },
-        "redirect": {
+        "STSSecretAccessKey": {
           "type": "string"
-        }
-      }
-    },
-    "loginOauth2AuthRequest": {
-      "type": "object",
-      "required": [
-        "state",
-        "code"
-      ],
-      "properties": {
-        "code": {
+        },
+        "STSSessionToken": {
           "type": "string"
         },
-        "state": {
+        "accountAccessKey": {
           "type": "string"
+        },
+        "actions": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
-    "loginOperatorRequest": {
-      "type": "object",
-      "required": [
-        "jwt"
-      ],
-      "properties": {
-        "jwt": {
-          "type": "string"
-        }
-      }
+    "profilerType": {
+      "type": "string",
+      "enum": [
+        "cpu",
+        "mem",
+        "block",
+        "mutex",
+        "trace",
+        "threads",
+        "goroutines"
+      ]
     },
-    "loginRequest": {
+    "profilingCapture": {
       "type": "object",
-      "required": [
-        "accessKey",
-        "secretKey"
-      ],
       "properties": {
-        "accessKey": {
+        "error": {
           "type": "string"
         },
-        "secretKey": {
+        "finishedAt": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "nodes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "startedAt": {
           "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "running",
+            "completed",
+            "failed"
+          ]
+        },
+        "types": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
-    "loginResponse": {
+    "profilingCaptureList": {
       "type": "object",
       "properties": {
-        "sessionId": {
-          "type": "string"
+        "captures": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/profilingCapture"
+          }
         }
       }
     },
-    "makeBucketRequest": {
+    "profilingFunction": {
       "type": "object",
-      "required": [
-        "name"
-      ],
       "properties": {
-        "locking": {
-          "type": "boolean"
+        "cum": {
+          "type": "integer",
+          "format": "int64"
         },
-        "name": {
-          "type": "string"
+        "cumPercent": {
+          "type": "number"
         },
-        "quota": {
-          "$ref": "#/definitions/setBucketQuota"
+        "flat": {
+          "type": "integer",
+          "format": "int64"
         },
-        "retention": {
-          "$ref": "#/definitions/putBucketRetentionRequest"
+        "flatPercent": {
+          "type": "number"
         },
-        "versioning": {
-          "type": "boolean"
+        "name": {
+          "type": "string"
         }
       }
     },
-    "multiBucketReplication": {
-      "required": [
-        "accessKey",
-        "secretKey",
-        "targetURL",
-        "bucketsRelation"
-      ],
+    "profilingNodeSummary": {
+      "type": "object",
       "properties": {
-        "accessKey": {
-          "type": "string",
-          "minLength": 3
-        },
-        "bandwidth": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "bucketsRelation": {
+        "functions": {
           "type": "array",
-          "minLength": 1,
           "items": {
-            "$ref": "#/definitions/multiBucketsRelation"
+            "$ref": "#/definitions/profilingFunction"
           }
         },
-        "healthCheckPeriod": {
-          "type": "integer",
-          "format": "int32"
-        },
-        "prefix": {
-          "type": "string"
-        },
-        "region": {
+        "node": {
           "type": "string"
         },
-        "replicateDeleteMarkers": {
-          "type": "boolean"
-        },
-        "replicateDeletes": {
-          "type": "boolean"
+        "total": {
+          "type": "integer",
+          "format": "int64"
         },
-        "replicateMetadata": {
-          "type": "boolean"
+        "unit": {
+          "type": "string"
+        }
+      }
+    },
+    "profilingStartRequest": {
+      "type": "object",
+      "title": "one of type or types is required",
+      "properties": {
+        "duration": {
+          "type": "integer",
+          "format": "int64",
+          "title": "seconds after which the capture is stopped automatically"
         },
-        "secretKey": {
-          "type": "string",
-          "minLength": 8
+        "nodes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "syncMode": {
-          "type": "string",
-          "default": "async",
-          "enum": [
-            "async",
-            "sync"
-          ]
+        "type": {
+          "$ref": "#/definitions/profilerType"
         },
-        "tags": {
+        "types": {
+          "type": "array",
+          "title": "profilers to run, type is still accepted for a single profiler",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "profilingSummary": {
+      "type": "object",
+      "properties": {
+        "captureId": {
           "type": "string"
         },
-        "targetURL": {
-          "type": "string"
+        "nodes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/profilingNodeSummary"
+          }
         }
       }
     },
-    "multiBucketResponseItem": {
+    "prometheusConfiguration": {
       "type": "object",
       "properties": {
-        "errorString": {
+        "image": {
           "type": "string"
         },
-        "originBucket": {
-          "type": "string"
+        "storageClass": {
+          "type": "string",
+          "default": ""
         },
-        "targetBucket": {
-          "type": "string"
+        "storageSize": {
+          "type": "number",
+          "default": 5
         }
       }
     },
-    "multiBucketResponseState": {
+    "putBucketRetentionRequest": {
       "type": "object",
+      "required": [
+        "mode",
+        "unit",
+        "validity"
+      ],
       "properties": {
-        "replicationState": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/multiBucketResponseItem"
-          }
+        "mode": {
+          "$ref": "#/definitions/objectRetentionMode"
+        },
+        "unit": {
+          "$ref": "#/definitions/objectRetentionUnit"
+        },
+        "validity": {
+          "type": "integer",
+          "format": "int32"
         }
       }
     },
-    "multiBucketsRelation": {
+    "putObjectLegalHoldRequest": {
       "type": "object",
+      "required": [
+        "status"
+      ],
       "properties": {
-        "destinationBucket": {
+        "status": {
+          "$ref": "#/definitions/objectLegalHoldStatus"
+        }
+      }
+    },
+    "putObjectRetentionRequest": {
+      "type": "object",
+      "required": [
+        "mode",
+        "expires"
+      ],
+      "properties": {
+        "expires": {
           "type": "string"
         },
-        "originBucket": {
-          "type": "string"
+        "governance_bypass": {
+          "type": "boolean"
+        },
+        "mode": {
+          "$ref": "#/definitions/objectRetentionMode"
         }
       }
     },
-    "nofiticationService": {
-      "type": "string",
-      "enum": [
-        "webhook",
-        "amqp",
-        "kafka",
-        "mqtt",
-        "nats",
-        "nsq",
-        "mysql",
-        "postgres",
-        "elasticsearch",
-        "redis"
-      ]
-    },
-    "notifEndpointResponse": {
+    "putObjectTagsRequest": {
       "type": "object",
       "properties": {
-        "notification_endpoints": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/notificationEndpointItem"
+        "tags": {
+          "additionalProperties": {
+            "type": "string"
           }
         }
       }
     },
-    "notificationConfig": {
+    "rebalancePoolStatus": {
       "type": "object",
-      "required": [
-        "arn"
-      ],
       "properties": {
-        "arn": {
+        "bucket": {
           "type": "string"
         },
-        "events": {
-          "type": "array",
-          "title": "filter specific type of event. Defaults to all event (default: '[put,delete,get]')",
-          "items": {
-            "$ref": "#/definitions/notificationEventType"
-          }
+        "bytes": {
+          "type": "integer",
+          "format": "uint64"
+        },
+        "elapsed": {
+          "type": "integer",
+          "format": "int64",
+          "title": "seconds since the rebalance of the pool started"
+        },
+        "eta": {
+          "type": "integer",
+          "format": "int64",
+          "title": "estimated seconds until the rebalance of the pool completes"
         },
         "id": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "object": {
           "type": "string"
         },
-        "prefix": {
-          "type": "string",
-          "title": "filter event associated to the specified prefix"
+        "objects": {
+          "type": "integer",
+          "format": "uint64"
         },
-        "suffix": {
-          "type": "string",
-          "title": "filter event associated to the specified suffix"
+        "status": {
+          "type": "string"
+        },
+        "used": {
+          "type": "number",
+          "format": "double"
+        },
+        "versions": {
+          "type": "integer",
+          "format": "uint64"
         }
       }
     },
-    "notificationDeleteRequest": {
+    "rebalanceStatusResponse": {
       "type": "object",
-      "required": [
-        "events",
-        "prefix",
-        "suffix"
-      ],
       "properties": {
-        "events": {
+        "id": {
+          "type": "string"
+        },
+        "pools": {
           "type": "array",
-          "title": "filter specific type of event. Defaults to all event (default: '[put,delete,get]')",
-          "minLength": 1,
           "items": {
-            "$ref": "#/definitions/notificationEventType"
+            "$ref": "#/definitions/rebalancePoolStatus"
           }
         },
-        "prefix": {
-          "type": "string",
-          "title": "filter event associated to the specified prefix"
+        "running": {
+          "type": "boolean"
         },
-        "suffix": {
-          "type": "string",
-          "title": "filter event associated to the specified suffix"
+        "stopped_at": {
+          "type": "string"
         }
       }
     },
-    "notificationEndpoint": {
+    "remoteBucket": {
       "type": "object",
       "required": [
-        "service",
-        "account_id",
-        "properties"
+        "accessKey",
+        "sourceBucket",
+        "remoteARN"
       ],
       "properties": {
-        "account_id": {
-          "type": "string"
+        "accessKey": {
+          "type": "string",
+          "minLength": 3
         },
-        "properties": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
-          }
+        "bandwidth": {
+          "type": "integer",
+          "format": "int64"
         },
-        "service": {
-          "$ref": "#/definitions/nofiticationService"
-        }
-      }
-    },
-    "notificationEndpointItem": {
-      "type": "object",
-      "properties": {
-        "account_id": {
+        "healthCheckPeriod": {
+          "type": "integer"
+        },
+        "remoteARN": {
           "type": "string"
         },
+        "secretKey": {
+          "type": "string",
+          "minLength": 8
+        },
         "service": {
-          "$ref": "#/definitions/nofiticationService"
+          "type": "string",
+          "enum": [
+            "replication"
+          ]
+        },
+        "sourceBucket": {
+          "type": "string"
         },
         "status": {
           "type": "string"
+        },
+        "syncMode": {
+          "type": "string"
+        },
+        "targetBucket": {
+          "type": "string"
+        },
+        "targetURL": {
+          "type": "string"
         }
       }
     },
-    "notificationEventType": {
-      "type": "string",
-      "enum": [
-        "put",
-        "delete",
-        "get"
-      ]
-    },
-    "objectBucketLifecycle": {
+    "replicationFailedObject": {
       "type": "object",
       "properties": {
-        "expiration": {
-          "$ref": "#/definitions/expirationResponse"
+        "last_modified": {
+          "type": "string"
         },
-        "id": {
+        "name": {
           "type": "string"
         },
-        "prefix": {
+        "reason": {
           "type": "string"
         },
-        "status": {
+        "rule_id": {
           "type": "string"
         },
-        "tags": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/lifecycleTag"
-          }
+        "size": {
+          "type": "integer",
+          "format": "int64"
         },
-        "transition": {
-          "$ref": "#/definitions/transitionResponse"
-        }
-      }
-    },
-    "objectLegalHoldStatus": {
-      "type": "string",
-      "enum": [
-        "enabled",
-        "disabled"
-      ]
-    },
-    "objectRetentionMode": {
-      "type": "string",
-      "enum": [
-        "governance",
-        "compliance"
-      ]
-    },
-    "objectRetentionUnit": {
-      "type": "string",
-      "enum": [
-        "days",
-        "years"
-      ]
+        "target": {
+          "type": "string"
+        },
+        "version_id": {
+          "type": "string"
+        }
+      }
     },
-    "permissionAction": {
+    "replicationResyncRequest": {
       "type": "object",
       "properties": {
-        "can": {
-          "type": "boolean"
+        "arn": {
+          "type": "string"
         },
-        "id": {
+        "olderThan": {
           "type": "string"
         }
       }
     },
-    "policy": {
+    "replicationResyncResponse": {
       "type": "object",
       "properties": {
-        "name": {
+        "resetID": {
           "type": "string"
+        }
+      }
+    },
+    "replicationRetryRequest": {
+      "type": "object",
+      "properties": {
+        "objects": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "policy": {
+        "prefix": {
           "type": "string"
         }
       }
     },
-    "policyArgs": {
+    "replicationRetryResponse": {
       "type": "object",
       "properties": {
-        "action": {
-          "type": "string"
+        "failed": {
+          "type": "integer",
+          "format": "int64"
         },
-        "bucket_name": {
-          "type": "string"
+        "objects": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/replicationRetryResult"
+          }
         },
-        "id": {
-          "type": "string"
+        "queued": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "skipped": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "policyEntity": {
-      "type": "string",
-      "default": "user",
-      "enum": [
-        "user",
-        "group"
-      ]
-    },
-    "principal": {
+    "replicationRetryResult": {
       "type": "object",
       "properties": {
-        "STSAccessKeyID": {
+        "name": {
           "type": "string"
         },
-        "STSSecretAccessKey": {
+        "reason": {
           "type": "string"
         },
-        "STSSessionToken": {
-          "type": "string"
+        "status": {
+          "type": "string",
+          "enum": [
+            "queued",
+            "skipped",
+            "failed"
+          ]
         },
-        "accountAccessKey": {
+        "version_id": {
           "type": "string"
-        },
-        "actions": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
         }
       }
     },
-    "profilerType": {
-      "type": "string",
-      "enum": [
-        "cpu",
-        "mem",
-        "block",
-        "mutex",
-        "trace",
-        "threads",
-        "goroutines"
-      ]
-    },
-    "profilingStartRequest": {
+    "replicationRuleUpdateResponse": {
       "type": "object",
-      "required": [
-        "type"
-      ],
       "properties": {
-        "type": {
-          "$ref": "#/definitions/profilerType"
+        "warning": {
+          "type": "string"
         }
       }
     },
-    "prometheusConfiguration": {
+    "replicationTargetMetrics": {
       "type": "object",
       "properties": {
-        "image": {
+        "arn": {
           "type": "string"
         },
-        "storageClass": {
-          "type": "string",
-          "default": ""
+        "bandwidthLimit": {
+          "type": "integer",
+          "format": "int64"
         },
-        "storageSize": {
+        "bucket": {
+          "type": "string"
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "healthCheckPeriod": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "latencyMs": {
           "type": "number",
-          "default": 5
+          "format": "double"
+        },
+        "resetBeforeDate": {
+          "type": "string"
+        },
+        "resetID": {
+          "type": "string"
+        },
+        "secure": {
+          "type": "boolean"
+        },
+        "storageClass": {
+          "type": "string"
+        },
+        "syncMode": {
+          "type": "string",
+          "enum": [
+            "async",
+            "sync"
+          ]
         }
       }
     },
-    "putBucketRetentionRequest": {
+    "resetConfigRequest": {
       "type": "object",
-      "required": [
-        "mode",
-        "unit",
-        "validity"
-      ],
       "properties": {
-        "mode": {
-          "$ref": "#/definitions/objectRetentionMode"
-        },
-        "unit": {
-          "$ref": "#/definitions/objectRetentionUnit"
+        "arn_resource_id": {
+          "type": "string",
+          "title": "Used if configuration is an event notification's target"
         },
-        "validity": {
-          "type": "integer",
-          "format": "int32"
+        "keys": {
+          "type": "array",
+          "title": "keys to reset, the whole configuration is reset when empty",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
-    "putObjectLegalHoldRequest": {
+    "restoreObject": {
       "type": "object",
-      "required": [
-        "status"
-      ],
       "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "restore",
+            "remove",
+            "skip"
+          ]
+        },
+        "error": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "reason": {
+          "type": "string"
+        },
         "status": {
-          "$ref": "#/definitions/objectLegalHoldStatus"
+          "type": "string",
+          "enum": [
+            "planned",
+            "restored",
+            "removed",
+            "skipped",
+            "failed"
+          ]
+        },
+        "versionId": {
+          "type": "string"
         }
       }
     },
-    "putObjectRetentionRequest": {
+    "restoreRequest": {
       "type": "object",
       "required": [
-        "mode",
-        "expires"
+        "date"
       ],
       "properties": {
-        "expires": {
+        "date": {
           "type": "string"
         },
-        "governance_bypass": {
+        "dryRun": {
           "type": "boolean"
         },
-        "mode": {
-          "$ref": "#/definitions/objectRetentionMode"
-        }
-      }
-    },
-    "putObjectTagsRequest": {
-      "type": "object",
-      "properties": {
-        "tags": {
-          "additionalProperties": {
-            "type": "string"
-          }
+        "prefix": {
+          "type": "string"
+        },
+        "removeNewer": {
+          "type": "boolean"
         }
       }
     },
-    "remoteBucket": {
+    "restoreSummary": {
       "type": "object",
-      "required": [
-        "accessKey",
-        "sourceBucket",
-        "remoteARN"
-      ],
       "properties": {
-        "accessKey": {
-          "type": "string",
-          "minLength": 3
+        "dryRun": {
+          "type": "boolean"
         },
-        "bandwidth": {
+        "failed": {
           "type": "integer",
           "format": "int64"
         },
-        "healthCheckPeriod": {
-          "type": "integer"
-        },
-        "remoteARN": {
-          "type": "string"
-        },
-        "secretKey": {
-          "type": "string",
-          "minLength": 8
-        },
-        "service": {
-          "type": "string",
-          "enum": [
-            "replication"
-          ]
+        "limitReached": {
+          "type": "boolean"
         },
-        "sourceBucket": {
-          "type": "string"
+        "objects": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/restoreObject"
+          }
         },
-        "status": {
-          "type": "string"
+        "removed": {
+          "type": "integer",
+          "format": "int64"
         },
-        "syncMode": {
-          "type": "string"
+        "restored": {
+          "type": "integer",
+          "format": "int64"
         },
-        "targetBucket": {
-          "type": "string"
+        "skipped": {
+          "type": "integer",
+          "format": "int64"
         },
-        "targetURL": {
-          "type": "string"
+        "truncated": {
+          "type": "boolean"
         }
       }
     },
@@ -10225,14 +21887,38 @@ func init() {
         }
       }
     },
-    "rewindResponse": {
+    "rewindResponse": {
+      "type": "object",
+      "properties": {
+        "objects": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/rewindItem"
+          }
+        }
+      }
+    },
+    "serverUpdateRequest": {
+      "type": "object",
+      "properties": {
+        "force": {
+          "type": "boolean",
+          "title": "skip the pre-flight health check"
+        },
+        "url": {
+          "type": "string",
+          "title": "update URL, the Console configured one or the official release when empty"
+        }
+      }
+    },
+    "serverUpdateResponse": {
       "type": "object",
       "properties": {
-        "objects": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/rewindItem"
-          }
+        "current_version": {
+          "type": "string"
+        },
+        "updated_version": {
+          "type": "string"
         }
       }
     },
@@ -10262,6 +21948,62 @@ func init() {
         "type": "string"
       }
     },
+    "serviceServerStatus": {
+      "type": "object",
+      "properties": {
+        "endpoint": {
+          "type": "string"
+        },
+        "offline_drives": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "state": {
+          "type": "string"
+        },
+        "uptime": {
+          "type": "integer",
+          "format": "int64",
+          "title": "seconds since the server started"
+        },
+        "version": {
+          "type": "string"
+        }
+      }
+    },
+    "serviceStatusResponse": {
+      "type": "object",
+      "properties": {
+        "healthy": {
+          "type": "boolean",
+          "title": "whether the pre-flight health check passes"
+        },
+        "issues": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "online_servers": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "ready": {
+          "type": "boolean",
+          "title": "every server is online, restarted and running the expected version when requested"
+        },
+        "servers": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/serviceServerStatus"
+          }
+        },
+        "total_servers": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
     "sessionResponse": {
       "type": "object",
       "properties": {
@@ -10359,6 +22101,21 @@ func init() {
         }
       }
     },
+    "setConfigTargetRequest": {
+      "type": "object",
+      "required": [
+        "key_values"
+      ],
+      "properties": {
+        "key_values": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/configurationKV"
+          }
+        }
+      }
+    },
     "setNotificationEndpointResponse": {
       "type": "object",
       "required": [
@@ -10416,6 +22173,235 @@ func init() {
         }
       }
     },
+    "siteReplicationAddRequest": {
+      "type": "object",
+      "required": [
+        "sites"
+      ],
+      "properties": {
+        "sites": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/peerSite"
+          }
+        }
+      }
+    },
+    "siteReplicationAddResponse": {
+      "type": "object",
+      "properties": {
+        "errorDetail": {
+          "type": "string"
+        },
+        "initialSyncErrorMessage": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        },
+        "success": {
+          "type": "boolean"
+        }
+      }
+    },
+    "siteReplicationEditResponse": {
+      "type": "object",
+      "properties": {
+        "errorDetail": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        },
+        "success": {
+          "type": "boolean"
+        }
+      }
+    },
+    "siteReplicationEntitySiteStatus": {
+      "type": "object",
+      "properties": {
+        "deploymentID": {
+          "type": "string"
+        },
+        "mismatches": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "present": {
+          "type": "boolean"
+        }
+      }
+    },
+    "siteReplicationEntityStatus": {
+      "type": "object",
+      "properties": {
+        "inSync": {
+          "type": "boolean"
+        },
+        "name": {
+          "type": "string"
+        },
+        "sites": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/siteReplicationEntitySiteStatus"
+          }
+        }
+      }
+    },
+    "siteReplicationInfo": {
+      "type": "object",
+      "properties": {
+        "enabled": {
+          "type": "boolean"
+        },
+        "name": {
+          "type": "string"
+        },
+        "serviceAccountAccessKey": {
+          "type": "string"
+        },
+        "sites": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/peerInfo"
+          }
+        }
+      }
+    },
+    "siteReplicationRemoveRequest": {
+      "type": "object",
+      "properties": {
+        "all": {
+          "type": "boolean"
+        },
+        "sites": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "siteReplicationRemoveResponse": {
+      "type": "object",
+      "properties": {
+        "errorDetail": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "siteReplicationSiteStatus": {
+      "type": "object",
+      "properties": {
+        "deploymentID": {
+          "type": "string"
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "replicatedBuckets": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "replicatedGroups": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "replicatedPolicies": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "replicatedUsers": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "totalBuckets": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "totalGroups": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "totalPolicies": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "totalUsers": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "siteReplicationStatusResponse": {
+      "type": "object",
+      "properties": {
+        "buckets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/siteReplicationEntityStatus"
+          }
+        },
+        "enabled": {
+          "type": "boolean"
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/siteReplicationEntityStatus"
+          }
+        },
+        "policies": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/siteReplicationEntityStatus"
+          }
+        },
+        "sites": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/siteReplicationSiteStatus"
+          }
+        },
+        "users": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/siteReplicationEntityStatus"
+          }
+        }
+      }
+    },
+    "sizeHistogramBucket": {
+      "type": "object",
+      "properties": {
+        "label": {
+          "type": "string"
+        },
+        "max": {
+          "type": "integer",
+          "format": "int64",
+          "title": "upper bound of the sizes, 0 when there is none"
+        },
+        "min": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "objects": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
     "startProfilingItem": {
       "type": "object",
       "properties": {
@@ -10439,10 +22425,26 @@ func init() {
             "$ref": "#/definitions/startProfilingItem"
           }
         },
-        "total": {
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "number of start results"
+        }
+      }
+    },
+    "storageClassUsage": {
+      "type": "object",
+      "properties": {
+        "objects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "size": {
           "type": "integer",
-          "format": "int64",
-          "title": "number of start results"
+          "format": "int64"
+        },
+        "storage_class": {
+          "type": "string"
         }
       }
     },
@@ -10603,6 +22605,32 @@ func init() {
         }
       }
     },
+    "topologyResponse": {
+      "type": "object",
+      "properties": {
+        "pools": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/poolTopology"
+          }
+        },
+        "rrs_parity": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "standard_parity": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "unassigned_drives": {
+          "type": "array",
+          "title": "drives not assigned to an erasure set yet",
+          "items": {
+            "$ref": "#/definitions/driveInfo"
+          }
+        }
+      }
+    },
     "transitionResponse": {
       "type": "object",
       "properties": {
@@ -10629,6 +22657,51 @@ func init() {
         }
       }
     },
+    "updateBucketReplicationRule": {
+      "type": "object",
+      "title": "the settings left out keep their current values",
+      "properties": {
+        "delete_marker_replication": {
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "deletes_replication": {
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "existing_objects": {
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "metadata_replication": {
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "prefix": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "priority": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "Enabled",
+            "Disabled"
+          ]
+        },
+        "storageClass": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "tags": {
+          "type": "string",
+          "x-nullable": true
+        }
+      }
+    },
     "updateGroupRequest": {
       "type": "object",
       "required": [
@@ -10702,6 +22775,257 @@ func init() {
         }
       }
     },
+    "versionsCleanupItem": {
+      "type": "object",
+      "properties": {
+        "deleteMarker": {
+          "type": "boolean"
+        },
+        "error": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "versionID": {
+          "type": "string"
+        }
+      }
+    },
+    "versionsCleanupRequest": {
+      "type": "object",
+      "properties": {
+        "dryRun": {
+          "type": "boolean"
+        },
+        "keepLatest": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "olderThanDays": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "purgeDeleteMarkers": {
+          "type": "boolean"
+        }
+      }
+    },
+    "versionsCleanupSummary": {
+      "type": "object",
+      "properties": {
+        "dryRun": {
+          "type": "boolean"
+        },
+        "failed": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "items": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/versionsCleanupItem"
+          }
+        },
+        "removedDeleteMarkers": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "removedSize": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "removedVersions": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "scanned": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "truncated": {
+          "type": "boolean"
+        }
+      }
+    },
+    "versionsPrefixStats": {
+      "type": "object",
+      "properties": {
+        "deleteMarkers": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "noncurrentSize": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "noncurrentVersions": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "objects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "orphanedDeleteMarkers": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "versions": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "versionsStatsResponse": {
+      "type": "object",
+      "properties": {
+        "prefixes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/versionsPrefixStats"
+          }
+        },
+        "scanned": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "truncated": {
+          "type": "boolean"
+        }
+      }
+    },
+    "webhook": {
+      "type": "object",
+      "properties": {
+        "created": {
+          "type": "string"
+        },
+        "created_by": {
+          "type": "string"
+        },
+        "disabled": {
+          "type": "boolean"
+        },
+        "events": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "has_secret": {
+          "type": "boolean"
+        },
+        "id": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "url": {
+          "type": "string"
+        }
+      }
+    },
+    "webhookDelivery": {
+      "type": "object",
+      "properties": {
+        "attempts": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "created": {
+          "type": "string"
+        },
+        "error": {
+          "type": "string"
+        },
+        "event": {
+          "type": "string"
+        },
+        "event_id": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "last_attempt": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "title": "pending, succeeded or failed"
+        },
+        "status_code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "webhook_id": {
+          "type": "string"
+        }
+      }
+    },
+    "webhookDeliveryList": {
+      "type": "object",
+      "properties": {
+        "deliveries": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/webhookDelivery"
+          }
+        }
+      }
+    },
+    "webhookList": {
+      "type": "object",
+      "properties": {
+        "webhooks": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/webhook"
+          }
+        }
+      }
+    },
+    "webhookRequest": {
+      "type": "object",
+      "required": [
+        "name",
+        "url"
+      ],
+      "properties": {
+        "disabled": {
+          "type": "boolean"
+        },
+        "events": {
+          "type": "array",
+          "title": "bucket.created, policy.changed, user.added or heal.corrupted, every event when empty",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "secret": {
+          "type": "string",
+          "title": "key of the HMAC-SHA256 signature of the events, the current one is kept when empty"
+        },
+        "url": {
+          "type": "string"
+        }
+      }
+    },
     "widget": {
       "type": "object",
       "properties": {
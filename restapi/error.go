@@ -33,6 +33,65 @@ var (
 	errLicenseNotFound              = errors.New("license not found")
 	errAvoidSelfAccountDelete       = errors.New("logged in user cannot be deleted by itself")
 	errAccessDenied                 = errors.New("access denied")
+	errInvalidProfilerType          = errors.New("invalid profiler type")
+	errProfilerTypeNotSet           = errors.New("one of type or types is required")
+	errProfilingCaptureNotCompleted = errors.New("profiling capture is not completed")
+	errNoCPUProfileInCapture        = errors.New("profiling capture has no cpu profile")
+	errDashboardBodyNotInRequest    = errors.New("error dashboard body not in request")
+	errDashboardNameRequired        = errors.New("dashboard name is required")
+	errDashboardAlreadyExists       = errors.New("dashboard already exists")
+	errDashboardUnknownWidget       = errors.New("dashboard references an unknown widget")
+	errDashboardWidgetInUse         = errors.New("widget is used by a dashboard")
+	errBuiltInDashboardWidget       = errors.New("built-in widgets cannot be modified")
+	errPromQLNotSupported           = errors.New("query is not supported by the built-in metrics collector, configure Prometheus to run it")
+	errInvalidWidgetVariable        = errors.New("widget variable names must start with a letter or an underscore and contain only letters, digits and underscores")
+	errAlertBodyNotInRequest        = errors.New("error alert body not in request")
+	errInvalidAlertTarget           = errors.New("invalid alert target")
+	errAlertTargetAlreadyExists     = errors.New("alert target already exists")
+	errAccessKeyFilterRequiresRaw   = errors.New("filtering by access key requires the raw query type")
+	errInvalidLogSearchStatusCode   = errors.New("invalid status code")
+	errInvalidLogSearchInterval     = errors.New("interval must be greater than zero")
+	errRestoreBodyNotInRequest      = errors.New("error restore body not in request")
+	errInvalidRestoreDate           = errors.New("invalid restore date, it must be in RFC3339 format")
+	errReplicationBodyNotInRequest  = errors.New("error replication body not in request")
+	errInvalidResyncOlderThan       = errors.New("invalid olderThan duration, use values such as 12h or 30d")
+	errReplicationTargetNotFound    = errors.New("the replication target of the rule doesn't exist")
+	errRemoteBucketNotVersioned     = errors.New("the remote bucket must have versioning enabled")
+	errInvalidReplicationOrder      = errors.New("the order must list every replication rule once")
+	errSiteReplicationTooFewSites   = errors.New("site replication requires at least two sites including this deployment")
+	errSiteReplicationNoDeployment  = errors.New("the deployment id and the endpoint of the site are required")
+	errSiteReplicationNoSites       = errors.New("no sites to remove")
+	errInvalidLifecycleRule         = errors.New("invalid lifecycle rule")
+	errInvalidLifecycleConfig       = errors.New("invalid lifecycle configuration")
+	errLifecycleRuleNotFound        = errors.New("lifecycle rule not found")
+	errInvalidSoftQuota             = errors.New("the soft quota limit must be positive and the thresholds between 1 and 100")
+	errInvalidBucketPattern         = errors.New("invalid bucket name pattern")
+	errBucketQuotaBulkEmpty         = errors.New("a quota or a soft quota is required")
+	errSoftQuotaNotAllowed          = errors.New("soft quotas require the admin:SetBucketQuota permission")
+	errInvalidKMSKey                = errors.New("invalid KMS key")
+	errKMSKeyInUse                  = errors.New("the KMS key is used by the encryption of some buckets")
+	errKMSKeyUsageNotVerified       = errors.New("the buckets using the KMS key can't be verified, the session can't see every bucket")
+	errGovernanceBypassNotAllowed   = errors.New("bypassing the governance retention requires the s3:BypassGovernanceRetention permission")
+	errInvalidBulkObjectLock        = errors.New("invalid object lock request")
+	errInvalidVersionsCleanup       = errors.New("invalid versions cleanup")
+	errConfigRevisionNotFound       = errors.New("configuration revision not found")
+	errInvalidConfigValue           = errors.New("invalid configuration value")
+	errConfigTargetNotFound         = errors.New("configuration target not found")
+	errServicePreflightFailed       = errors.New("the cluster isn't healthy, use force to proceed anyway")
+	errInvalidUpdateURL             = errors.New("the update URL must be an http or https URL")
+	errInvalidPool                  = errors.New("invalid pool, use the index of the pool")
+	errPoolNotFound                 = errors.New("pool not found")
+	errDecommissionPreflightFailed  = errors.New("the pool can't be decommissioned safely, use force to proceed anyway")
+	errInvalidJob                   = errors.New("invalid job")
+	errJobNotFound                  = errors.New("job not found")
+	errJobRunNotFound               = errors.New("job run not found")
+	errJobRunning                   = errors.New("the job is already running")
+	errJobNotAllowed                = errors.New("the session isn't allowed to manage the job")
+	errJobsCredentialsNotSet        = errors.New("scheduled jobs need the CONSOLE_JOBS_ACCESS_KEY and CONSOLE_JOBS_SECRET_KEY credentials")
+	errBucketAnalyticsBusy          = errors.New("too many bucket analytics listings in progress, try again later")
+	errMonitorCredentialsNotSet     = errors.New("the monitoring needs the CONSOLE_MONITOR_ACCESS_KEY and CONSOLE_MONITOR_SECRET_KEY credentials")
+	errInvalidWebhook               = errors.New("invalid webhook")
+	errWebhookNotFound              = errors.New("webhook not found")
 )
 
 // prepareError receives an error object and parse it against k8sErrors, returns the right error code paired with a generic error message
@@ -112,6 +171,222 @@ func prepareError(err ...error) *models.Error {
 			errorCode = 403
 			errorMessage = errAvoidSelfAccountDelete.Error()
 		}
+		if errors.Is(err[0], errInvalidProfilerType) {
+			errorCode = 400
+			errorMessage = errInvalidProfilerType.Error()
+		}
+		if errors.Is(err[0], errProfilerTypeNotSet) {
+			errorCode = 400
+			errorMessage = errProfilerTypeNotSet.Error()
+		}
+		if errors.Is(err[0], errProfilingCaptureNotCompleted) {
+			errorCode = 400
+			errorMessage = errProfilingCaptureNotCompleted.Error()
+		}
+		if errors.Is(err[0], errNoCPUProfileInCapture) {
+			errorCode = 404
+			errorMessage = errNoCPUProfileInCapture.Error()
+		}
+		if errors.Is(err[0], errDashboardBodyNotInRequest) {
+			errorCode = 400
+			errorMessage = errDashboardBodyNotInRequest.Error()
+		}
+		if errors.Is(err[0], errDashboardNameRequired) {
+			errorCode = 400
+			errorMessage = errDashboardNameRequired.Error()
+		}
+		if errors.Is(err[0], errDashboardAlreadyExists) {
+			errorCode = 400
+			errorMessage = errDashboardAlreadyExists.Error()
+		}
+		if errors.Is(err[0], errDashboardUnknownWidget) {
+			errorCode = 400
+			errorMessage = errDashboardUnknownWidget.Error()
+		}
+		if errors.Is(err[0], errDashboardWidgetInUse) {
+			errorCode = 400
+			errorMessage = errDashboardWidgetInUse.Error()
+		}
+		if errors.Is(err[0], errBuiltInDashboardWidget) {
+			errorCode = 400
+			errorMessage = errBuiltInDashboardWidget.Error()
+		}
+		if errors.Is(err[0], errPromQLNotSupported) {
+			errorCode = 400
+			errorMessage = err[0].Error()
+		}
+		if errors.Is(err[0], errInvalidWidgetVariable) {
+			errorCode = 400
+			errorMessage = errInvalidWidgetVariable.Error()
+		}
+		if errors.Is(err[0], errAlertBodyNotInRequest) {
+			errorCode = 400
+			errorMessage = errAlertBodyNotInRequest.Error()
+		}
+		if errors.Is(err[0], errInvalidAlertTarget) {
+			errorCode = 400
+			errorMessage = errInvalidAlertTarget.Error()
+		}
+		if errors.Is(err[0], errAlertTargetAlreadyExists) {
+			errorCode = 400
+			errorMessage = errAlertTargetAlreadyExists.Error()
+		}
+		if errors.Is(err[0], errAccessKeyFilterRequiresRaw) {
+			errorCode = 400
+			errorMessage = errAccessKeyFilterRequiresRaw.Error()
+		}
+		if errors.Is(err[0], errInvalidLogSearchStatusCode) {
+			errorCode = 400
+			errorMessage = errInvalidLogSearchStatusCode.Error()
+		}
+		if errors.Is(err[0], errInvalidLogSearchInterval) {
+			errorCode = 400
+			errorMessage = errInvalidLogSearchInterval.Error()
+		}
+		if errors.Is(err[0], errRestoreBodyNotInRequest) {
+			errorCode = 400
+			errorMessage = errRestoreBodyNotInRequest.Error()
+		}
+		if errors.Is(err[0], errInvalidRestoreDate) {
+			errorCode = 400
+			errorMessage = errInvalidRestoreDate.Error()
+		}
+		if errors.Is(err[0], errReplicationBodyNotInRequest) {
+			errorCode = 400
+			errorMessage = errReplicationBodyNotInRequest.Error()
+		}
+		if errors.Is(err[0], errInvalidResyncOlderThan) {
+			errorCode = 400
+			errorMessage = errInvalidResyncOlderThan.Error()
+		}
+		if errors.Is(err[0], errReplicationTargetNotFound) {
+			errorCode = 400
+			errorMessage = errReplicationTargetNotFound.Error()
+		}
+		if errors.Is(err[0], errRemoteBucketNotVersioned) {
+			errorCode = 400
+			errorMessage = errRemoteBucketNotVersioned.Error()
+		}
+		if errors.Is(err[0], errInvalidReplicationOrder) {
+			errorCode = 400
+			errorMessage = errInvalidReplicationOrder.Error()
+		}
+		if errors.Is(err[0], errSiteReplicationTooFewSites) {
+			errorCode = 400
+			errorMessage = errSiteReplicationTooFewSites.Error()
+		}
+		if errors.Is(err[0], errSiteReplicationNoDeployment) {
+			errorCode = 400
+			errorMessage = errSiteReplicationNoDeployment.Error()
+		}
+		if errors.Is(err[0], errSiteReplicationNoSites) {
+			errorCode = 400
+			errorMessage = errSiteReplicationNoSites.Error()
+		}
+		if errors.Is(err[0], errInvalidLifecycleRule) || errors.Is(err[0], errInvalidLifecycleConfig) {
+			errorCode = 400
+			errorMessage = err[0].Error()
+		}
+		if errors.Is(err[0], errLifecycleRuleNotFound) {
+			errorCode = 404
+			errorMessage = errLifecycleRuleNotFound.Error()
+		}
+		if errors.Is(err[0], errInvalidSoftQuota) {
+			errorCode = 400
+			errorMessage = errInvalidSoftQuota.Error()
+		}
+		if errors.Is(err[0], errInvalidBucketPattern) {
+			errorCode = 400
+			errorMessage = errInvalidBucketPattern.Error()
+		}
+		if errors.Is(err[0], errBucketQuotaBulkEmpty) {
+			errorCode = 400
+			errorMessage = errBucketQuotaBulkEmpty.Error()
+		}
+		if errors.Is(err[0], errSoftQuotaNotAllowed) {
+			errorCode = 403
+			errorMessage = errSoftQuotaNotAllowed.Error()
+		}
+		if errors.Is(err[0], errInvalidKMSKey) || errors.Is(err[0], errKMSKeyInUse) {
+			errorCode = 400
+			errorMessage = err[0].Error()
+		}
+		if errors.Is(err[0], errKMSKeyUsageNotVerified) {
+			errorCode = 403
+			errorMessage = errKMSKeyUsageNotVerified.Error()
+		}
+		if errors.Is(err[0], errGovernanceBypassNotAllowed) {
+			errorCode = 403
+			errorMessage = errGovernanceBypassNotAllowed.Error()
+		}
+		if errors.Is(err[0], errInvalidBulkObjectLock) || errors.Is(err[0], errInvalidVersionsCleanup) {
+			errorCode = 400
+			errorMessage = err[0].Error()
+		}
+		if errors.Is(err[0], errConfigRevisionNotFound) || errors.Is(err[0], errConfigTargetNotFound) {
+			errorCode = 404
+			errorMessage = err[0].Error()
+		}
+		if errors.Is(err[0], errInvalidConfigValue) {
+			errorCode = 400
+			errorMessage = err[0].Error()
+		}
+		if errors.Is(err[0], errServicePreflightFailed) {
+			errorCode = 409
+			errorMessage = err[0].Error()
+		}
+		if errors.Is(err[0], errInvalidUpdateURL) {
+			errorCode = 400
+			errorMessage = errInvalidUpdateURL.Error()
+		}
+		if errors.Is(err[0], errInvalidPool) {
+			errorCode = 400
+			errorMessage = errInvalidPool.Error()
+		}
+		if errors.Is(err[0], errPoolNotFound) {
+			errorCode = 404
+			errorMessage = errPoolNotFound.Error()
+		}
+		if errors.Is(err[0], errDecommissionPreflightFailed) {
+			errorCode = 409
+			errorMessage = err[0].Error()
+		}
+		if errors.Is(err[0], errInvalidJob) {
+			errorCode = 400
+			errorMessage = err[0].Error()
+		}
+		if errors.Is(err[0], errJobNotFound) {
+			errorCode = 404
+			errorMessage = errJobNotFound.Error()
+		}
+		if errors.Is(err[0], errJobRunNotFound) {
+			errorCode = 404
+			errorMessage = errJobRunNotFound.Error()
+		}
+		if errors.Is(err[0], errJobRunning) {
+			errorCode = 409
+			errorMessage = errJobRunning.Error()
+		}
+		if errors.Is(err[0], errJobNotAllowed) {
+			errorCode = 403
+			errorMessage = err[0].Error()
+		}
+		if errors.Is(err[0], errInvalidWebhook) {
+			errorCode = 400
+			errorMessage = err[0].Error()
+		}
+		if errors.Is(err[0], errWebhookNotFound) {
+			errorCode = 404
+			errorMessage = errWebhookNotFound.Error()
+		}
+		if errors.Is(err[0], errBucketAnalyticsBusy) {
+			errorCode = 429
+			errorMessage = errBucketAnalyticsBusy.Error()
+		}
+		if errors.Is(err[0], errAccessDenied) {
+			errorCode = 403
+			errorMessage = errAccessDenied.Error()
+		}
 		if madmin.ToErrorResponse(err[0]).Code == "AccessDenied" {
 			errorCode = 403
 			errorMessage = errAccessDenied.Error()
@@ -26,11 +26,17 @@ import (
 	"errors"
 	"net/url"
 	golangswaggerpaths "path"
+
+	"github.com/go-openapi/swag"
 )
 
 // RestartServiceURL generates an URL for the restart service operation
 type RestartServiceURL struct {
+	Check *bool
+
 	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
 }
 
 // WithBasePath sets the base path for this url builder, only required when it's different from the
@@ -60,6 +66,18 @@ func (o *RestartServiceURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var checkQ string
+	if o.Check != nil {
+		checkQ = swag.FormatBool(*o.Check)
+	}
+	if checkQ != "" {
+		qs.Set("check", checkQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
@@ -101,33 +101,93 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		UserAPIBucketSetPolicyHandler: user_api.BucketSetPolicyHandlerFunc(func(params user_api.BucketSetPolicyParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.BucketSetPolicy has not yet been implemented")
 		}),
+		UserAPIBulkObjectLockHandler: user_api.BulkObjectLockHandlerFunc(func(params user_api.BulkObjectLockParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.BulkObjectLock has not yet been implemented")
+		}),
 		AdminAPIBulkUpdateUsersGroupsHandler: admin_api.BulkUpdateUsersGroupsHandlerFunc(func(params admin_api.BulkUpdateUsersGroupsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.BulkUpdateUsersGroups has not yet been implemented")
 		}),
+		AdminAPICancelPoolDecommissionHandler: admin_api.CancelPoolDecommissionHandlerFunc(func(params admin_api.CancelPoolDecommissionParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.CancelPoolDecommission has not yet been implemented")
+		}),
+		AdminAPICapacityForecastHandler: admin_api.CapacityForecastHandlerFunc(func(params admin_api.CapacityForecastParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.CapacityForecast has not yet been implemented")
+		}),
 		AdminAPIChangeUserPasswordHandler: admin_api.ChangeUserPasswordHandlerFunc(func(params admin_api.ChangeUserPasswordParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.ChangeUserPassword has not yet been implemented")
 		}),
+		UserAPICleanupBucketVersionsHandler: user_api.CleanupBucketVersionsHandlerFunc(func(params user_api.CleanupBucketVersionsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.CleanupBucketVersions has not yet been implemented")
+		}),
+		AdminAPIClusterTopologyHandler: admin_api.ClusterTopologyHandlerFunc(func(params admin_api.ClusterTopologyParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ClusterTopology has not yet been implemented")
+		}),
 		AdminAPIConfigInfoHandler: admin_api.ConfigInfoHandlerFunc(func(params admin_api.ConfigInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.ConfigInfo has not yet been implemented")
 		}),
+		AdminAPICreateAlertRuleHandler: admin_api.CreateAlertRuleHandlerFunc(func(params admin_api.CreateAlertRuleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.CreateAlertRule has not yet been implemented")
+		}),
+		AdminAPICreateAlertTargetHandler: admin_api.CreateAlertTargetHandlerFunc(func(params admin_api.CreateAlertTargetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.CreateAlertTarget has not yet been implemented")
+		}),
 		UserAPICreateBucketEventHandler: user_api.CreateBucketEventHandlerFunc(func(params user_api.CreateBucketEventParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.CreateBucketEvent has not yet been implemented")
 		}),
+		AdminAPICreateConfigSnapshotHandler: admin_api.CreateConfigSnapshotHandlerFunc(func(params admin_api.CreateConfigSnapshotParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.CreateConfigSnapshot has not yet been implemented")
+		}),
+		AdminAPICreateDashboardHandler: admin_api.CreateDashboardHandlerFunc(func(params admin_api.CreateDashboardParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.CreateDashboard has not yet been implemented")
+		}),
+		AdminAPICreateDashboardWidgetHandler: admin_api.CreateDashboardWidgetHandlerFunc(func(params admin_api.CreateDashboardWidgetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.CreateDashboardWidget has not yet been implemented")
+		}),
+		AdminAPICreateJobHandler: admin_api.CreateJobHandlerFunc(func(params admin_api.CreateJobParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.CreateJob has not yet been implemented")
+		}),
 		UserAPICreateServiceAccountHandler: user_api.CreateServiceAccountHandlerFunc(func(params user_api.CreateServiceAccountParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.CreateServiceAccount has not yet been implemented")
 		}),
+		AdminAPICreateWebhookHandler: admin_api.CreateWebhookHandlerFunc(func(params admin_api.CreateWebhookParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.CreateWebhook has not yet been implemented")
+		}),
 		AdminAPIDashboardWidgetDetailsHandler: admin_api.DashboardWidgetDetailsHandlerFunc(func(params admin_api.DashboardWidgetDetailsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.DashboardWidgetDetails has not yet been implemented")
 		}),
+		AdminAPIDeleteAlertRuleHandler: admin_api.DeleteAlertRuleHandlerFunc(func(params admin_api.DeleteAlertRuleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.DeleteAlertRule has not yet been implemented")
+		}),
+		AdminAPIDeleteAlertTargetHandler: admin_api.DeleteAlertTargetHandlerFunc(func(params admin_api.DeleteAlertTargetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.DeleteAlertTarget has not yet been implemented")
+		}),
 		UserAPIDeleteBucketHandler: user_api.DeleteBucketHandlerFunc(func(params user_api.DeleteBucketParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.DeleteBucket has not yet been implemented")
 		}),
 		UserAPIDeleteBucketEventHandler: user_api.DeleteBucketEventHandlerFunc(func(params user_api.DeleteBucketEventParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.DeleteBucketEvent has not yet been implemented")
 		}),
+		UserAPIDeleteBucketLifecycleRuleHandler: user_api.DeleteBucketLifecycleRuleHandlerFunc(func(params user_api.DeleteBucketLifecycleRuleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.DeleteBucketLifecycleRule has not yet been implemented")
+		}),
 		UserAPIDeleteBucketReplicationRuleHandler: user_api.DeleteBucketReplicationRuleHandlerFunc(func(params user_api.DeleteBucketReplicationRuleParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.DeleteBucketReplicationRule has not yet been implemented")
 		}),
+		UserAPIDeleteBucketSoftQuotaHandler: user_api.DeleteBucketSoftQuotaHandlerFunc(func(params user_api.DeleteBucketSoftQuotaParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.DeleteBucketSoftQuota has not yet been implemented")
+		}),
+		AdminAPIDeleteConfigTargetHandler: admin_api.DeleteConfigTargetHandlerFunc(func(params admin_api.DeleteConfigTargetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.DeleteConfigTarget has not yet been implemented")
+		}),
+		AdminAPIDeleteDashboardHandler: admin_api.DeleteDashboardHandlerFunc(func(params admin_api.DeleteDashboardParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.DeleteDashboard has not yet been implemented")
+		}),
+		AdminAPIDeleteDashboardWidgetHandler: admin_api.DeleteDashboardWidgetHandlerFunc(func(params admin_api.DeleteDashboardWidgetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.DeleteDashboardWidget has not yet been implemented")
+		}),
+		AdminAPIDeleteJobHandler: admin_api.DeleteJobHandlerFunc(func(params admin_api.DeleteJobParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.DeleteJob has not yet been implemented")
+		}),
 		UserAPIDeleteObjectHandler: user_api.DeleteObjectHandlerFunc(func(params user_api.DeleteObjectParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.DeleteObject has not yet been implemented")
 		}),
@@ -140,18 +200,45 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		UserAPIDeleteServiceAccountHandler: user_api.DeleteServiceAccountHandlerFunc(func(params user_api.DeleteServiceAccountParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.DeleteServiceAccount has not yet been implemented")
 		}),
+		AdminAPIDeleteWebhookHandler: admin_api.DeleteWebhookHandlerFunc(func(params admin_api.DeleteWebhookParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.DeleteWebhook has not yet been implemented")
+		}),
+		AdminAPIDiffConfigRevisionsHandler: admin_api.DiffConfigRevisionsHandlerFunc(func(params admin_api.DiffConfigRevisionsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.DiffConfigRevisions has not yet been implemented")
+		}),
 		UserAPIDisableBucketEncryptionHandler: user_api.DisableBucketEncryptionHandlerFunc(func(params user_api.DisableBucketEncryptionParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.DisableBucketEncryption has not yet been implemented")
 		}),
+		AdminAPIDownloadJobRunReportHandler: admin_api.DownloadJobRunReportHandlerFunc(func(params admin_api.DownloadJobRunReportParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.DownloadJobRunReport has not yet been implemented")
+		}),
 		UserAPIDownloadObjectHandler: user_api.DownloadObjectHandlerFunc(func(params user_api.DownloadObjectParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.DownloadObject has not yet been implemented")
 		}),
+		AdminAPIDownloadProfilingCaptureHandler: admin_api.DownloadProfilingCaptureHandlerFunc(func(params admin_api.DownloadProfilingCaptureParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.DownloadProfilingCapture has not yet been implemented")
+		}),
+		AdminAPIDriveStateHistoryHandler: admin_api.DriveStateHistoryHandlerFunc(func(params admin_api.DriveStateHistoryParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.DriveStateHistory has not yet been implemented")
+		}),
 		AdminAPIEditTierCredentialsHandler: admin_api.EditTierCredentialsHandlerFunc(func(params admin_api.EditTierCredentialsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.EditTierCredentials has not yet been implemented")
 		}),
 		UserAPIEnableBucketEncryptionHandler: user_api.EnableBucketEncryptionHandlerFunc(func(params user_api.EnableBucketEncryptionParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.EnableBucketEncryption has not yet been implemented")
 		}),
+		UserAPIExportBucketLifecycleHandler: user_api.ExportBucketLifecycleHandlerFunc(func(params user_api.ExportBucketLifecycleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.ExportBucketLifecycle has not yet been implemented")
+		}),
+		AdminAPIExportConfigHandler: admin_api.ExportConfigHandlerFunc(func(params admin_api.ExportConfigParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ExportConfig has not yet been implemented")
+		}),
+		AdminAPIGetAlertRuleHandler: admin_api.GetAlertRuleHandlerFunc(func(params admin_api.GetAlertRuleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.GetAlertRule has not yet been implemented")
+		}),
+		UserAPIGetBucketAnalyticsHandler: user_api.GetBucketAnalyticsHandlerFunc(func(params user_api.GetBucketAnalyticsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.GetBucketAnalytics has not yet been implemented")
+		}),
 		UserAPIGetBucketEncryptionInfoHandler: user_api.GetBucketEncryptionInfoHandlerFunc(func(params user_api.GetBucketEncryptionInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.GetBucketEncryptionInfo has not yet been implemented")
 		}),
@@ -167,6 +254,9 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		UserAPIGetBucketReplicationHandler: user_api.GetBucketReplicationHandlerFunc(func(params user_api.GetBucketReplicationParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.GetBucketReplication has not yet been implemented")
 		}),
+		UserAPIGetBucketReplicationMetricsHandler: user_api.GetBucketReplicationMetricsHandlerFunc(func(params user_api.GetBucketReplicationMetricsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.GetBucketReplicationMetrics has not yet been implemented")
+		}),
 		UserAPIGetBucketRetentionConfigHandler: user_api.GetBucketRetentionConfigHandlerFunc(func(params user_api.GetBucketRetentionConfigParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.GetBucketRetentionConfig has not yet been implemented")
 		}),
@@ -176,30 +266,99 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		UserAPIGetBucketVersioningHandler: user_api.GetBucketVersioningHandlerFunc(func(params user_api.GetBucketVersioningParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.GetBucketVersioning has not yet been implemented")
 		}),
+		UserAPIGetBucketVersionsStatsHandler: user_api.GetBucketVersionsStatsHandlerFunc(func(params user_api.GetBucketVersionsStatsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.GetBucketVersionsStats has not yet been implemented")
+		}),
+		AdminAPIGetConfigTargetHandler: admin_api.GetConfigTargetHandlerFunc(func(params admin_api.GetConfigTargetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.GetConfigTarget has not yet been implemented")
+		}),
+		AdminAPIGetDashboardHandler: admin_api.GetDashboardHandlerFunc(func(params admin_api.GetDashboardParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.GetDashboard has not yet been implemented")
+		}),
+		AdminAPIGetDashboardWidgetHandler: admin_api.GetDashboardWidgetHandlerFunc(func(params admin_api.GetDashboardWidgetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.GetDashboardWidget has not yet been implemented")
+		}),
+		AdminAPIGetJobHandler: admin_api.GetJobHandlerFunc(func(params admin_api.GetJobParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.GetJob has not yet been implemented")
+		}),
+		AdminAPIGetJobRunHandler: admin_api.GetJobRunHandlerFunc(func(params admin_api.GetJobRunParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.GetJobRun has not yet been implemented")
+		}),
 		AdminAPIGetTierHandler: admin_api.GetTierHandlerFunc(func(params admin_api.GetTierParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.GetTier has not yet been implemented")
 		}),
 		AdminAPIGetUserInfoHandler: admin_api.GetUserInfoHandlerFunc(func(params admin_api.GetUserInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.GetUserInfo has not yet been implemented")
 		}),
+		AdminAPIGetWebhookHandler: admin_api.GetWebhookHandlerFunc(func(params admin_api.GetWebhookParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.GetWebhook has not yet been implemented")
+		}),
 		AdminAPIGroupInfoHandler: admin_api.GroupInfoHandlerFunc(func(params admin_api.GroupInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.GroupInfo has not yet been implemented")
 		}),
 		UserAPIHasPermissionToHandler: user_api.HasPermissionToHandlerFunc(func(params user_api.HasPermissionToParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.HasPermissionTo has not yet been implemented")
 		}),
+		UserAPIImportBucketLifecycleHandler: user_api.ImportBucketLifecycleHandlerFunc(func(params user_api.ImportBucketLifecycleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.ImportBucketLifecycle has not yet been implemented")
+		}),
+		AdminAPIKMSCreateKeyHandler: admin_api.KMSCreateKeyHandlerFunc(func(params admin_api.KMSCreateKeyParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.KMSCreateKey has not yet been implemented")
+		}),
+		AdminAPIKMSDeleteKeyHandler: admin_api.KMSDeleteKeyHandlerFunc(func(params admin_api.KMSDeleteKeyParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.KMSDeleteKey has not yet been implemented")
+		}),
+		AdminAPIKMSImportKeyHandler: admin_api.KMSImportKeyHandlerFunc(func(params admin_api.KMSImportKeyParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.KMSImportKey has not yet been implemented")
+		}),
+		AdminAPIKMSKeyStatusHandler: admin_api.KMSKeyStatusHandlerFunc(func(params admin_api.KMSKeyStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.KMSKeyStatus has not yet been implemented")
+		}),
+		AdminAPIKMSListKeysHandler: admin_api.KMSListKeysHandlerFunc(func(params admin_api.KMSListKeysParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.KMSListKeys has not yet been implemented")
+		}),
+		AdminAPIKMSStatusHandler: admin_api.KMSStatusHandlerFunc(func(params admin_api.KMSStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.KMSStatus has not yet been implemented")
+		}),
 		AdminAPIListAUserServiceAccountsHandler: admin_api.ListAUserServiceAccountsHandlerFunc(func(params admin_api.ListAUserServiceAccountsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.ListAUserServiceAccounts has not yet been implemented")
 		}),
+		AdminAPIListAlertRulesHandler: admin_api.ListAlertRulesHandlerFunc(func(params admin_api.ListAlertRulesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ListAlertRules has not yet been implemented")
+		}),
+		AdminAPIListAlertTargetsHandler: admin_api.ListAlertTargetsHandlerFunc(func(params admin_api.ListAlertTargetsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ListAlertTargets has not yet been implemented")
+		}),
+		AdminAPIListAlertsHandler: admin_api.ListAlertsHandlerFunc(func(params admin_api.ListAlertsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ListAlerts has not yet been implemented")
+		}),
 		UserAPIListBucketEventsHandler: user_api.ListBucketEventsHandlerFunc(func(params user_api.ListBucketEventsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.ListBucketEvents has not yet been implemented")
 		}),
+		UserAPIListBucketQuotasHandler: user_api.ListBucketQuotasHandlerFunc(func(params user_api.ListBucketQuotasParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.ListBucketQuotas has not yet been implemented")
+		}),
+		UserAPIListBucketReplicationFailedHandler: user_api.ListBucketReplicationFailedHandlerFunc(func(params user_api.ListBucketReplicationFailedParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.ListBucketReplicationFailed has not yet been implemented")
+		}),
 		UserAPIListBucketsHandler: user_api.ListBucketsHandlerFunc(func(params user_api.ListBucketsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.ListBuckets has not yet been implemented")
 		}),
 		AdminAPIListConfigHandler: admin_api.ListConfigHandlerFunc(func(params admin_api.ListConfigParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.ListConfig has not yet been implemented")
 		}),
+		AdminAPIListConfigHistoryHandler: admin_api.ListConfigHistoryHandlerFunc(func(params admin_api.ListConfigHistoryParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ListConfigHistory has not yet been implemented")
+		}),
+		AdminAPIListConfigTargetsHandler: admin_api.ListConfigTargetsHandlerFunc(func(params admin_api.ListConfigTargetsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ListConfigTargets has not yet been implemented")
+		}),
+		AdminAPIListDashboardWidgetsHandler: admin_api.ListDashboardWidgetsHandlerFunc(func(params admin_api.ListDashboardWidgetsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ListDashboardWidgets has not yet been implemented")
+		}),
+		AdminAPIListDashboardsHandler: admin_api.ListDashboardsHandlerFunc(func(params admin_api.ListDashboardsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ListDashboards has not yet been implemented")
+		}),
 		UserAPIListExternalBucketsHandler: user_api.ListExternalBucketsHandlerFunc(func(params user_api.ListExternalBucketsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.ListExternalBuckets has not yet been implemented")
 		}),
@@ -209,6 +368,18 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		AdminAPIListGroupsForPolicyHandler: admin_api.ListGroupsForPolicyHandlerFunc(func(params admin_api.ListGroupsForPolicyParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.ListGroupsForPolicy has not yet been implemented")
 		}),
+		AdminAPIListJobRunsHandler: admin_api.ListJobRunsHandlerFunc(func(params admin_api.ListJobRunsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ListJobRuns has not yet been implemented")
+		}),
+		AdminAPIListJobsHandler: admin_api.ListJobsHandlerFunc(func(params admin_api.ListJobsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ListJobs has not yet been implemented")
+		}),
+		UserAPIListLockedObjectsHandler: user_api.ListLockedObjectsHandlerFunc(func(params user_api.ListLockedObjectsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.ListLockedObjects has not yet been implemented")
+		}),
+		AdminAPIListNodesHandler: admin_api.ListNodesHandlerFunc(func(params admin_api.ListNodesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ListNodes has not yet been implemented")
+		}),
 		UserAPIListObjectsHandler: user_api.ListObjectsHandlerFunc(func(params user_api.ListObjectsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.ListObjects has not yet been implemented")
 		}),
@@ -218,6 +389,12 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		AdminAPIListPoliciesWithBucketHandler: admin_api.ListPoliciesWithBucketHandlerFunc(func(params admin_api.ListPoliciesWithBucketParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.ListPoliciesWithBucket has not yet been implemented")
 		}),
+		AdminAPIListPoolsStatusHandler: admin_api.ListPoolsStatusHandlerFunc(func(params admin_api.ListPoolsStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ListPoolsStatus has not yet been implemented")
+		}),
+		AdminAPIListProfilingCapturesHandler: admin_api.ListProfilingCapturesHandlerFunc(func(params admin_api.ListProfilingCapturesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ListProfilingCaptures has not yet been implemented")
+		}),
 		UserAPIListRemoteBucketsHandler: user_api.ListRemoteBucketsHandlerFunc(func(params user_api.ListRemoteBucketsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.ListRemoteBuckets has not yet been implemented")
 		}),
@@ -233,9 +410,18 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		AdminAPIListUsersWithAccessToBucketHandler: admin_api.ListUsersWithAccessToBucketHandlerFunc(func(params admin_api.ListUsersWithAccessToBucketParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.ListUsersWithAccessToBucket has not yet been implemented")
 		}),
+		AdminAPIListWebhookDeliveriesHandler: admin_api.ListWebhookDeliveriesHandlerFunc(func(params admin_api.ListWebhookDeliveriesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ListWebhookDeliveries has not yet been implemented")
+		}),
+		AdminAPIListWebhooksHandler: admin_api.ListWebhooksHandlerFunc(func(params admin_api.ListWebhooksParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ListWebhooks has not yet been implemented")
+		}),
 		UserAPILogSearchHandler: user_api.LogSearchHandlerFunc(func(params user_api.LogSearchParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.LogSearch has not yet been implemented")
 		}),
+		UserAPILogSearchExportHandler: user_api.LogSearchExportHandlerFunc(func(params user_api.LogSearchExportParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.LogSearchExport has not yet been implemented")
+		}),
 		UserAPILoginHandler: user_api.LoginHandlerFunc(func(params user_api.LoginParams) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.Login has not yet been implemented")
 		}),
@@ -254,15 +440,30 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		UserAPIMakeBucketHandler: user_api.MakeBucketHandlerFunc(func(params user_api.MakeBucketParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.MakeBucket has not yet been implemented")
 		}),
+		AdminAPINodeInfoHandler: admin_api.NodeInfoHandlerFunc(func(params admin_api.NodeInfoParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.NodeInfo has not yet been implemented")
+		}),
 		AdminAPINotificationEndpointListHandler: admin_api.NotificationEndpointListHandlerFunc(func(params admin_api.NotificationEndpointListParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.NotificationEndpointList has not yet been implemented")
 		}),
+		AdminAPIPauseJobHandler: admin_api.PauseJobHandlerFunc(func(params admin_api.PauseJobParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.PauseJob has not yet been implemented")
+		}),
 		AdminAPIPolicyInfoHandler: admin_api.PolicyInfoHandlerFunc(func(params admin_api.PolicyInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.PolicyInfo has not yet been implemented")
 		}),
+		AdminAPIPoolDecommissionStatusHandler: admin_api.PoolDecommissionStatusHandlerFunc(func(params admin_api.PoolDecommissionStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.PoolDecommissionStatus has not yet been implemented")
+		}),
 		UserAPIPostBucketsBucketNameObjectsUploadHandler: user_api.PostBucketsBucketNameObjectsUploadHandlerFunc(func(params user_api.PostBucketsBucketNameObjectsUploadParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.PostBucketsBucketNameObjectsUpload has not yet been implemented")
 		}),
+		UserAPIPreviewBucketLifecycleHandler: user_api.PreviewBucketLifecycleHandlerFunc(func(params user_api.PreviewBucketLifecycleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.PreviewBucketLifecycle has not yet been implemented")
+		}),
+		AdminAPIProfilingCaptureSummaryHandler: admin_api.ProfilingCaptureSummaryHandlerFunc(func(params admin_api.ProfilingCaptureSummaryParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ProfilingCaptureSummary has not yet been implemented")
+		}),
 		AdminAPIProfilingStartHandler: admin_api.ProfilingStartHandlerFunc(func(params admin_api.ProfilingStartParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.ProfilingStart has not yet been implemented")
 		}),
@@ -278,6 +479,9 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		UserAPIPutObjectTagsHandler: user_api.PutObjectTagsHandlerFunc(func(params user_api.PutObjectTagsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.PutObjectTags has not yet been implemented")
 		}),
+		AdminAPIRebalanceStatusHandler: admin_api.RebalanceStatusHandlerFunc(func(params admin_api.RebalanceStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.RebalanceStatus has not yet been implemented")
+		}),
 		UserAPIRemoteBucketDetailsHandler: user_api.RemoteBucketDetailsHandlerFunc(func(params user_api.RemoteBucketDetailsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.RemoteBucketDetails has not yet been implemented")
 		}),
@@ -290,24 +494,63 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		AdminAPIRemoveUserHandler: admin_api.RemoveUserHandlerFunc(func(params admin_api.RemoveUserParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.RemoveUser has not yet been implemented")
 		}),
+		AdminAPIResetConfigHandler: admin_api.ResetConfigHandlerFunc(func(params admin_api.ResetConfigParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ResetConfig has not yet been implemented")
+		}),
 		AdminAPIRestartServiceHandler: admin_api.RestartServiceHandlerFunc(func(params admin_api.RestartServiceParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.RestartService has not yet been implemented")
 		}),
+		UserAPIRestoreBucketHandler: user_api.RestoreBucketHandlerFunc(func(params user_api.RestoreBucketParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.RestoreBucket has not yet been implemented")
+		}),
+		AdminAPIRestoreConfigRevisionHandler: admin_api.RestoreConfigRevisionHandlerFunc(func(params admin_api.RestoreConfigRevisionParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.RestoreConfigRevision has not yet been implemented")
+		}),
+		AdminAPIResumeJobHandler: admin_api.ResumeJobHandlerFunc(func(params admin_api.ResumeJobParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ResumeJob has not yet been implemented")
+		}),
+		UserAPIResyncBucketReplicationHandler: user_api.ResyncBucketReplicationHandlerFunc(func(params user_api.ResyncBucketReplicationParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.ResyncBucketReplication has not yet been implemented")
+		}),
+		UserAPIRetryBucketReplicationHandler: user_api.RetryBucketReplicationHandlerFunc(func(params user_api.RetryBucketReplicationParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.RetryBucketReplication has not yet been implemented")
+		}),
+		AdminAPIRunJobHandler: admin_api.RunJobHandlerFunc(func(params admin_api.RunJobParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.RunJob has not yet been implemented")
+		}),
+		AdminAPIServiceStatusHandler: admin_api.ServiceStatusHandlerFunc(func(params admin_api.ServiceStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ServiceStatus has not yet been implemented")
+		}),
 		UserAPISessionCheckHandler: user_api.SessionCheckHandlerFunc(func(params user_api.SessionCheckParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.SessionCheck has not yet been implemented")
 		}),
 		UserAPISetBucketQuotaHandler: user_api.SetBucketQuotaHandlerFunc(func(params user_api.SetBucketQuotaParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.SetBucketQuota has not yet been implemented")
 		}),
+		UserAPISetBucketQuotasHandler: user_api.SetBucketQuotasHandlerFunc(func(params user_api.SetBucketQuotasParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.SetBucketQuotas has not yet been implemented")
+		}),
+		UserAPISetBucketReplicationRuleStatusHandler: user_api.SetBucketReplicationRuleStatusHandlerFunc(func(params user_api.SetBucketReplicationRuleStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.SetBucketReplicationRuleStatus has not yet been implemented")
+		}),
+		UserAPISetBucketReplicationRulesOrderHandler: user_api.SetBucketReplicationRulesOrderHandlerFunc(func(params user_api.SetBucketReplicationRulesOrderParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.SetBucketReplicationRulesOrder has not yet been implemented")
+		}),
 		UserAPISetBucketRetentionConfigHandler: user_api.SetBucketRetentionConfigHandlerFunc(func(params user_api.SetBucketRetentionConfigParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.SetBucketRetentionConfig has not yet been implemented")
 		}),
+		UserAPISetBucketSoftQuotaHandler: user_api.SetBucketSoftQuotaHandlerFunc(func(params user_api.SetBucketSoftQuotaParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.SetBucketSoftQuota has not yet been implemented")
+		}),
 		UserAPISetBucketVersioningHandler: user_api.SetBucketVersioningHandlerFunc(func(params user_api.SetBucketVersioningParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.SetBucketVersioning has not yet been implemented")
 		}),
 		AdminAPISetConfigHandler: admin_api.SetConfigHandlerFunc(func(params admin_api.SetConfigParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.SetConfig has not yet been implemented")
 		}),
+		AdminAPISetConfigTargetHandler: admin_api.SetConfigTargetHandlerFunc(func(params admin_api.SetConfigTargetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.SetConfigTarget has not yet been implemented")
+		}),
 		UserAPISetMultiBucketReplicationHandler: user_api.SetMultiBucketReplicationHandlerFunc(func(params user_api.SetMultiBucketReplicationParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.SetMultiBucketReplication has not yet been implemented")
 		}),
@@ -320,24 +563,69 @@ func NewConsoleAPI(spec *loads.Document) *ConsoleAPI {
 		UserAPIShareObjectHandler: user_api.ShareObjectHandlerFunc(func(params user_api.ShareObjectParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.ShareObject has not yet been implemented")
 		}),
+		AdminAPISiteReplicationAddHandler: admin_api.SiteReplicationAddHandlerFunc(func(params admin_api.SiteReplicationAddParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.SiteReplicationAdd has not yet been implemented")
+		}),
+		AdminAPISiteReplicationEditHandler: admin_api.SiteReplicationEditHandlerFunc(func(params admin_api.SiteReplicationEditParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.SiteReplicationEdit has not yet been implemented")
+		}),
+		AdminAPISiteReplicationInfoHandler: admin_api.SiteReplicationInfoHandlerFunc(func(params admin_api.SiteReplicationInfoParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.SiteReplicationInfo has not yet been implemented")
+		}),
+		AdminAPISiteReplicationRemoveHandler: admin_api.SiteReplicationRemoveHandlerFunc(func(params admin_api.SiteReplicationRemoveParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.SiteReplicationRemove has not yet been implemented")
+		}),
+		AdminAPISiteReplicationStatusHandler: admin_api.SiteReplicationStatusHandlerFunc(func(params admin_api.SiteReplicationStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.SiteReplicationStatus has not yet been implemented")
+		}),
+		AdminAPIStartPoolDecommissionHandler: admin_api.StartPoolDecommissionHandlerFunc(func(params admin_api.StartPoolDecommissionParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.StartPoolDecommission has not yet been implemented")
+		}),
+		AdminAPIStopServiceHandler: admin_api.StopServiceHandlerFunc(func(params admin_api.StopServiceParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.StopService has not yet been implemented")
+		}),
 		AdminAPISubscriptionInfoHandler: admin_api.SubscriptionInfoHandlerFunc(func(params admin_api.SubscriptionInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.SubscriptionInfo has not yet been implemented")
 		}),
+		AdminAPITestWebhookHandler: admin_api.TestWebhookHandlerFunc(func(params admin_api.TestWebhookParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.TestWebhook has not yet been implemented")
+		}),
 		AdminAPITiersListHandler: admin_api.TiersListHandlerFunc(func(params admin_api.TiersListParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.TiersList has not yet been implemented")
 		}),
+		AdminAPIUpdateAlertRuleHandler: admin_api.UpdateAlertRuleHandlerFunc(func(params admin_api.UpdateAlertRuleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.UpdateAlertRule has not yet been implemented")
+		}),
+		AdminAPIUpdateAlertTargetHandler: admin_api.UpdateAlertTargetHandlerFunc(func(params admin_api.UpdateAlertTargetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.UpdateAlertTarget has not yet been implemented")
+		}),
 		UserAPIUpdateBucketLifecycleHandler: user_api.UpdateBucketLifecycleHandlerFunc(func(params user_api.UpdateBucketLifecycleParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation user_api.UpdateBucketLifecycle has not yet been implemented")
 		}),
+		UserAPIUpdateBucketReplicationRuleHandler: user_api.UpdateBucketReplicationRuleHandlerFunc(func(params user_api.UpdateBucketReplicationRuleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation user_api.UpdateBucketReplicationRule has not yet been implemented")
+		}),
+		AdminAPIUpdateDashboardHandler: admin_api.UpdateDashboardHandlerFunc(func(params admin_api.UpdateDashboardParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.UpdateDashboard has not yet been implemented")
+		}),
+		AdminAPIUpdateDashboardWidgetHandler: admin_api.UpdateDashboardWidgetHandlerFunc(func(params admin_api.UpdateDashboardWidgetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.UpdateDashboardWidget has not yet been implemented")
+		}),
 		AdminAPIUpdateGroupHandler: admin_api.UpdateGroupHandlerFunc(func(params admin_api.UpdateGroupParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.UpdateGroup has not yet been implemented")
 		}),
+		AdminAPIUpdateServerHandler: admin_api.UpdateServerHandlerFunc(func(params admin_api.UpdateServerParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.UpdateServer has not yet been implemented")
+		}),
 		AdminAPIUpdateUserGroupsHandler: admin_api.UpdateUserGroupsHandlerFunc(func(params admin_api.UpdateUserGroupsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.UpdateUserGroups has not yet been implemented")
 		}),
 		AdminAPIUpdateUserInfoHandler: admin_api.UpdateUserInfoHandlerFunc(func(params admin_api.UpdateUserInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.UpdateUserInfo has not yet been implemented")
 		}),
+		AdminAPIUpdateWebhookHandler: admin_api.UpdateWebhookHandlerFunc(func(params admin_api.UpdateWebhookParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.UpdateWebhook has not yet been implemented")
+		}),
 
 		KeyAuth: func(token string, scopes []string) (*models.Principal, error) {
 			return nil, errors.NotImplemented("oauth2 bearer auth (key) has not yet been implemented")
@@ -417,24 +705,64 @@ type ConsoleAPI struct {
 	UserAPIBucketInfoHandler user_api.BucketInfoHandler
 	// UserAPIBucketSetPolicyHandler sets the operation handler for the bucket set policy operation
 	UserAPIBucketSetPolicyHandler user_api.BucketSetPolicyHandler
+	// UserAPIBulkObjectLockHandler sets the operation handler for the bulk object lock operation
+	UserAPIBulkObjectLockHandler user_api.BulkObjectLockHandler
 	// AdminAPIBulkUpdateUsersGroupsHandler sets the operation handler for the bulk update users groups operation
 	AdminAPIBulkUpdateUsersGroupsHandler admin_api.BulkUpdateUsersGroupsHandler
+	// AdminAPICancelPoolDecommissionHandler sets the operation handler for the cancel pool decommission operation
+	AdminAPICancelPoolDecommissionHandler admin_api.CancelPoolDecommissionHandler
+	// AdminAPICapacityForecastHandler sets the operation handler for the capacity forecast operation
+	AdminAPICapacityForecastHandler admin_api.CapacityForecastHandler
 	// AdminAPIChangeUserPasswordHandler sets the operation handler for the change user password operation
 	AdminAPIChangeUserPasswordHandler admin_api.ChangeUserPasswordHandler
+	// UserAPICleanupBucketVersionsHandler sets the operation handler for the cleanup bucket versions operation
+	UserAPICleanupBucketVersionsHandler user_api.CleanupBucketVersionsHandler
+	// AdminAPIClusterTopologyHandler sets the operation handler for the cluster topology operation
+	AdminAPIClusterTopologyHandler admin_api.ClusterTopologyHandler
 	// AdminAPIConfigInfoHandler sets the operation handler for the config info operation
 	AdminAPIConfigInfoHandler admin_api.ConfigInfoHandler
+	// AdminAPICreateAlertRuleHandler sets the operation handler for the create alert rule operation
+	AdminAPICreateAlertRuleHandler admin_api.CreateAlertRuleHandler
+	// AdminAPICreateAlertTargetHandler sets the operation handler for the create alert target operation
+	AdminAPICreateAlertTargetHandler admin_api.CreateAlertTargetHandler
 	// UserAPICreateBucketEventHandler sets the operation handler for the create bucket event operation
 	UserAPICreateBucketEventHandler user_api.CreateBucketEventHandler
+	// AdminAPICreateConfigSnapshotHandler sets the operation handler for the create config snapshot operation
+	AdminAPICreateConfigSnapshotHandler admin_api.CreateConfigSnapshotHandler
+	// AdminAPICreateDashboardHandler sets the operation handler for the create dashboard operation
+	AdminAPICreateDashboardHandler admin_api.CreateDashboardHandler
+	// AdminAPICreateDashboardWidgetHandler sets the operation handler for the create dashboard widget operation
+	AdminAPICreateDashboardWidgetHandler admin_api.CreateDashboardWidgetHandler
+	// AdminAPICreateJobHandler sets the operation handler for the create job operation
+	AdminAPICreateJobHandler admin_api.CreateJobHandler
 	// UserAPICreateServiceAccountHandler sets the operation handler for the create service account operation
 	UserAPICreateServiceAccountHandler user_api.CreateServiceAccountHandler
+	// AdminAPICreateWebhookHandler sets the operation handler for the create webhook operation
+	AdminAPICreateWebhookHandler admin_api.CreateWebhookHandler
 	// AdminAPIDashboardWidgetDetailsHandler sets the operation handler for the dashboard widget details operation
 	AdminAPIDashboardWidgetDetailsHandler admin_api.DashboardWidgetDetailsHandler
+	// AdminAPIDeleteAlertRuleHandler sets the operation handler for the delete alert rule operation
+	AdminAPIDeleteAlertRuleHandler admin_api.DeleteAlertRuleHandler
+	// AdminAPIDeleteAlertTargetHandler sets the operation handler for the delete alert target operation
+	AdminAPIDeleteAlertTargetHandler admin_api.DeleteAlertTargetHandler
 	// UserAPIDeleteBucketHandler sets the operation handler for the delete bucket operation
 	UserAPIDeleteBucketHandler user_api.DeleteBucketHandler
 	// UserAPIDeleteBucketEventHandler sets the operation handler for the delete bucket event operation
 	UserAPIDeleteBucketEventHandler user_api.DeleteBucketEventHandler
+	// UserAPIDeleteBucketLifecycleRuleHandler sets the operation handler for the delete bucket lifecycle rule operation
+	UserAPIDeleteBucketLifecycleRuleHandler user_api.DeleteBucketLifecycleRuleHandler
 	// UserAPIDeleteBucketReplicationRuleHandler sets the operation handler for the delete bucket replication rule operation
 	UserAPIDeleteBucketReplicationRuleHandler user_api.DeleteBucketReplicationRuleHandler
+	// UserAPIDeleteBucketSoftQuotaHandler sets the operation handler for the delete bucket soft quota operation
+	UserAPIDeleteBucketSoftQuotaHandler user_api.DeleteBucketSoftQuotaHandler
+	// AdminAPIDeleteConfigTargetHandler sets the operation handler for the delete config target operation
+	AdminAPIDeleteConfigTargetHandler admin_api.DeleteConfigTargetHandler
+	// AdminAPIDeleteDashboardHandler sets the operation handler for the delete dashboard operation
+	AdminAPIDeleteDashboardHandler admin_api.DeleteDashboardHandler
+	// AdminAPIDeleteDashboardWidgetHandler sets the operation handler for the delete dashboard widget operation
+	AdminAPIDeleteDashboardWidgetHandler admin_api.DeleteDashboardWidgetHandler
+	// AdminAPIDeleteJobHandler sets the operation handler for the delete job operation
+	AdminAPIDeleteJobHandler admin_api.DeleteJobHandler
 	// UserAPIDeleteObjectHandler sets the operation handler for the delete object operation
 	UserAPIDeleteObjectHandler user_api.DeleteObjectHandler
 	// UserAPIDeleteObjectRetentionHandler sets the operation handler for the delete object retention operation
@@ -443,14 +771,32 @@ type ConsoleAPI struct {
 	UserAPIDeleteRemoteBucketHandler user_api.DeleteRemoteBucketHandler
 	// UserAPIDeleteServiceAccountHandler sets the operation handler for the delete service account operation
 	UserAPIDeleteServiceAccountHandler user_api.DeleteServiceAccountHandler
+	// AdminAPIDeleteWebhookHandler sets the operation handler for the delete webhook operation
+	AdminAPIDeleteWebhookHandler admin_api.DeleteWebhookHandler
+	// AdminAPIDiffConfigRevisionsHandler sets the operation handler for the diff config revisions operation
+	AdminAPIDiffConfigRevisionsHandler admin_api.DiffConfigRevisionsHandler
 	// UserAPIDisableBucketEncryptionHandler sets the operation handler for the disable bucket encryption operation
 	UserAPIDisableBucketEncryptionHandler user_api.DisableBucketEncryptionHandler
+	// AdminAPIDownloadJobRunReportHandler sets the operation handler for the download job run report operation
+	AdminAPIDownloadJobRunReportHandler admin_api.DownloadJobRunReportHandler
 	// UserAPIDownloadObjectHandler sets the operation handler for the download object operation
 	UserAPIDownloadObjectHandler user_api.DownloadObjectHandler
+	// AdminAPIDownloadProfilingCaptureHandler sets the operation handler for the download profiling capture operation
+	AdminAPIDownloadProfilingCaptureHandler admin_api.DownloadProfilingCaptureHandler
+	// AdminAPIDriveStateHistoryHandler sets the operation handler for the drive state history operation
+	AdminAPIDriveStateHistoryHandler admin_api.DriveStateHistoryHandler
 	// AdminAPIEditTierCredentialsHandler sets the operation handler for the edit tier credentials operation
 	AdminAPIEditTierCredentialsHandler admin_api.EditTierCredentialsHandler
 	// UserAPIEnableBucketEncryptionHandler sets the operation handler for the enable bucket encryption operation
 	UserAPIEnableBucketEncryptionHandler user_api.EnableBucketEncryptionHandler
+	// UserAPIExportBucketLifecycleHandler sets the operation handler for the export bucket lifecycle operation
+	UserAPIExportBucketLifecycleHandler user_api.ExportBucketLifecycleHandler
+	// AdminAPIExportConfigHandler sets the operation handler for the export config operation
+	AdminAPIExportConfigHandler admin_api.ExportConfigHandler
+	// AdminAPIGetAlertRuleHandler sets the operation handler for the get alert rule operation
+	AdminAPIGetAlertRuleHandler admin_api.GetAlertRuleHandler
+	// UserAPIGetBucketAnalyticsHandler sets the operation handler for the get bucket analytics operation
+	UserAPIGetBucketAnalyticsHandler user_api.GetBucketAnalyticsHandler
 	// UserAPIGetBucketEncryptionInfoHandler sets the operation handler for the get bucket encryption info operation
 	UserAPIGetBucketEncryptionInfoHandler user_api.GetBucketEncryptionInfoHandler
 	// UserAPIGetBucketLifecycleHandler sets the operation handler for the get bucket lifecycle operation
@@ -461,40 +807,100 @@ type ConsoleAPI struct {
 	UserAPIGetBucketQuotaHandler user_api.GetBucketQuotaHandler
 	// UserAPIGetBucketReplicationHandler sets the operation handler for the get bucket replication operation
 	UserAPIGetBucketReplicationHandler user_api.GetBucketReplicationHandler
+	// UserAPIGetBucketReplicationMetricsHandler sets the operation handler for the get bucket replication metrics operation
+	UserAPIGetBucketReplicationMetricsHandler user_api.GetBucketReplicationMetricsHandler
 	// UserAPIGetBucketRetentionConfigHandler sets the operation handler for the get bucket retention config operation
 	UserAPIGetBucketRetentionConfigHandler user_api.GetBucketRetentionConfigHandler
 	// UserAPIGetBucketRewindHandler sets the operation handler for the get bucket rewind operation
 	UserAPIGetBucketRewindHandler user_api.GetBucketRewindHandler
 	// UserAPIGetBucketVersioningHandler sets the operation handler for the get bucket versioning operation
 	UserAPIGetBucketVersioningHandler user_api.GetBucketVersioningHandler
+	// UserAPIGetBucketVersionsStatsHandler sets the operation handler for the get bucket versions stats operation
+	UserAPIGetBucketVersionsStatsHandler user_api.GetBucketVersionsStatsHandler
+	// AdminAPIGetConfigTargetHandler sets the operation handler for the get config target operation
+	AdminAPIGetConfigTargetHandler admin_api.GetConfigTargetHandler
+	// AdminAPIGetDashboardHandler sets the operation handler for the get dashboard operation
+	AdminAPIGetDashboardHandler admin_api.GetDashboardHandler
+	// AdminAPIGetDashboardWidgetHandler sets the operation handler for the get dashboard widget operation
+	AdminAPIGetDashboardWidgetHandler admin_api.GetDashboardWidgetHandler
+	// AdminAPIGetJobHandler sets the operation handler for the get job operation
+	AdminAPIGetJobHandler admin_api.GetJobHandler
+	// AdminAPIGetJobRunHandler sets the operation handler for the get job run operation
+	AdminAPIGetJobRunHandler admin_api.GetJobRunHandler
 	// AdminAPIGetTierHandler sets the operation handler for the get tier operation
 	AdminAPIGetTierHandler admin_api.GetTierHandler
 	// AdminAPIGetUserInfoHandler sets the operation handler for the get user info operation
 	AdminAPIGetUserInfoHandler admin_api.GetUserInfoHandler
+	// AdminAPIGetWebhookHandler sets the operation handler for the get webhook operation
+	AdminAPIGetWebhookHandler admin_api.GetWebhookHandler
 	// AdminAPIGroupInfoHandler sets the operation handler for the group info operation
 	AdminAPIGroupInfoHandler admin_api.GroupInfoHandler
 	// UserAPIHasPermissionToHandler sets the operation handler for the has permission to operation
 	UserAPIHasPermissionToHandler user_api.HasPermissionToHandler
+	// UserAPIImportBucketLifecycleHandler sets the operation handler for the import bucket lifecycle operation
+	UserAPIImportBucketLifecycleHandler user_api.ImportBucketLifecycleHandler
+	// AdminAPIKMSCreateKeyHandler sets the operation handler for the k m s create key operation
+	AdminAPIKMSCreateKeyHandler admin_api.KMSCreateKeyHandler
+	// AdminAPIKMSDeleteKeyHandler sets the operation handler for the k m s delete key operation
+	AdminAPIKMSDeleteKeyHandler admin_api.KMSDeleteKeyHandler
+	// AdminAPIKMSImportKeyHandler sets the operation handler for the k m s import key operation
+	AdminAPIKMSImportKeyHandler admin_api.KMSImportKeyHandler
+	// AdminAPIKMSKeyStatusHandler sets the operation handler for the k m s key status operation
+	AdminAPIKMSKeyStatusHandler admin_api.KMSKeyStatusHandler
+	// AdminAPIKMSListKeysHandler sets the operation handler for the k m s list keys operation
+	AdminAPIKMSListKeysHandler admin_api.KMSListKeysHandler
+	// AdminAPIKMSStatusHandler sets the operation handler for the k m s status operation
+	AdminAPIKMSStatusHandler admin_api.KMSStatusHandler
 	// AdminAPIListAUserServiceAccountsHandler sets the operation handler for the list a user service accounts operation
 	AdminAPIListAUserServiceAccountsHandler admin_api.ListAUserServiceAccountsHandler
+	// AdminAPIListAlertRulesHandler sets the operation handler for the list alert rules operation
+	AdminAPIListAlertRulesHandler admin_api.ListAlertRulesHandler
+	// AdminAPIListAlertTargetsHandler sets the operation handler for the list alert targets operation
+	AdminAPIListAlertTargetsHandler admin_api.ListAlertTargetsHandler
+	// AdminAPIListAlertsHandler sets the operation handler for the list alerts operation
+	AdminAPIListAlertsHandler admin_api.ListAlertsHandler
 	// UserAPIListBucketEventsHandler sets the operation handler for the list bucket events operation
 	UserAPIListBucketEventsHandler user_api.ListBucketEventsHandler
+	// UserAPIListBucketQuotasHandler sets the operation handler for the list bucket quotas operation
+	UserAPIListBucketQuotasHandler user_api.ListBucketQuotasHandler
+	// UserAPIListBucketReplicationFailedHandler sets the operation handler for the list bucket replication failed operation
+	UserAPIListBucketReplicationFailedHandler user_api.ListBucketReplicationFailedHandler
 	// UserAPIListBucketsHandler sets the operation handler for the list buckets operation
 	UserAPIListBucketsHandler user_api.ListBucketsHandler
 	// AdminAPIListConfigHandler sets the operation handler for the list config operation
 	AdminAPIListConfigHandler admin_api.ListConfigHandler
+	// AdminAPIListConfigHistoryHandler sets the operation handler for the list config history operation
+	AdminAPIListConfigHistoryHandler admin_api.ListConfigHistoryHandler
+	// AdminAPIListConfigTargetsHandler sets the operation handler for the list config targets operation
+	AdminAPIListConfigTargetsHandler admin_api.ListConfigTargetsHandler
+	// AdminAPIListDashboardWidgetsHandler sets the operation handler for the list dashboard widgets operation
+	AdminAPIListDashboardWidgetsHandler admin_api.ListDashboardWidgetsHandler
+	// AdminAPIListDashboardsHandler sets the operation handler for the list dashboards operation
+	AdminAPIListDashboardsHandler admin_api.ListDashboardsHandler
 	// UserAPIListExternalBucketsHandler sets the operation handler for the list external buckets operation
 	UserAPIListExternalBucketsHandler user_api.ListExternalBucketsHandler
 	// AdminAPIListGroupsHandler sets the operation handler for the list groups operation
 	AdminAPIListGroupsHandler admin_api.ListGroupsHandler
 	// AdminAPIListGroupsForPolicyHandler sets the operation handler for the list groups for policy operation
 	AdminAPIListGroupsForPolicyHandler admin_api.ListGroupsForPolicyHandler
+	// AdminAPIListJobRunsHandler sets the operation handler for the list job runs operation
+	AdminAPIListJobRunsHandler admin_api.ListJobRunsHandler
+	// AdminAPIListJobsHandler sets the operation handler for the list jobs operation
+	AdminAPIListJobsHandler admin_api.ListJobsHandler
+	// UserAPIListLockedObjectsHandler sets the operation handler for the list locked objects operation
+	UserAPIListLockedObjectsHandler user_api.ListLockedObjectsHandler
+	// AdminAPIListNodesHandler sets the operation handler for the list nodes operation
+	AdminAPIListNodesHandler admin_api.ListNodesHandler
 	// UserAPIListObjectsHandler sets the operation handler for the list objects operation
 	UserAPIListObjectsHandler user_api.ListObjectsHandler
 	// AdminAPIListPoliciesHandler sets the operation handler for the list policies operation
 	AdminAPIListPoliciesHandler admin_api.ListPoliciesHandler
 	// AdminAPIListPoliciesWithBucketHandler sets the operation handler for the list policies with bucket operation
 	AdminAPIListPoliciesWithBucketHandler admin_api.ListPoliciesWithBucketHandler
+	// AdminAPIListPoolsStatusHandler sets the operation handler for the list pools status operation
+	AdminAPIListPoolsStatusHandler admin_api.ListPoolsStatusHandler
+	// AdminAPIListProfilingCapturesHandler sets the operation handler for the list profiling captures operation
+	AdminAPIListProfilingCapturesHandler admin_api.ListProfilingCapturesHandler
 	// UserAPIListRemoteBucketsHandler sets the operation handler for the list remote buckets operation
 	UserAPIListRemoteBucketsHandler user_api.ListRemoteBucketsHandler
 	// UserAPIListUserServiceAccountsHandler sets the operation handler for the list user service accounts operation
@@ -505,8 +911,14 @@ type ConsoleAPI struct {
 	AdminAPIListUsersForPolicyHandler admin_api.ListUsersForPolicyHandler
 	// AdminAPIListUsersWithAccessToBucketHandler sets the operation handler for the list users with access to bucket operation
 	AdminAPIListUsersWithAccessToBucketHandler admin_api.ListUsersWithAccessToBucketHandler
+	// AdminAPIListWebhookDeliveriesHandler sets the operation handler for the list webhook deliveries operation
+	AdminAPIListWebhookDeliveriesHandler admin_api.ListWebhookDeliveriesHandler
+	// AdminAPIListWebhooksHandler sets the operation handler for the list webhooks operation
+	AdminAPIListWebhooksHandler admin_api.ListWebhooksHandler
 	// UserAPILogSearchHandler sets the operation handler for the log search operation
 	UserAPILogSearchHandler user_api.LogSearchHandler
+	// UserAPILogSearchExportHandler sets the operation handler for the log search export operation
+	UserAPILogSearchExportHandler user_api.LogSearchExportHandler
 	// UserAPILoginHandler sets the operation handler for the login operation
 	UserAPILoginHandler user_api.LoginHandler
 	// UserAPILoginDetailHandler sets the operation handler for the login detail operation
@@ -519,12 +931,22 @@ type ConsoleAPI struct {
 	UserAPILogoutHandler user_api.LogoutHandler
 	// UserAPIMakeBucketHandler sets the operation handler for the make bucket operation
 	UserAPIMakeBucketHandler user_api.MakeBucketHandler
+	// AdminAPINodeInfoHandler sets the operation handler for the node info operation
+	AdminAPINodeInfoHandler admin_api.NodeInfoHandler
 	// AdminAPINotificationEndpointListHandler sets the operation handler for the notification endpoint list operation
 	AdminAPINotificationEndpointListHandler admin_api.NotificationEndpointListHandler
+	// AdminAPIPauseJobHandler sets the operation handler for the pause job operation
+	AdminAPIPauseJobHandler admin_api.PauseJobHandler
 	// AdminAPIPolicyInfoHandler sets the operation handler for the policy info operation
 	AdminAPIPolicyInfoHandler admin_api.PolicyInfoHandler
+	// AdminAPIPoolDecommissionStatusHandler sets the operation handler for the pool decommission status operation
+	AdminAPIPoolDecommissionStatusHandler admin_api.PoolDecommissionStatusHandler
 	// UserAPIPostBucketsBucketNameObjectsUploadHandler sets the operation handler for the post buckets bucket name objects upload operation
 	UserAPIPostBucketsBucketNameObjectsUploadHandler user_api.PostBucketsBucketNameObjectsUploadHandler
+	// UserAPIPreviewBucketLifecycleHandler sets the operation handler for the preview bucket lifecycle operation
+	UserAPIPreviewBucketLifecycleHandler user_api.PreviewBucketLifecycleHandler
+	// AdminAPIProfilingCaptureSummaryHandler sets the operation handler for the profiling capture summary operation
+	AdminAPIProfilingCaptureSummaryHandler admin_api.ProfilingCaptureSummaryHandler
 	// AdminAPIProfilingStartHandler sets the operation handler for the profiling start operation
 	AdminAPIProfilingStartHandler admin_api.ProfilingStartHandler
 	// AdminAPIProfilingStopHandler sets the operation handler for the profiling stop operation
@@ -535,6 +957,8 @@ type ConsoleAPI struct {
 	UserAPIPutObjectRetentionHandler user_api.PutObjectRetentionHandler
 	// UserAPIPutObjectTagsHandler sets the operation handler for the put object tags operation
 	UserAPIPutObjectTagsHandler user_api.PutObjectTagsHandler
+	// AdminAPIRebalanceStatusHandler sets the operation handler for the rebalance status operation
+	AdminAPIRebalanceStatusHandler admin_api.RebalanceStatusHandler
 	// UserAPIRemoteBucketDetailsHandler sets the operation handler for the remote bucket details operation
 	UserAPIRemoteBucketDetailsHandler user_api.RemoteBucketDetailsHandler
 	// AdminAPIRemoveGroupHandler sets the operation handler for the remove group operation
@@ -543,18 +967,44 @@ type ConsoleAPI struct {
 	AdminAPIRemovePolicyHandler admin_api.RemovePolicyHandler
 	// AdminAPIRemoveUserHandler sets the operation handler for the remove user operation
 	AdminAPIRemoveUserHandler admin_api.RemoveUserHandler
+	// AdminAPIResetConfigHandler sets the operation handler for the reset config operation
+	AdminAPIResetConfigHandler admin_api.ResetConfigHandler
 	// AdminAPIRestartServiceHandler sets the operation handler for the restart service operation
 	AdminAPIRestartServiceHandler admin_api.RestartServiceHandler
+	// UserAPIRestoreBucketHandler sets the operation handler for the restore bucket operation
+	UserAPIRestoreBucketHandler user_api.RestoreBucketHandler
+	// AdminAPIRestoreConfigRevisionHandler sets the operation handler for the restore config revision operation
+	AdminAPIRestoreConfigRevisionHandler admin_api.RestoreConfigRevisionHandler
+	// AdminAPIResumeJobHandler sets the operation handler for the resume job operation
+	AdminAPIResumeJobHandler admin_api.ResumeJobHandler
+	// UserAPIResyncBucketReplicationHandler sets the operation handler for the resync bucket replication operation
+	UserAPIResyncBucketReplicationHandler user_api.ResyncBucketReplicationHandler
+	// UserAPIRetryBucketReplicationHandler sets the operation handler for the retry bucket replication operation
+	UserAPIRetryBucketReplicationHandler user_api.RetryBucketReplicationHandler
+	// AdminAPIRunJobHandler sets the operation handler for the run job operation
+	AdminAPIRunJobHandler admin_api.RunJobHandler
+	// AdminAPIServiceStatusHandler sets the operation handler for the service status operation
+	AdminAPIServiceStatusHandler admin_api.ServiceStatusHandler
 	// UserAPISessionCheckHandler sets the operation handler for the session check operation
 	UserAPISessionCheckHandler user_api.SessionCheckHandler
 	// UserAPISetBucketQuotaHandler sets the operation handler for the set bucket quota operation
 	UserAPISetBucketQuotaHandler user_api.SetBucketQuotaHandler
+	// UserAPISetBucketQuotasHandler sets the operation handler for the set bucket quotas operation
+	UserAPISetBucketQuotasHandler user_api.SetBucketQuotasHandler
+	// UserAPISetBucketReplicationRuleStatusHandler sets the operation handler for the set bucket replication rule status operation
+	UserAPISetBucketReplicationRuleStatusHandler user_api.SetBucketReplicationRuleStatusHandler
+	// UserAPISetBucketReplicationRulesOrderHandler sets the operation handler for the set bucket replication rules order operation
+	UserAPISetBucketReplicationRulesOrderHandler user_api.SetBucketReplicationRulesOrderHandler
 	// UserAPISetBucketRetentionConfigHandler sets the operation handler for the set bucket retention config operation
 	UserAPISetBucketRetentionConfigHandler user_api.SetBucketRetentionConfigHandler
+	// UserAPISetBucketSoftQuotaHandler sets the operation handler for the set bucket soft quota operation
+	UserAPISetBucketSoftQuotaHandler user_api.SetBucketSoftQuotaHandler
 	// UserAPISetBucketVersioningHandler sets the operation handler for the set bucket versioning operation
 	UserAPISetBucketVersioningHandler user_api.SetBucketVersioningHandler
 	// AdminAPISetConfigHandler sets the operation handler for the set config operation
 	AdminAPISetConfigHandler admin_api.SetConfigHandler
+	// AdminAPISetConfigTargetHandler sets the operation handler for the set config target operation
+	AdminAPISetConfigTargetHandler admin_api.SetConfigTargetHandler
 	// UserAPISetMultiBucketReplicationHandler sets the operation handler for the set multi bucket replication operation
 	UserAPISetMultiBucketReplicationHandler user_api.SetMultiBucketReplicationHandler
 	// AdminAPISetPolicyHandler sets the operation handler for the set policy operation
@@ -563,18 +1013,48 @@ type ConsoleAPI struct {
 	AdminAPISetPolicyMultipleHandler admin_api.SetPolicyMultipleHandler
 	// UserAPIShareObjectHandler sets the operation handler for the share object operation
 	UserAPIShareObjectHandler user_api.ShareObjectHandler
+	// AdminAPISiteReplicationAddHandler sets the operation handler for the site replication add operation
+	AdminAPISiteReplicationAddHandler admin_api.SiteReplicationAddHandler
+	// AdminAPISiteReplicationEditHandler sets the operation handler for the site replication edit operation
+	AdminAPISiteReplicationEditHandler admin_api.SiteReplicationEditHandler
+	// AdminAPISiteReplicationInfoHandler sets the operation handler for the site replication info operation
+	AdminAPISiteReplicationInfoHandler admin_api.SiteReplicationInfoHandler
+	// AdminAPISiteReplicationRemoveHandler sets the operation handler for the site replication remove operation
+	AdminAPISiteReplicationRemoveHandler admin_api.SiteReplicationRemoveHandler
+	// AdminAPISiteReplicationStatusHandler sets the operation handler for the site replication status operation
+	AdminAPISiteReplicationStatusHandler admin_api.SiteReplicationStatusHandler
+	// AdminAPIStartPoolDecommissionHandler sets the operation handler for the start pool decommission operation
+	AdminAPIStartPoolDecommissionHandler admin_api.StartPoolDecommissionHandler
+	// AdminAPIStopServiceHandler sets the operation handler for the stop service operation
+	AdminAPIStopServiceHandler admin_api.StopServiceHandler
 	// AdminAPISubscriptionInfoHandler sets the operation handler for the subscription info operation
 	AdminAPISubscriptionInfoHandler admin_api.SubscriptionInfoHandler
+	// AdminAPITestWebhookHandler sets the operation handler for the test webhook operation
+	AdminAPITestWebhookHandler admin_api.TestWebhookHandler
 	// AdminAPITiersListHandler sets the operation handler for the tiers list operation
 	AdminAPITiersListHandler admin_api.TiersListHandler
+	// AdminAPIUpdateAlertRuleHandler sets the operation handler for the update alert rule operation
+	AdminAPIUpdateAlertRuleHandler admin_api.UpdateAlertRuleHandler
+	// AdminAPIUpdateAlertTargetHandler sets the operation handler for the update alert target operation
+	AdminAPIUpdateAlertTargetHandler admin_api.UpdateAlertTargetHandler
 	// UserAPIUpdateBucketLifecycleHandler sets the operation handler for the update bucket lifecycle operation
 	UserAPIUpdateBucketLifecycleHandler user_api.UpdateBucketLifecycleHandler
+	// UserAPIUpdateBucketReplicationRuleHandler sets the operation handler for the update bucket replication rule operation
+	UserAPIUpdateBucketReplicationRuleHandler user_api.UpdateBucketReplicationRuleHandler
+	// AdminAPIUpdateDashboardHandler sets the operation handler for the update dashboard operation
+	AdminAPIUpdateDashboardHandler admin_api.UpdateDashboardHandler
+	// AdminAPIUpdateDashboardWidgetHandler sets the operation handler for the update dashboard widget operation
+	AdminAPIUpdateDashboardWidgetHandler admin_api.UpdateDashboardWidgetHandler
 	// AdminAPIUpdateGroupHandler sets the operation handler for the update group operation
 	AdminAPIUpdateGroupHandler admin_api.UpdateGroupHandler
+	// AdminAPIUpdateServerHandler sets the operation handler for the update server operation
+	AdminAPIUpdateServerHandler admin_api.UpdateServerHandler
 	// AdminAPIUpdateUserGroupsHandler sets the operation handler for the update user groups operation
 	AdminAPIUpdateUserGroupsHandler admin_api.UpdateUserGroupsHandler
 	// AdminAPIUpdateUserInfoHandler sets the operation handler for the update user info operation
 	AdminAPIUpdateUserInfoHandler admin_api.UpdateUserInfoHandler
+	// AdminAPIUpdateWebhookHandler sets the operation handler for the update webhook operation
+	AdminAPIUpdateWebhookHandler admin_api.UpdateWebhookHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -698,33 +1178,93 @@ func (o *ConsoleAPI) Validate() error {
 	if o.UserAPIBucketSetPolicyHandler == nil {
 		unregistered = append(unregistered, "user_api.BucketSetPolicyHandler")
 	}
+	if o.UserAPIBulkObjectLockHandler == nil {
+		unregistered = append(unregistered, "user_api.BulkObjectLockHandler")
+	}
 	if o.AdminAPIBulkUpdateUsersGroupsHandler == nil {
 		unregistered = append(unregistered, "admin_api.BulkUpdateUsersGroupsHandler")
 	}
+	if o.AdminAPICancelPoolDecommissionHandler == nil {
+		unregistered = append(unregistered, "admin_api.CancelPoolDecommissionHandler")
+	}
+	if o.AdminAPICapacityForecastHandler == nil {
+		unregistered = append(unregistered, "admin_api.CapacityForecastHandler")
+	}
 	if o.AdminAPIChangeUserPasswordHandler == nil {
 		unregistered = append(unregistered, "admin_api.ChangeUserPasswordHandler")
 	}
+	if o.UserAPICleanupBucketVersionsHandler == nil {
+		unregistered = append(unregistered, "user_api.CleanupBucketVersionsHandler")
+	}
+	if o.AdminAPIClusterTopologyHandler == nil {
+		unregistered = append(unregistered, "admin_api.ClusterTopologyHandler")
+	}
 	if o.AdminAPIConfigInfoHandler == nil {
 		unregistered = append(unregistered, "admin_api.ConfigInfoHandler")
 	}
+	if o.AdminAPICreateAlertRuleHandler == nil {
+		unregistered = append(unregistered, "admin_api.CreateAlertRuleHandler")
+	}
+	if o.AdminAPICreateAlertTargetHandler == nil {
+		unregistered = append(unregistered, "admin_api.CreateAlertTargetHandler")
+	}
 	if o.UserAPICreateBucketEventHandler == nil {
 		unregistered = append(unregistered, "user_api.CreateBucketEventHandler")
 	}
+	if o.AdminAPICreateConfigSnapshotHandler == nil {
+		unregistered = append(unregistered, "admin_api.CreateConfigSnapshotHandler")
+	}
+	if o.AdminAPICreateDashboardHandler == nil {
+		unregistered = append(unregistered, "admin_api.CreateDashboardHandler")
+	}
+	if o.AdminAPICreateDashboardWidgetHandler == nil {
+		unregistered = append(unregistered, "admin_api.CreateDashboardWidgetHandler")
+	}
+	if o.AdminAPICreateJobHandler == nil {
+		unregistered = append(unregistered, "admin_api.CreateJobHandler")
+	}
 	if o.UserAPICreateServiceAccountHandler == nil {
 		unregistered = append(unregistered, "user_api.CreateServiceAccountHandler")
 	}
+	if o.AdminAPICreateWebhookHandler == nil {
+		unregistered = append(unregistered, "admin_api.CreateWebhookHandler")
+	}
 	if o.AdminAPIDashboardWidgetDetailsHandler == nil {
 		unregistered = append(unregistered, "admin_api.DashboardWidgetDetailsHandler")
 	}
+	if o.AdminAPIDeleteAlertRuleHandler == nil {
+		unregistered = append(unregistered, "admin_api.DeleteAlertRuleHandler")
+	}
+	if o.AdminAPIDeleteAlertTargetHandler == nil {
+		unregistered = append(unregistered, "admin_api.DeleteAlertTargetHandler")
+	}
 	if o.UserAPIDeleteBucketHandler == nil {
 		unregistered = append(unregistered, "user_api.DeleteBucketHandler")
 	}
 	if o.UserAPIDeleteBucketEventHandler == nil {
 		unregistered = append(unregistered, "user_api.DeleteBucketEventHandler")
 	}
+	if o.UserAPIDeleteBucketLifecycleRuleHandler == nil {
+		unregistered = append(unregistered, "user_api.DeleteBucketLifecycleRuleHandler")
+	}
 	if o.UserAPIDeleteBucketReplicationRuleHandler == nil {
 		unregistered = append(unregistered, "user_api.DeleteBucketReplicationRuleHandler")
 	}
+	if o.UserAPIDeleteBucketSoftQuotaHandler == nil {
+		unregistered = append(unregistered, "user_api.DeleteBucketSoftQuotaHandler")
+	}
+	if o.AdminAPIDeleteConfigTargetHandler == nil {
+		unregistered = append(unregistered, "admin_api.DeleteConfigTargetHandler")
+	}
+	if o.AdminAPIDeleteDashboardHandler == nil {
+		unregistered = append(unregistered, "admin_api.DeleteDashboardHandler")
+	}
+	if o.AdminAPIDeleteDashboardWidgetHandler == nil {
+		unregistered = append(unregistered, "admin_api.DeleteDashboardWidgetHandler")
+	}
+	if o.AdminAPIDeleteJobHandler == nil {
+		unregistered = append(unregistered, "admin_api.DeleteJobHandler")
+	}
 	if o.UserAPIDeleteObjectHandler == nil {
 		unregistered = append(unregistered, "user_api.DeleteObjectHandler")
 	}
@@ -737,18 +1277,45 @@ func (o *ConsoleAPI) Validate() error {
 	if o.UserAPIDeleteServiceAccountHandler == nil {
 		unregistered = append(unregistered, "user_api.DeleteServiceAccountHandler")
 	}
+	if o.AdminAPIDeleteWebhookHandler == nil {
+		unregistered = append(unregistered, "admin_api.DeleteWebhookHandler")
+	}
+	if o.AdminAPIDiffConfigRevisionsHandler == nil {
+		unregistered = append(unregistered, "admin_api.DiffConfigRevisionsHandler")
+	}
 	if o.UserAPIDisableBucketEncryptionHandler == nil {
 		unregistered = append(unregistered, "user_api.DisableBucketEncryptionHandler")
 	}
+	if o.AdminAPIDownloadJobRunReportHandler == nil {
+		unregistered = append(unregistered, "admin_api.DownloadJobRunReportHandler")
+	}
 	if o.UserAPIDownloadObjectHandler == nil {
 		unregistered = append(unregistered, "user_api.DownloadObjectHandler")
 	}
+	if o.AdminAPIDownloadProfilingCaptureHandler == nil {
+		unregistered = append(unregistered, "admin_api.DownloadProfilingCaptureHandler")
+	}
+	if o.AdminAPIDriveStateHistoryHandler == nil {
+		unregistered = append(unregistered, "admin_api.DriveStateHistoryHandler")
+	}
 	if o.AdminAPIEditTierCredentialsHandler == nil {
 		unregistered = append(unregistered, "admin_api.EditTierCredentialsHandler")
 	}
 	if o.UserAPIEnableBucketEncryptionHandler == nil {
 		unregistered = append(unregistered, "user_api.EnableBucketEncryptionHandler")
 	}
+	if o.UserAPIExportBucketLifecycleHandler == nil {
+		unregistered = append(unregistered, "user_api.ExportBucketLifecycleHandler")
+	}
+	if o.AdminAPIExportConfigHandler == nil {
+		unregistered = append(unregistered, "admin_api.ExportConfigHandler")
+	}
+	if o.AdminAPIGetAlertRuleHandler == nil {
+		unregistered = append(unregistered, "admin_api.GetAlertRuleHandler")
+	}
+	if o.UserAPIGetBucketAnalyticsHandler == nil {
+		unregistered = append(unregistered, "user_api.GetBucketAnalyticsHandler")
+	}
 	if o.UserAPIGetBucketEncryptionInfoHandler == nil {
 		unregistered = append(unregistered, "user_api.GetBucketEncryptionInfoHandler")
 	}
@@ -764,6 +1331,9 @@ func (o *ConsoleAPI) Validate() error {
 	if o.UserAPIGetBucketReplicationHandler == nil {
 		unregistered = append(unregistered, "user_api.GetBucketReplicationHandler")
 	}
+	if o.UserAPIGetBucketReplicationMetricsHandler == nil {
+		unregistered = append(unregistered, "user_api.GetBucketReplicationMetricsHandler")
+	}
 	if o.UserAPIGetBucketRetentionConfigHandler == nil {
 		unregistered = append(unregistered, "user_api.GetBucketRetentionConfigHandler")
 	}
@@ -773,30 +1343,99 @@ func (o *ConsoleAPI) Validate() error {
 	if o.UserAPIGetBucketVersioningHandler == nil {
 		unregistered = append(unregistered, "user_api.GetBucketVersioningHandler")
 	}
+	if o.UserAPIGetBucketVersionsStatsHandler == nil {
+		unregistered = append(unregistered, "user_api.GetBucketVersionsStatsHandler")
+	}
+	if o.AdminAPIGetConfigTargetHandler == nil {
+		unregistered = append(unregistered, "admin_api.GetConfigTargetHandler")
+	}
+	if o.AdminAPIGetDashboardHandler == nil {
+		unregistered = append(unregistered, "admin_api.GetDashboardHandler")
+	}
+	if o.AdminAPIGetDashboardWidgetHandler == nil {
+		unregistered = append(unregistered, "admin_api.GetDashboardWidgetHandler")
+	}
+	if o.AdminAPIGetJobHandler == nil {
+		unregistered = append(unregistered, "admin_api.GetJobHandler")
+	}
+	if o.AdminAPIGetJobRunHandler == nil {
+		unregistered = append(unregistered, "admin_api.GetJobRunHandler")
+	}
 	if o.AdminAPIGetTierHandler == nil {
 		unregistered = append(unregistered, "admin_api.GetTierHandler")
 	}
 	if o.AdminAPIGetUserInfoHandler == nil {
 		unregistered = append(unregistered, "admin_api.GetUserInfoHandler")
 	}
+	if o.AdminAPIGetWebhookHandler == nil {
+		unregistered = append(unregistered, "admin_api.GetWebhookHandler")
+	}
 	if o.AdminAPIGroupInfoHandler == nil {
 		unregistered = append(unregistered, "admin_api.GroupInfoHandler")
 	}
 	if o.UserAPIHasPermissionToHandler == nil {
 		unregistered = append(unregistered, "user_api.HasPermissionToHandler")
 	}
+	if o.UserAPIImportBucketLifecycleHandler == nil {
+		unregistered = append(unregistered, "user_api.ImportBucketLifecycleHandler")
+	}
+	if o.AdminAPIKMSCreateKeyHandler == nil {
+		unregistered = append(unregistered, "admin_api.KMSCreateKeyHandler")
+	}
+	if o.AdminAPIKMSDeleteKeyHandler == nil {
+		unregistered = append(unregistered, "admin_api.KMSDeleteKeyHandler")
+	}
+	if o.AdminAPIKMSImportKeyHandler == nil {
+		unregistered = append(unregistered, "admin_api.KMSImportKeyHandler")
+	}
+	if o.AdminAPIKMSKeyStatusHandler == nil {
+		unregistered = append(unregistered, "admin_api.KMSKeyStatusHandler")
+	}
+	if o.AdminAPIKMSListKeysHandler == nil {
+		unregistered = append(unregistered, "admin_api.KMSListKeysHandler")
+	}
+	if o.AdminAPIKMSStatusHandler == nil {
+		unregistered = append(unregistered, "admin_api.KMSStatusHandler")
+	}
 	if o.AdminAPIListAUserServiceAccountsHandler == nil {
 		unregistered = append(unregistered, "admin_api.ListAUserServiceAccountsHandler")
 	}
+	if o.AdminAPIListAlertRulesHandler == nil {
+		unregistered = append(unregistered, "admin_api.ListAlertRulesHandler")
+	}
+	if o.AdminAPIListAlertTargetsHandler == nil {
+		unregistered = append(unregistered, "admin_api.ListAlertTargetsHandler")
+	}
+	if o.AdminAPIListAlertsHandler == nil {
+		unregistered = append(unregistered, "admin_api.ListAlertsHandler")
+	}
 	if o.UserAPIListBucketEventsHandler == nil {
 		unregistered = append(unregistered, "user_api.ListBucketEventsHandler")
 	}
+	if o.UserAPIListBucketQuotasHandler == nil {
+		unregistered = append(unregistered, "user_api.ListBucketQuotasHandler")
+	}
+	if o.UserAPIListBucketReplicationFailedHandler == nil {
+		unregistered = append(unregistered, "user_api.ListBucketReplicationFailedHandler")
+	}
 	if o.UserAPIListBucketsHandler == nil {
 		unregistered = append(unregistered, "user_api.ListBucketsHandler")
 	}
 	if o.AdminAPIListConfigHandler == nil {
 		unregistered = append(unregistered, "admin_api.ListConfigHandler")
 	}
+	if o.AdminAPIListConfigHistoryHandler == nil {
+		unregistered = append(unregistered, "admin_api.ListConfigHistoryHandler")
+	}
+	if o.AdminAPIListConfigTargetsHandler == nil {
+		unregistered = append(unregistered, "admin_api.ListConfigTargetsHandler")
+	}
+	if o.AdminAPIListDashboardWidgetsHandler == nil {
+		unregistered = append(unregistered, "admin_api.ListDashboardWidgetsHandler")
+	}
+	if o.AdminAPIListDashboardsHandler == nil {
+		unregistered = append(unregistered, "admin_api.ListDashboardsHandler")
+	}
 	if o.UserAPIListExternalBucketsHandler == nil {
 		unregistered = append(unregistered, "user_api.ListExternalBucketsHandler")
 	}
@@ -806,6 +1445,18 @@ func (o *ConsoleAPI) Validate() error {
 	if o.AdminAPIListGroupsForPolicyHandler == nil {
 		unregistered = append(unregistered, "admin_api.ListGroupsForPolicyHandler")
 	}
+	if o.AdminAPIListJobRunsHandler == nil {
+		unregistered = append(unregistered, "admin_api.ListJobRunsHandler")
+	}
+	if o.AdminAPIListJobsHandler == nil {
+		unregistered = append(unregistered, "admin_api.ListJobsHandler")
+	}
+	if o.UserAPIListLockedObjectsHandler == nil {
+		unregistered = append(unregistered, "user_api.ListLockedObjectsHandler")
+	}
+	if o.AdminAPIListNodesHandler == nil {
+		unregistered = append(unregistered, "admin_api.ListNodesHandler")
+	}
 	if o.UserAPIListObjectsHandler == nil {
 		unregistered = append(unregistered, "user_api.ListObjectsHandler")
 	}
@@ -815,6 +1466,12 @@ func (o *ConsoleAPI) Validate() error {
 	if o.AdminAPIListPoliciesWithBucketHandler == nil {
 		unregistered = append(unregistered, "admin_api.ListPoliciesWithBucketHandler")
 	}
+	if o.AdminAPIListPoolsStatusHandler == nil {
+		unregistered = append(unregistered, "admin_api.ListPoolsStatusHandler")
+	}
+	if o.AdminAPIListProfilingCapturesHandler == nil {
+		unregistered = append(unregistered, "admin_api.ListProfilingCapturesHandler")
+	}
 	if o.UserAPIListRemoteBucketsHandler == nil {
 		unregistered = append(unregistered, "user_api.ListRemoteBucketsHandler")
 	}
@@ -830,9 +1487,18 @@ func (o *ConsoleAPI) Validate() error {
 	if o.AdminAPIListUsersWithAccessToBucketHandler == nil {
 		unregistered = append(unregistered, "admin_api.ListUsersWithAccessToBucketHandler")
 	}
+	if o.AdminAPIListWebhookDeliveriesHandler == nil {
+		unregistered = append(unregistered, "admin_api.ListWebhookDeliveriesHandler")
+	}
+	if o.AdminAPIListWebhooksHandler == nil {
+		unregistered = append(unregistered, "admin_api.ListWebhooksHandler")
+	}
 	if o.UserAPILogSearchHandler == nil {
 		unregistered = append(unregistered, "user_api.LogSearchHandler")
 	}
+	if o.UserAPILogSearchExportHandler == nil {
+		unregistered = append(unregistered, "user_api.LogSearchExportHandler")
+	}
 	if o.UserAPILoginHandler == nil {
 		unregistered = append(unregistered, "user_api.LoginHandler")
 	}
@@ -851,15 +1517,30 @@ func (o *ConsoleAPI) Validate() error {
 	if o.UserAPIMakeBucketHandler == nil {
 		unregistered = append(unregistered, "user_api.MakeBucketHandler")
 	}
+	if o.AdminAPINodeInfoHandler == nil {
+		unregistered = append(unregistered, "admin_api.NodeInfoHandler")
+	}
 	if o.AdminAPINotificationEndpointListHandler == nil {
 		unregistered = append(unregistered, "admin_api.NotificationEndpointListHandler")
 	}
+	if o.AdminAPIPauseJobHandler == nil {
+		unregistered = append(unregistered, "admin_api.PauseJobHandler")
+	}
 	if o.AdminAPIPolicyInfoHandler == nil {
 		unregistered = append(unregistered, "admin_api.PolicyInfoHandler")
 	}
+	if o.AdminAPIPoolDecommissionStatusHandler == nil {
+		unregistered = append(unregistered, "admin_api.PoolDecommissionStatusHandler")
+	}
 	if o.UserAPIPostBucketsBucketNameObjectsUploadHandler == nil {
 		unregistered = append(unregistered, "user_api.PostBucketsBucketNameObjectsUploadHandler")
 	}
+	if o.UserAPIPreviewBucketLifecycleHandler == nil {
+		unregistered = append(unregistered, "user_api.PreviewBucketLifecycleHandler")
+	}
+	if o.AdminAPIProfilingCaptureSummaryHandler == nil {
+		unregistered = append(unregistered, "admin_api.ProfilingCaptureSummaryHandler")
+	}
 	if o.AdminAPIProfilingStartHandler == nil {
 		unregistered = append(unregistered, "admin_api.ProfilingStartHandler")
 	}
@@ -875,6 +1556,9 @@ func (o *ConsoleAPI) Validate() error {
 	if o.UserAPIPutObjectTagsHandler == nil {
 		unregistered = append(unregistered, "user_api.PutObjectTagsHandler")
 	}
+	if o.AdminAPIRebalanceStatusHandler == nil {
+		unregistered = append(unregistered, "admin_api.RebalanceStatusHandler")
+	}
 	if o.UserAPIRemoteBucketDetailsHandler == nil {
 		unregistered = append(unregistered, "user_api.RemoteBucketDetailsHandler")
 	}
@@ -887,24 +1571,63 @@ func (o *ConsoleAPI) Validate() error {
 	if o.AdminAPIRemoveUserHandler == nil {
 		unregistered = append(unregistered, "admin_api.RemoveUserHandler")
 	}
+	if o.AdminAPIResetConfigHandler == nil {
+		unregistered = append(unregistered, "admin_api.ResetConfigHandler")
+	}
 	if o.AdminAPIRestartServiceHandler == nil {
 		unregistered = append(unregistered, "admin_api.RestartServiceHandler")
 	}
+	if o.UserAPIRestoreBucketHandler == nil {
+		unregistered = append(unregistered, "user_api.RestoreBucketHandler")
+	}
+	if o.AdminAPIRestoreConfigRevisionHandler == nil {
+		unregistered = append(unregistered, "admin_api.RestoreConfigRevisionHandler")
+	}
+	if o.AdminAPIResumeJobHandler == nil {
+		unregistered = append(unregistered, "admin_api.ResumeJobHandler")
+	}
+	if o.UserAPIResyncBucketReplicationHandler == nil {
+		unregistered = append(unregistered, "user_api.ResyncBucketReplicationHandler")
+	}
+	if o.UserAPIRetryBucketReplicationHandler == nil {
+		unregistered = append(unregistered, "user_api.RetryBucketReplicationHandler")
+	}
+	if o.AdminAPIRunJobHandler == nil {
+		unregistered = append(unregistered, "admin_api.RunJobHandler")
+	}
+	if o.AdminAPIServiceStatusHandler == nil {
+		unregistered = append(unregistered, "admin_api.ServiceStatusHandler")
+	}
 	if o.UserAPISessionCheckHandler == nil {
 		unregistered = append(unregistered, "user_api.SessionCheckHandler")
 	}
 	if o.UserAPISetBucketQuotaHandler == nil {
 		unregistered = append(unregistered, "user_api.SetBucketQuotaHandler")
 	}
+	if o.UserAPISetBucketQuotasHandler == nil {
+		unregistered = append(unregistered, "user_api.SetBucketQuotasHandler")
+	}
+	if o.UserAPISetBucketReplicationRuleStatusHandler == nil {
+		unregistered = append(unregistered, "user_api.SetBucketReplicationRuleStatusHandler")
+	}
+	if o.UserAPISetBucketReplicationRulesOrderHandler == nil {
+		unregistered = append(unregistered, "user_api.SetBucketReplicationRulesOrderHandler")
+	}
 	if o.UserAPISetBucketRetentionConfigHandler == nil {
 		unregistered = append(unregistered, "user_api.SetBucketRetentionConfigHandler")
 	}
+	if o.UserAPISetBucketSoftQuotaHandler == nil {
+		unregistered = append(unregistered, "user_api.SetBucketSoftQuotaHandler")
+	}
 	if o.UserAPISetBucketVersioningHandler == nil {
 		unregistered = append(unregistered, "user_api.SetBucketVersioningHandler")
 	}
 	if o.AdminAPISetConfigHandler == nil {
 		unregistered = append(unregistered, "admin_api.SetConfigHandler")
 	}
+	if o.AdminAPISetConfigTargetHandler == nil {
+		unregistered = append(unregistered, "admin_api.SetConfigTargetHandler")
+	}
 	if o.UserAPISetMultiBucketReplicationHandler == nil {
 		unregistered = append(unregistered, "user_api.SetMultiBucketReplicationHandler")
 	}
@@ -917,24 +1640,69 @@ func (o *ConsoleAPI) Validate() error {
 	if o.UserAPIShareObjectHandler == nil {
 		unregistered = append(unregistered, "user_api.ShareObjectHandler")
 	}
+	if o.AdminAPISiteReplicationAddHandler == nil {
+		unregistered = append(unregistered, "admin_api.SiteReplicationAddHandler")
+	}
+	if o.AdminAPISiteReplicationEditHandler == nil {
+		unregistered = append(unregistered, "admin_api.SiteReplicationEditHandler")
+	}
+	if o.AdminAPISiteReplicationInfoHandler == nil {
+		unregistered = append(unregistered, "admin_api.SiteReplicationInfoHandler")
+	}
+	if o.AdminAPISiteReplicationRemoveHandler == nil {
+		unregistered = append(unregistered, "admin_api.SiteReplicationRemoveHandler")
+	}
+	if o.AdminAPISiteReplicationStatusHandler == nil {
+		unregistered = append(unregistered, "admin_api.SiteReplicationStatusHandler")
+	}
+	if o.AdminAPIStartPoolDecommissionHandler == nil {
+		unregistered = append(unregistered, "admin_api.StartPoolDecommissionHandler")
+	}
+	if o.AdminAPIStopServiceHandler == nil {
+		unregistered = append(unregistered, "admin_api.StopServiceHandler")
+	}
 	if o.AdminAPISubscriptionInfoHandler == nil {
 		unregistered = append(unregistered, "admin_api.SubscriptionInfoHandler")
 	}
+	if o.AdminAPITestWebhookHandler == nil {
+		unregistered = append(unregistered, "admin_api.TestWebhookHandler")
+	}
 	if o.AdminAPITiersListHandler == nil {
 		unregistered = append(unregistered, "admin_api.TiersListHandler")
 	}
+	if o.AdminAPIUpdateAlertRuleHandler == nil {
+		unregistered = append(unregistered, "admin_api.UpdateAlertRuleHandler")
+	}
+	if o.AdminAPIUpdateAlertTargetHandler == nil {
+		unregistered = append(unregistered, "admin_api.UpdateAlertTargetHandler")
+	}
 	if o.UserAPIUpdateBucketLifecycleHandler == nil {
 		unregistered = append(unregistered, "user_api.UpdateBucketLifecycleHandler")
 	}
+	if o.UserAPIUpdateBucketReplicationRuleHandler == nil {
+		unregistered = append(unregistered, "user_api.UpdateBucketReplicationRuleHandler")
+	}
+	if o.AdminAPIUpdateDashboardHandler == nil {
+		unregistered = append(unregistered, "admin_api.UpdateDashboardHandler")
+	}
+	if o.AdminAPIUpdateDashboardWidgetHandler == nil {
+		unregistered = append(unregistered, "admin_api.UpdateDashboardWidgetHandler")
+	}
 	if o.AdminAPIUpdateGroupHandler == nil {
 		unregistered = append(unregistered, "admin_api.UpdateGroupHandler")
 	}
+	if o.AdminAPIUpdateServerHandler == nil {
+		unregistered = append(unregistered, "admin_api.UpdateServerHandler")
+	}
 	if o.AdminAPIUpdateUserGroupsHandler == nil {
 		unregistered = append(unregistered, "admin_api.UpdateUserGroupsHandler")
 	}
 	if o.AdminAPIUpdateUserInfoHandler == nil {
 		unregistered = append(unregistered, "admin_api.UpdateUserInfoHandler")
 	}
+	if o.AdminAPIUpdateWebhookHandler == nil {
+		unregistered = append(unregistered, "admin_api.UpdateWebhookHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -1088,11 +1856,31 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/buckets/{bucket_name}/objects/lock"] = user_api.NewBulkObjectLock(o.context, o.UserAPIBulkObjectLockHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/users-groups-bulk"] = admin_api.NewBulkUpdateUsersGroups(o.context, o.AdminAPIBulkUpdateUsersGroupsHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/admin/pools/{pool}/decommission"] = admin_api.NewCancelPoolDecommission(o.context, o.AdminAPICancelPoolDecommissionHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/capacity/forecast"] = admin_api.NewCapacityForecast(o.context, o.AdminAPICapacityForecastHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/account/change-user-password"] = admin_api.NewChangeUserPassword(o.context, o.AdminAPIChangeUserPasswordHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/buckets/{bucket_name}/versions-cleanup"] = user_api.NewCleanupBucketVersions(o.context, o.UserAPICleanupBucketVersionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/topology"] = admin_api.NewClusterTopology(o.context, o.AdminAPIClusterTopologyHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1100,11 +1888,39 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/admin/alerts/rules"] = admin_api.NewCreateAlertRule(o.context, o.AdminAPICreateAlertRuleHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/alerts/targets"] = admin_api.NewCreateAlertTarget(o.context, o.AdminAPICreateAlertTargetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/buckets/{bucket_name}/events"] = user_api.NewCreateBucketEvent(o.context, o.UserAPICreateBucketEventHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/configs-history"] = admin_api.NewCreateConfigSnapshot(o.context, o.AdminAPICreateConfigSnapshotHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/dashboards"] = admin_api.NewCreateDashboard(o.context, o.AdminAPICreateDashboardHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/dashboard/widgets"] = admin_api.NewCreateDashboardWidget(o.context, o.AdminAPICreateDashboardWidgetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/jobs"] = admin_api.NewCreateJob(o.context, o.AdminAPICreateJobHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/service-accounts"] = user_api.NewCreateServiceAccount(o.context, o.UserAPICreateServiceAccountHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/webhooks"] = admin_api.NewCreateWebhook(o.context, o.AdminAPICreateWebhookHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1112,6 +1928,14 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/admin/alerts/rules/{id}"] = admin_api.NewDeleteAlertRule(o.context, o.AdminAPIDeleteAlertRuleHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/admin/alerts/targets/{name}"] = admin_api.NewDeleteAlertTarget(o.context, o.AdminAPIDeleteAlertTargetHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/buckets/{name}"] = user_api.NewDeleteBucket(o.context, o.UserAPIDeleteBucketHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -1120,10 +1944,34 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/buckets/{bucket_name}/lifecycle/{lifecycle_id}"] = user_api.NewDeleteBucketLifecycleRule(o.context, o.UserAPIDeleteBucketLifecycleRuleHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/buckets/{bucket_name}/replication/{rule_id}"] = user_api.NewDeleteBucketReplicationRule(o.context, o.UserAPIDeleteBucketReplicationRuleHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/buckets/{name}/soft-quota"] = user_api.NewDeleteBucketSoftQuota(o.context, o.UserAPIDeleteBucketSoftQuotaHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/configs/{name}/targets/{target}"] = admin_api.NewDeleteConfigTarget(o.context, o.AdminAPIDeleteConfigTargetHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/admin/dashboards/{name}"] = admin_api.NewDeleteDashboard(o.context, o.AdminAPIDeleteDashboardHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/admin/dashboard/widgets/{widgetId}"] = admin_api.NewDeleteDashboardWidget(o.context, o.AdminAPIDeleteDashboardWidgetHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/admin/jobs/{id}"] = admin_api.NewDeleteJob(o.context, o.AdminAPIDeleteJobHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/buckets/{bucket_name}/objects"] = user_api.NewDeleteObject(o.context, o.UserAPIDeleteObjectHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -1137,6 +1985,14 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
 	o.handlers["DELETE"]["/service-accounts/{access_key}"] = user_api.NewDeleteServiceAccount(o.context, o.UserAPIDeleteServiceAccountHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/admin/webhooks/{id}"] = admin_api.NewDeleteWebhook(o.context, o.AdminAPIDeleteWebhookHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/configs-history/diff"] = admin_api.NewDiffConfigRevisions(o.context, o.AdminAPIDiffConfigRevisionsHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -1144,7 +2000,19 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/admin/jobs/{id}/runs/{run}/report"] = admin_api.NewDownloadJobRunReport(o.context, o.AdminAPIDownloadJobRunReportHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/buckets/{bucket_name}/objects/download"] = user_api.NewDownloadObject(o.context, o.UserAPIDownloadObjectHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/profiling/captures/{capture_id}"] = admin_api.NewDownloadProfilingCapture(o.context, o.AdminAPIDownloadProfilingCaptureHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/nodes/drives/history"] = admin_api.NewDriveStateHistory(o.context, o.AdminAPIDriveStateHistoryHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -1156,6 +2024,22 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/buckets/{bucket_name}/lifecycle-export"] = user_api.NewExportBucketLifecycle(o.context, o.UserAPIExportBucketLifecycleHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/configs-export"] = admin_api.NewExportConfig(o.context, o.AdminAPIExportConfigHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/alerts/rules/{id}"] = admin_api.NewGetAlertRule(o.context, o.AdminAPIGetAlertRuleHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/buckets/{bucket_name}/analytics"] = user_api.NewGetBucketAnalytics(o.context, o.UserAPIGetBucketAnalyticsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/buckets/{bucket_name}/encryption/info"] = user_api.NewGetBucketEncryptionInfo(o.context, o.UserAPIGetBucketEncryptionInfoHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1176,6 +2060,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/buckets/{bucket_name}/replication-metrics"] = user_api.NewGetBucketReplicationMetrics(o.context, o.UserAPIGetBucketReplicationMetricsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/buckets/{bucket_name}/retention"] = user_api.NewGetBucketRetentionConfig(o.context, o.UserAPIGetBucketRetentionConfigHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1188,6 +2076,30 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/buckets/{bucket_name}/versions-stats"] = user_api.NewGetBucketVersionsStats(o.context, o.UserAPIGetBucketVersionsStatsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/configs/{name}/targets/{target}"] = admin_api.NewGetConfigTarget(o.context, o.AdminAPIGetConfigTargetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/dashboards/{name}"] = admin_api.NewGetDashboard(o.context, o.AdminAPIGetDashboardHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/dashboard/widgets/{widgetId}"] = admin_api.NewGetDashboardWidget(o.context, o.AdminAPIGetDashboardWidgetHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/jobs/{id}"] = admin_api.NewGetJob(o.context, o.AdminAPIGetJobHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/jobs/{id}/runs/{run}"] = admin_api.NewGetJobRun(o.context, o.AdminAPIGetJobRunHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/admin/tiers/{type}/{name}"] = admin_api.NewGetTier(o.context, o.AdminAPIGetTierHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1196,11 +2108,43 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/admin/webhooks/{id}"] = admin_api.NewGetWebhook(o.context, o.AdminAPIGetWebhookHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/groups/{name}"] = admin_api.NewGroupInfo(o.context, o.AdminAPIGroupInfoHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/has-permission"] = user_api.NewHasPermissionTo(o.context, o.UserAPIHasPermissionToHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/buckets/{bucket_name}/lifecycle-import"] = user_api.NewImportBucketLifecycle(o.context, o.UserAPIImportBucketLifecycleHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/kms/keys"] = admin_api.NewKMSCreateKey(o.context, o.AdminAPIKMSCreateKeyHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/admin/kms/keys/{name}"] = admin_api.NewKMSDeleteKey(o.context, o.AdminAPIKMSDeleteKeyHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/kms/keys/{name}/import"] = admin_api.NewKMSImportKey(o.context, o.AdminAPIKMSImportKeyHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/kms/keys/{name}"] = admin_api.NewKMSKeyStatus(o.context, o.AdminAPIKMSKeyStatusHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/kms/keys"] = admin_api.NewKMSListKeys(o.context, o.AdminAPIKMSListKeysHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/kms/status"] = admin_api.NewKMSStatus(o.context, o.AdminAPIKMSStatusHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1208,15 +2152,51 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/admin/alerts/rules"] = admin_api.NewListAlertRules(o.context, o.AdminAPIListAlertRulesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/alerts/targets"] = admin_api.NewListAlertTargets(o.context, o.AdminAPIListAlertTargetsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/alerts"] = admin_api.NewListAlerts(o.context, o.AdminAPIListAlertsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/buckets/{bucket_name}/events"] = user_api.NewListBucketEvents(o.context, o.UserAPIListBucketEventsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/quotas"] = user_api.NewListBucketQuotas(o.context, o.UserAPIListBucketQuotasHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/buckets/{bucket_name}/replication-failed"] = user_api.NewListBucketReplicationFailed(o.context, o.UserAPIListBucketReplicationFailedHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/buckets"] = user_api.NewListBuckets(o.context, o.UserAPIListBucketsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/configs"] = admin_api.NewListConfig(o.context, o.AdminAPIListConfigHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/configs-history"] = admin_api.NewListConfigHistory(o.context, o.AdminAPIListConfigHistoryHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/configs/{name}/targets"] = admin_api.NewListConfigTargets(o.context, o.AdminAPIListConfigTargetsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/dashboard/widgets"] = admin_api.NewListDashboardWidgets(o.context, o.AdminAPIListDashboardWidgetsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/dashboards"] = admin_api.NewListDashboards(o.context, o.AdminAPIListDashboardsHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -1232,6 +2212,22 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/admin/jobs/{id}/runs"] = admin_api.NewListJobRuns(o.context, o.AdminAPIListJobRunsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/jobs"] = admin_api.NewListJobs(o.context, o.AdminAPIListJobsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/buckets/{bucket_name}/locked-objects"] = user_api.NewListLockedObjects(o.context, o.UserAPIListLockedObjectsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/nodes"] = admin_api.NewListNodes(o.context, o.AdminAPIListNodesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/buckets/{bucket_name}/objects"] = user_api.NewListObjects(o.context, o.UserAPIListObjectsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1244,6 +2240,14 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/admin/pools"] = admin_api.NewListPoolsStatus(o.context, o.AdminAPIListPoolsStatusHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/profiling/captures"] = admin_api.NewListProfilingCaptures(o.context, o.AdminAPIListProfilingCapturesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/remote-buckets"] = user_api.NewListRemoteBuckets(o.context, o.UserAPIListRemoteBucketsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -1264,7 +2268,19 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/admin/webhooks/{id}/deliveries"] = admin_api.NewListWebhookDeliveries(o.context, o.AdminAPIListWebhookDeliveriesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/webhooks"] = admin_api.NewListWebhooks(o.context, o.AdminAPIListWebhooksHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/logs/search"] = user_api.NewLogSearch(o.context, o.UserAPILogSearchHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/logs/search/export"] = user_api.NewLogSearchExport(o.context, o.UserAPILogSearchExportHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -1292,11 +2308,23 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/admin/nodes/{node}"] = admin_api.NewNodeInfo(o.context, o.AdminAPINodeInfoHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/admin/notification_endpoints"] = admin_api.NewNotificationEndpointList(o.context, o.AdminAPINotificationEndpointListHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/jobs/{id}/pause"] = admin_api.NewPauseJob(o.context, o.AdminAPIPauseJobHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/policy"] = admin_api.NewPolicyInfo(o.context, o.AdminAPIPolicyInfoHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/pools/{pool}/decommission"] = admin_api.NewPoolDecommissionStatus(o.context, o.AdminAPIPoolDecommissionStatusHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -1304,6 +2332,14 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/buckets/{bucket_name}/lifecycle-preview"] = user_api.NewPreviewBucketLifecycle(o.context, o.UserAPIPreviewBucketLifecycleHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/profiling/captures/{capture_id}/summary"] = admin_api.NewProfilingCaptureSummary(o.context, o.AdminAPIProfilingCaptureSummaryHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/profiling/start"] = admin_api.NewProfilingStart(o.context, o.AdminAPIProfilingStartHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -1324,6 +2360,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/admin/rebalance"] = admin_api.NewRebalanceStatus(o.context, o.AdminAPIRebalanceStatusHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/remote-buckets/{name}"] = user_api.NewRemoteBucketDetails(o.context, o.UserAPIRemoteBucketDetailsHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -1340,7 +2380,39 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/configs/{name}/reset"] = admin_api.NewResetConfig(o.context, o.AdminAPIResetConfigHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/service/restart"] = admin_api.NewRestartService(o.context, o.AdminAPIRestartServiceHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/buckets/{bucket_name}/restore"] = user_api.NewRestoreBucket(o.context, o.UserAPIRestoreBucketHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/configs-history/{id}/restore"] = admin_api.NewRestoreConfigRevision(o.context, o.AdminAPIRestoreConfigRevisionHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/jobs/{id}/resume"] = admin_api.NewResumeJob(o.context, o.AdminAPIResumeJobHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/buckets/{bucket_name}/replication-resync"] = user_api.NewResyncBucketReplication(o.context, o.UserAPIResyncBucketReplicationHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/buckets/{bucket_name}/replication-retry"] = user_api.NewRetryBucketReplication(o.context, o.UserAPIRetryBucketReplicationHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/jobs/{id}/run"] = admin_api.NewRunJob(o.context, o.AdminAPIRunJobHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/service/status"] = admin_api.NewServiceStatus(o.context, o.AdminAPIServiceStatusHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1352,15 +2424,35 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/quotas"] = user_api.NewSetBucketQuotas(o.context, o.UserAPISetBucketQuotasHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/buckets/{bucket_name}/replication/{rule_id}/status"] = user_api.NewSetBucketReplicationRuleStatus(o.context, o.UserAPISetBucketReplicationRuleStatusHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/buckets/{bucket_name}/replication-order"] = user_api.NewSetBucketReplicationRulesOrder(o.context, o.UserAPISetBucketReplicationRulesOrderHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/buckets/{bucket_name}/retention"] = user_api.NewSetBucketRetentionConfig(o.context, o.UserAPISetBucketRetentionConfigHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/buckets/{name}/soft-quota"] = user_api.NewSetBucketSoftQuota(o.context, o.UserAPISetBucketSoftQuotaHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/buckets/{bucket_name}/versioning"] = user_api.NewSetBucketVersioning(o.context, o.UserAPISetBucketVersioningHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/configs/{name}"] = admin_api.NewSetConfig(o.context, o.AdminAPISetConfigHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/configs/{name}/targets/{target}"] = admin_api.NewSetConfigTarget(o.context, o.AdminAPISetConfigTargetHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -1377,10 +2469,42 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/buckets/{bucket_name}/objects/share"] = user_api.NewShareObject(o.context, o.UserAPIShareObjectHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/site-replication"] = admin_api.NewSiteReplicationAdd(o.context, o.AdminAPISiteReplicationAddHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/admin/site-replication"] = admin_api.NewSiteReplicationEdit(o.context, o.AdminAPISiteReplicationEditHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/site-replication"] = admin_api.NewSiteReplicationInfo(o.context, o.AdminAPISiteReplicationInfoHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/admin/site-replication"] = admin_api.NewSiteReplicationRemove(o.context, o.AdminAPISiteReplicationRemoveHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/admin/site-replication/status"] = admin_api.NewSiteReplicationStatus(o.context, o.AdminAPISiteReplicationStatusHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/pools/{pool}/decommission"] = admin_api.NewStartPoolDecommission(o.context, o.AdminAPIStartPoolDecommissionHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/service/stop"] = admin_api.NewStopService(o.context, o.AdminAPIStopServiceHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/subscription/info"] = admin_api.NewSubscriptionInfo(o.context, o.AdminAPISubscriptionInfoHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/admin/webhooks/{id}/test"] = admin_api.NewTestWebhook(o.context, o.AdminAPITestWebhookHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1388,11 +2512,35 @@ func (o *ConsoleAPI) initHandlerCache() {
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/admin/alerts/rules/{id}"] = admin_api.NewUpdateAlertRule(o.context, o.AdminAPIUpdateAlertRuleHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/admin/alerts/targets/{name}"] = admin_api.NewUpdateAlertTarget(o.context, o.AdminAPIUpdateAlertTargetHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/buckets/{bucket_name}/lifecycle/{lifecycle_id}"] = user_api.NewUpdateBucketLifecycle(o.context, o.UserAPIUpdateBucketLifecycleHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/buckets/{bucket_name}/replication/{rule_id}"] = user_api.NewUpdateBucketReplicationRule(o.context, o.UserAPIUpdateBucketReplicationRuleHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/admin/dashboards/{name}"] = admin_api.NewUpdateDashboard(o.context, o.AdminAPIUpdateDashboardHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/admin/dashboard/widgets/{widgetId}"] = admin_api.NewUpdateDashboardWidget(o.context, o.AdminAPIUpdateDashboardWidgetHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/groups/{name}"] = admin_api.NewUpdateGroup(o.context, o.AdminAPIUpdateGroupHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/service/update"] = admin_api.NewUpdateServer(o.context, o.AdminAPIUpdateServerHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -1401,6 +2549,10 @@ func (o *ConsoleAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/users/{name}"] = admin_api.NewUpdateUserInfo(o.context, o.AdminAPIUpdateUserInfoHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/admin/webhooks/{id}"] = admin_api.NewUpdateWebhook(o.context, o.AdminAPIUpdateWebhookHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
@@ -55,6 +55,10 @@ type DeleteObjectParams struct {
 	  In: path
 	*/
 	BucketName string
+	/*
+	  In: query
+	*/
+	Bypass *bool
 	/*
 	  Required: true
 	  In: query
@@ -86,6 +90,11 @@ func (o *DeleteObjectParams) BindRequest(r *http.Request, route *middleware.Matc
 		res = append(res, err)
 	}
 
+	qBypass, qhkBypass, _ := qs.GetOK("bypass")
+	if err := o.bindBypass(qBypass, qhkBypass, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qPath, qhkPath, _ := qs.GetOK("path")
 	if err := o.bindPath(qPath, qhkPath, route.Formats); err != nil {
 		res = append(res, err)
@@ -120,6 +129,29 @@ func (o *DeleteObjectParams) bindBucketName(rawData []string, hasKey bool, forma
 	return nil
 }
 
+// bindBypass binds and validates parameter Bypass from query.
+func (o *DeleteObjectParams) bindBypass(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("bypass", "query", "bool", raw)
+	}
+	o.Bypass = &value
+
+	return nil
+}
+
 // bindPath binds and validates parameter Path from query.
 func (o *DeleteObjectParams) bindPath(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	if !hasKey {
@@ -35,6 +35,7 @@ import (
 type DeleteObjectURL struct {
 	BucketName string
 
+	Bypass    *bool
 	Path      string
 	Recursive *bool
 	VersionID *string
@@ -80,6 +81,14 @@ func (o *DeleteObjectURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
+	var bypassQ string
+	if o.Bypass != nil {
+		bypassQ = swag.FormatBool(*o.Bypass)
+	}
+	if bypassQ != "" {
+		qs.Set("bypass", bypassQ)
+	}
+
 	pathQ := o.Path
 	if pathQ != "" {
 		qs.Set("path", pathQ)
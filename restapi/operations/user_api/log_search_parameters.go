@@ -40,17 +40,23 @@ func NewLogSearchParams() LogSearchParams {
 	var (
 		// initialize parameters with default values
 
+		intervalDefault = int32(60)
 		orderDefault    = string("timeDesc")
 		pageNoDefault   = int32(0)
 		pageSizeDefault = int32(10)
+		qDefault        = string("reqinfo")
 	)
 
 	return LogSearchParams{
+		Interval: &intervalDefault,
+
 		Order: &orderDefault,
 
 		PageNo: &pageNoDefault,
 
 		PageSize: &pageSizeDefault,
+
+		Q: &qDefault,
 	}
 }
 
@@ -63,11 +69,32 @@ type LogSearchParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*
+	  In: query
+	*/
+	APIName *string
+	/*User or access key that performed the request
+	  In: query
+	*/
+	AccessKey *string
+	/*
+	  In: query
+	*/
+	Bucket *string
 	/*Filter Parameters
 	  In: query
 	  Collection Format: multi
 	*/
 	Fp []string
+	/*Interval in seconds of the aggregated stats
+	  In: query
+	  Default: 60
+	*/
+	Interval *int32
+	/*
+	  In: query
+	*/
+	Object *string
 	/*
 	  In: query
 	  Default: "timeDesc"
@@ -83,6 +110,27 @@ type LogSearchParams struct {
 	  Default: 10
 	*/
 	PageSize *int32
+	/*Query type
+	  In: query
+	  Default: "reqinfo"
+	*/
+	Q *string
+	/*Maximum time to response in milliseconds
+	  In: query
+	*/
+	ResponseTimeMax *int64
+	/*Minimum time to response in milliseconds
+	  In: query
+	*/
+	ResponseTimeMin *int64
+	/*
+	  In: query
+	*/
+	StatusCode *int32
+	/*
+	  In: query
+	*/
+	TimeEnd *string
 	/*
 	  In: query
 	*/
@@ -100,11 +148,36 @@ func (o *LogSearchParams) BindRequest(r *http.Request, route *middleware.Matched
 
 	qs := runtime.Values(r.URL.Query())
 
+	qAPIName, qhkAPIName, _ := qs.GetOK("apiName")
+	if err := o.bindAPIName(qAPIName, qhkAPIName, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qAccessKey, qhkAccessKey, _ := qs.GetOK("accessKey")
+	if err := o.bindAccessKey(qAccessKey, qhkAccessKey, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qBucket, qhkBucket, _ := qs.GetOK("bucket")
+	if err := o.bindBucket(qBucket, qhkBucket, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qFp, qhkFp, _ := qs.GetOK("fp")
 	if err := o.bindFp(qFp, qhkFp, route.Formats); err != nil {
 		res = append(res, err)
 	}
 
+	qInterval, qhkInterval, _ := qs.GetOK("interval")
+	if err := o.bindInterval(qInterval, qhkInterval, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qObject, qhkObject, _ := qs.GetOK("object")
+	if err := o.bindObject(qObject, qhkObject, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qOrder, qhkOrder, _ := qs.GetOK("order")
 	if err := o.bindOrder(qOrder, qhkOrder, route.Formats); err != nil {
 		res = append(res, err)
@@ -120,6 +193,31 @@ func (o *LogSearchParams) BindRequest(r *http.Request, route *middleware.Matched
 		res = append(res, err)
 	}
 
+	qQ, qhkQ, _ := qs.GetOK("q")
+	if err := o.bindQ(qQ, qhkQ, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qResponseTimeMax, qhkResponseTimeMax, _ := qs.GetOK("responseTimeMax")
+	if err := o.bindResponseTimeMax(qResponseTimeMax, qhkResponseTimeMax, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qResponseTimeMin, qhkResponseTimeMin, _ := qs.GetOK("responseTimeMin")
+	if err := o.bindResponseTimeMin(qResponseTimeMin, qhkResponseTimeMin, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qStatusCode, qhkStatusCode, _ := qs.GetOK("statusCode")
+	if err := o.bindStatusCode(qStatusCode, qhkStatusCode, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qTimeEnd, qhkTimeEnd, _ := qs.GetOK("timeEnd")
+	if err := o.bindTimeEnd(qTimeEnd, qhkTimeEnd, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qTimeStart, qhkTimeStart, _ := qs.GetOK("timeStart")
 	if err := o.bindTimeStart(qTimeStart, qhkTimeStart, route.Formats); err != nil {
 		res = append(res, err)
@@ -130,6 +228,60 @@ func (o *LogSearchParams) BindRequest(r *http.Request, route *middleware.Matched
 	return nil
 }
 
+// bindAPIName binds and validates parameter APIName from query.
+func (o *LogSearchParams) bindAPIName(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.APIName = &raw
+
+	return nil
+}
+
+// bindAccessKey binds and validates parameter AccessKey from query.
+func (o *LogSearchParams) bindAccessKey(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.AccessKey = &raw
+
+	return nil
+}
+
+// bindBucket binds and validates parameter Bucket from query.
+func (o *LogSearchParams) bindBucket(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Bucket = &raw
+
+	return nil
+}
+
 // bindFp binds and validates array parameter Fp from query.
 //
 // Arrays are parsed according to CollectionFormat: "multi" (defaults to "csv" when empty).
@@ -152,6 +304,48 @@ func (o *LogSearchParams) bindFp(rawData []string, hasKey bool, formats strfmt.R
 	return nil
 }
 
+// bindInterval binds and validates parameter Interval from query.
+func (o *LogSearchParams) bindInterval(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewLogSearchParams()
+		return nil
+	}
+
+	value, err := swag.ConvertInt32(raw)
+	if err != nil {
+		return errors.InvalidType("interval", "query", "int32", raw)
+	}
+	o.Interval = &value
+
+	return nil
+}
+
+// bindObject binds and validates parameter Object from query.
+func (o *LogSearchParams) bindObject(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Object = &raw
+
+	return nil
+}
+
 // bindOrder binds and validates parameter Order from query.
 func (o *LogSearchParams) bindOrder(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -233,6 +427,126 @@ func (o *LogSearchParams) bindPageSize(rawData []string, hasKey bool, formats st
 	return nil
 }
 
+// bindQ binds and validates parameter Q from query.
+func (o *LogSearchParams) bindQ(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewLogSearchParams()
+		return nil
+	}
+	o.Q = &raw
+
+	if err := o.validateQ(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateQ carries on validations for parameter Q
+func (o *LogSearchParams) validateQ(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("q", "query", *o.Q, []interface{}{"reqinfo", "raw", "statsinfo"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindResponseTimeMax binds and validates parameter ResponseTimeMax from query.
+func (o *LogSearchParams) bindResponseTimeMax(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("responseTimeMax", "query", "int64", raw)
+	}
+	o.ResponseTimeMax = &value
+
+	return nil
+}
+
+// bindResponseTimeMin binds and validates parameter ResponseTimeMin from query.
+func (o *LogSearchParams) bindResponseTimeMin(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("responseTimeMin", "query", "int64", raw)
+	}
+	o.ResponseTimeMin = &value
+
+	return nil
+}
+
+// bindStatusCode binds and validates parameter StatusCode from query.
+func (o *LogSearchParams) bindStatusCode(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt32(raw)
+	if err != nil {
+		return errors.InvalidType("statusCode", "query", "int32", raw)
+	}
+	o.StatusCode = &value
+
+	return nil
+}
+
+// bindTimeEnd binds and validates parameter TimeEnd from query.
+func (o *LogSearchParams) bindTimeEnd(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.TimeEnd = &raw
+
+	return nil
+}
+
 // bindTimeStart binds and validates parameter TimeStart from query.
 func (o *LogSearchParams) bindTimeStart(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
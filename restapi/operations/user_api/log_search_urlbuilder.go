@@ -32,11 +32,21 @@ import (
 
 // LogSearchURL generates an URL for the log search operation
 type LogSearchURL struct {
-	Fp        []string
-	Order     *string
-	PageNo    *int32
-	PageSize  *int32
-	TimeStart *string
+	APIName         *string
+	AccessKey       *string
+	Bucket          *string
+	Fp              []string
+	Interval        *int32
+	Object          *string
+	Order           *string
+	PageNo          *int32
+	PageSize        *int32
+	Q               *string
+	ResponseTimeMax *int64
+	ResponseTimeMin *int64
+	StatusCode      *int32
+	TimeEnd         *string
+	TimeStart       *string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -72,6 +82,30 @@ func (o *LogSearchURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
+	var apiNameQ string
+	if o.APIName != nil {
+		apiNameQ = *o.APIName
+	}
+	if apiNameQ != "" {
+		qs.Set("apiName", apiNameQ)
+	}
+
+	var accessKeyQ string
+	if o.AccessKey != nil {
+		accessKeyQ = *o.AccessKey
+	}
+	if accessKeyQ != "" {
+		qs.Set("accessKey", accessKeyQ)
+	}
+
+	var bucketQ string
+	if o.Bucket != nil {
+		bucketQ = *o.Bucket
+	}
+	if bucketQ != "" {
+		qs.Set("bucket", bucketQ)
+	}
+
 	var fpIR []string
 	for _, fpI := range o.Fp {
 		fpIS := fpI
@@ -86,6 +120,22 @@ func (o *LogSearchURL) Build() (*url.URL, error) {
 		qs.Add("fp", qsv)
 	}
 
+	var intervalQ string
+	if o.Interval != nil {
+		intervalQ = swag.FormatInt32(*o.Interval)
+	}
+	if intervalQ != "" {
+		qs.Set("interval", intervalQ)
+	}
+
+	var objectQ string
+	if o.Object != nil {
+		objectQ = *o.Object
+	}
+	if objectQ != "" {
+		qs.Set("object", objectQ)
+	}
+
 	var orderQ string
 	if o.Order != nil {
 		orderQ = *o.Order
@@ -110,6 +160,46 @@ func (o *LogSearchURL) Build() (*url.URL, error) {
 		qs.Set("pageSize", pageSizeQ)
 	}
 
+	var qQ string
+	if o.Q != nil {
+		qQ = *o.Q
+	}
+	if qQ != "" {
+		qs.Set("q", qQ)
+	}
+
+	var responseTimeMaxQ string
+	if o.ResponseTimeMax != nil {
+		responseTimeMaxQ = swag.FormatInt64(*o.ResponseTimeMax)
+	}
+	if responseTimeMaxQ != "" {
+		qs.Set("responseTimeMax", responseTimeMaxQ)
+	}
+
+	var responseTimeMinQ string
+	if o.ResponseTimeMin != nil {
+		responseTimeMinQ = swag.FormatInt64(*o.ResponseTimeMin)
+	}
+	if responseTimeMinQ != "" {
+		qs.Set("responseTimeMin", responseTimeMinQ)
+	}
+
+	var statusCodeQ string
+	if o.StatusCode != nil {
+		statusCodeQ = swag.FormatInt32(*o.StatusCode)
+	}
+	if statusCodeQ != "" {
+		qs.Set("statusCode", statusCodeQ)
+	}
+
+	var timeEndQ string
+	if o.TimeEnd != nil {
+		timeEndQ = *o.TimeEnd
+	}
+	if timeEndQ != "" {
+		qs.Set("timeEnd", timeEndQ)
+	}
+
 	var timeStartQ string
 	if o.TimeStart != nil {
 		timeStartQ = *o.TimeStart
@@ -19,6 +19,8 @@ package restapi
 import (
 	"context"
 	"errors"
+	"path"
+	"time"
 
 	"github.com/go-openapi/swag"
 
@@ -27,6 +29,7 @@ import (
 	"github.com/minio/console/restapi/operations/user_api"
 
 	"github.com/minio/madmin-go"
+	iampolicy "github.com/minio/pkg/iam/policy"
 
 	"github.com/minio/console/models"
 )
@@ -49,6 +52,42 @@ func registerBucketQuotaHandlers(api *operations.ConsoleAPI) {
 		}
 		return user_api.NewGetBucketQuotaOK().WithPayload(resp)
 	})
+
+	// list the quota and the usage of every bucket
+	api.UserAPIListBucketQuotasHandler = user_api.ListBucketQuotasHandlerFunc(func(params user_api.ListBucketQuotasParams, session *models.Principal) middleware.Responder {
+		resp, err := getListBucketQuotasResponse(session, params)
+		if err != nil {
+			return user_api.NewListBucketQuotasDefault(int(err.Code)).WithPayload(err)
+		}
+		return user_api.NewListBucketQuotasOK().WithPayload(resp)
+	})
+
+	// set the quota of the buckets matching a pattern
+	api.UserAPISetBucketQuotasHandler = user_api.SetBucketQuotasHandlerFunc(func(params user_api.SetBucketQuotasParams, session *models.Principal) middleware.Responder {
+		resp, err := getSetBucketQuotasResponse(session, params)
+		if err != nil {
+			return user_api.NewSetBucketQuotasDefault(int(err.Code)).WithPayload(err)
+		}
+		return user_api.NewSetBucketQuotasOK().WithPayload(resp)
+	})
+
+	// set bucket soft quota
+	api.UserAPISetBucketSoftQuotaHandler = user_api.SetBucketSoftQuotaHandlerFunc(func(params user_api.SetBucketSoftQuotaParams, session *models.Principal) middleware.Responder {
+		err := setBucketSoftQuotaResponse(session, params)
+		if err != nil {
+			return user_api.NewSetBucketSoftQuotaDefault(int(err.Code)).WithPayload(err)
+		}
+		return user_api.NewSetBucketSoftQuotaNoContent()
+	})
+
+	// remove bucket soft quota
+	api.UserAPIDeleteBucketSoftQuotaHandler = user_api.DeleteBucketSoftQuotaHandlerFunc(func(params user_api.DeleteBucketSoftQuotaParams, session *models.Principal) middleware.Responder {
+		err := deleteBucketSoftQuotaResponse(session, params)
+		if err != nil {
+			return user_api.NewDeleteBucketSoftQuotaDefault(int(err.Code)).WithPayload(err)
+		}
+		return user_api.NewDeleteBucketSoftQuotaNoContent()
+	})
 }
 
 func setBucketQuotaResponse(session *models.Principal, params user_api.SetBucketQuotaParams) *models.Error {
@@ -69,7 +108,7 @@ func setBucketQuotaResponse(session *models.Principal, params user_api.SetBucket
 	return nil
 }
 
-func setBucketQuota(ctx context.Context, ac *AdminClient, bucket *string, bucketQuota *models.SetBucketQuota) error {
+func setBucketQuota(ctx context.Context, ac MinioAdmin, bucket *string, bucketQuota *models.SetBucketQuota) error {
 	if bucketQuota == nil {
 		return errors.New("nil bucket quota was provided")
 	}
@@ -88,7 +127,7 @@ func setBucketQuota(ctx context.Context, ac *AdminClient, bucket *string, bucket
 			return err
 		}
 	} else {
-		if err := ac.Client.SetBucketQuota(ctx, *bucket, &madmin.BucketQuota{}); err != nil {
+		if err := ac.setBucketQuota(ctx, *bucket, &madmin.BucketQuota{}); err != nil {
 			return err
 		}
 	}
@@ -130,3 +169,175 @@ func getBucketQuota(ctx context.Context, ac *AdminClient, bucket *string) (*mode
 		Type:  string(quota.Type),
 	}, nil
 }
+
+func softQuotaFromModel(quota *models.BucketSoftQuota) SoftQuota {
+	return SoftQuota{Limit: *quota.Limit, Thresholds: quota.Thresholds}
+}
+
+// getBucketQuotasOverview returns the quota, the usage and the soft quota of
+// every bucket, the soft quotas are checked with the usage on the way
+func getBucketQuotasOverview(ctx context.Context, ac MinioAdmin, softQuotas *softQuotaManager, erasure bool, now time.Time) (*models.BucketQuotaOverview, error) {
+	info, err := ac.AccountInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	overview := &models.BucketQuotaOverview{Buckets: []*models.BucketQuotaUsage{}}
+	usage := map[string]int64{}
+	for _, bucket := range info.Buckets {
+		bucketUsage := &models.BucketQuotaUsage{
+			Bucket: bucket.Name,
+			Usage:  int64(bucket.Size),
+		}
+		usage[bucket.Name] = int64(bucket.Size)
+		if erasure {
+			quota, err := ac.getBucketQuota(ctx, bucket.Name)
+			if err != nil && madmin.ToErrorResponse(err).Code != "XMinioAdminNoSuchQuotaConfiguration" {
+				return nil, err
+			}
+			if quota.Quota > 0 {
+				bucketUsage.QuotaType = string(quota.Type)
+				bucketUsage.Quota = int64(quota.Quota)
+				bucketUsage.Percentage = 100 * float64(bucket.Size) / float64(quota.Quota)
+			}
+		}
+		softQuota, ok, err := softQuotas.get(bucket.Name)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			bucketUsage.SoftQuota = &models.BucketSoftQuota{Limit: swag.Int64(softQuota.Limit), Thresholds: softQuota.Thresholds}
+			bucketUsage.SoftQuotaPercentage, bucketUsage.SoftQuotaThreshold = softQuotaThreshold(softQuota, bucketUsage.Usage)
+		}
+		overview.Buckets = append(overview.Buckets, bucketUsage)
+	}
+	softQuotas.check(ctx, usage, now)
+	return overview, nil
+}
+
+func getListBucketQuotasResponse(session *models.Principal, params user_api.ListBucketQuotasParams) (*models.BucketQuotaOverview, *models.Error) {
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+
+	overview, err := getBucketQuotasOverview(params.HTTPRequest.Context(), adminClient, globalSoftQuotaManager, isErasureBackend(), time.Now())
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return overview, nil
+}
+
+// setBucketQuotas sets the quota and the soft quota of the buckets matching
+// the pattern, the buckets that fail report their error
+func setBucketQuotas(ctx context.Context, ac MinioAdmin, softQuotas *softQuotaManager, request *models.BucketQuotaBulkRequest) (*models.BucketQuotaBulkResponse, error) {
+	if request.Quota == nil && request.SoftQuota == nil {
+		return nil, errBucketQuotaBulkEmpty
+	}
+	if _, err := path.Match(*request.Pattern, ""); err != nil {
+		return nil, errInvalidBucketPattern
+	}
+	var softQuota SoftQuota
+	if request.SoftQuota != nil {
+		var err error
+		if softQuota, err = validateSoftQuota(softQuotaFromModel(request.SoftQuota)); err != nil {
+			return nil, err
+		}
+	}
+	info, err := ac.AccountInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	response := &models.BucketQuotaBulkResponse{Buckets: []*models.BucketQuotaBulkResult{}}
+	for _, bucket := range info.Buckets {
+		if matched, _ := path.Match(*request.Pattern, bucket.Name); !matched {
+			continue
+		}
+		name := bucket.Name
+		result := &models.BucketQuotaBulkResult{Bucket: name}
+		var err error
+		if request.Quota != nil {
+			err = setBucketQuota(ctx, ac, &name, request.Quota)
+		}
+		if err == nil && request.SoftQuota != nil {
+			err = softQuotas.set(name, softQuota)
+		}
+		if err != nil {
+			result.Error = err.Error()
+		}
+		response.Buckets = append(response.Buckets, result)
+	}
+	return response, nil
+}
+
+func getSetBucketQuotasResponse(session *models.Principal, params user_api.SetBucketQuotasParams) (*models.BucketQuotaBulkResponse, *models.Error) {
+	if params.Body.SoftQuota != nil && !sessionAllowsAction(session, iampolicy.SetBucketQuotaAdminAction) {
+		return nil, prepareError(errSoftQuotaNotAllowed)
+	}
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+
+	response, err := setBucketQuotas(params.HTTPRequest.Context(), adminClient, globalSoftQuotaManager, params.Body)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return response, nil
+}
+
+// checkSoftQuotaBucket fails when the session isn't allowed to manage the
+// quotas or the bucket doesn't exist, the soft quotas are kept by Console so
+// MinIO can't check them
+func checkSoftQuotaBucket(ctx context.Context, session *models.Principal, client MinioClient, bucketName string) error {
+	if !sessionAllowsAction(session, iampolicy.SetBucketQuotaAdminAction) {
+		return errSoftQuotaNotAllowed
+	}
+	exists, err := client.bucketExists(ctx, bucketName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return ErrorGenericNotFound
+	}
+	return nil
+}
+
+func setBucketSoftQuotaResponse(session *models.Principal, params user_api.SetBucketSoftQuotaParams) *models.Error {
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return prepareError(err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+	if err := checkSoftQuotaBucket(params.HTTPRequest.Context(), session, minioClient, params.Name); err != nil {
+		return prepareError(err)
+	}
+	if err := globalSoftQuotaManager.set(params.Name, softQuotaFromModel(params.Body)); err != nil {
+		return prepareError(err)
+	}
+	return nil
+}
+
+func deleteBucketSoftQuotaResponse(session *models.Principal, params user_api.DeleteBucketSoftQuotaParams) *models.Error {
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return prepareError(err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+	if err := checkSoftQuotaBucket(params.HTTPRequest.Context(), session, minioClient, params.Name); err != nil {
+		return prepareError(err)
+	}
+	if err := globalSoftQuotaManager.remove(params.Name); err != nil {
+		return prepareError(err)
+	}
+	return nil
+}
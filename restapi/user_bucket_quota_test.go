@@ -0,0 +1,207 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go"
+	"github.com/stretchr/testify/assert"
+)
+
+var minioGetBucketQuotaMock func(ctx context.Context, bucket string) (madmin.BucketQuota, error)
+var minioSetBucketQuotaMock func(ctx context.Context, bucket string, quota *madmin.BucketQuota) error
+
+// mock function of getBucketQuota()
+func (ac adminClientMock) getBucketQuota(ctx context.Context, bucket string) (madmin.BucketQuota, error) {
+	return minioGetBucketQuotaMock(ctx, bucket)
+}
+
+// mock function of setBucketQuota()
+func (ac adminClientMock) setBucketQuota(ctx context.Context, bucket string, quota *madmin.BucketQuota) error {
+	return minioSetBucketQuotaMock(ctx, bucket, quota)
+}
+
+func testQuotaAccountInfo(ctx context.Context) (madmin.AccountInfo, error) {
+	return madmin.AccountInfo{Buckets: []madmin.BucketAccessInfo{
+		{Name: "logs-2020", Size: 500},
+		{Name: "logs-2021", Size: 950},
+		{Name: "photos", Size: 100},
+	}}, nil
+}
+
+func TestSoftQuotaManagerCheck(t *testing.T) {
+	assert := assert.New(t)
+	defer useTempDataDir(t)()
+	var notified []*models.Alert
+	manager := newSoftQuotaManager(softQuotasFile, func(ctx context.Context, alert *models.Alert) {
+		notified = append(notified, alert)
+	})
+	// Test-1 : invalid soft quotas
+	assert.Equal(errInvalidSoftQuota, manager.set("logs", SoftQuota{}))
+	assert.Equal(errInvalidSoftQuota, manager.set("logs", SoftQuota{Limit: 1000, Thresholds: []int32{120}}))
+	// Test-2 : the default thresholds are used
+	if assert.NoError(manager.set("logs", SoftQuota{Limit: 1000})) {
+		quota, ok, err := manager.get("logs")
+		assert.NoError(err)
+		assert.True(ok)
+		assert.Equal([]int32{80, 90, 100}, quota.Thresholds)
+	}
+	now := time.Now()
+	// Test-3 : crossing thresholds notifies the highest one
+	manager.check(context.Background(), map[string]int64{"logs": 500}, now)
+	assert.Empty(notified)
+	manager.check(context.Background(), map[string]int64{"logs": 950}, now)
+	if assert.Equal(1, len(notified)) {
+		assert.Equal(models.AlertStateFiring, notified[0].State)
+		assert.Equal("90", notified[0].Labels["threshold"])
+		assert.Equal("logs", notified[0].Labels["bucket"])
+		assert.Equal(models.AlertRuleSeverityWarning, notified[0].Severity)
+	}
+	// Test-4 : the same threshold isn't notified twice, even after a restart
+	manager = newSoftQuotaManager(softQuotasFile, manager.notify)
+	manager.check(context.Background(), map[string]int64{"logs": 960}, now)
+	assert.Equal(1, len(notified))
+	manager.check(context.Background(), map[string]int64{"logs": 1200}, now)
+	if assert.Equal(2, len(notified)) {
+		assert.Equal("100", notified[1].Labels["threshold"])
+		assert.Equal(models.AlertRuleSeverityCritical, notified[1].Severity)
+	}
+	// Test-5 : going down a threshold is silent, going under all of them resolves
+	manager.check(context.Background(), map[string]int64{"logs": 850}, now)
+	assert.Equal(2, len(notified))
+	manager.check(context.Background(), map[string]int64{"logs": 100}, now)
+	if assert.Equal(3, len(notified)) {
+		assert.Equal(models.AlertStateResolved, notified[2].State)
+		assert.Equal("80", notified[2].Labels["threshold"])
+	}
+	// Test-6 : removing soft quotas
+	assert.NoError(manager.remove("logs"))
+	assert.Equal(ErrorGenericNotFound, manager.remove("logs"))
+}
+
+func TestGetBucketQuotasOverview(t *testing.T) {
+	assert := assert.New(t)
+	defer useTempDataDir(t)()
+	adminClient := adminClientMock{}
+	var notified []*models.Alert
+	manager := newSoftQuotaManager(softQuotasFile, func(ctx context.Context, alert *models.Alert) {
+		notified = append(notified, alert)
+	})
+	assert.NoError(manager.set("logs-2021", SoftQuota{Limit: 1000, Thresholds: []int32{90}}))
+	minioAccountInfoMock = testQuotaAccountInfo
+	minioGetBucketQuotaMock = func(ctx context.Context, bucket string) (madmin.BucketQuota, error) {
+		if bucket == "photos" {
+			return madmin.BucketQuota{}, madmin.ErrorResponse{Code: "XMinioAdminNoSuchQuotaConfiguration"}
+		}
+		return madmin.BucketQuota{Quota: 2000, Type: madmin.HardQuota}, nil
+	}
+	// Test-1 : quota, usage and soft quota of every bucket
+	overview, err := getBucketQuotasOverview(context.Background(), adminClient, manager, true, time.Now())
+	if assert.NoError(err) && assert.Equal(3, len(overview.Buckets)) {
+		logs := overview.Buckets[1]
+		assert.Equal("hard", logs.QuotaType)
+		assert.Equal(int64(2000), logs.Quota)
+		assert.Equal(47.5, logs.Percentage)
+		assert.Equal(int64(1000), *logs.SoftQuota.Limit)
+		assert.Equal(95.0, logs.SoftQuotaPercentage)
+		assert.Equal(int32(90), logs.SoftQuotaThreshold)
+		assert.Nil(overview.Buckets[0].SoftQuota)
+		assert.Equal(int64(0), overview.Buckets[2].Quota)
+		assert.Equal(int64(100), overview.Buckets[2].Usage)
+	}
+	// the soft quota is checked with the usage
+	assert.Equal(1, len(notified))
+	// Test-2 : errors getting the quotas are returned
+	minioGetBucketQuotaMock = func(ctx context.Context, bucket string) (madmin.BucketQuota, error) {
+		return madmin.BucketQuota{}, errors.New("access denied")
+	}
+	_, err = getBucketQuotasOverview(context.Background(), adminClient, manager, true, time.Now())
+	assert.Error(err)
+	// Test-3 : quotas aren't available without erasure coding
+	overview, err = getBucketQuotasOverview(context.Background(), adminClient, manager, false, time.Now())
+	if assert.NoError(err) {
+		assert.Equal(int64(0), overview.Buckets[1].Quota)
+	}
+}
+
+var minioBucketExistsMock func(ctx context.Context, bucketName string) (bool, error)
+
+// mock function of bucketExists()
+func (mc minioClientMock) bucketExists(ctx context.Context, bucketName string) (bool, error) {
+	return minioBucketExistsMock(ctx, bucketName)
+}
+
+func TestCheckSoftQuotaBucket(t *testing.T) {
+	assert := assert.New(t)
+	client := minioClientMock{}
+	minioBucketExistsMock = func(ctx context.Context, bucketName string) (bool, error) {
+		return bucketName == "logs", nil
+	}
+	session := &models.Principal{Actions: []string{"admin:SetBucketQuota"}}
+	// Test-1 : allowed session on an existing bucket
+	assert.NoError(checkSoftQuotaBucket(context.Background(), session, client, "logs"))
+	// Test-2 : the bucket must exist
+	assert.Equal(ErrorGenericNotFound, checkSoftQuotaBucket(context.Background(), session, client, "gone"))
+	// Test-3 : the session must be allowed to set quotas
+	assert.Equal(errSoftQuotaNotAllowed, checkSoftQuotaBucket(context.Background(), &models.Principal{Actions: []string{"s3:*"}}, client, "logs"))
+	assert.NoError(checkSoftQuotaBucket(context.Background(), &models.Principal{Actions: []string{"admin:*"}}, client, "logs"))
+}
+
+func TestSetBucketQuotas(t *testing.T) {
+	assert := assert.New(t)
+	defer useTempDataDir(t)()
+	adminClient := adminClientMock{}
+	manager := newSoftQuotaManager(softQuotasFile, func(ctx context.Context, alert *models.Alert) {})
+	minioAccountInfoMock = testQuotaAccountInfo
+	quotas := map[string]madmin.BucketQuota{}
+	minioSetBucketQuotaMock = func(ctx context.Context, bucket string, quota *madmin.BucketQuota) error {
+		if bucket == "logs-2020" {
+			return errors.New("access denied")
+		}
+		quotas[bucket] = *quota
+		return nil
+	}
+	// Test-1 : the buckets matching the pattern are updated
+	response, err := setBucketQuotas(context.Background(), adminClient, manager, &models.BucketQuotaBulkRequest{
+		Pattern:   swag.String("logs-*"),
+		Quota:     &models.SetBucketQuota{Enabled: swag.Bool(true), QuotaType: models.SetBucketQuotaQuotaTypeHard, Amount: 4096},
+		SoftQuota: &models.BucketSoftQuota{Limit: swag.Int64(2048)},
+	})
+	if assert.NoError(err) && assert.Equal(2, len(response.Buckets)) {
+		assert.Equal("logs-2020", response.Buckets[0].Bucket)
+		assert.Equal("access denied", response.Buckets[0].Error)
+		assert.Empty(response.Buckets[1].Error)
+		assert.Equal(madmin.BucketQuota{Quota: 4096, Type: madmin.HardQuota}, quotas["logs-2021"])
+		_, ok, _ := manager.get("logs-2021")
+		assert.True(ok)
+		_, ok, _ = manager.get("logs-2020")
+		assert.False(ok)
+	}
+	// Test-2 : invalid requests
+	_, err = setBucketQuotas(context.Background(), adminClient, manager, &models.BucketQuotaBulkRequest{Pattern: swag.String("logs-*")})
+	assert.Equal(errBucketQuotaBulkEmpty, err)
+	_, err = setBucketQuotas(context.Background(), adminClient, manager, &models.BucketQuotaBulkRequest{Pattern: swag.String("logs-["), SoftQuota: &models.BucketSoftQuota{Limit: swag.Int64(1)}})
+	assert.Equal(errInvalidBucketPattern, err)
+	_, err = setBucketQuotas(context.Background(), adminClient, manager, &models.BucketQuotaBulkRequest{Pattern: swag.String("*"), SoftQuota: &models.BucketSoftQuota{Limit: swag.Int64(0)}})
+	assert.Equal(errInvalidSoftQuota, err)
+}
@@ -0,0 +1,274 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/minio/console/models"
+)
+
+const softQuotasFile = "soft-quotas.json"
+
+// softQuotaAlertRuleID identifies the soft quota notifications sent to the alert targets
+const softQuotaAlertRuleID = "bucket-soft-quota"
+
+// defaultSoftQuotaThresholds are the usage percentages notified when none are set
+var defaultSoftQuotaThresholds = []int32{80, 90, 100}
+
+// SoftQuota is a bucket size that isn't enforced by MinIO, the alert targets
+// are notified when the usage crosses one of the thresholds
+type SoftQuota struct {
+	Limit      int64
+	Thresholds []int32
+	// Notified is the highest threshold notified so far, it survives restarts so
+	// the same threshold isn't notified twice
+	Notified int32
+}
+
+// softQuotaManager keeps the soft quotas of the buckets in a file of the
+// Console data directory
+type softQuotaManager struct {
+	sync.Mutex
+	file   string
+	loaded bool
+	quotas map[string]SoftQuota
+	notify func(ctx context.Context, alert *models.Alert)
+}
+
+var globalSoftQuotaManager = newSoftQuotaManager(softQuotasFile, notifyAlertTargets)
+
+func newSoftQuotaManager(file string, notify func(ctx context.Context, alert *models.Alert)) *softQuotaManager {
+	return &softQuotaManager{
+		file:   file,
+		quotas: map[string]SoftQuota{},
+		notify: notify,
+	}
+}
+
+// notifyAlertTargets sends an alert to every alert target
+func notifyAlertTargets(ctx context.Context, alert *models.Alert) {
+	targets, err := globalAlertManager.listTargets()
+	if err != nil {
+		LogError("unable to load alert targets: %v", err)
+		return
+	}
+	for _, target := range targets {
+		if err := globalAlertManager.notify(ctx, target, alert); err != nil {
+			LogError("unable to notify alert %s to %s: %v", alert.RuleID, target.Name, err)
+		}
+	}
+}
+
+// load reads the soft quotas file the first time it's needed, callers must hold the lock
+func (m *softQuotaManager) load() error {
+	if m.loaded {
+		return nil
+	}
+	quotas := map[string]SoftQuota{}
+	if err := readDataFile(m.file, &quotas); err != nil {
+		return err
+	}
+	m.quotas = quotas
+	m.loaded = true
+	return nil
+}
+
+func (m *softQuotaManager) get(bucket string) (SoftQuota, bool, error) {
+	m.Lock()
+	defer m.Unlock()
+	if err := m.load(); err != nil {
+		return SoftQuota{}, false, err
+	}
+	quota, ok := m.quotas[bucket]
+	return quota, ok, nil
+}
+
+// validateSoftQuota checks a soft quota and sorts its thresholds, the default
+// thresholds are used when none are set
+func validateSoftQuota(quota SoftQuota) (SoftQuota, error) {
+	if quota.Limit <= 0 {
+		return quota, errInvalidSoftQuota
+	}
+	thresholds := append([]int32{}, quota.Thresholds...)
+	if len(thresholds) == 0 {
+		thresholds = append(thresholds, defaultSoftQuotaThresholds...)
+	}
+	for _, threshold := range thresholds {
+		if threshold <= 0 || threshold > 100 {
+			return quota, errInvalidSoftQuota
+		}
+	}
+	sort.Slice(thresholds, func(i, j int) bool { return thresholds[i] < thresholds[j] })
+	quota.Thresholds = thresholds
+	return quota, nil
+}
+
+// set stores the soft quota of a bucket, the notified threshold is kept when
+// the limit doesn't change
+func (m *softQuotaManager) set(bucket string, quota SoftQuota) error {
+	m.Lock()
+	defer m.Unlock()
+	if err := m.load(); err != nil {
+		return err
+	}
+	quota, err := validateSoftQuota(quota)
+	if err != nil {
+		return err
+	}
+	quotas := m.copy()
+	if previous, ok := quotas[bucket]; ok && previous.Limit == quota.Limit {
+		quota.Notified = previous.Notified
+	}
+	quotas[bucket] = quota
+	return m.save(quotas)
+}
+
+func (m *softQuotaManager) remove(bucket string) error {
+	m.Lock()
+	defer m.Unlock()
+	if err := m.load(); err != nil {
+		return err
+	}
+	if _, ok := m.quotas[bucket]; !ok {
+		return ErrorGenericNotFound
+	}
+	quotas := m.copy()
+	delete(quotas, bucket)
+	return m.save(quotas)
+}
+
+// copy returns a copy of the soft quotas, callers must hold the lock
+func (m *softQuotaManager) copy() map[string]SoftQuota {
+	quotas := map[string]SoftQuota{}
+	for bucket, quota := range m.quotas {
+		quotas[bucket] = quota
+	}
+	return quotas
+}
+
+// save writes the given soft quotas and makes them the current ones, callers must hold the lock
+func (m *softQuotaManager) save(quotas map[string]SoftQuota) error {
+	if err := writeDataFile(m.file, quotas); err != nil {
+		return err
+	}
+	m.quotas = quotas
+	return nil
+}
+
+// softQuotaThreshold returns the highest threshold crossed by the usage
+func softQuotaThreshold(quota SoftQuota, usage int64) (percentage float64, threshold int32) {
+	percentage = 100 * float64(usage) / float64(quota.Limit)
+	for _, t := range quota.Thresholds {
+		if percentage >= float64(t) {
+			threshold = t
+		}
+	}
+	return percentage, threshold
+}
+
+// check compares the usage of the buckets with their soft quotas, the alert
+// targets are notified when a higher threshold is crossed and when the usage
+// goes back under every threshold. Buckets without usage are left untouched
+func (m *softQuotaManager) check(ctx context.Context, usage map[string]int64, now time.Time) {
+	m.Lock()
+	if err := m.load(); err != nil {
+		m.Unlock()
+		LogError("unable to load soft quotas: %v", err)
+		return
+	}
+	var alerts []*models.Alert
+	changed := false
+	quotas := m.copy()
+	for bucket, quota := range quotas {
+		size, ok := usage[bucket]
+		if !ok {
+			continue
+		}
+		percentage, threshold := softQuotaThreshold(quota, size)
+		if threshold == quota.Notified {
+			continue
+		}
+		notified := quota.Notified
+		quota.Notified = threshold
+		quotas[bucket] = quota
+		changed = true
+		// the usage went down but is still above a lower threshold, that
+		// threshold is notified again once the usage goes back up
+		if threshold != 0 && threshold < notified {
+			continue
+		}
+		alert := &models.Alert{
+			RuleID:   softQuotaAlertRuleID,
+			RuleName: "Bucket soft quota",
+			Severity: models.AlertRuleSeverityWarning,
+			State:    models.AlertStateFiring,
+			Labels:   map[string]string{"bucket": bucket, "threshold": strconv.Itoa(int(threshold))},
+			Value:    percentage,
+			ActiveAt: now.Format(time.RFC3339),
+			FiredAt:  now.Format(time.RFC3339),
+		}
+		if threshold >= 100 {
+			alert.Severity = models.AlertRuleSeverityCritical
+		}
+		if threshold == 0 {
+			alert.State = models.AlertStateResolved
+			alert.Labels["threshold"] = strconv.Itoa(int(notified))
+			alert.FiredAt = ""
+			alert.ResolvedAt = now.Format(time.RFC3339)
+		}
+		alerts = append(alerts, alert)
+	}
+	if changed {
+		if err := m.save(quotas); err != nil {
+			LogError("unable to save soft quotas: %v", err)
+		}
+	}
+	m.Unlock()
+
+	for _, alert := range alerts {
+		m.notify(ctx, alert)
+	}
+}
+
+// startSoftQuotaMonitor checks the soft quotas in the background with the
+// bucket usage of the cluster metrics
+func startSoftQuotaMonitor() {
+	go func() {
+		ticker := time.NewTicker(getAlertsEvaluationInterval())
+		defer ticker.Stop()
+		for now := range ticker.C {
+			samples, err := queryMetrics(context.Background(), `max(minio_bucket_usage_total_bytes{job="${jobid}"}) by (bucket)`)
+			if err != nil {
+				LogError("unable to get the bucket usage: %v", err)
+				continue
+			}
+			if len(samples) == 0 {
+				continue
+			}
+			usage := map[string]int64{}
+			for _, sample := range samples {
+				usage[sample.Labels["bucket"]] = int64(sample.Value)
+			}
+			globalSoftQuotaManager.check(context.Background(), usage, now)
+		}
+	}()
+}
@@ -55,6 +55,11 @@ func registerBucketsHandlers(api *operations.ConsoleAPI) {
 		if err := getMakeBucketResponse(session, params.Body); err != nil {
 			return user_api.NewMakeBucketDefault(int(err.Code)).WithPayload(err)
 		}
+		publishConsoleEvent(session.AccountAccessKey, consoleEventBucketCreated, bucketCreatedEvent{
+			Bucket:     swag.StringValue(params.Body.Name),
+			Locking:    params.Body.Locking,
+			Versioning: params.Body.Versioning,
+		})
 		return user_api.NewMakeBucketCreated()
 	})
 	// delete bucket
@@ -80,6 +85,11 @@ func registerBucketsHandlers(api *operations.ConsoleAPI) {
 		if err != nil {
 			return user_api.NewBucketSetPolicyDefault(int(err.Code)).WithPayload(err)
 		}
+		publishConsoleEvent(session.AccountAccessKey, consoleEventPolicyChanged, policyChangedEvent{
+			Action: policyChangedBucketAccess,
+			Bucket: params.Name,
+			Access: string(*params.Body.Access),
+		})
 		return user_api.NewBucketSetPolicyOK().WithPayload(bucketSetPolicyResp)
 	})
 	// get bucket versioning
@@ -0,0 +1,428 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/user_api"
+	"github.com/minio/madmin-go"
+	"github.com/minio/minio-go/v7"
+)
+
+// bucketAnalyticsScanLimit is the maximum number of object versions listed by an analytics job
+const bucketAnalyticsScanLimit = 1000000
+
+// bucketAnalyticsTimeout bounds every analytics listing
+const bucketAnalyticsTimeout = 30 * time.Minute
+
+// bucketAnalyticsCacheTTL is for how long the result of a listing is served
+// before the next request lists the bucket again
+const bucketAnalyticsCacheTTL = time.Hour
+
+// bucketAnalyticsConcurrency is the number of buckets listed at the same time
+const bucketAnalyticsConcurrency = 2
+
+// bucketAnalyticsCacheSize is the number of listings kept, the oldest finished
+// ones are evicted first
+const bucketAnalyticsCacheSize = 100
+
+// bucketAnalyticsTopPrefixes is the number of prefixes reported by size and by count
+const bucketAnalyticsTopPrefixes = 10
+
+const (
+	bucketAnalyticsSourceDataUsage = "data-usage"
+	bucketAnalyticsSourceListing   = "listing"
+	// bucketAnalyticsSourceMixed is for the totals and the histogram of the
+	// data usage with the versions, prefixes and storage classes of a listing
+	bucketAnalyticsSourceMixed = "data-usage-and-listing"
+)
+
+const (
+	bucketAnalyticsQueued  = "queued"
+	bucketAnalyticsRunning = "running"
+	bucketAnalyticsReady   = "ready"
+	bucketAnalyticsFailed  = "failed"
+)
+
+// sizeHistogramInterval is an interval of the object sizes histogram, the
+// names are the ones used by the MinIO data usage info, empty for the
+// intervals it doesn't have
+type sizeHistogramInterval struct {
+	name  string
+	label string
+	min   int64
+	// max is excluded, 0 when the interval has no upper bound
+	max int64
+}
+
+var sizeHistogramIntervals = []sizeHistogramInterval{
+	{name: "LESS_THAN_1024_B", label: "<1KiB", min: 0, max: 1024},
+	{name: "BETWEEN_1024_B_AND_1_MB", label: "1KiB-1MiB", min: 1024, max: 1024 * 1024},
+	{name: "BETWEEN_1_MB_AND_10_MB", label: "1MiB-10MiB", min: 1024 * 1024, max: 10 * 1024 * 1024},
+	{name: "BETWEEN_10_MB_AND_64_MB", label: "10MiB-64MiB", min: 10 * 1024 * 1024, max: 64 * 1024 * 1024},
+	{name: "BETWEEN_64_MB_AND_128_MB", label: "64MiB-128MiB", min: 64 * 1024 * 1024, max: 128 * 1024 * 1024},
+	{name: "BETWEEN_128_MB_AND_512_MB", label: "128MiB-512MiB", min: 128 * 1024 * 1024, max: 512 * 1024 * 1024},
+	{label: "512MiB-1GiB", min: 512 * 1024 * 1024, max: 1024 * 1024 * 1024},
+	{label: ">1GiB", min: 1024 * 1024 * 1024},
+}
+
+// dataUsageHistogramIntervals are the intervals of the MinIO data usage info,
+// which doesn't split the sizes above 512MiB
+var dataUsageHistogramIntervals = append(sizeHistogramIntervals[:6:6], sizeHistogramInterval{name: "GREATER_THAN_512_MB", label: ">512MiB", min: 512 * 1024 * 1024})
+
+func registerBucketAnalyticsHandlers(api *operations.ConsoleAPI) {
+	// get the analytics of a bucket
+	api.UserAPIGetBucketAnalyticsHandler = user_api.GetBucketAnalyticsHandlerFunc(func(params user_api.GetBucketAnalyticsParams, session *models.Principal) middleware.Responder {
+		analytics, err := getBucketAnalyticsResponse(session, params)
+		if err != nil {
+			return user_api.NewGetBucketAnalyticsDefault(int(err.Code)).WithPayload(err)
+		}
+		return user_api.NewGetBucketAnalyticsOK().WithPayload(analytics)
+	})
+}
+
+// newSizeHistogram returns the histogram intervals without objects
+func newSizeHistogram(intervals []sizeHistogramInterval) []*models.SizeHistogramBucket {
+	var histogram []*models.SizeHistogramBucket
+	for _, interval := range intervals {
+		histogram = append(histogram, &models.SizeHistogramBucket{
+			Label: interval.label,
+			Min:   interval.min,
+			Max:   interval.max,
+		})
+	}
+	return histogram
+}
+
+// sizeHistogramIndex returns the interval of the histogram the size falls in
+func sizeHistogramIndex(size int64) int {
+	for i, interval := range sizeHistogramIntervals {
+		if interval.max == 0 || size < interval.max {
+			return i
+		}
+	}
+	return len(sizeHistogramIntervals) - 1
+}
+
+// bucketAnalyticsPrefix is the top level prefix of the key, empty for the
+// objects at the root of the bucket
+func bucketAnalyticsPrefix(key string) string {
+	if i := strings.Index(key, "/"); i >= 0 {
+		return key[:i+1]
+	}
+	return ""
+}
+
+// topPrefixes returns the first prefixes sorted by less
+func topPrefixes(prefixes map[string]*models.PrefixUsage, less func(a, b *models.PrefixUsage) bool) []*models.PrefixUsage {
+	top := []*models.PrefixUsage{}
+	for _, usage := range prefixes {
+		top = append(top, usage)
+	}
+	sort.Slice(top, func(i, j int) bool {
+		if less(top[i], top[j]) {
+			return true
+		}
+		if less(top[j], top[i]) {
+			return false
+		}
+		return top[i].Prefix < top[j].Prefix
+	})
+	if len(top) > bucketAnalyticsTopPrefixes {
+		top = top[:bucketAnalyticsTopPrefixes]
+	}
+	return top
+}
+
+// listBucketAnalytics lists up to limit versions of the bucket and computes
+// the analytics of its latest versions, noncurrent versions are counted
+// and their size is accounted to their prefix and storage class
+func listBucketAnalytics(ctx context.Context, client MinioClient, bucketName string, limit int64) (*models.BucketAnalytics, error) {
+	analytics := &models.BucketAnalytics{
+		Bucket:    bucketName,
+		Source:    bucketAnalyticsSourceListing,
+		Histogram: newSizeHistogram(sizeHistogramIntervals),
+	}
+	prefixes := map[string]*models.PrefixUsage{}
+	classes := map[string]*models.StorageClassUsage{}
+	scanned, truncated, err := forEachObjectVersions(ctx, client, bucketName, "", limit, func(versions []minio.ObjectInfo) error {
+		prefix := bucketAnalyticsPrefix(versions[0].Key)
+		prefixUsage, ok := prefixes[prefix]
+		if !ok {
+			prefixUsage = &models.PrefixUsage{Prefix: prefix}
+			prefixes[prefix] = prefixUsage
+		}
+		for i, version := range versions {
+			if version.IsDeleteMarker {
+				analytics.DeleteMarkers++
+				continue
+			}
+			storageClass := version.StorageClass
+			if storageClass == "" {
+				storageClass = "STANDARD"
+			}
+			classUsage, ok := classes[storageClass]
+			if !ok {
+				classUsage = &models.StorageClassUsage{StorageClass: storageClass}
+				classes[storageClass] = classUsage
+			}
+			classUsage.Size += version.Size
+			analytics.Versions++
+			prefixUsage.Versions++
+			prefixUsage.Size += version.Size
+			if i > 0 {
+				continue
+			}
+			classUsage.Objects++
+			analytics.Objects++
+			analytics.Size += version.Size
+			prefixUsage.Objects++
+			analytics.Histogram[sizeHistogramIndex(version.Size)].Objects++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	analytics.Scanned = scanned
+	analytics.Truncated = truncated
+	analytics.TopPrefixesBySize = topPrefixes(prefixes, func(a, b *models.PrefixUsage) bool { return a.Size > b.Size })
+	analytics.TopPrefixesByCount = topPrefixes(prefixes, func(a, b *models.PrefixUsage) bool { return a.Objects > b.Objects })
+	analytics.StorageClasses = []*models.StorageClassUsage{}
+	for _, usage := range classes {
+		analytics.StorageClasses = append(analytics.StorageClasses, usage)
+	}
+	sort.Slice(analytics.StorageClasses, func(i, j int) bool {
+		return analytics.StorageClasses[i].StorageClass < analytics.StorageClasses[j].StorageClass
+	})
+	return analytics, nil
+}
+
+// bucketAnalyticsJob is the listing of a bucket, the result is kept until a
+// newer listing of the bucket completes
+type bucketAnalyticsJob struct {
+	status  string
+	err     string
+	started time.Time
+	updated time.Time
+	result  *models.BucketAnalytics
+}
+
+func (job *bucketAnalyticsJob) inProgress() bool {
+	return job.status == bucketAnalyticsQueued || job.status == bucketAnalyticsRunning
+}
+
+// bucketAnalyticsManager runs the analytics listings in the background and
+// caches their results, at most bucketAnalyticsConcurrency at the same time
+// and size listings in the cache
+type bucketAnalyticsManager struct {
+	sync.Mutex
+	jobs  map[string]*bucketAnalyticsJob
+	slots chan struct{}
+	size  int
+	limit int64
+}
+
+var globalBucketAnalytics = newBucketAnalyticsManager(bucketAnalyticsConcurrency, bucketAnalyticsCacheSize, bucketAnalyticsScanLimit)
+
+func newBucketAnalyticsManager(concurrency, size int, limit int64) *bucketAnalyticsManager {
+	return &bucketAnalyticsManager{
+		jobs:  map[string]*bucketAnalyticsJob{},
+		slots: make(chan struct{}, concurrency),
+		size:  size,
+		limit: limit,
+	}
+}
+
+// evict drops the finished listings older than the cache TTL, then the oldest
+// finished ones until there is room for a new listing. Callers must hold the lock
+func (m *bucketAnalyticsManager) evict(now time.Time) error {
+	for key, job := range m.jobs {
+		if !job.inProgress() && now.Sub(job.started) >= bucketAnalyticsCacheTTL {
+			delete(m.jobs, key)
+		}
+	}
+	for len(m.jobs) >= m.size {
+		oldest := ""
+		for key, job := range m.jobs {
+			if !job.inProgress() && (oldest == "" || job.started.Before(m.jobs[oldest].started)) {
+				oldest = key
+			}
+		}
+		if oldest == "" {
+			return errBucketAnalyticsBusy
+		}
+		delete(m.jobs, oldest)
+	}
+	return nil
+}
+
+// get returns a copy of the job of the key, a new listing is started when
+// there is none, the last one is older than the cache TTL or a refresh is
+// requested and no listing is in progress
+func (m *bucketAnalyticsManager) get(key string, client MinioClient, bucketName string, refresh bool, now time.Time) (bucketAnalyticsJob, error) {
+	m.Lock()
+	defer m.Unlock()
+	job, ok := m.jobs[key]
+	if !ok {
+		if err := m.evict(now); err != nil {
+			return bucketAnalyticsJob{}, err
+		}
+		job = &bucketAnalyticsJob{}
+		m.jobs[key] = job
+	}
+	if !job.inProgress() && (job.status == "" || refresh || now.Sub(job.started) >= bucketAnalyticsCacheTTL) {
+		job.status = bucketAnalyticsQueued
+		job.err = ""
+		job.started = now
+		go m.run(job, client, bucketName)
+	}
+	return *job, nil
+}
+
+// cached returns a copy of the job of the key without starting a listing, an
+// empty job when there is none or it's older than the cache TTL
+func (m *bucketAnalyticsManager) cached(key string, now time.Time) bucketAnalyticsJob {
+	m.Lock()
+	defer m.Unlock()
+	job, ok := m.jobs[key]
+	if !ok || (!job.inProgress() && now.Sub(job.started) >= bucketAnalyticsCacheTTL) {
+		return bucketAnalyticsJob{}
+	}
+	return *job
+}
+
+// run lists the bucket once a slot is available and stores the result in the job
+func (m *bucketAnalyticsManager) run(job *bucketAnalyticsJob, client MinioClient, bucketName string) {
+	m.slots <- struct{}{}
+	defer func() { <-m.slots }()
+	m.Lock()
+	job.status = bucketAnalyticsRunning
+	m.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), bucketAnalyticsTimeout)
+	defer cancel()
+	result, err := listBucketAnalytics(ctx, client, bucketName, m.limit)
+
+	m.Lock()
+	defer m.Unlock()
+	job.updated = time.Now()
+	if err != nil {
+		LogError("unable to list the analytics of bucket %s: %v", bucketName, err)
+		job.status = bucketAnalyticsFailed
+		job.err = err.Error()
+		return
+	}
+	job.status = bucketAnalyticsReady
+	job.result = result
+}
+
+// applyDataUsage replaces the object count, size and histogram of the bucket
+// with the ones of the MinIO data usage info, those cover the whole bucket
+// while the listing might be truncated. The result is mixed when the other
+// values come from a listing
+func applyDataUsage(analytics *models.BucketAnalytics, usage madmin.BucketUsageInfo, listed bool) {
+	analytics.Source = bucketAnalyticsSourceDataUsage
+	if listed {
+		analytics.Source = bucketAnalyticsSourceMixed
+	}
+	analytics.Objects = int64(usage.ObjectsCount)
+	analytics.Size = int64(usage.Size)
+	analytics.Histogram = newSizeHistogram(dataUsageHistogramIntervals)
+	for i, interval := range dataUsageHistogramIntervals {
+		analytics.Histogram[i].Objects = int64(usage.ObjectSizesHistogram[interval.name])
+	}
+}
+
+// getBucketAnalytics returns the analytics of the bucket. The MinIO data usage
+// info, which requires admin privileges, gives the totals and the histogram
+// without listing the bucket. The bucket is only listed when the data usage
+// isn't available or a refresh is requested, the listing gives the versions,
+// the prefixes and the storage classes
+func getBucketAnalytics(ctx context.Context, client MinioClient, adminClient MinioAdmin, manager *bucketAnalyticsManager, key, bucketName string, refresh bool, now time.Time) (*models.BucketAnalytics, error) {
+	var bucketUsage *madmin.BucketUsageInfo
+	if adminClient != nil {
+		if usage, err := adminClient.dataUsageInfo(ctx); err == nil {
+			if info, ok := usage.BucketsUsage[bucketName]; ok {
+				bucketUsage = &info
+			}
+		}
+	}
+	var job bucketAnalyticsJob
+	if bucketUsage == nil || refresh {
+		var err error
+		if job, err = manager.get(key, client, bucketName, refresh, now); err != nil {
+			return nil, err
+		}
+	} else {
+		job = manager.cached(key, now)
+	}
+	analytics := &models.BucketAnalytics{
+		Bucket:             bucketName,
+		Source:             bucketAnalyticsSourceListing,
+		Histogram:          newSizeHistogram(sizeHistogramIntervals),
+		TopPrefixesBySize:  []*models.PrefixUsage{},
+		TopPrefixesByCount: []*models.PrefixUsage{},
+		StorageClasses:     []*models.StorageClassUsage{},
+	}
+	if job.result != nil {
+		copied := *job.result
+		analytics = &copied
+	}
+	analytics.Status = job.status
+	analytics.Error = job.err
+	if !job.updated.IsZero() {
+		analytics.Updated = job.updated.UTC().Format(time.RFC3339)
+	}
+	if bucketUsage != nil {
+		applyDataUsage(analytics, *bucketUsage, job.result != nil)
+	}
+	return analytics, nil
+}
+
+func getBucketAnalyticsResponse(session *models.Principal, params user_api.GetBucketAnalyticsParams) (*models.BucketAnalytics, *models.Error) {
+	ctx := context.Background()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+
+	// the data usage info is optional, it's only available to admins
+	var adminClient MinioAdmin
+	if mAdmin, err := NewMinioAdminClient(session); err == nil {
+		adminClient = AdminClient{Client: mAdmin}
+	}
+	refresh := params.Refresh != nil && *params.Refresh
+	// the results are cached per user since they depend on what the user can list
+	key := session.AccountAccessKey + "/" + params.BucketName
+	analytics, err := getBucketAnalytics(ctx, minioClient, adminClient, globalBucketAnalytics, key, params.BucketName, refresh, time.Now())
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return analytics, nil
+}
@@ -0,0 +1,160 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/minio/madmin-go"
+	"github.com/stretchr/testify/assert"
+)
+
+var minioDataUsageInfoMock func(ctx context.Context) (madmin.DataUsageInfo, error)
+
+// mock function of dataUsageInfo()
+func (ac adminClientMock) dataUsageInfo(ctx context.Context) (madmin.DataUsageInfo, error) {
+	return minioDataUsageInfoMock(ctx)
+}
+
+func TestListBucketAnalytics(t *testing.T) {
+	assert := assert.New(t)
+	client := minioClientMock{}
+	objects := versionsTestObjects(time.Now())
+	objects[0].Size = 2048
+	objects[1].StorageClass = "WARM-TIER"
+	minioListObjectsMock = mockReplicationListing(objects)
+	analytics, err := listBucketAnalytics(context.Background(), client, "bucket", 0)
+	if assert.NoError(err) {
+		// Test-1 : latest versions are the objects, delete markers hide them
+		assert.Equal(int64(8), analytics.Scanned)
+		assert.Equal(int64(2), analytics.Objects)
+		assert.Equal(int64(6), analytics.Versions)
+		assert.Equal(int64(2), analytics.DeleteMarkers)
+		assert.Equal(int64(2049), analytics.Size)
+		assert.Equal(int64(1), analytics.Histogram[0].Objects)
+		assert.Equal(int64(1), analytics.Histogram[1].Objects)
+		// Test-2 : prefixes by size and by count
+		if assert.Len(analytics.TopPrefixesBySize, 2) {
+			assert.Equal("logs/", analytics.TopPrefixesBySize[0].Prefix)
+			assert.Equal(int64(2113), analytics.TopPrefixesBySize[0].Size)
+			assert.Equal(int64(5), analytics.TopPrefixesBySize[0].Versions)
+		}
+		if assert.Len(analytics.TopPrefixesByCount, 2) {
+			assert.Equal("", analytics.TopPrefixesByCount[0].Prefix)
+		}
+		// Test-3 : noncurrent versions are accounted to their storage class
+		if assert.Len(analytics.StorageClasses, 2) {
+			assert.Equal("STANDARD", analytics.StorageClasses[0].StorageClass)
+			assert.Equal(int64(2), analytics.StorageClasses[0].Objects)
+			assert.Equal("WARM-TIER", analytics.StorageClasses[1].StorageClass)
+			assert.Equal(int64(0), analytics.StorageClasses[1].Objects)
+			assert.Equal(int64(30), analytics.StorageClasses[1].Size)
+		}
+	}
+	// Test-4 : the listing is bounded
+	analytics, err = listBucketAnalytics(context.Background(), client, "bucket", 3)
+	if assert.NoError(err) {
+		assert.True(analytics.Truncated)
+		assert.Equal(int64(3), analytics.Versions)
+	}
+	assert.Equal(0, sizeHistogramIndex(1023))
+	assert.Equal(6, sizeHistogramIndex(600*1024*1024))
+	assert.Equal(7, sizeHistogramIndex(1<<40))
+}
+
+func TestGetBucketAnalytics(t *testing.T) {
+	assert := assert.New(t)
+	client := minioClientMock{}
+	adminClient := adminClientMock{}
+	ctx := context.Background()
+	manager := newBucketAnalyticsManager(1, 10, 0)
+	now := time.Now()
+	minioListObjectsMock = mockReplicationListing(versionsTestObjects(now))
+	minioDataUsageInfoMock = func(ctx context.Context) (madmin.DataUsageInfo, error) {
+		return madmin.DataUsageInfo{}, errors.New("access denied")
+	}
+	// Test-1 : without the data usage info the listing is started in the background
+	analytics, err := getBucketAnalytics(ctx, client, adminClient, manager, "user/bucket", "bucket", false, now)
+	if assert.NoError(err) {
+		assert.Contains([]string{bucketAnalyticsQueued, bucketAnalyticsRunning}, analytics.Status)
+		assert.Equal(int64(0), analytics.Objects)
+	}
+	assert.Eventually(func() bool {
+		return manager.cached("user/bucket", now).status == bucketAnalyticsReady
+	}, 5*time.Second, 10*time.Millisecond)
+	// Test-2 : the cached listing is served without the data usage info
+	analytics, err = getBucketAnalytics(ctx, client, adminClient, manager, "user/bucket", "bucket", false, now)
+	if assert.NoError(err) {
+		assert.Equal(bucketAnalyticsReady, analytics.Status)
+		assert.Equal(bucketAnalyticsSourceListing, analytics.Source)
+		assert.Equal(int64(2), analytics.Objects)
+		assert.Len(analytics.Histogram, 8)
+	}
+	// Test-3 : the data usage info replaces the totals and the histogram of the listing
+	minioDataUsageInfoMock = func(ctx context.Context) (madmin.DataUsageInfo, error) {
+		return madmin.DataUsageInfo{BucketsUsage: map[string]madmin.BucketUsageInfo{
+			"bucket": {Size: 5000, ObjectsCount: 7, ObjectSizesHistogram: map[string]uint64{"LESS_THAN_1024_B": 3, "BETWEEN_1024_B_AND_1_MB": 4, "GREATER_THAN_512_MB": 2}},
+		}}, nil
+	}
+	analytics, err = getBucketAnalytics(ctx, client, adminClient, manager, "user/bucket", "bucket", false, now)
+	if assert.NoError(err) {
+		assert.Equal(bucketAnalyticsSourceMixed, analytics.Source)
+		assert.Equal(int64(7), analytics.Objects)
+		assert.Equal(int64(4), analytics.Histogram[1].Objects)
+		if assert.Len(analytics.Histogram, 7) {
+			assert.Equal(">512MiB", analytics.Histogram[6].Label)
+			assert.Equal(int64(2), analytics.Histogram[6].Objects)
+		}
+		assert.Equal(int64(6), analytics.Versions)
+	}
+	// Test-4 : stale listings aren't served nor listed again when the data usage is available
+	analytics, err = getBucketAnalytics(ctx, client, adminClient, manager, "user/bucket", "bucket", false, now.Add(bucketAnalyticsCacheTTL))
+	if assert.NoError(err) {
+		assert.Equal(bucketAnalyticsSourceDataUsage, analytics.Source)
+		assert.Equal(int64(0), analytics.Versions)
+		assert.Equal("", manager.cached("user/bucket", now.Add(bucketAnalyticsCacheTTL)).status)
+	}
+	// Test-5 : a refresh lists the bucket even when the data usage is available
+	analytics, err = getBucketAnalytics(ctx, client, adminClient, manager, "user/bucket", "bucket", true, now.Add(bucketAnalyticsCacheTTL))
+	if assert.NoError(err) {
+		assert.Contains([]string{bucketAnalyticsQueued, bucketAnalyticsRunning}, analytics.Status)
+	}
+	assert.Eventually(func() bool {
+		return manager.cached("user/bucket", now.Add(bucketAnalyticsCacheTTL)).status == bucketAnalyticsReady
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestBucketAnalyticsManagerEvict(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Now()
+	manager := newBucketAnalyticsManager(1, 2, 0)
+	manager.jobs["stale"] = &bucketAnalyticsJob{status: bucketAnalyticsReady, started: now.Add(-bucketAnalyticsCacheTTL)}
+	manager.jobs["old"] = &bucketAnalyticsJob{status: bucketAnalyticsFailed, started: now.Add(-time.Minute)}
+	manager.jobs["new"] = &bucketAnalyticsJob{status: bucketAnalyticsReady, started: now}
+	// Test-1 : stale listings are dropped, then the oldest finished ones
+	assert.NoError(manager.evict(now))
+	assert.Len(manager.jobs, 1)
+	assert.Contains(manager.jobs, "new")
+	// Test-2 : listings in progress are never evicted
+	manager.jobs["new"].status = bucketAnalyticsRunning
+	manager.jobs["queued"] = &bucketAnalyticsJob{status: bucketAnalyticsQueued, started: now}
+	assert.ErrorIs(manager.evict(now), errBucketAnalyticsBusy)
+	assert.Len(manager.jobs, 2)
+}
@@ -17,21 +17,22 @@
 package restapi
 
 import (
+	"bytes"
 	"context"
-	"errors"
-	"strconv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
 	"strings"
 	"time"
 
 	"github.com/rs/xid"
 
-	"github.com/minio/mc/pkg/probe"
-
-	"github.com/minio/mc/cmd/ilm"
-
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/lifecycle"
 
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/minio/console/models"
 	"github.com/minio/console/restapi/operations"
@@ -53,6 +54,33 @@ func registerBucketsLifecycleHandlers(api *operations.ConsoleAPI) {
 		}
 		return user_api.NewAddBucketLifecycleCreated()
 	})
+	api.UserAPIDeleteBucketLifecycleRuleHandler = user_api.DeleteBucketLifecycleRuleHandlerFunc(func(params user_api.DeleteBucketLifecycleRuleParams, session *models.Principal) middleware.Responder {
+		err := getDeleteBucketLifecycleRuleResponse(session, params)
+		if err != nil {
+			return user_api.NewDeleteBucketLifecycleRuleDefault(int(err.Code)).WithPayload(err)
+		}
+		return user_api.NewDeleteBucketLifecycleRuleNoContent()
+	})
+	api.UserAPIExportBucketLifecycleHandler = user_api.ExportBucketLifecycleHandlerFunc(func(params user_api.ExportBucketLifecycleParams, session *models.Principal) middleware.Responder {
+		data, err := getExportBucketLifecycleResponse(session, params)
+		if err != nil {
+			return user_api.NewExportBucketLifecycleDefault(int(err.Code)).WithPayload(err)
+		}
+		// Custom response writer to set the content-disposition header to tell the
+		// HTTP client the name and extension of the file we are returning
+		return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+			w.Header().Set("Content-Type", "application/octet-stream")
+			w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%s-lifecycle.%s", params.BucketName, *params.Format))
+			io.Copy(w, bytes.NewReader(data))
+		})
+	})
+	api.UserAPIImportBucketLifecycleHandler = user_api.ImportBucketLifecycleHandlerFunc(func(params user_api.ImportBucketLifecycleParams, session *models.Principal) middleware.Responder {
+		importBucketLifecycleResponse, err := getImportBucketLifecycleResponse(session, params)
+		if err != nil {
+			return user_api.NewImportBucketLifecycleDefault(int(err.Code)).WithPayload(err)
+		}
+		return user_api.NewImportBucketLifecycleOK().WithPayload(importBucketLifecycleResponse)
+	})
 }
 
 // getBucketLifecycle() gets lifecycle lists for a bucket from MinIO API and returns their implementations
@@ -68,7 +96,7 @@ func getBucketLifecycle(ctx context.Context, client MinioClient, bucketName stri
 
 		var tags []*models.LifecycleTag
 
-		for _, tagData := range rule.RuleFilter.And.Tags {
+		for _, tagData := range lifecycleRuleTags(rule) {
 			tags = append(tags, &models.LifecycleTag{
 				Key:   tagData.Key,
 				Value: tagData.Value,
@@ -76,12 +104,15 @@ func getBucketLifecycle(ctx context.Context, client MinioClient, bucketName stri
 		}
 
 		rules = append(rules, &models.ObjectBucketLifecycle{
-			ID:         rule.ID,
-			Status:     rule.Status,
-			Prefix:     rule.RuleFilter.And.Prefix,
-			Expiration: &models.ExpirationResponse{Date: rule.Expiration.Date.Format(time.RFC3339), Days: int64(rule.Expiration.Days), DeleteMarker: rule.Expiration.DeleteMarker.IsEnabled()},
-			Transition: &models.TransitionResponse{Date: rule.Transition.Date.Format(time.RFC3339), Days: int64(rule.Transition.Days), StorageClass: rule.Transition.StorageClass},
-			Tags:       tags,
+			ID:                           rule.ID,
+			Status:                       rule.Status,
+			Prefix:                       lifecycleRulePrefix(rule),
+			Expiration:                   &models.ExpirationResponse{Date: rule.Expiration.Date.Format(time.RFC3339), Days: int64(rule.Expiration.Days), DeleteMarker: rule.Expiration.DeleteMarker.IsEnabled()},
+			Transition:                   &models.TransitionResponse{Date: rule.Transition.Date.Format(time.RFC3339), Days: int64(rule.Transition.Days), StorageClass: rule.Transition.StorageClass},
+			Tags:                         tags,
+			NoncurrentExpirationDays:     int64(rule.NoncurrentVersionExpiration.NoncurrentDays),
+			NoncurrentTransition:         &models.NoncurrentTransitionResponse{Days: int64(rule.NoncurrentVersionTransition.NoncurrentDays), StorageClass: rule.NoncurrentVersionTransition.StorageClass},
+			AbortIncompleteMultipartDays: int64(rule.AbortIncompleteMultipartUpload.DaysAfterInitiation),
 		})
 	}
 
@@ -111,123 +142,293 @@ func getBucketLifecycleResponse(session *models.Principal, params user_api.GetBu
 	return bucketEvents, nil
 }
 
-// addBucketLifecycle gets lifecycle lists for a bucket from MinIO API and returns their implementations
-func addBucketLifecycle(ctx context.Context, client MinioClient, params user_api.AddBucketLifecycleParams) error {
-	// Configuration that is already set.
-	lfcCfg, err := client.getLifecycleRules(ctx, params.BucketName)
-	if err != nil {
-		if e := err; minio.ToErrorResponse(e).Code == "NoSuchLifecycleConfiguration" {
-			lfcCfg = lifecycle.NewConfiguration()
-		} else {
-			return err
-		}
-	}
-
-	id := xid.New().String()
-
-	opts := ilm.LifecycleOptions{}
-
-	// Verify if transition items are set
-	if params.Body.ExpiryDate == "" && params.Body.ExpiryDays == 0 {
-		if params.Body.TransitionDate != "" && params.Body.TransitionDays != 0 {
-			return errors.New("only one transition configuration can be set (days or date)")
-		}
-
-		if params.Body.ExpiryDate != "" || params.Body.ExpiryDays != 0 {
-			return errors.New("expiry cannot be set when transition is being configured")
-		}
-
-		if params.Body.NoncurrentversionExpirationDays != 0 {
-			return errors.New("non current version Expiration Days cannot be set when transition is being configured")
-		}
-
-		if params.Body.TransitionDate != "" {
-			opts = ilm.LifecycleOptions{
-				ID:                                      id,
-				Prefix:                                  params.Body.Prefix,
-				Status:                                  !params.Body.Disable,
-				IsTagsSet:                               params.Body.Tags != "",
-				IsStorageClassSet:                       params.Body.StorageClass != "",
-				Tags:                                    params.Body.Tags,
-				TransitionDate:                          params.Body.TransitionDate,
-				StorageClass:                            strings.ToUpper(params.Body.StorageClass),
-				ExpiredObjectDeleteMarker:               params.Body.ExpiredObjectDeleteMarker,
-				NoncurrentVersionTransitionDays:         int(params.Body.NoncurrentversionTransitionDays),
-				NoncurrentVersionTransitionStorageClass: strings.ToUpper(params.Body.NoncurrentversionTransitionStorageClass),
-			}
-		} else if params.Body.TransitionDays != 0 {
-			opts = ilm.LifecycleOptions{
-				ID:                                      id,
-				Prefix:                                  params.Body.Prefix,
-				Status:                                  !params.Body.Disable,
-				IsTagsSet:                               params.Body.Tags != "",
-				IsStorageClassSet:                       params.Body.StorageClass != "",
-				Tags:                                    params.Body.Tags,
-				TransitionDays:                          strconv.Itoa(int(params.Body.TransitionDays)),
-				StorageClass:                            strings.ToUpper(params.Body.StorageClass),
-				ExpiredObjectDeleteMarker:               params.Body.ExpiredObjectDeleteMarker,
-				NoncurrentVersionTransitionDays:         int(params.Body.NoncurrentversionTransitionDays),
-				NoncurrentVersionTransitionStorageClass: strings.ToUpper(params.Body.NoncurrentversionTransitionStorageClass),
-			}
-		}
-	} else if params.Body.TransitionDate == "" && params.Body.TransitionDays == 0 {
-		// Verify if expiry items are set
-		if params.Body.ExpiryDate != "" && params.Body.ExpiryDays != 0 {
-			return errors.New("only one expiry configuration can be set (days or date)")
-		}
-
-		if params.Body.TransitionDate != "" || params.Body.TransitionDays != 0 {
-			return errors.New("transition cannot be set when expiry is being configured")
-		}
-
-		if params.Body.NoncurrentversionTransitionDays != 0 {
-			return errors.New("non current version Transition Days cannot be set when expiry is being configured")
-		}
-
-		if params.Body.NoncurrentversionTransitionStorageClass != "" {
-			return errors.New("non current version Transition Storage Class cannot be set when expiry is being configured")
-		}
-
-		if params.Body.ExpiryDate != "" {
-			opts = ilm.LifecycleOptions{
-				ID:                              id,
-				Prefix:                          params.Body.Prefix,
-				Status:                          !params.Body.Disable,
-				IsTagsSet:                       params.Body.Tags != "",
-				IsStorageClassSet:               params.Body.StorageClass != "",
-				Tags:                            params.Body.Tags,
-				ExpiryDate:                      params.Body.ExpiryDate,
-				ExpiredObjectDeleteMarker:       params.Body.ExpiredObjectDeleteMarker,
-				NoncurrentVersionExpirationDays: int(params.Body.NoncurrentversionExpirationDays),
-			}
-		} else if params.Body.ExpiryDays != 0 {
-			opts = ilm.LifecycleOptions{
-				ID:                              id,
-				Prefix:                          params.Body.Prefix,
-				Status:                          !params.Body.Disable,
-				IsTagsSet:                       params.Body.Tags != "",
-				IsStorageClassSet:               params.Body.StorageClass != "",
-				Tags:                            params.Body.Tags,
-				ExpiryDays:                      strconv.Itoa(int(params.Body.ExpiryDays)),
-				ExpiredObjectDeleteMarker:       params.Body.ExpiredObjectDeleteMarker,
-				NoncurrentVersionExpirationDays: int(params.Body.NoncurrentversionExpirationDays),
-			}
+// lifecycleDateFormat is the format of the dates sent by the lifecycle forms
+const lifecycleDateFormat = "2006-01-02"
+
+// parseLifecycleDate parses a lifecycle date, S3 requires the dates to be at
+// midnight UTC
+func parseLifecycleDate(date string) (lifecycle.ExpirationDate, error) {
+	t, err := time.Parse(lifecycleDateFormat, date)
+	if err != nil {
+		if t, err = time.Parse(time.RFC3339, date); err != nil {
+			return lifecycle.ExpirationDate{}, fmt.Errorf("%w: invalid date %s, use the YYYY-MM-DD format", errInvalidLifecycleRule, date)
+		}
+	}
+	t = t.UTC()
+	if !t.Equal(t.Truncate(24 * time.Hour)) {
+		return lifecycle.ExpirationDate{}, fmt.Errorf("%w: the date %s must be at midnight UTC", errInvalidLifecycleRule, date)
+	}
+	return lifecycle.ExpirationDate{Time: t}, nil
+}
+
+// parseLifecycleTags parses tags in the key1=value1&key2=value2 format
+func parseLifecycleTags(tags string) ([]lifecycle.Tag, error) {
+	var result []lifecycle.Tag
+	if tags == "" {
+		return result, nil
+	}
+	for _, tag := range strings.Split(tags, "&") {
+		kv := strings.SplitN(tag, "=", 2)
+		if kv[0] == "" {
+			return nil, fmt.Errorf("%w: invalid tag %s, tags are set as key=value", errInvalidLifecycleRule, tag)
+		}
+		t := lifecycle.Tag{Key: kv[0]}
+		if len(kv) > 1 {
+			t.Value = kv[1]
 		}
+		result = append(result, t)
+	}
+	return result, nil
+}
 
-	} else {
-		// Non set, we return error
-		return errors.New("no valid configuration is set")
+// lifecycleFilter builds the filter of a rule, a single condition is set on
+// its own while a prefix and tags or several tags are combined with And
+func lifecycleFilter(prefix string, tags []lifecycle.Tag) lifecycle.Filter {
+	switch {
+	case len(tags) == 0:
+		return lifecycle.Filter{Prefix: prefix}
+	case len(tags) == 1 && prefix == "":
+		return lifecycle.Filter{Tag: tags[0]}
+	default:
+		return lifecycle.Filter{And: lifecycle.And{Prefix: prefix, Tags: tags}}
+	}
+}
+
+// lifecycleRuleTags returns the tags a rule filters on
+func lifecycleRuleTags(rule lifecycle.Rule) []lifecycle.Tag {
+	if len(rule.RuleFilter.And.Tags) > 0 {
+		return rule.RuleFilter.And.Tags
+	}
+	if !rule.RuleFilter.Tag.IsEmpty() {
+		return []lifecycle.Tag{rule.RuleFilter.Tag}
+	}
+	return nil
+}
+
+// lifecycleRulePrefix returns the prefix a rule filters on wherever it's set
+func lifecycleRulePrefix(rule lifecycle.Rule) string {
+	switch {
+	case rule.RuleFilter.And.Prefix != "":
+		return rule.RuleFilter.And.Prefix
+	case rule.RuleFilter.Prefix != "":
+		return rule.RuleFilter.Prefix
+	default:
+		return rule.Prefix
+	}
+}
+
+// lifecycleRuleFromRequest builds a lifecycle rule from the fields of the
+// lifecycle forms, expiration and transitions can be combined in a rule
+func lifecycleRuleFromRequest(id string, body *models.AddBucketLifecycle) (lifecycle.Rule, error) {
+	tags, err := parseLifecycleTags(body.Tags)
+	if err != nil {
+		return lifecycle.Rule{}, err
+	}
+	rule := lifecycle.Rule{
+		ID:         id,
+		Status:     "Enabled",
+		RuleFilter: lifecycleFilter(body.Prefix, tags),
+		Expiration: lifecycle.Expiration{
+			Days:         lifecycle.ExpirationDays(body.ExpiryDays),
+			DeleteMarker: lifecycle.ExpireDeleteMarker(body.ExpiredObjectDeleteMarker),
+		},
+		Transition: lifecycle.Transition{
+			Days:         lifecycle.ExpirationDays(body.TransitionDays),
+			StorageClass: strings.ToUpper(body.StorageClass),
+		},
+		NoncurrentVersionExpiration: lifecycle.NoncurrentVersionExpiration{
+			NoncurrentDays: lifecycle.ExpirationDays(body.NoncurrentversionExpirationDays),
+		},
+		NoncurrentVersionTransition: lifecycle.NoncurrentVersionTransition{
+			NoncurrentDays: lifecycle.ExpirationDays(body.NoncurrentversionTransitionDays),
+			StorageClass:   strings.ToUpper(body.NoncurrentversionTransitionStorageClass),
+		},
+		AbortIncompleteMultipartUpload: lifecycle.AbortIncompleteMultipartUpload{
+			DaysAfterInitiation: lifecycle.ExpirationDays(body.AbortIncompleteMultipartDays),
+		},
+	}
+	if body.Disable {
+		rule.Status = "Disabled"
+	}
+	if body.ExpiryDate != "" {
+		if rule.Expiration.Date, err = parseLifecycleDate(body.ExpiryDate); err != nil {
+			return lifecycle.Rule{}, err
+		}
+	}
+	if body.TransitionDate != "" {
+		if rule.Transition.Date, err = parseLifecycleDate(body.TransitionDate); err != nil {
+			return lifecycle.Rule{}, err
+		}
+	}
+	return rule, validateLifecycleRule(rule)
+}
+
+// validateLifecycleRule checks a rule the way S3 does before it's sent to MinIO
+// so the user gets a meaningful error
+func validateLifecycleRule(rule lifecycle.Rule) error {
+	invalid := func(reason string) error {
+		return fmt.Errorf("%w: %s", errInvalidLifecycleRule, reason)
+	}
+	if rule.ID == "" || len(rule.ID) > 255 {
+		return invalid("the rule id must have between 1 and 255 characters")
+	}
+	if rule.Status != "Enabled" && rule.Status != "Disabled" {
+		return invalid("the status must be Enabled or Disabled")
+	}
+	if rule.Expiration.IsNull() && rule.Transition.IsNull() &&
+		rule.NoncurrentVersionExpiration.IsDaysNull() && rule.NoncurrentVersionTransition.IsDaysNull() &&
+		rule.AbortIncompleteMultipartUpload.IsDaysNull() {
+		return invalid("the rule must set an expiration, a transition or the days to abort incomplete multipart uploads")
+	}
+	if rule.Expiration.Days < 0 || rule.Transition.Days < 0 || rule.NoncurrentVersionExpiration.NoncurrentDays < 0 ||
+		rule.NoncurrentVersionTransition.NoncurrentDays < 0 || rule.AbortIncompleteMultipartUpload.DaysAfterInitiation < 0 {
+		return invalid("days must be positive numbers")
+	}
+	if !rule.Expiration.IsDaysNull() && !rule.Expiration.IsDateNull() {
+		return invalid("only one expiry configuration can be set (days or date)")
+	}
+	if rule.Expiration.IsDeleteMarkerExpirationEnabled() && (!rule.Expiration.IsDaysNull() || !rule.Expiration.IsDateNull()) {
+		return invalid("expired object delete markers can't be set along with expiry days or date")
+	}
+	if !rule.Transition.IsDaysNull() && !rule.Transition.IsDateNull() {
+		return invalid("only one transition configuration can be set (days or date)")
 	}
+	if rule.Transition.IsNull() != (rule.Transition.StorageClass == "") {
+		return invalid("a transition requires both the storage class and the days or date")
+	}
+	if !rule.Transition.IsDaysNull() && !rule.Expiration.IsDaysNull() && rule.Transition.Days >= rule.Expiration.Days {
+		return invalid("transition days must be lower than the expiry days")
+	}
+	if !rule.Transition.IsDateNull() && !rule.Expiration.IsDateNull() && !rule.Transition.Date.Before(rule.Expiration.Date.Time) {
+		return invalid("transition date must be before the expiry date")
+	}
+	if rule.NoncurrentVersionTransition.IsDaysNull() != rule.NoncurrentVersionTransition.IsStorageClassEmpty() {
+		return invalid("a noncurrent version transition requires both the days and the storage class")
+	}
+	if !rule.NoncurrentVersionTransition.IsDaysNull() && !rule.NoncurrentVersionExpiration.IsDaysNull() &&
+		rule.NoncurrentVersionTransition.NoncurrentDays >= rule.NoncurrentVersionExpiration.NoncurrentDays {
+		return invalid("noncurrent version transition days must be lower than the noncurrent version expiration days")
+	}
+	tags := lifecycleRuleTags(rule)
+	if !rule.AbortIncompleteMultipartUpload.IsDaysNull() && len(tags) > 0 {
+		return invalid("incomplete multipart uploads can't be aborted by rules filtering on tags")
+	}
+	keys := map[string]bool{}
+	for _, tag := range tags {
+		if keys[tag.Key] {
+			return invalid(fmt.Sprintf("duplicated tag %s", tag.Key))
+		}
+		keys[tag.Key] = true
+	}
+	return nil
+}
 
-	var err2 *probe.Error
-	lfcCfg, err2 = opts.ToConfig(lfcCfg)
-	if err2.ToGoError() != nil {
-		return err2.ToGoError()
+// getBucketLifecycleConfig gets the lifecycle configuration of a bucket, an
+// empty configuration is returned if the bucket doesn't have one
+func getBucketLifecycleConfig(ctx context.Context, client MinioClient, bucketName string) (*lifecycle.Configuration, error) {
+	lfcCfg, err := client.getLifecycleRules(ctx, bucketName)
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchLifecycleConfiguration" {
+			return lifecycle.NewConfiguration(), nil
+		}
+		return nil, err
 	}
+	return lfcCfg, nil
+}
+
+// addBucketLifecycle adds a rule to the lifecycle configuration of a bucket
+func addBucketLifecycle(ctx context.Context, client MinioClient, params user_api.AddBucketLifecycleParams) error {
+	// Configuration that is already set.
+	lfcCfg, err := getBucketLifecycleConfig(ctx, client, params.BucketName)
+	if err != nil {
+		return err
+	}
+
+	rule, err := lifecycleRuleFromRequest(xid.New().String(), params.Body)
+	if err != nil {
+		return err
+	}
+	lfcCfg.Rules = append(lfcCfg.Rules, rule)
 
 	return client.setBucketLifecycle(ctx, params.BucketName, lfcCfg)
 }
 
+// deleteBucketLifecycleRule removes a rule from the lifecycle configuration of
+// a bucket, the configuration is removed along with its last rule
+func deleteBucketLifecycleRule(ctx context.Context, client MinioClient, bucketName, ruleID string) error {
+	lfcCfg, err := getBucketLifecycleConfig(ctx, client, bucketName)
+	if err != nil {
+		return err
+	}
+	rules := make([]lifecycle.Rule, 0, len(lfcCfg.Rules))
+	for _, rule := range lfcCfg.Rules {
+		if rule.ID != ruleID {
+			rules = append(rules, rule)
+		}
+	}
+	if len(rules) == len(lfcCfg.Rules) {
+		return errLifecycleRuleNotFound
+	}
+	lfcCfg.Rules = rules
+	return client.setBucketLifecycle(ctx, bucketName, lfcCfg)
+}
+
+// exportBucketLifecycle returns the lifecycle configuration of a bucket in the
+// XML format of the S3 API or in the JSON format of mc ilm export
+func exportBucketLifecycle(ctx context.Context, client MinioClient, bucketName, format string) ([]byte, error) {
+	lfcCfg, err := client.getLifecycleRules(ctx, bucketName)
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchLifecycleConfiguration" {
+			return nil, errBucketLifeCycleNotConfigured
+		}
+		return nil, err
+	}
+	if format == "json" {
+		return json.MarshalIndent(lfcCfg, "", "  ")
+	}
+	data, err := xml.MarshalIndent(lfcCfg, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), data...), nil
+}
+
+// importBucketLifecycle replaces the lifecycle configuration of a bucket with
+// an exported one, every rule is validated before the configuration is set
+func importBucketLifecycle(ctx context.Context, client MinioClient, bucketName string, request *models.BucketLifecycleImport) (*models.BucketLifecycleResponse, error) {
+	lfcCfg := lifecycle.NewConfiguration()
+	var err error
+	if *request.Format == "json" {
+		err = json.Unmarshal([]byte(*request.Config), lfcCfg)
+	} else {
+		err = xml.Unmarshal([]byte(*request.Config), lfcCfg)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidLifecycleConfig, err)
+	}
+	if lfcCfg.Empty() {
+		return nil, fmt.Errorf("%w: the configuration has no rules", errInvalidLifecycleConfig)
+	}
+	ids := map[string]bool{}
+	for i := range lfcCfg.Rules {
+		rule := &lfcCfg.Rules[i]
+		if rule.ID == "" {
+			rule.ID = xid.New().String()
+		}
+		if ids[rule.ID] {
+			return nil, fmt.Errorf("%w: duplicated rule id %s", errInvalidLifecycleConfig, rule.ID)
+		}
+		ids[rule.ID] = true
+		if err := validateLifecycleRule(*rule); err != nil {
+			return nil, fmt.Errorf("rule %s: %w", rule.ID, err)
+		}
+	}
+	if err := client.setBucketLifecycle(ctx, bucketName, lfcCfg); err != nil {
+		return nil, err
+	}
+	return getBucketLifecycle(ctx, client, bucketName)
+}
+
 // getAddBucketLifecycleResponse returns the respose of adding a bucket lifecycle response
 func getAddBucketLifecycleResponse(session *models.Principal, params user_api.AddBucketLifecycleParams) *models.Error {
 	ctx := context.Background()
@@ -246,3 +447,51 @@ func getAddBucketLifecycleResponse(session *models.Principal, params user_api.Ad
 
 	return nil
 }
+
+// getDeleteBucketLifecycleRuleResponse returns the response of deleting a bucket lifecycle rule
+func getDeleteBucketLifecycleRuleResponse(session *models.Principal, params user_api.DeleteBucketLifecycleRuleParams) *models.Error {
+	ctx := context.Background()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return prepareError(err)
+	}
+	minioClient := minioClient{client: mClient}
+
+	err = deleteBucketLifecycleRule(ctx, minioClient, params.BucketName, params.LifecycleID)
+	if err != nil {
+		return prepareError(err)
+	}
+	return nil
+}
+
+// getExportBucketLifecycleResponse returns the exported lifecycle configuration of a bucket
+func getExportBucketLifecycleResponse(session *models.Principal, params user_api.ExportBucketLifecycleParams) ([]byte, *models.Error) {
+	ctx := context.Background()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	minioClient := minioClient{client: mClient}
+
+	data, err := exportBucketLifecycle(ctx, minioClient, params.BucketName, *params.Format)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return data, nil
+}
+
+// getImportBucketLifecycleResponse returns the rules of the imported lifecycle configuration
+func getImportBucketLifecycleResponse(session *models.Principal, params user_api.ImportBucketLifecycleParams) (*models.BucketLifecycleResponse, *models.Error) {
+	ctx := context.Background()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	minioClient := minioClient{client: mClient}
+
+	lifecycleResponse, err := importBucketLifecycle(ctx, minioClient, params.BucketName, params.Body)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return lifecycleResponse, nil
+}
@@ -0,0 +1,301 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/user_api"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
+)
+
+// lifecyclePreviewWindows are the days from now the preview reports on
+var lifecyclePreviewWindows = []int{0, 30, 90}
+
+// lifecyclePreviewScanLimit is the maximum number of object versions scanned by a preview
+const lifecyclePreviewScanLimit = 100000
+
+func registerBucketLifecyclePreviewHandlers(api *operations.ConsoleAPI) {
+	api.UserAPIPreviewBucketLifecycleHandler = user_api.PreviewBucketLifecycleHandlerFunc(func(params user_api.PreviewBucketLifecycleParams, session *models.Principal) middleware.Responder {
+		previewResponse, err := getPreviewBucketLifecycleResponse(session, params)
+		if err != nil {
+			return user_api.NewPreviewBucketLifecycleDefault(int(err.Code)).WithPayload(err)
+		}
+		return user_api.NewPreviewBucketLifecycleOK().WithPayload(previewResponse)
+	})
+}
+
+// lifecycleObjectVersion is an object version evaluated by the preview
+type lifecycleObjectVersion struct {
+	minio.ObjectInfo
+	// NoncurrentSince is the time the version stopped being the latest one
+	NoncurrentSince time.Time
+	// OnlyVersion is set when the key has no other versions
+	OnlyVersion bool
+}
+
+// lifecycleExpectedTime returns when an action set to run days after t runs,
+// MinIO rounds it up to the next midnight UTC the same way S3 does
+func lifecycleExpectedTime(t time.Time, days lifecycle.ExpirationDays) time.Time {
+	if days == 0 {
+		return t
+	}
+	return t.UTC().Add(time.Duration(days+1) * 24 * time.Hour).Truncate(24 * time.Hour)
+}
+
+// lifecycleRuleMatches returns whether an enabled rule applies to an object
+// with the given tags
+func lifecycleRuleMatches(rule lifecycle.Rule, key string, tags map[string]string) bool {
+	if rule.Status != "Enabled" || !strings.HasPrefix(key, lifecycleRulePrefix(rule)) {
+		return false
+	}
+	for _, tag := range lifecycleRuleTags(rule) {
+		if value, ok := tags[tag.Key]; !ok || value != tag.Value {
+			return false
+		}
+	}
+	return true
+}
+
+// lifecycleVersionAction returns what the rules do to an object version by
+// the given time, expiration takes precedence over transitions
+func lifecycleVersionAction(rules []lifecycle.Rule, version lifecycleObjectVersion, tags map[string]string, at time.Time) (action, tier string) {
+	due := func(t time.Time) bool {
+		return !t.After(at)
+	}
+	for _, rule := range rules {
+		if !lifecycleRuleMatches(rule, version.Key, tags) {
+			continue
+		}
+		switch {
+		case version.IsDeleteMarker:
+			if version.IsLatest && version.OnlyVersion && rule.Expiration.IsDeleteMarkerExpirationEnabled() {
+				return models.LifecyclePreviewGroupActionExpire, ""
+			}
+		case version.IsLatest:
+			if (!rule.Expiration.IsDaysNull() && due(lifecycleExpectedTime(version.LastModified, rule.Expiration.Days))) ||
+				(!rule.Expiration.IsDateNull() && due(rule.Expiration.Date.Time)) {
+				return models.LifecyclePreviewGroupActionExpire, ""
+			}
+			if tier == "" && rule.Transition.StorageClass != "" && rule.Transition.StorageClass != version.StorageClass &&
+				((!rule.Transition.IsDaysNull() && due(lifecycleExpectedTime(version.LastModified, rule.Transition.Days))) ||
+					(!rule.Transition.IsDateNull() && due(rule.Transition.Date.Time))) {
+				tier = rule.Transition.StorageClass
+			}
+		default:
+			if !rule.NoncurrentVersionExpiration.IsDaysNull() && due(lifecycleExpectedTime(version.NoncurrentSince, rule.NoncurrentVersionExpiration.NoncurrentDays)) {
+				return models.LifecyclePreviewGroupActionExpire, ""
+			}
+			transition := rule.NoncurrentVersionTransition
+			if tier == "" && !transition.IsDaysNull() && transition.StorageClass != version.StorageClass &&
+				due(lifecycleExpectedTime(version.NoncurrentSince, transition.NoncurrentDays)) {
+				tier = transition.StorageClass
+			}
+		}
+	}
+	if tier != "" {
+		return models.LifecyclePreviewGroupActionTransition, tier
+	}
+	return "", ""
+}
+
+// lifecyclePreviewPrefix is the top level prefix objects are grouped by
+func lifecyclePreviewPrefix(key string) string {
+	if i := strings.Index(key, "/"); i >= 0 {
+		return key[:i+1]
+	}
+	return ""
+}
+
+// lifecyclePreview accumulates the objects affected in each window
+type lifecyclePreview struct {
+	windows []*models.LifecyclePreviewWindow
+	groups  []map[string]*models.LifecyclePreviewGroup
+}
+
+func newLifecyclePreview() *lifecyclePreview {
+	preview := &lifecyclePreview{}
+	for _, days := range lifecyclePreviewWindows {
+		preview.windows = append(preview.windows, &models.LifecyclePreviewWindow{Days: int64(days)})
+		preview.groups = append(preview.groups, map[string]*models.LifecyclePreviewGroup{})
+	}
+	return preview
+}
+
+func (p *lifecyclePreview) add(rules []lifecycle.Rule, version lifecycleObjectVersion, tags map[string]string, now time.Time) {
+	for i, days := range lifecyclePreviewWindows {
+		action, tier := lifecycleVersionAction(rules, version, tags, now.Add(time.Duration(days)*24*time.Hour))
+		if action == "" {
+			continue
+		}
+		window := p.windows[i]
+		if action == models.LifecyclePreviewGroupActionExpire {
+			window.ExpireObjects++
+			window.ExpireSize += version.Size
+		} else {
+			window.TransitionObjects++
+			window.TransitionSize += version.Size
+		}
+		prefix := lifecyclePreviewPrefix(version.Key)
+		groupKey := strings.Join([]string{action, tier, prefix}, "\x00")
+		group, ok := p.groups[i][groupKey]
+		if !ok {
+			group = &models.LifecyclePreviewGroup{Action: action, Tier: tier, Prefix: prefix}
+			p.groups[i][groupKey] = group
+		}
+		group.Objects++
+		group.Size += version.Size
+	}
+}
+
+func (p *lifecyclePreview) result() []*models.LifecyclePreviewWindow {
+	for i, window := range p.windows {
+		window.Groups = []*models.LifecyclePreviewGroup{}
+		for _, group := range p.groups[i] {
+			window.Groups = append(window.Groups, group)
+		}
+		sort.Slice(window.Groups, func(a, b int) bool {
+			ga, gb := window.Groups[a], window.Groups[b]
+			if ga.Size != gb.Size {
+				return ga.Size > gb.Size
+			}
+			if ga.Prefix != gb.Prefix {
+				return ga.Prefix < gb.Prefix
+			}
+			return ga.Action+ga.Tier < gb.Action+gb.Tier
+		})
+	}
+	return p.windows
+}
+
+// previewBucketLifecycle scans the versions of a bucket and reports what the
+// rules would expire or transition today and in the following windows. Tags
+// aren't part of version listings so they are fetched only when a rule
+// filters on them
+func previewBucketLifecycle(ctx context.Context, client MinioClient, bucketName string, rules []lifecycle.Rule, now time.Time) (*models.LifecyclePreviewResponse, error) {
+	var tagRules []lifecycle.Rule
+	for _, rule := range rules {
+		if len(lifecycleRuleTags(rule)) > 0 {
+			tagRules = append(tagRules, rule)
+		}
+	}
+	versionTags := func(version minio.ObjectInfo) (map[string]string, error) {
+		needed := false
+		for _, rule := range tagRules {
+			if rule.Status == "Enabled" && strings.HasPrefix(version.Key, lifecycleRulePrefix(rule)) {
+				needed = true
+				break
+			}
+		}
+		if !needed || version.IsDeleteMarker {
+			return nil, nil
+		}
+		objectTags, err := client.getObjectTagging(ctx, bucketName, version.Key, minio.GetObjectTaggingOptions{VersionID: version.VersionID})
+		if err != nil {
+			return nil, err
+		}
+		return objectTags.ToMap(), nil
+	}
+
+	preview := newLifecyclePreview()
+	response := &models.LifecyclePreviewResponse{}
+	// versions of a key are listed from the latest one, they are evaluated
+	// once every version of the key is known
+	var versions []minio.ObjectInfo
+	flush := func() error {
+		for i, version := range versions {
+			v := lifecycleObjectVersion{ObjectInfo: version, OnlyVersion: len(versions) == 1}
+			if i > 0 {
+				v.NoncurrentSince = versions[i-1].LastModified
+			}
+			tags, err := versionTags(version)
+			if err != nil {
+				return err
+			}
+			preview.add(rules, v, tags, now)
+		}
+		versions = versions[:0]
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	for info := range client.listObjects(ctx, bucketName, minio.ListObjectsOptions{Recursive: true, WithVersions: true}) {
+		if info.Err != nil {
+			return nil, info.Err
+		}
+		if response.ScannedObjects >= lifecyclePreviewScanLimit {
+			response.Truncated = true
+			break
+		}
+		if len(versions) > 0 && versions[0].Key != info.Key {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+		}
+		versions = append(versions, info)
+		response.ScannedObjects++
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	response.Windows = preview.result()
+	return response, nil
+}
+
+// getPreviewBucketLifecycleResponse previews the proposed rule or the current
+// lifecycle configuration of the bucket when no rule is sent
+func getPreviewBucketLifecycleResponse(session *models.Principal, params user_api.PreviewBucketLifecycleParams) (*models.LifecyclePreviewResponse, *models.Error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	minioClient := minioClient{client: mClient}
+
+	var rules []lifecycle.Rule
+	if params.Body.Rule != nil {
+		rule, err := lifecycleRuleFromRequest("preview", params.Body.Rule)
+		if err != nil {
+			return nil, prepareError(err)
+		}
+		rules = append(rules, rule)
+	} else {
+		lfcCfg, err := minioClient.getLifecycleRules(ctx, params.BucketName)
+		if err != nil {
+			if minio.ToErrorResponse(err).Code == "NoSuchLifecycleConfiguration" {
+				return nil, prepareError(errBucketLifeCycleNotConfigured)
+			}
+			return nil, prepareError(err)
+		}
+		rules = lfcCfg.Rules
+	}
+
+	preview, err := previewBucketLifecycle(ctx, minioClient, params.BucketName, rules, time.Now())
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return preview, nil
+}
@@ -0,0 +1,108 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
+	"github.com/minio/minio-go/v7/pkg/tags"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLifecycleExpectedTime(t *testing.T) {
+	assert := assert.New(t)
+	modTime := time.Date(2021, 5, 1, 15, 30, 0, 0, time.UTC)
+	assert.Equal(time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC), lifecycleExpectedTime(modTime, 60))
+	assert.Equal(modTime, lifecycleExpectedTime(modTime, 0))
+}
+
+func TestPreviewBucketLifecycle(t *testing.T) {
+	assert := assert.New(t)
+	client := minioClientMock{}
+	day := func(month time.Month, d int) time.Time {
+		return time.Date(2021, month, d, 0, 0, 0, 0, time.UTC)
+	}
+	minioListObjectsMock = mockReplicationListing([]minio.ObjectInfo{
+		{Key: "data/c.bin", Size: 1000, LastModified: day(1, 1), IsLatest: true},
+		{Key: "docs/e.txt", Size: 10, LastModified: day(1, 1), IsLatest: true, VersionID: "e1"},
+		{Key: "logs/a.log", Size: 100, LastModified: day(5, 1), IsLatest: true},
+		{Key: "logs/b.log", Size: 50, LastModified: day(5, 31), IsLatest: true},
+		{Key: "logs/b.log", Size: 200, LastModified: day(1, 1)},
+		{Key: "tmp/d", LastModified: day(1, 1), IsLatest: true, IsDeleteMarker: true},
+	})
+	var tagged []string
+	minioGetObjectTaggingMock = func(ctx context.Context, bucketName, objectName string, opts minio.GetObjectTaggingOptions) (*tags.Tags, error) {
+		tagged = append(tagged, objectName)
+		if objectName == "docs/e.txt" && opts.VersionID == "e1" {
+			return tags.ParseObjectTags("env=dev")
+		}
+		return tags.ParseObjectTags("")
+	}
+	rules := []lifecycle.Rule{
+		{ID: "logs", Status: "Enabled", RuleFilter: lifecycle.Filter{Prefix: "logs/"}, Expiration: lifecycle.Expiration{Days: 60}, NoncurrentVersionExpiration: lifecycle.NoncurrentVersionExpiration{NoncurrentDays: 10}},
+		{ID: "data", Status: "Enabled", RuleFilter: lifecycle.Filter{Prefix: "data/"}, Transition: lifecycle.Transition{Days: 30, StorageClass: "WARM"}},
+		{ID: "tmp", Status: "Enabled", RuleFilter: lifecycle.Filter{Prefix: "tmp/"}, Expiration: lifecycle.Expiration{DeleteMarker: true}},
+		{ID: "dev", Status: "Enabled", RuleFilter: lifecycle.Filter{Tag: lifecycle.Tag{Key: "env", Value: "dev"}}, Expiration: lifecycle.Expiration{Days: 1}},
+		{ID: "disabled", Status: "Disabled", Expiration: lifecycle.Expiration{Days: 1}},
+	}
+	preview, err := previewBucketLifecycle(context.Background(), client, "testBucket", rules, day(6, 1))
+	if !assert.NoError(err) || !assert.Equal(3, len(preview.Windows)) {
+		return
+	}
+	assert.Equal(int64(6), preview.ScannedObjects)
+	assert.False(preview.Truncated)
+	// delete markers don't need their tags
+	assert.Equal(5, len(tagged))
+	// Test-1 : today
+	today := preview.Windows[0]
+	assert.Equal(int64(0), today.Days)
+	assert.Equal(int64(2), today.ExpireObjects)
+	assert.Equal(int64(10), today.ExpireSize)
+	assert.Equal(int64(1), today.TransitionObjects)
+	assert.Equal(int64(1000), today.TransitionSize)
+	assert.Equal([]*models.LifecyclePreviewGroup{
+		{Action: models.LifecyclePreviewGroupActionTransition, Tier: "WARM", Prefix: "data/", Objects: 1, Size: 1000},
+		{Action: models.LifecyclePreviewGroupActionExpire, Prefix: "docs/", Objects: 1, Size: 10},
+		{Action: models.LifecyclePreviewGroupActionExpire, Prefix: "tmp/", Objects: 1, Size: 0},
+	}, today.Groups)
+	// Test-2 : in 30 days the logs and the noncurrent version expire
+	assert.Equal(int64(4), preview.Windows[1].ExpireObjects)
+	assert.Equal(int64(310), preview.Windows[1].ExpireSize)
+	// Test-3 : in 90 days the latest version of the log expires too
+	assert.Equal(int64(5), preview.Windows[2].ExpireObjects)
+	assert.Equal(int64(360), preview.Windows[2].ExpireSize)
+	assert.Equal(int64(1), preview.Windows[2].TransitionObjects)
+	// Test-4 : objects already in the tier aren't transitioned again
+	minioListObjectsMock = mockReplicationListing([]minio.ObjectInfo{
+		{Key: "data/c.bin", Size: 1000, LastModified: day(1, 1), IsLatest: true, StorageClass: "WARM"},
+	})
+	preview, err = previewBucketLifecycle(context.Background(), client, "testBucket", rules[1:2], day(6, 1))
+	if assert.NoError(err) {
+		assert.Equal(int64(0), preview.Windows[2].TransitionObjects)
+		assert.Empty(preview.Windows[2].Groups)
+	}
+	// Test-5 : listing errors are returned
+	minioListObjectsMock = mockReplicationListing([]minio.ObjectInfo{{Err: errors.New("access denied")}})
+	_, err = previewBucketLifecycle(context.Background(), client, "testBucket", rules, day(6, 1))
+	assert.Error(err)
+}
@@ -22,10 +22,12 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/go-openapi/swag"
 	"github.com/minio/console/models"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/minio/console/restapi/operations/user_api"
+	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/lifecycle"
 )
 
@@ -198,3 +200,147 @@ func TestSetLifecycleRule(t *testing.T) {
 
 	assert.Equal(errors.New("error setting lifecycle"), err2, fmt.Sprintf("Failed on %s: Error returned", function))
 }
+
+func TestLifecycleRuleFromRequest(t *testing.T) {
+	assert := assert.New(t)
+	// Test-1 : expiration and transitions combined with several tags
+	rule, err := lifecycleRuleFromRequest("rule1", &models.AddBucketLifecycle{
+		Prefix:                                  "logs/",
+		Tags:                                    "team=ops&env=prod",
+		ExpiryDays:                              90,
+		TransitionDays:                          30,
+		StorageClass:                            "warm",
+		NoncurrentversionExpirationDays:         60,
+		NoncurrentversionTransitionDays:         10,
+		NoncurrentversionTransitionStorageClass: "cold",
+	})
+	if assert.NoError(err) {
+		assert.Equal("Enabled", rule.Status)
+		assert.Equal("logs/", rule.RuleFilter.And.Prefix)
+		assert.Equal([]lifecycle.Tag{{Key: "team", Value: "ops"}, {Key: "env", Value: "prod"}}, rule.RuleFilter.And.Tags)
+		assert.Equal(lifecycle.ExpirationDays(90), rule.Expiration.Days)
+		assert.Equal("WARM", rule.Transition.StorageClass)
+		assert.Equal("COLD", rule.NoncurrentVersionTransition.StorageClass)
+	}
+	// Test-2 : a single tag doesn't need an And filter
+	rule, err = lifecycleRuleFromRequest("rule2", &models.AddBucketLifecycle{Tags: "team=ops", ExpiryDate: "2030-01-01", Disable: true})
+	if assert.NoError(err) {
+		assert.Equal("Disabled", rule.Status)
+		assert.Equal(lifecycle.Tag{Key: "team", Value: "ops"}, rule.RuleFilter.Tag)
+		assert.True(rule.RuleFilter.And.IsEmpty())
+		assert.Equal(2030, rule.Expiration.Date.Year())
+	}
+	// Test-3 : expired delete markers and incomplete multipart uploads
+	rule, err = lifecycleRuleFromRequest("rule3", &models.AddBucketLifecycle{Prefix: "tmp/", ExpiredObjectDeleteMarker: true, AbortIncompleteMultipartDays: 7})
+	if assert.NoError(err) {
+		assert.True(rule.Expiration.IsDeleteMarkerExpirationEnabled())
+		assert.Equal(lifecycle.ExpirationDays(7), rule.AbortIncompleteMultipartUpload.DaysAfterInitiation)
+		assert.Equal("tmp/", rule.RuleFilter.Prefix)
+	}
+	// Test-4 : invalid rules
+	invalid := []*models.AddBucketLifecycle{
+		{},
+		{ExpiryDays: 10, ExpiryDate: "2030-01-01"},
+		{ExpiryDays: 10, ExpiredObjectDeleteMarker: true},
+		{TransitionDays: 10},
+		{TransitionDays: 30, StorageClass: "warm", ExpiryDays: 30},
+		{TransitionDate: "2030-01-01", StorageClass: "warm", ExpiryDate: "2029-01-01"},
+		{NoncurrentversionTransitionDays: 10},
+		{NoncurrentversionTransitionDays: 10, NoncurrentversionTransitionStorageClass: "cold", NoncurrentversionExpirationDays: 5},
+		{Tags: "team=ops", AbortIncompleteMultipartDays: 7},
+		{Tags: "team=ops&team=dev", ExpiryDays: 10},
+		{Tags: "=ops", ExpiryDays: 10},
+		{ExpiryDate: "2030-01-01T10:00:00Z"},
+		{ExpiryDate: "tomorrow"},
+	}
+	for i, body := range invalid {
+		_, err = lifecycleRuleFromRequest("invalid", body)
+		assert.True(errors.Is(err, errInvalidLifecycleRule), fmt.Sprintf("rule %d: %v", i, err))
+	}
+}
+
+func TestDeleteBucketLifecycleRule(t *testing.T) {
+	assert := assert.New(t)
+	minClient := minioClientMock{}
+	mockLifecycle := func() (*lifecycle.Configuration, error) {
+		return &lifecycle.Configuration{Rules: []lifecycle.Rule{
+			{ID: "rule1", Status: "Enabled", Expiration: lifecycle.Expiration{Days: 10}},
+			{ID: "rule2", Status: "Enabled", Expiration: lifecycle.Expiration{Days: 20}},
+		}}, nil
+	}
+	minioGetLifecycleRulesMock = func(ctx context.Context, bucketName string) (*lifecycle.Configuration, error) {
+		return mockLifecycle()
+	}
+	var set *lifecycle.Configuration
+	minioSetBucketLifecycleMock = func(ctx context.Context, bucketName string, config *lifecycle.Configuration) error {
+		set = config
+		return nil
+	}
+	// Test-1 : the rule is removed
+	if assert.NoError(deleteBucketLifecycleRule(context.Background(), minClient, "testBucket", "rule1")) && assert.Equal(1, len(set.Rules)) {
+		assert.Equal("rule2", set.Rules[0].ID)
+	}
+	// Test-2 : unknown rules
+	assert.Equal(errLifecycleRuleNotFound, deleteBucketLifecycleRule(context.Background(), minClient, "testBucket", "rule3"))
+}
+
+func TestImportExportBucketLifecycle(t *testing.T) {
+	assert := assert.New(t)
+	minClient := minioClientMock{}
+	var current *lifecycle.Configuration
+	minioGetLifecycleRulesMock = func(ctx context.Context, bucketName string) (*lifecycle.Configuration, error) {
+		return current, nil
+	}
+	minioSetBucketLifecycleMock = func(ctx context.Context, bucketName string, config *lifecycle.Configuration) error {
+		current = config
+		return nil
+	}
+	original := &lifecycle.Configuration{Rules: []lifecycle.Rule{
+		{
+			ID:                          "rule1",
+			Status:                      "Enabled",
+			RuleFilter:                  lifecycle.Filter{And: lifecycle.And{Prefix: "logs/", Tags: []lifecycle.Tag{{Key: "a", Value: "1"}, {Key: "b", Value: "2"}}}},
+			Expiration:                  lifecycle.Expiration{Days: 90},
+			Transition:                  lifecycle.Transition{Days: 30, StorageClass: "WARM"},
+			NoncurrentVersionTransition: lifecycle.NoncurrentVersionTransition{NoncurrentDays: 5, StorageClass: "COLD"},
+		},
+		{ID: "rule2", Status: "Disabled", RuleFilter: lifecycle.Filter{Prefix: "tmp/"}, AbortIncompleteMultipartUpload: lifecycle.AbortIncompleteMultipartUpload{DaysAfterInitiation: 3}},
+	}}
+	for _, format := range []string{"xml", "json"} {
+		current = original
+		// Test-1 : the exported configuration can be imported back
+		data, err := exportBucketLifecycle(context.Background(), minClient, "testBucket", format)
+		if !assert.NoError(err) {
+			continue
+		}
+		current = nil
+		response, err := importBucketLifecycle(context.Background(), minClient, "testBucket", &models.BucketLifecycleImport{Format: &format, Config: swag.String(string(data))})
+		if assert.NoError(err, format) && assert.Equal(2, len(response.Lifecycle)) {
+			assert.Equal("logs/", response.Lifecycle[0].Prefix)
+			assert.Equal(2, len(response.Lifecycle[0].Tags))
+			assert.Equal(int64(30), response.Lifecycle[0].Transition.Days)
+			assert.Equal("COLD", response.Lifecycle[0].NoncurrentTransition.StorageClass)
+			assert.Equal("tmp/", response.Lifecycle[1].Prefix)
+			assert.Equal(int64(3), response.Lifecycle[1].AbortIncompleteMultipartDays)
+		}
+	}
+	// Test-2 : invalid configurations aren't set
+	current = nil
+	format := "json"
+	_, err := importBucketLifecycle(context.Background(), minClient, "testBucket", &models.BucketLifecycleImport{Format: &format, Config: swag.String(`{"Rules": [{"ID": "r", "Status": "Enabled"}]}`)})
+	assert.True(errors.Is(err, errInvalidLifecycleRule))
+	_, err = importBucketLifecycle(context.Background(), minClient, "testBucket", &models.BucketLifecycleImport{Format: &format, Config: swag.String(`<LifecycleConfiguration>`)})
+	assert.True(errors.Is(err, errInvalidLifecycleConfig))
+	assert.Nil(current)
+	// Test-3 : only a missing configuration is reported as not configured
+	minioGetLifecycleRulesMock = func(ctx context.Context, bucketName string) (*lifecycle.Configuration, error) {
+		return nil, minio.ErrorResponse{Code: "NoSuchLifecycleConfiguration"}
+	}
+	_, err = exportBucketLifecycle(context.Background(), minClient, "testBucket", format)
+	assert.Equal(errBucketLifeCycleNotConfigured, err)
+	minioGetLifecycleRulesMock = func(ctx context.Context, bucketName string) (*lifecycle.Configuration, error) {
+		return nil, minio.ErrorResponse{Code: "AccessDenied"}
+	}
+	_, err = exportBucketLifecycle(context.Background(), minClient, "testBucket", format)
+	assert.Equal("AccessDenied", minio.ToErrorResponse(err).Code)
+}
@@ -0,0 +1,476 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/user_api"
+	"github.com/minio/madmin-go"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/replication"
+)
+
+// replicationFailedLimit is the default number of failed objects returned
+const replicationFailedLimit = 1000
+
+func registerBucketReplicationStatusHandlers(api *operations.ConsoleAPI) {
+	// get bucket replication metrics
+	api.UserAPIGetBucketReplicationMetricsHandler = user_api.GetBucketReplicationMetricsHandlerFunc(func(params user_api.GetBucketReplicationMetricsParams, session *models.Principal) middleware.Responder {
+		metrics, err := getBucketReplicationMetricsResponse(session, params)
+		if err != nil {
+			return user_api.NewGetBucketReplicationMetricsDefault(int(err.Code)).WithPayload(err)
+		}
+		return user_api.NewGetBucketReplicationMetricsOK().WithPayload(metrics)
+	})
+	// list the objects which failed to replicate
+	api.UserAPIListBucketReplicationFailedHandler = user_api.ListBucketReplicationFailedHandlerFunc(func(params user_api.ListBucketReplicationFailedParams, session *models.Principal) middleware.Responder {
+		failed, err := getListBucketReplicationFailedResponse(session, params)
+		if err != nil {
+			return user_api.NewListBucketReplicationFailedDefault(int(err.Code)).WithPayload(err)
+		}
+		return user_api.NewListBucketReplicationFailedOK().WithPayload(failed)
+	})
+	// resync existing objects to every replication target of the bucket
+	api.UserAPIResyncBucketReplicationHandler = user_api.ResyncBucketReplicationHandlerFunc(func(params user_api.ResyncBucketReplicationParams, session *models.Principal) middleware.Responder {
+		resync, err := getResyncBucketReplicationResponse(session, params)
+		if err != nil {
+			return user_api.NewResyncBucketReplicationDefault(int(err.Code)).WithPayload(err)
+		}
+		return user_api.NewResyncBucketReplicationOK().WithPayload(resync)
+	})
+	// retry the failed replications
+	api.UserAPIRetryBucketReplicationHandler = user_api.RetryBucketReplicationHandlerFunc(func(params user_api.RetryBucketReplicationParams, session *models.Principal) middleware.Responder {
+		retry, err := getRetryBucketReplicationResponse(session, params)
+		if err != nil {
+			return user_api.NewRetryBucketReplicationDefault(int(err.Code)).WithPayload(err)
+		}
+		return user_api.NewRetryBucketReplicationOK().WithPayload(retry)
+	})
+}
+
+// getBucketReplicationMetrics combines the replication counters MinIO keeps for
+// the bucket with the configuration of its replication targets. The bandwidth
+// and the per target latency come from the cluster metrics when Prometheus or
+// the built-in collector has them, MinIO only reports the counters per bucket.
+func getBucketReplicationMetrics(ctx context.Context, client MinioClient, targets []madmin.BucketTarget, bucketName string, query func(ctx context.Context, expr string) ([]alertSample, error)) (*models.BucketReplicationMetrics, error) {
+	metrics, err := client.getBucketReplicationMetrics(ctx, bucketName)
+	if err != nil {
+		return nil, err
+	}
+	result := &models.BucketReplicationMetrics{
+		PendingCount:   int64(metrics.PendingCount),
+		FailedCount:    int64(metrics.FailedCount),
+		PendingSize:    int64(metrics.PendingSize),
+		FailedSize:     int64(metrics.FailedSize),
+		ReplicatedSize: int64(metrics.ReplicatedSize),
+		ReplicaSize:    int64(metrics.ReplicaSize),
+		Targets:        []*models.ReplicationTargetMetrics{},
+	}
+	latencies := make(map[string]float64)
+	if query != nil {
+		// we will tolerate the metrics being unavailable
+		samples, err := query(ctx, fmt.Sprintf(`sum(rate(minio_bucket_replication_sent_bytes{job="${jobid}",bucket="%s"}[5m]))`, bucketName))
+		if err != nil {
+			LogError("error querying replication bandwidth: %v", err)
+		}
+		for _, s := range samples {
+			result.Bandwidth = s.Value
+		}
+		samples, err = query(ctx, fmt.Sprintf(`avg by (targetArn) (minio_bucket_replication_latency_ms{job="${jobid}",bucket="%s"})`, bucketName))
+		if err != nil {
+			LogError("error querying replication latency: %v", err)
+		}
+		for _, s := range samples {
+			latencies[s.Labels["targetArn"]] = s.Value
+		}
+	}
+	for _, target := range targets {
+		if target.Type != madmin.ReplicationService {
+			continue
+		}
+		targetMetrics := &models.ReplicationTargetMetrics{
+			Arn:               target.Arn,
+			Endpoint:          target.Endpoint,
+			Bucket:            target.TargetBucket,
+			Secure:            target.Secure,
+			SyncMode:          models.ReplicationTargetMetricsSyncModeAsync,
+			StorageClass:      target.StorageClass,
+			BandwidthLimit:    target.BandwidthLimit,
+			HealthCheckPeriod: int64(target.HealthCheckDuration / time.Second),
+			LatencyMs:         latencies[target.Arn],
+			ResetID:           target.ResetID,
+		}
+		if target.ReplicationSync {
+			targetMetrics.SyncMode = models.ReplicationTargetMetricsSyncModeSync
+		}
+		if !target.ResetBeforeDate.IsZero() {
+			targetMetrics.ResetBeforeDate = target.ResetBeforeDate.Format(time.RFC3339)
+		}
+		result.Targets = append(result.Targets, targetMetrics)
+	}
+	return result, nil
+}
+
+// getBucketReplicationMetricsResponse returns the replication metrics of a bucket
+func getBucketReplicationMetricsResponse(session *models.Principal, params user_api.GetBucketReplicationMetricsParams) (*models.BucketReplicationMetrics, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	targets, err := adminClient.listRemoteBuckets(ctx, params.BucketName, string(madmin.ReplicationService))
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	metrics, err := getBucketReplicationMetrics(ctx, minioClient, targets, params.BucketName, queryMetrics)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return metrics, nil
+}
+
+// getObjectReplicationStatus returns the replication status of a listed object,
+// MinIO sends it as part of the metadata when the listing includes it
+func getObjectReplicationStatus(info minio.ObjectInfo) string {
+	if info.ReplicationStatus != "" {
+		return info.ReplicationStatus
+	}
+	for k, v := range info.UserMetadata {
+		if strings.EqualFold(k, "X-Amz-Replication-Status") {
+			return v
+		}
+	}
+	return ""
+}
+
+// walkFailedReplications calls fn with every object under the prefix whose
+// replication failed until fn returns false
+func walkFailedReplications(ctx context.Context, client MinioClient, bucketName, prefix string, fn func(info minio.ObjectInfo) bool) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	for info := range client.listObjects(ctx, bucketName, minio.ListObjectsOptions{
+		Prefix:       prefix,
+		Recursive:    true,
+		WithMetadata: true,
+	}) {
+		if info.Err != nil {
+			return info.Err
+		}
+		if getObjectReplicationStatus(info) != string(minio.ReplicationStatusFailed) {
+			continue
+		}
+		if !fn(info) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// matchReplicationRule returns the rule that applies to the object, enabled
+// rules are preferred over disabled ones and then the highest priority wins
+func matchReplicationRule(config replication.Config, objectName string) *replication.Rule {
+	var match *replication.Rule
+	for i := range config.Rules {
+		rule := &config.Rules[i]
+		if !strings.HasPrefix(objectName, rule.Prefix()) {
+			continue
+		}
+		if match == nil {
+			match = rule
+			continue
+		}
+		enabled, matchEnabled := rule.Status == replication.Enabled, match.Status == replication.Enabled
+		if (enabled && !matchEnabled) || (enabled == matchEnabled && rule.Priority > match.Priority) {
+			match = rule
+		}
+	}
+	return match
+}
+
+// replicationFailureReason explains why the replication of an object may have
+// failed. MinIO doesn't keep the error of a failed replication so the reason is
+// derived from the replication rules and the remote targets of the bucket
+func replicationFailureReason(config replication.Config, targets []madmin.BucketTarget, objectName string) (ruleID, arn, reason string) {
+	rule := matchReplicationRule(config, objectName)
+	if rule == nil {
+		return "", "", "no replication rule applies to the object anymore"
+	}
+	arn = replicationRuleTargetArn(config, *rule)
+	if rule.Status != replication.Enabled {
+		return rule.ID, arn, fmt.Sprintf("replication rule %s is disabled", rule.ID)
+	}
+	for _, target := range targets {
+		if target.Arn == arn {
+			return rule.ID, arn, fmt.Sprintf("replication to %s/%s failed, check the target is online and its credentials can write to the bucket", target.Endpoint, target.TargetBucket)
+		}
+	}
+	return rule.ID, arn, fmt.Sprintf("replication target %s no longer exists", arn)
+}
+
+// listReplicationFailedObjects lists up to limit objects whose replication
+// failed along with the likely reason
+func listReplicationFailedObjects(ctx context.Context, client MinioClient, targets []madmin.BucketTarget, bucketName, prefix string, limit int) (*models.BucketReplicationFailedObjects, error) {
+	// we will tolerate this call failing, the objects are listed without a rule
+	config, err := client.getBucketReplication(ctx, bucketName)
+	if err != nil {
+		LogError("error getting bucket replication: %v", err)
+	}
+	result := &models.BucketReplicationFailedObjects{Objects: []*models.ReplicationFailedObject{}}
+	err = walkFailedReplications(ctx, client, bucketName, prefix, func(info minio.ObjectInfo) bool {
+		if len(result.Objects) == limit {
+			result.Truncated = true
+			return false
+		}
+		ruleID, arn, reason := replicationFailureReason(config, targets, info.Key)
+		result.Objects = append(result.Objects, &models.ReplicationFailedObject{
+			Name:         info.Key,
+			VersionID:    info.VersionID,
+			Size:         info.Size,
+			LastModified: info.LastModified.Format(time.RFC3339),
+			RuleID:       ruleID,
+			Target:       arn,
+			Reason:       reason,
+		})
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// getListBucketReplicationFailedResponse lists the objects of a bucket which failed to replicate
+func getListBucketReplicationFailedResponse(session *models.Principal, params user_api.ListBucketReplicationFailedParams) (*models.BucketReplicationFailedObjects, *models.Error) {
+	ctx := params.HTTPRequest.Context()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+	mAdmin, err := NewMinioAdminClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	// create a MinIO Admin Client interface implementation
+	// defining the client to be used
+	adminClient := AdminClient{Client: mAdmin}
+	targets, err := adminClient.listRemoteBuckets(ctx, params.BucketName, string(madmin.ReplicationService))
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	prefix := ""
+	if params.Prefix != nil {
+		prefix = *params.Prefix
+	}
+	limit := replicationFailedLimit
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = int(*params.Limit)
+	}
+	failed, err := listReplicationFailedObjects(ctx, minioClient, targets, params.BucketName, prefix, limit)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return failed, nil
+}
+
+// resyncBucketReplication replicates again the existing objects older than
+// olderThan, or all of them when it's empty, to the target of the arn or to
+// every target of the bucket when it's empty. MinIO requires existing object
+// replication to be enabled in the rules
+func resyncBucketReplication(ctx context.Context, client MinioClient, bucketName, olderThan, arn string) (*models.ReplicationResyncResponse, error) {
+	var duration time.Duration
+	if olderThan != "" {
+		var err error
+		if duration, err = parsePromDuration(olderThan); err != nil {
+			return nil, errInvalidResyncOlderThan
+		}
+	}
+	var resetID string
+	var err error
+	if arn != "" {
+		resetID, err = client.resetBucketReplicationOnTarget(ctx, bucketName, duration, arn)
+	} else {
+		resetID, err = client.resetBucketReplication(ctx, bucketName, duration)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &models.ReplicationResyncResponse{ResetID: resetID}, nil
+}
+
+// getResyncBucketReplicationResponse starts the resync of the existing objects of a bucket
+func getResyncBucketReplicationResponse(session *models.Principal, params user_api.ResyncBucketReplicationParams) (*models.ReplicationResyncResponse, *models.Error) {
+	if params.Body == nil {
+		return nil, prepareError(errReplicationBodyNotInRequest)
+	}
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient, creds: getConsoleCredentialsFromSession(session)}
+	resync, err := resyncBucketReplication(params.HTTPRequest.Context(), minioClient, params.BucketName, params.Body.OlderThan, params.Body.Arn)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return resync, nil
+}
+
+// replicationRetryHeaders are the standard headers kept by the metadata
+// replace of a retry, along with the user metadata
+var replicationRetryHeaders = []string{"Cache-Control", "Content-Disposition", "Content-Encoding", "Content-Language", "Content-Type", "Expires"}
+
+// replicationRetryMetadata returns the metadata of the object to set again,
+// the replication status and the other internal headers are left out
+func replicationRetryMetadata(info minio.ObjectInfo) map[string]string {
+	metadata := make(map[string]string)
+	if info.ContentType != "" {
+		metadata["Content-Type"] = info.ContentType
+	}
+	for k, v := range info.UserMetadata {
+		if strings.HasPrefix(strings.ToLower(k), "x-amz-meta-") {
+			metadata[k] = v
+			continue
+		}
+		for _, header := range replicationRetryHeaders {
+			if strings.EqualFold(k, header) {
+				metadata[header] = v
+			}
+		}
+	}
+	return metadata
+}
+
+// retryFailedReplications queues the objects which failed to replicate again
+// by replacing the metadata of their latest version with the same metadata,
+// MinIO updates the version in place and replicates it again without
+// creating a new version. When no names are given every failed object under
+// the prefix is retried, otherwise the named objects are read one by one
+func retryFailedReplications(ctx context.Context, client MinioClient, bucketName, prefix string, names []string) (*models.ReplicationRetryResponse, error) {
+	var failed []minio.ObjectInfo
+	var results []*models.ReplicationRetryResult
+	if len(names) == 0 {
+		err := walkFailedReplications(ctx, client, bucketName, prefix, func(info minio.ObjectInfo) bool {
+			failed = append(failed, info)
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, name := range names {
+		info, err := client.statObject(ctx, bucketName, name, minio.StatObjectOptions{})
+		if err != nil {
+			results = append(results, &models.ReplicationRetryResult{
+				Name:   name,
+				Status: models.ReplicationRetryResultStatusFailed,
+				Reason: err.Error(),
+			})
+			continue
+		}
+		if getObjectReplicationStatus(info) != string(minio.ReplicationStatusFailed) {
+			results = append(results, &models.ReplicationRetryResult{
+				Name:   name,
+				Status: models.ReplicationRetryResultStatusSkipped,
+				Reason: "the object replication has not failed",
+			})
+			continue
+		}
+		// the stat returns the metadata as headers, the retry reads it the way the listing returns it
+		info.UserMetadata = minio.StringMap{}
+		for k, v := range info.Metadata {
+			if len(v) > 0 {
+				info.UserMetadata[k] = v[0]
+			}
+		}
+		failed = append(failed, info)
+	}
+
+	response := &models.ReplicationRetryResponse{Objects: []*models.ReplicationRetryResult{}}
+	for _, info := range failed {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		result := &models.ReplicationRetryResult{Name: info.Key, VersionID: info.VersionID, Status: models.ReplicationRetryResultStatusQueued}
+		err := copyObjectVersion(ctx, client, minio.CopyDestOptions{
+			Bucket:          bucketName,
+			Object:          info.Key,
+			ReplaceMetadata: true,
+			UserMetadata:    replicationRetryMetadata(info),
+		}, minio.CopySrcOptions{
+			Bucket:    bucketName,
+			Object:    info.Key,
+			VersionID: info.VersionID,
+		}, info.Size)
+		if err != nil {
+			result.Status = models.ReplicationRetryResultStatusFailed
+			result.Reason = err.Error()
+		}
+		response.Objects = append(response.Objects, result)
+	}
+	response.Objects = append(response.Objects, results...)
+	for _, result := range response.Objects {
+		switch result.Status {
+		case models.ReplicationRetryResultStatusQueued:
+			response.Queued++
+		case models.ReplicationRetryResultStatusFailed:
+			response.Failed++
+		default:
+			response.Skipped++
+		}
+	}
+	return response, nil
+}
+
+// getRetryBucketReplicationResponse retries the failed replications of a bucket
+func getRetryBucketReplicationResponse(session *models.Principal, params user_api.RetryBucketReplicationParams) (*models.ReplicationRetryResponse, *models.Error) {
+	if params.Body == nil {
+		return nil, prepareError(errReplicationBodyNotInRequest)
+	}
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+	retry, err := retryFailedReplications(params.HTTPRequest.Context(), minioClient, params.BucketName, params.Body.Prefix, params.Body.Objects)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return retry, nil
+}
@@ -0,0 +1,266 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/minio/console/models"
+	"github.com/minio/madmin-go"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/replication"
+	"github.com/stretchr/testify/assert"
+)
+
+var minioGetBucketReplicationMock func(ctx context.Context, bucketName string) (replication.Config, error)
+var minioGetBucketReplicationMetricsMock func(ctx context.Context, bucketName string) (replication.Metrics, error)
+var minioResetBucketReplicationMock func(ctx context.Context, bucketName string, olderThan time.Duration) (string, error)
+var minioResetBucketReplicationOnTargetMock func(ctx context.Context, bucketName string, olderThan time.Duration, arn string) (string, error)
+var minioStatObjectMock func(ctx context.Context, bucketName, objectName string, opts minio.StatObjectOptions) (minio.ObjectInfo, error)
+
+// mock function of getBucketReplication()
+func (mc minioClientMock) getBucketReplication(ctx context.Context, bucketName string) (replication.Config, error) {
+	return minioGetBucketReplicationMock(ctx, bucketName)
+}
+
+// mock function of getBucketReplicationMetrics()
+func (mc minioClientMock) getBucketReplicationMetrics(ctx context.Context, bucketName string) (replication.Metrics, error) {
+	return minioGetBucketReplicationMetricsMock(ctx, bucketName)
+}
+
+// mock function of resetBucketReplication()
+func (mc minioClientMock) resetBucketReplication(ctx context.Context, bucketName string, olderThan time.Duration) (string, error) {
+	return minioResetBucketReplicationMock(ctx, bucketName, olderThan)
+}
+
+// mock function of resetBucketReplicationOnTarget()
+func (mc minioClientMock) resetBucketReplicationOnTarget(ctx context.Context, bucketName string, olderThan time.Duration, arn string) (string, error) {
+	return minioResetBucketReplicationOnTargetMock(ctx, bucketName, olderThan, arn)
+}
+
+// mock function of statObject()
+func (mc minioClientMock) statObject(ctx context.Context, bucketName, objectName string, opts minio.StatObjectOptions) (minio.ObjectInfo, error) {
+	return minioStatObjectMock(ctx, bucketName, objectName, opts)
+}
+
+// mockReplicationListing lists the objects under the prefix of the listing options
+func mockReplicationListing(objects []minio.ObjectInfo) func(ctx context.Context, bucket string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+	return func(ctx context.Context, bucket string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		ch := make(chan minio.ObjectInfo)
+		go func() {
+			defer close(ch)
+			for _, info := range objects {
+				if !strings.HasPrefix(info.Key, opts.Prefix) {
+					continue
+				}
+				select {
+				case ch <- info:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		return ch
+	}
+}
+
+func TestGetBucketReplicationMetrics(t *testing.T) {
+	assert := assert.New(t)
+	client := minioClientMock{}
+	minioGetBucketReplicationMetricsMock = func(ctx context.Context, bucketName string) (replication.Metrics, error) {
+		return replication.Metrics{PendingCount: 2, FailedCount: 1, PendingSize: 2048, FailedSize: 1024, ReplicatedSize: 4096}, nil
+	}
+	targets := []madmin.BucketTarget{
+		{Arn: "arn:minio:replication::1:dest", Endpoint: "play.min.io", TargetBucket: "dest", Type: madmin.ReplicationService, BandwidthLimit: 1048576, ReplicationSync: true, HealthCheckDuration: 5 * time.Second},
+		{Arn: "arn:minio:ilm::1:tier", Endpoint: "tier.min.io", TargetBucket: "tier", Type: madmin.ServiceType("ilm")},
+	}
+	var queries []string
+	query := func(ctx context.Context, expr string) ([]alertSample, error) {
+		queries = append(queries, expr)
+		if strings.Contains(expr, "latency") {
+			return []alertSample{{Labels: map[string]string{"targetArn": "arn:minio:replication::1:dest"}, Value: 12.5}}, nil
+		}
+		return []alertSample{{Labels: map[string]string{}, Value: 512}}, nil
+	}
+	// Test-1 : counters, bandwidth and the replication targets
+	metrics, err := getBucketReplicationMetrics(context.Background(), client, targets, "photos", query)
+	if assert.NoError(err) {
+		assert.Equal(int64(2), metrics.PendingCount)
+		assert.Equal(int64(1), metrics.FailedCount)
+		assert.Equal(int64(4096), metrics.ReplicatedSize)
+		assert.Equal(float64(512), metrics.Bandwidth)
+		if assert.Equal(1, len(metrics.Targets)) {
+			assert.Equal("dest", metrics.Targets[0].Bucket)
+			assert.Equal(models.ReplicationTargetMetricsSyncModeSync, metrics.Targets[0].SyncMode)
+			assert.Equal(int64(5), metrics.Targets[0].HealthCheckPeriod)
+			assert.Equal(12.5, metrics.Targets[0].LatencyMs)
+		}
+		assert.Contains(queries[0], `bucket="photos"`)
+	}
+	// Test-2 : metrics queries failing are tolerated
+	metrics, err = getBucketReplicationMetrics(context.Background(), client, targets, "photos", func(ctx context.Context, expr string) ([]alertSample, error) {
+		return nil, errors.New("prometheus is down")
+	})
+	if assert.NoError(err) {
+		assert.Equal(float64(0), metrics.Bandwidth)
+		assert.Equal(float64(0), metrics.Targets[0].LatencyMs)
+	}
+	// Test-3 : errors getting the counters are returned
+	minioGetBucketReplicationMetricsMock = func(ctx context.Context, bucketName string) (replication.Metrics, error) {
+		return replication.Metrics{}, errors.New("replication not configured")
+	}
+	_, err = getBucketReplicationMetrics(context.Background(), client, targets, "photos", nil)
+	assert.Error(err)
+}
+
+func TestListReplicationFailedObjects(t *testing.T) {
+	assert := assert.New(t)
+	client := minioClientMock{}
+	minioListObjectsMock = mockReplicationListing([]minio.ObjectInfo{
+		{Key: "docs/a.txt", VersionID: "a1", ReplicationStatus: "FAILED"},
+		{Key: "docs/b.txt", VersionID: "b1", ReplicationStatus: "COMPLETED"},
+		{Key: "logs/c.txt", VersionID: "c1", UserMetadata: minio.StringMap{"X-Amz-Replication-Status": "FAILED"}},
+		{Key: "other/d.txt", VersionID: "d1", ReplicationStatus: "FAILED"},
+		{Key: "tmp/e.txt", VersionID: "e1", ReplicationStatus: "FAILED"},
+	})
+	minioGetBucketReplicationMock = func(ctx context.Context, bucketName string) (replication.Config, error) {
+		return replication.Config{Rules: []replication.Rule{
+			{ID: "docs", Status: replication.Enabled, Priority: 1, Filter: replication.Filter{Prefix: "docs/"}, Destination: replication.Destination{Bucket: "arn:minio:replication::1:dest"}},
+			{ID: "logs", Status: replication.Disabled, Priority: 2, Filter: replication.Filter{Prefix: "logs/"}, Destination: replication.Destination{Bucket: "arn:minio:replication::1:dest"}},
+			{ID: "tmp", Status: replication.Enabled, Priority: 3, Filter: replication.Filter{Prefix: "tmp/"}, Destination: replication.Destination{Bucket: "arn:minio:replication::1:gone"}},
+		}}, nil
+	}
+	targets := []madmin.BucketTarget{{Arn: "arn:minio:replication::1:dest", Endpoint: "play.min.io", TargetBucket: "dest", Type: madmin.ReplicationService}}
+	// Test-1 : failed objects with the reason of the failure
+	failed, err := listReplicationFailedObjects(context.Background(), client, targets, "photos", "", replicationFailedLimit)
+	if assert.NoError(err) && assert.Equal(4, len(failed.Objects)) {
+		assert.False(failed.Truncated)
+		assert.Equal("docs/a.txt", failed.Objects[0].Name)
+		assert.Equal("docs", failed.Objects[0].RuleID)
+		assert.Contains(failed.Objects[0].Reason, "play.min.io/dest")
+		assert.Equal("replication rule logs is disabled", failed.Objects[1].Reason)
+		assert.Equal("no replication rule applies to the object anymore", failed.Objects[2].Reason)
+		assert.Equal("replication target arn:minio:replication::1:gone no longer exists", failed.Objects[3].Reason)
+	}
+	// Test-2 : the listing is truncated at the limit
+	failed, err = listReplicationFailedObjects(context.Background(), client, targets, "photos", "", 2)
+	if assert.NoError(err) {
+		assert.Equal(2, len(failed.Objects))
+		assert.True(failed.Truncated)
+	}
+	// Test-3 : listing errors are returned
+	minioListObjectsMock = mockReplicationListing([]minio.ObjectInfo{{Err: errors.New("access denied")}})
+	_, err = listReplicationFailedObjects(context.Background(), client, targets, "photos", "", replicationFailedLimit)
+	assert.Error(err)
+}
+
+func TestRetryFailedReplications(t *testing.T) {
+	assert := assert.New(t)
+	client := minioClientMock{}
+	minioListObjectsMock = mockReplicationListing([]minio.ObjectInfo{
+		{Key: "docs/a.txt", VersionID: "a1", ReplicationStatus: "FAILED", ContentType: "text/plain", UserMetadata: minio.StringMap{
+			"X-Amz-Meta-Owner": "alice", "X-Amz-Replication-Status": "FAILED", "cache-control": "no-cache",
+		}},
+		{Key: "docs/b.txt", VersionID: "b1", ReplicationStatus: "COMPLETED"},
+		{Key: "docs/c.txt", VersionID: "c1", ReplicationStatus: "FAILED"},
+	})
+	var copied []string
+	var metadata map[string]string
+	minioCopyObjectMock = func(ctx context.Context, dst minio.CopyDestOptions, src minio.CopySrcOptions) (minio.UploadInfo, error) {
+		if src.Object == "docs/c.txt" {
+			return minio.UploadInfo{}, errors.New("access denied")
+		}
+		assert.True(dst.ReplaceMetadata)
+		metadata = dst.UserMetadata
+		copied = append(copied, src.Object+"@"+src.VersionID)
+		return minio.UploadInfo{VersionID: "a1"}, nil
+	}
+	// Test-1 : every failed object under the prefix is retried, keeping its metadata
+	retry, err := retryFailedReplications(context.Background(), client, "photos", "docs/", nil)
+	if assert.NoError(err) {
+		assert.Equal([]string{"docs/a.txt@a1"}, copied)
+		assert.Equal(map[string]string{"Content-Type": "text/plain", "X-Amz-Meta-Owner": "alice", "Cache-Control": "no-cache"}, metadata)
+		assert.Equal(int64(1), retry.Queued)
+		assert.Equal(int64(1), retry.Failed)
+		assert.Equal("a1", retry.Objects[0].VersionID)
+		assert.Equal("access denied", retry.Objects[1].Reason)
+	}
+	// Test-2 : named objects are read one by one, objects which didn't fail are skipped
+	minioListObjectsMock = nil
+	minioStatObjectMock = func(ctx context.Context, bucketName, objectName string, opts minio.StatObjectOptions) (minio.ObjectInfo, error) {
+		switch objectName {
+		case "docs/a.txt":
+			return minio.ObjectInfo{Key: objectName, VersionID: "a1", ReplicationStatus: "FAILED", ContentType: "text/plain", Metadata: map[string][]string{
+				"X-Amz-Meta-Owner": {"alice"}, "Cache-Control": {"no-cache"},
+			}}, nil
+		case "docs/b.txt":
+			return minio.ObjectInfo{Key: objectName, VersionID: "b1", ReplicationStatus: "COMPLETED"}, nil
+		}
+		return minio.ObjectInfo{}, errors.New("The specified key does not exist.")
+	}
+	copied = nil
+	retry, err = retryFailedReplications(context.Background(), client, "photos", "", []string{"docs/a.txt", "docs/b.txt", "docs/d.txt"})
+	if assert.NoError(err) && assert.Equal(3, len(retry.Objects)) {
+		assert.Equal([]string{"docs/a.txt@a1"}, copied)
+		assert.Equal(map[string]string{"Content-Type": "text/plain", "X-Amz-Meta-Owner": "alice", "Cache-Control": "no-cache"}, metadata)
+		assert.Equal(int64(1), retry.Queued)
+		assert.Equal(int64(1), retry.Skipped)
+		assert.Equal(int64(1), retry.Failed)
+		assert.Equal(models.ReplicationRetryResultStatusSkipped, retry.Objects[1].Status)
+		assert.Equal("The specified key does not exist.", retry.Objects[2].Reason)
+	}
+}
+
+func TestResyncBucketReplication(t *testing.T) {
+	assert := assert.New(t)
+	client := minioClientMock{}
+	var olderThan time.Duration
+	minioResetBucketReplicationMock = func(ctx context.Context, bucketName string, d time.Duration) (string, error) {
+		olderThan = d
+		return "reset-id", nil
+	}
+	var target string
+	minioResetBucketReplicationOnTargetMock = func(ctx context.Context, bucketName string, d time.Duration, arn string) (string, error) {
+		olderThan = d
+		target = arn
+		return "target-reset-id", nil
+	}
+	// Test-1 : durations in days
+	resync, err := resyncBucketReplication(context.Background(), client, "photos", "2d", "")
+	if assert.NoError(err) {
+		assert.Equal("reset-id", resync.ResetID)
+		assert.Equal(48*time.Hour, olderThan)
+	}
+	// Test-2 : every object is resynced without a duration
+	_, err = resyncBucketReplication(context.Background(), client, "photos", "", "")
+	assert.NoError(err)
+	assert.Equal(time.Duration(0), olderThan)
+	// Test-3 : invalid durations
+	_, err = resyncBucketReplication(context.Background(), client, "photos", "yesterday", "")
+	assert.Equal(errInvalidResyncOlderThan, err)
+	// Test-4 : a single target is resynced when its arn is given
+	resync, err = resyncBucketReplication(context.Background(), client, "photos", "1h", "arn:minio:replication::1:backup")
+	if assert.NoError(err) {
+		assert.Equal("target-reset-id", resync.ResetID)
+		assert.Equal("arn:minio:replication::1:backup", target)
+		assert.Equal(time.Hour, olderThan)
+	}
+}
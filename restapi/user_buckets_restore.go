@@ -0,0 +1,323 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/gorilla/websocket"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/user_api"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/pkg/env"
+)
+
+func registerBucketRestoreHandlers(api *operations.ConsoleAPI) {
+	// restore a bucket or prefix to a point in time
+	api.UserAPIRestoreBucketHandler = user_api.RestoreBucketHandlerFunc(func(params user_api.RestoreBucketParams, session *models.Principal) middleware.Responder {
+		summary, err := getRestoreBucketResponse(session, params)
+		if err != nil {
+			return user_api.NewRestoreBucketDefault(int(err.Code)).WithPayload(err)
+		}
+		return user_api.NewRestoreBucketOK().WithPayload(summary)
+	})
+}
+
+// restoreItemsLimit is the maximum number of objects listed in a restore
+// summary, the websocket restore reports every object of larger restores
+const restoreItemsLimit = 1000
+
+// maxCopyObjectSize is the largest object copied with a single request
+const maxCopyObjectSize = 5 << 30
+
+// reasons an object is left out of the restore
+const (
+	restoreReasonUnchanged    = "unchanged since the restore date"
+	restoreReasonCreatedAfter = "created after the restore date"
+	restoreReasonDeleted      = "deleted at the restore date"
+)
+
+// restoreOptions holds the parameters of a point in time restore, no more
+// than MaxObjects objects are restored when set
+type restoreOptions struct {
+	BucketName  string
+	Prefix      string
+	Date        time.Time
+	RemoveNewer bool
+	DryRun      bool
+	MaxObjects  int
+}
+
+// restoreProgress is sent through the websocket connection after every
+// object, the summary is only set on the last message
+type restoreProgress struct {
+	Processed int                    `json:"processed"`
+	Object    *models.RestoreObject  `json:"object,omitempty"`
+	Summary   *models.RestoreSummary `json:"summary,omitempty"`
+}
+
+// getRestoreMaxObjects returns how many objects a restore goes through, 100000 by default
+func getRestoreMaxObjects() int {
+	maxObjects, err := strconv.Atoi(env.Get(ConsoleRestoreMaxObjects, "100000"))
+	if err != nil || maxObjects < 1 {
+		return 100000
+	}
+	return maxObjects
+}
+
+// copyObjectVersion copies a version with a single request, versions larger
+// than maxCopyObjectSize are copied in parts
+func copyObjectVersion(ctx context.Context, client MinioClient, dst minio.CopyDestOptions, src minio.CopySrcOptions, size int64) error {
+	var err error
+	if size > maxCopyObjectSize {
+		_, err = client.composeObject(ctx, dst, []minio.CopySrcOptions{src})
+	} else {
+		_, err = client.copyObject(ctx, dst, src)
+	}
+	return err
+}
+
+// planRestoreObject compares an object with the version that was the latest
+// at the restore date, the same one the rewind view shows
+func planRestoreObject(name string, latest, atDate *minio.ObjectInfo, removeNewer bool) *models.RestoreObject {
+	item := &models.RestoreObject{Name: name, Action: models.RestoreObjectActionSkip}
+	switch {
+	case atDate != nil && !atDate.IsDeleteMarker:
+		item.VersionID = atDate.VersionID
+		if latest.VersionID == atDate.VersionID {
+			item.Reason = restoreReasonUnchanged
+		} else {
+			item.Action = models.RestoreObjectActionRestore
+		}
+	case latest.IsDeleteMarker:
+		item.Reason = restoreReasonUnchanged
+	case atDate == nil:
+		item.Reason = restoreReasonCreatedAfter
+	default:
+		item.Reason = restoreReasonDeleted
+	}
+	if item.Action == models.RestoreObjectActionSkip && item.Reason != restoreReasonUnchanged && removeNewer {
+		item.Action = models.RestoreObjectActionRemove
+	}
+	item.Status = models.RestoreObjectStatusPlanned
+	if item.Action == models.RestoreObjectActionSkip {
+		item.Status = models.RestoreObjectStatusSkipped
+	}
+	return item
+}
+
+// planBucketRestore plans the restore of every object under the prefix. The
+// listing returns the versions of an object one after the other so fn gets
+// each object, along with the size of the version to restore, as soon as its
+// versions are read. It returns whether the listing stopped at opts.MaxObjects
+func planBucketRestore(ctx context.Context, client MinioClient, opts restoreOptions, fn func(item *models.RestoreObject, size int64) error) (bool, error) {
+	// stop the listing when returning before its end
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var name string
+	var latest, atDate *minio.ObjectInfo
+	planned := 0
+	plan := func() error {
+		if latest == nil {
+			return nil
+		}
+		item := planRestoreObject(name, latest, atDate, opts.RemoveNewer)
+		var size int64
+		if item.Action == models.RestoreObjectActionRestore {
+			size = atDate.Size
+		}
+		latest, atDate = nil, nil
+		planned++
+		return fn(item, size)
+	}
+	for info := range client.listObjects(ctx, opts.BucketName, minio.ListObjectsOptions{
+		Prefix:       opts.Prefix,
+		Recursive:    true,
+		WithVersions: true,
+	}) {
+		if info.Err != nil {
+			return false, info.Err
+		}
+		info := info
+		if info.Key != name {
+			if err := plan(); err != nil {
+				return false, err
+			}
+			if opts.MaxObjects > 0 && planned >= opts.MaxObjects {
+				return true, nil
+			}
+			name = info.Key
+		}
+		if info.IsLatest || latest == nil || (!latest.IsLatest && info.LastModified.After(latest.LastModified)) {
+			latest = &info
+		}
+		if !info.LastModified.After(opts.Date) && (atDate == nil || info.LastModified.After(atDate.LastModified)) {
+			atDate = &info
+		}
+	}
+	return false, plan()
+}
+
+// restoreBucket restores the objects under the prefix to the versions they had
+// at the restore date by copying them as new latest versions, objects created
+// afterwards are removed with a delete marker when requested. On a dry run the
+// plan is returned without changes and the summary counts the planned actions.
+// The summary lists up to restoreItemsLimit objects, progress gets all of them
+func restoreBucket(ctx context.Context, client MinioClient, opts restoreOptions, progress func(processed int, item *models.RestoreObject) error) (*models.RestoreSummary, error) {
+	summary := &models.RestoreSummary{DryRun: opts.DryRun, Objects: []*models.RestoreObject{}}
+	processed := 0
+	limitReached, err := planBucketRestore(ctx, client, opts, func(item *models.RestoreObject, size int64) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if !opts.DryRun {
+			var actionErr error
+			switch item.Action {
+			case models.RestoreObjectActionRestore:
+				actionErr = copyObjectVersion(ctx, client, minio.CopyDestOptions{
+					Bucket: opts.BucketName,
+					Object: item.Name,
+				}, minio.CopySrcOptions{
+					Bucket:    opts.BucketName,
+					Object:    item.Name,
+					VersionID: item.VersionID,
+				}, size)
+				item.Status = models.RestoreObjectStatusRestored
+			case models.RestoreObjectActionRemove:
+				actionErr = client.removeObject(ctx, opts.BucketName, item.Name, minio.RemoveObjectOptions{})
+				item.Status = models.RestoreObjectStatusRemoved
+			}
+			if actionErr != nil {
+				item.Status = models.RestoreObjectStatusFailed
+				item.Error = actionErr.Error()
+			}
+		}
+		switch {
+		case item.Status == models.RestoreObjectStatusFailed:
+			summary.Failed++
+		case item.Action == models.RestoreObjectActionRestore:
+			summary.Restored++
+		case item.Action == models.RestoreObjectActionRemove:
+			summary.Removed++
+		default:
+			summary.Skipped++
+		}
+		if len(summary.Objects) < restoreItemsLimit {
+			summary.Objects = append(summary.Objects, item)
+		} else {
+			summary.Truncated = true
+		}
+		processed++
+		if progress != nil {
+			return progress(processed, item)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	summary.LimitReached = limitReached
+	return summary, nil
+}
+
+// getRestoreBucketResponse restores a bucket or prefix to a point in time
+func getRestoreBucketResponse(session *models.Principal, params user_api.RestoreBucketParams) (*models.RestoreSummary, *models.Error) {
+	if params.Body == nil {
+		return nil, prepareError(errRestoreBodyNotInRequest)
+	}
+	date, err := time.Parse(time.RFC3339, *params.Body.Date)
+	if err != nil {
+		return nil, prepareError(errInvalidRestoreDate)
+	}
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+	summary, err := restoreBucket(params.HTTPRequest.Context(), minioClient, restoreOptions{
+		BucketName:  params.BucketName,
+		Prefix:      params.Body.Prefix,
+		Date:        date,
+		RemoveNewer: params.Body.RemoveNewer,
+		DryRun:      params.Body.DryRun,
+		MaxObjects:  getRestoreMaxObjects(),
+	}, nil)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return summary, nil
+}
+
+// getRestoreOptionsFromReq gets the restore options from a request like
+// /restore/<bucket>?prefix=<prefix>&date=<RFC3339 date>&removeNewer=true&dryRun=true
+func getRestoreOptionsFromReq(req *http.Request) (*restoreOptions, error) {
+	re := regexp.MustCompile(`(/restore/)(.*?$)`)
+	matches := re.FindAllSubmatch([]byte(req.URL.Path), -1)
+	if len(matches) == 0 || len(matches[0]) < 3 || strings.TrimSpace(string(matches[0][2])) == "" {
+		return nil, fmt.Errorf("invalid url: %s", req.URL.Path)
+	}
+	date, err := time.Parse(time.RFC3339, req.FormValue("date"))
+	if err != nil {
+		return nil, errInvalidRestoreDate
+	}
+	return &restoreOptions{
+		BucketName:  strings.TrimSpace(string(matches[0][2])),
+		Prefix:      req.FormValue("prefix"),
+		Date:        date,
+		RemoveNewer: req.FormValue("removeNewer") == "true",
+		DryRun:      req.FormValue("dryRun") == "true",
+		MaxObjects:  getRestoreMaxObjects(),
+	}, nil
+}
+
+// startRestore restores a bucket sending the progress after every object and
+// the summary once done
+func startRestore(ctx context.Context, conn WSConn, client MinioClient, opts *restoreOptions) error {
+	sendProgress := func(message restoreProgress) error {
+		// Serialize message to be sent
+		bytes, err := json.Marshal(message)
+		if err != nil {
+			LogError("error on json.Marshal: %v", err)
+			return err
+		}
+		// Send Message through websocket connection
+		if err = conn.writeMessage(websocket.TextMessage, bytes); err != nil {
+			LogError("error writeMessage: %v", err)
+			return err
+		}
+		return nil
+	}
+	summary, err := restoreBucket(ctx, client, *opts, func(processed int, item *models.RestoreObject) error {
+		return sendProgress(restoreProgress{Processed: processed, Object: item})
+	})
+	if err != nil {
+		return err
+	}
+	processed := int(summary.Restored + summary.Removed + summary.Skipped + summary.Failed)
+	return sendProgress(restoreProgress{Processed: processed, Summary: summary})
+}
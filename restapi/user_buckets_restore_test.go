@@ -0,0 +1,227 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+var minioCopyObjectMock func(ctx context.Context, dst minio.CopyDestOptions, src minio.CopySrcOptions) (minio.UploadInfo, error)
+var minioComposeObjectMock func(ctx context.Context, dst minio.CopyDestOptions, srcs []minio.CopySrcOptions) (minio.UploadInfo, error)
+var minioRemoveObjectMock func(ctx context.Context, bucketName, objectName string, opts minio.RemoveObjectOptions) error
+
+// mock function of copyObject()
+func (ac minioClientMock) copyObject(ctx context.Context, dst minio.CopyDestOptions, src minio.CopySrcOptions) (minio.UploadInfo, error) {
+	return minioCopyObjectMock(ctx, dst, src)
+}
+
+// mock function of composeObject()
+func (ac minioClientMock) composeObject(ctx context.Context, dst minio.CopyDestOptions, srcs []minio.CopySrcOptions) (minio.UploadInfo, error) {
+	return minioComposeObjectMock(ctx, dst, srcs)
+}
+
+// mock function of removeObject()
+func (ac minioClientMock) removeObject(ctx context.Context, bucketName, objectName string, opts minio.RemoveObjectOptions) error {
+	return minioRemoveObjectMock(ctx, bucketName, objectName, opts)
+}
+
+func TestRestoreBucket(t *testing.T) {
+	assert := assert.New(t)
+	client := minioClientMock{}
+	date := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	before := date.Add(-time.Hour)
+	after := date.Add(time.Hour)
+	minioListObjectsMock = func(ctx context.Context, bucket string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		ch := make(chan minio.ObjectInfo)
+		go func() {
+			defer close(ch)
+			for _, info := range []minio.ObjectInfo{
+				// modified after the restore date
+				{Key: "photos/a.jpg", VersionID: "a2", LastModified: after, IsLatest: true},
+				{Key: "photos/a.jpg", VersionID: "a1", LastModified: before, Size: 6 << 30},
+				// unchanged
+				{Key: "photos/b.jpg", VersionID: "b1", LastModified: before, IsLatest: true},
+				// created after the restore date
+				{Key: "photos/c.jpg", VersionID: "c1", LastModified: after, IsLatest: true},
+				// deleted after the restore date
+				{Key: "photos/d.jpg", VersionID: "d2", LastModified: after, IsLatest: true, IsDeleteMarker: true},
+				{Key: "photos/d.jpg", VersionID: "d1", LastModified: before, Size: 1024},
+				// deleted at the restore date and recreated
+				{Key: "photos/e.jpg", VersionID: "e3", LastModified: after, IsLatest: true},
+				{Key: "photos/e.jpg", VersionID: "e2", LastModified: before, IsDeleteMarker: true},
+				{Key: "photos/e.jpg", VersionID: "e1", LastModified: before.Add(-time.Hour)},
+			} {
+				ch <- info
+			}
+		}()
+		return ch
+	}
+	var copied, composed, removed []string
+	minioCopyObjectMock = func(ctx context.Context, dst minio.CopyDestOptions, src minio.CopySrcOptions) (minio.UploadInfo, error) {
+		if src.Object == "photos/d.jpg" {
+			return minio.UploadInfo{}, errors.New("access denied")
+		}
+		copied = append(copied, src.Object+"@"+src.VersionID)
+		return minio.UploadInfo{}, nil
+	}
+	minioComposeObjectMock = func(ctx context.Context, dst minio.CopyDestOptions, srcs []minio.CopySrcOptions) (minio.UploadInfo, error) {
+		composed = append(composed, srcs[0].Object+"@"+srcs[0].VersionID)
+		return minio.UploadInfo{}, nil
+	}
+	minioRemoveObjectMock = func(ctx context.Context, bucketName, objectName string, opts minio.RemoveObjectOptions) error {
+		removed = append(removed, objectName)
+		return nil
+	}
+	opts := restoreOptions{BucketName: "bucket", Prefix: "photos/", Date: date, RemoveNewer: true, DryRun: true}
+
+	// Test-1 : a dry run reports the plan without changes
+	summary, err := restoreBucket(context.Background(), client, opts, nil)
+	assert.NoError(err)
+	assert.Empty(copied)
+	assert.Empty(removed)
+	assert.True(summary.DryRun)
+	actions := map[string]string{}
+	for _, item := range summary.Objects {
+		actions[item.Name] = item.Action
+	}
+	assert.Equal(map[string]string{
+		"photos/a.jpg": models.RestoreObjectActionRestore,
+		"photos/b.jpg": models.RestoreObjectActionSkip,
+		"photos/c.jpg": models.RestoreObjectActionRemove,
+		"photos/d.jpg": models.RestoreObjectActionRestore,
+		"photos/e.jpg": models.RestoreObjectActionRemove,
+	}, actions)
+	assert.Equal(int64(2), summary.Restored)
+	assert.Equal(int64(2), summary.Removed)
+	assert.Equal(int64(1), summary.Skipped)
+
+	// Test-2 : objects are restored and removed reporting the progress, versions larger than 5GiB are copied in parts
+	opts.DryRun = false
+	var processed []int
+	summary, err = restoreBucket(context.Background(), client, opts, func(n int, item *models.RestoreObject) error {
+		processed = append(processed, n)
+		return nil
+	})
+	assert.NoError(err)
+	assert.Equal([]int{1, 2, 3, 4, 5}, processed)
+	assert.Empty(copied)
+	assert.Equal([]string{"photos/a.jpg@a1"}, composed)
+	assert.Equal([]string{"photos/c.jpg", "photos/e.jpg"}, removed)
+	assert.Equal(int64(1), summary.Restored)
+	assert.Equal(int64(2), summary.Removed)
+	assert.Equal(int64(1), summary.Skipped)
+	assert.Equal(int64(1), summary.Failed)
+	assert.Equal("access denied", summary.Objects[3].Error)
+
+	// Test-3 : newer objects are kept unless requested
+	opts.RemoveNewer = false
+	opts.DryRun = true
+	summary, err = restoreBucket(context.Background(), client, opts, nil)
+	assert.NoError(err)
+	assert.Equal(int64(0), summary.Removed)
+	assert.Equal(int64(3), summary.Skipped)
+	assert.Equal(restoreReasonCreatedAfter, summary.Objects[2].Reason)
+
+	// Test-4 : the summary lists a limited number of objects, progress gets all of them
+	minioListObjectsMock = func(ctx context.Context, bucket string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		ch := make(chan minio.ObjectInfo)
+		go func() {
+			defer close(ch)
+			for i := 0; i <= restoreItemsLimit; i++ {
+				select {
+				case ch <- minio.ObjectInfo{Key: fmt.Sprintf("photos/%d.jpg", i), VersionID: "v1", LastModified: before, IsLatest: true}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		return ch
+	}
+	reported := 0
+	summary, err = restoreBucket(context.Background(), client, opts, func(n int, item *models.RestoreObject) error {
+		reported++
+		return nil
+	})
+	assert.NoError(err)
+	assert.Equal(restoreItemsLimit+1, reported)
+	assert.Equal(int64(restoreItemsLimit+1), summary.Skipped)
+	assert.Equal(restoreItemsLimit, len(summary.Objects))
+	assert.True(summary.Truncated)
+	assert.False(summary.LimitReached)
+
+	// Test-5 : the listing stops at the maximum number of objects
+	opts.MaxObjects = 10
+	summary, err = restoreBucket(context.Background(), client, opts, nil)
+	assert.NoError(err)
+	assert.Equal(int64(10), summary.Skipped)
+	assert.True(summary.LimitReached)
+}
+
+func TestStartRestore(t *testing.T) {
+	assert := assert.New(t)
+	client := minioClientMock{}
+	mockWSConn := mockConn{}
+	minioListObjectsMock = func(ctx context.Context, bucket string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo {
+		ch := make(chan minio.ObjectInfo, 1)
+		ch <- minio.ObjectInfo{Key: "a.txt", VersionID: "v1", LastModified: time.Now().Add(-time.Hour), IsLatest: true}
+		close(ch)
+		return ch
+	}
+	var messages []restoreProgress
+	connWriteMessageMock = func(messageType int, data []byte) error {
+		var message restoreProgress
+		assert.NoError(json.Unmarshal(data, &message))
+		messages = append(messages, message)
+		return nil
+	}
+	err := startRestore(context.Background(), mockWSConn, client, &restoreOptions{BucketName: "bucket", Date: time.Now()})
+	assert.NoError(err)
+	if assert.Equal(2, len(messages)) {
+		assert.Equal("a.txt", messages[0].Object.Name)
+		assert.Nil(messages[0].Summary)
+		if assert.NotNil(messages[1].Summary) {
+			assert.Equal(int64(1), messages[1].Summary.Skipped)
+		}
+	}
+}
+
+func TestGetRestoreOptionsFromReq(t *testing.T) {
+	assert := assert.New(t)
+	req, _ := http.NewRequest(http.MethodGet, "/ws/restore/bucket1?prefix=photos/&date=2021-06-01T12:00:00Z&removeNewer=true", nil)
+	opts, err := getRestoreOptionsFromReq(req)
+	assert.NoError(err)
+	assert.Equal("bucket1", opts.BucketName)
+	assert.Equal("photos/", opts.Prefix)
+	assert.True(opts.RemoveNewer)
+	assert.False(opts.DryRun)
+	req, _ = http.NewRequest(http.MethodGet, "/ws/restore/bucket1?date=yesterday", nil)
+	_, err = getRestoreOptionsFromReq(req)
+	assert.Equal(errInvalidRestoreDate, err)
+	req, _ = http.NewRequest(http.MethodGet, "/ws/restore/?date=2021-06-01T12:00:00Z", nil)
+	_, err = getRestoreOptionsFromReq(req)
+	assert.Error(err)
+}
@@ -0,0 +1,371 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/gorilla/websocket"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/user_api"
+	"github.com/minio/minio-go/v7"
+)
+
+// versionsStatsScanLimit is the maximum number of object versions scanned by the versions stats
+const versionsStatsScanLimit = 100000
+
+// versionsCleanupItemsLimit is the maximum number of removed versions listed in a cleanup summary
+const versionsCleanupItemsLimit = 1000
+
+func registerBucketVersionsHandlers(api *operations.ConsoleAPI) {
+	// get the version counts per prefix
+	api.UserAPIGetBucketVersionsStatsHandler = user_api.GetBucketVersionsStatsHandlerFunc(func(params user_api.GetBucketVersionsStatsParams, session *models.Principal) middleware.Responder {
+		stats, err := getBucketVersionsStatsResponse(session, params)
+		if err != nil {
+			return user_api.NewGetBucketVersionsStatsDefault(int(err.Code)).WithPayload(err)
+		}
+		return user_api.NewGetBucketVersionsStatsOK().WithPayload(stats)
+	})
+	// remove noncurrent versions and orphaned delete markers
+	api.UserAPICleanupBucketVersionsHandler = user_api.CleanupBucketVersionsHandlerFunc(func(params user_api.CleanupBucketVersionsParams, session *models.Principal) middleware.Responder {
+		summary, err := getCleanupBucketVersionsResponse(session, params)
+		if err != nil {
+			return user_api.NewCleanupBucketVersionsDefault(int(err.Code)).WithPayload(err)
+		}
+		return user_api.NewCleanupBucketVersionsOK().WithPayload(summary)
+	})
+}
+
+// forEachObjectVersions lists the versions under the prefix and calls fn with
+// the versions of every key, latest first. It stops after limit versions when
+// limit is positive and returns whether the listing was truncated
+func forEachObjectVersions(ctx context.Context, client MinioClient, bucketName, prefix string, limit int64, fn func(versions []minio.ObjectInfo) error) (scanned int64, truncated bool, err error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var versions []minio.ObjectInfo
+	for info := range client.listObjects(ctx, bucketName, minio.ListObjectsOptions{Prefix: prefix, Recursive: true, WithVersions: true}) {
+		if info.Err != nil {
+			return scanned, false, info.Err
+		}
+		if limit > 0 && scanned >= limit {
+			truncated = true
+			break
+		}
+		if len(versions) > 0 && versions[0].Key != info.Key {
+			if err := fn(versions); err != nil {
+				return scanned, false, err
+			}
+			versions = nil
+		}
+		versions = append(versions, info)
+		scanned++
+	}
+	if len(versions) > 0 {
+		if err := fn(versions); err != nil {
+			return scanned, false, err
+		}
+	}
+	return scanned, truncated, nil
+}
+
+// versionsStatsPrefix is the prefix one level below the requested one the key is grouped by
+func versionsStatsPrefix(prefix, key string) string {
+	rest := strings.TrimPrefix(key, prefix)
+	if i := strings.Index(rest, "/"); i >= 0 {
+		return prefix + rest[:i+1]
+	}
+	return prefix
+}
+
+// getBucketVersionsStats counts the versions under the prefix grouped by the
+// next level of the prefix, a delete marker without other versions left is
+// orphaned since it doesn't hide anything
+func getBucketVersionsStats(ctx context.Context, client MinioClient, bucketName, prefix string) (*models.VersionsStatsResponse, error) {
+	groups := map[string]*models.VersionsPrefixStats{}
+	scanned, truncated, err := forEachObjectVersions(ctx, client, bucketName, prefix, versionsStatsScanLimit, func(versions []minio.ObjectInfo) error {
+		groupPrefix := versionsStatsPrefix(prefix, versions[0].Key)
+		stats, ok := groups[groupPrefix]
+		if !ok {
+			stats = &models.VersionsPrefixStats{Prefix: groupPrefix}
+			groups[groupPrefix] = stats
+		}
+		if !versions[0].IsDeleteMarker {
+			stats.Objects++
+		} else if len(versions) == 1 {
+			stats.OrphanedDeleteMarkers++
+		}
+		for i, version := range versions {
+			if version.IsDeleteMarker {
+				stats.DeleteMarkers++
+				continue
+			}
+			stats.Versions++
+			if i > 0 {
+				stats.NoncurrentVersions++
+				stats.NoncurrentSize += version.Size
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	response := &models.VersionsStatsResponse{Scanned: scanned, Truncated: truncated, Prefixes: []*models.VersionsPrefixStats{}}
+	for _, stats := range groups {
+		response.Prefixes = append(response.Prefixes, stats)
+	}
+	sort.Slice(response.Prefixes, func(i, j int) bool {
+		a, b := response.Prefixes[i], response.Prefixes[j]
+		if a.NoncurrentSize != b.NoncurrentSize {
+			return a.NoncurrentSize > b.NoncurrentSize
+		}
+		return a.Prefix < b.Prefix
+	})
+	return response, nil
+}
+
+func getBucketVersionsStatsResponse(session *models.Principal, params user_api.GetBucketVersionsStatsParams) (*models.VersionsStatsResponse, *models.Error) {
+	ctx, cancel := context.WithTimeout(params.HTTPRequest.Context(), 5*time.Minute)
+	defer cancel()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+	prefix := ""
+	if params.Prefix != nil {
+		prefix = *params.Prefix
+	}
+	stats, err := getBucketVersionsStats(ctx, minioClient, params.BucketName, prefix)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return stats, nil
+}
+
+// versionsCleanupOptions holds the parameters of a versions cleanup
+type versionsCleanupOptions struct {
+	BucketName         string
+	Prefix             string
+	OlderThanDays      int
+	KeepLatest         int
+	PurgeDeleteMarkers bool
+	DryRun             bool
+}
+
+// versionsCleanupProgress is sent through the websocket connection after
+// every removed version, the summary is only set on the last message
+type versionsCleanupProgress struct {
+	Scanned int64                          `json:"scanned"`
+	Object  *models.VersionsCleanupItem    `json:"object,omitempty"`
+	Summary *models.VersionsCleanupSummary `json:"summary,omitempty"`
+}
+
+func validateVersionsCleanupOptions(opts versionsCleanupOptions) error {
+	if opts.OlderThanDays < 0 || opts.KeepLatest < 0 {
+		return fmt.Errorf("%w: the days and the versions to keep can't be negative", errInvalidVersionsCleanup)
+	}
+	if opts.OlderThanDays == 0 && opts.KeepLatest == 0 && !opts.PurgeDeleteMarkers {
+		return fmt.Errorf("%w: an age, a number of versions to keep or purging delete markers is required", errInvalidVersionsCleanup)
+	}
+	return nil
+}
+
+// planVersionsCleanup returns which versions of a key are removed. A noncurrent
+// version is removed when it's been noncurrent for longer than the days and
+// isn't one of the latest versions kept, both conditions must match when both
+// are set. The latest delete marker is removed last once it's orphaned
+func planVersionsCleanup(versions []minio.ObjectInfo, opts versionsCleanupOptions, now time.Time) (noncurrent []minio.ObjectInfo, deleteMarker *minio.ObjectInfo) {
+	if opts.OlderThanDays > 0 || opts.KeepLatest > 0 {
+		for i := 1; i < len(versions); i++ {
+			if opts.KeepLatest > 0 && i < opts.KeepLatest {
+				continue
+			}
+			// a version becomes noncurrent when the next one is written
+			if opts.OlderThanDays > 0 && now.Sub(versions[i-1].LastModified) < time.Duration(opts.OlderThanDays)*24*time.Hour {
+				continue
+			}
+			noncurrent = append(noncurrent, versions[i])
+		}
+	}
+	if opts.PurgeDeleteMarkers && versions[0].IsDeleteMarker && len(noncurrent) == len(versions)-1 {
+		deleteMarker = &versions[0]
+	}
+	return noncurrent, deleteMarker
+}
+
+// cleanupBucketVersions removes the noncurrent versions and orphaned delete
+// markers under the prefix, on a dry run the summary reports what would be
+// removed without changes
+func cleanupBucketVersions(ctx context.Context, client MinioClient, opts versionsCleanupOptions, now time.Time, progress func(scanned int64, item *models.VersionsCleanupItem) error) (*models.VersionsCleanupSummary, error) {
+	if err := validateVersionsCleanupOptions(opts); err != nil {
+		return nil, err
+	}
+	summary := &models.VersionsCleanupSummary{DryRun: opts.DryRun, Items: []*models.VersionsCleanupItem{}}
+	var scanned int64
+	remove := func(version minio.ObjectInfo) (bool, error) {
+		item := &models.VersionsCleanupItem{
+			Name:         version.Key,
+			VersionID:    version.VersionID,
+			DeleteMarker: version.IsDeleteMarker,
+			Size:         version.Size,
+		}
+		if !opts.DryRun {
+			if err := client.removeObject(ctx, opts.BucketName, version.Key, minio.RemoveObjectOptions{VersionID: version.VersionID}); err != nil {
+				item.Error = err.Error()
+			}
+		}
+		switch {
+		case item.Error != "":
+			summary.Failed++
+		case version.IsDeleteMarker:
+			summary.RemovedDeleteMarkers++
+		default:
+			summary.RemovedVersions++
+			summary.RemovedSize += version.Size
+		}
+		if len(summary.Items) < versionsCleanupItemsLimit {
+			summary.Items = append(summary.Items, item)
+		} else {
+			summary.Truncated = true
+		}
+		if progress != nil {
+			if err := progress(scanned, item); err != nil {
+				return false, err
+			}
+		}
+		return item.Error == "", nil
+	}
+	total, _, err := forEachObjectVersions(ctx, client, opts.BucketName, opts.Prefix, 0, func(versions []minio.ObjectInfo) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		scanned += int64(len(versions))
+		noncurrent, deleteMarker := planVersionsCleanup(versions, opts, now)
+		removedAll := true
+		for _, version := range noncurrent {
+			removed, err := remove(version)
+			if err != nil {
+				return err
+			}
+			removedAll = removedAll && removed
+		}
+		// the delete marker still hides the versions that couldn't be removed
+		if deleteMarker != nil && removedAll {
+			if _, err := remove(*deleteMarker); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	summary.Scanned = total
+	return summary, nil
+}
+
+func getCleanupBucketVersionsResponse(session *models.Principal, params user_api.CleanupBucketVersionsParams) (*models.VersionsCleanupSummary, *models.Error) {
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+	summary, err := cleanupBucketVersions(params.HTTPRequest.Context(), minioClient, versionsCleanupOptions{
+		BucketName:         params.BucketName,
+		Prefix:             params.Body.Prefix,
+		OlderThanDays:      int(params.Body.OlderThanDays),
+		KeepLatest:         int(params.Body.KeepLatest),
+		PurgeDeleteMarkers: params.Body.PurgeDeleteMarkers,
+		DryRun:             params.Body.DryRun,
+	}, time.Now(), nil)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return summary, nil
+}
+
+// getVersionsCleanupOptionsFromReq gets the cleanup options from a request like
+// /versions-cleanup/<bucket>?prefix=<prefix>&olderThanDays=30&keepLatest=3&purgeDeleteMarkers=true&dryRun=true
+func getVersionsCleanupOptionsFromReq(req *http.Request) (*versionsCleanupOptions, error) {
+	re := regexp.MustCompile(`(/versions-cleanup/)(.*?$)`)
+	matches := re.FindAllSubmatch([]byte(req.URL.Path), -1)
+	if len(matches) == 0 || len(matches[0]) < 3 || strings.TrimSpace(string(matches[0][2])) == "" {
+		return nil, fmt.Errorf("invalid url: %s", req.URL.Path)
+	}
+	opts := &versionsCleanupOptions{
+		BucketName:         strings.TrimSpace(string(matches[0][2])),
+		Prefix:             req.FormValue("prefix"),
+		PurgeDeleteMarkers: req.FormValue("purgeDeleteMarkers") == "true",
+		DryRun:             req.FormValue("dryRun") == "true",
+	}
+	var err error
+	if value := req.FormValue("olderThanDays"); value != "" {
+		if opts.OlderThanDays, err = strconv.Atoi(value); err != nil {
+			return nil, fmt.Errorf("%w: invalid olderThanDays", errInvalidVersionsCleanup)
+		}
+	}
+	if value := req.FormValue("keepLatest"); value != "" {
+		if opts.KeepLatest, err = strconv.Atoi(value); err != nil {
+			return nil, fmt.Errorf("%w: invalid keepLatest", errInvalidVersionsCleanup)
+		}
+	}
+	if err := validateVersionsCleanupOptions(*opts); err != nil {
+		return nil, err
+	}
+	return opts, nil
+}
+
+// startVersionsCleanup cleans up the versions sending the progress after every
+// removed version and the summary once done
+func startVersionsCleanup(ctx context.Context, conn WSConn, client MinioClient, opts *versionsCleanupOptions) error {
+	sendProgress := func(message versionsCleanupProgress) error {
+		// Serialize message to be sent
+		bytes, err := json.Marshal(message)
+		if err != nil {
+			LogError("error on json.Marshal: %v", err)
+			return err
+		}
+		// Send Message through websocket connection
+		if err = conn.writeMessage(websocket.TextMessage, bytes); err != nil {
+			LogError("error writeMessage: %v", err)
+			return err
+		}
+		return nil
+	}
+	summary, err := cleanupBucketVersions(ctx, client, *opts, time.Now(), func(scanned int64, item *models.VersionsCleanupItem) error {
+		return sendProgress(versionsCleanupProgress{Scanned: scanned, Object: item})
+	})
+	if err != nil {
+		return err
+	}
+	return sendProgress(versionsCleanupProgress{Scanned: summary.Scanned, Summary: summary})
+}
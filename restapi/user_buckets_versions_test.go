@@ -0,0 +1,131 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+// versionsTestObjects are listed latest version first like MinIO does
+func versionsTestObjects(now time.Time) []minio.ObjectInfo {
+	day := 24 * time.Hour
+	return []minio.ObjectInfo{
+		{Key: "logs/app.log", VersionID: "a4", IsLatest: true, Size: 40, LastModified: now.Add(-1 * day)},
+		{Key: "logs/app.log", VersionID: "a3", Size: 30, LastModified: now.Add(-10 * day)},
+		{Key: "logs/app.log", VersionID: "a2", Size: 20, LastModified: now.Add(-40 * day)},
+		{Key: "logs/app.log", VersionID: "a1", Size: 10, LastModified: now.Add(-50 * day)},
+		{Key: "logs/old.log", VersionID: "b2", IsLatest: true, IsDeleteMarker: true, LastModified: now.Add(-60 * day)},
+		{Key: "logs/old.log", VersionID: "b1", Size: 5, LastModified: now.Add(-90 * day)},
+		{Key: "logs/gone.log", VersionID: "c1", IsLatest: true, IsDeleteMarker: true, LastModified: now.Add(-5 * day)},
+		{Key: "readme.txt", VersionID: "d1", IsLatest: true, Size: 1, LastModified: now.Add(-5 * day)},
+	}
+}
+
+func TestGetBucketVersionsStats(t *testing.T) {
+	assert := assert.New(t)
+	client := minioClientMock{}
+	minioListObjectsMock = mockReplicationListing(versionsTestObjects(time.Now()))
+	stats, err := getBucketVersionsStats(context.Background(), client, "bucket", "")
+	if assert.NoError(err) {
+		assert.Equal(int64(8), stats.Scanned)
+		if assert.Equal(2, len(stats.Prefixes)) {
+			// Test-1 : prefixes are sorted by noncurrent size
+			logs := stats.Prefixes[0]
+			assert.Equal("logs/", logs.Prefix)
+			assert.Equal(int64(1), logs.Objects)
+			assert.Equal(int64(5), logs.Versions)
+			assert.Equal(int64(4), logs.NoncurrentVersions)
+			assert.Equal(int64(65), logs.NoncurrentSize)
+			assert.Equal(int64(2), logs.DeleteMarkers)
+			// Test-2 : delete markers without other versions are orphaned
+			assert.Equal(int64(1), logs.OrphanedDeleteMarkers)
+			assert.Equal("", stats.Prefixes[1].Prefix)
+			assert.Equal(int64(0), stats.Prefixes[1].NoncurrentVersions)
+		}
+	}
+	// Test-3 : groups are one level below the requested prefix
+	stats, err = getBucketVersionsStats(context.Background(), client, "bucket", "logs/")
+	if assert.NoError(err) && assert.Equal(1, len(stats.Prefixes)) {
+		assert.Equal("logs/", stats.Prefixes[0].Prefix)
+	}
+}
+
+func TestCleanupBucketVersions(t *testing.T) {
+	assert := assert.New(t)
+	client := minioClientMock{}
+	now := time.Now()
+	minioListObjectsMock = mockReplicationListing(versionsTestObjects(now))
+	var removed []string
+	minioRemoveObjectMock = func(ctx context.Context, bucketName, objectName string, opts minio.RemoveObjectOptions) error {
+		removed = append(removed, opts.VersionID)
+		return nil
+	}
+	// Test-1 : dry runs don't remove anything
+	summary, err := cleanupBucketVersions(context.Background(), client, versionsCleanupOptions{BucketName: "bucket", OlderThanDays: 30, DryRun: true}, now, nil)
+	if assert.NoError(err) {
+		assert.Empty(removed)
+		assert.True(summary.DryRun)
+		// a1 and b1 were replaced more than 30 days ago, a3 and a2 recently
+		assert.Equal(int64(2), summary.RemovedVersions)
+		assert.Equal(int64(15), summary.RemovedSize)
+	}
+	// Test-2 : keep the latest versions and purge the delete markers left orphaned
+	var progress int
+	summary, err = cleanupBucketVersions(context.Background(), client, versionsCleanupOptions{BucketName: "bucket", KeepLatest: 2, PurgeDeleteMarkers: true}, now, func(scanned int64, item *models.VersionsCleanupItem) error {
+		progress++
+		return nil
+	})
+	if assert.NoError(err) {
+		// old.log has two versions so the delete marker isn't orphaned
+		assert.Equal([]string{"a2", "a1", "c1"}, removed)
+		assert.Equal(int64(2), summary.RemovedVersions)
+		assert.Equal(int64(1), summary.RemovedDeleteMarkers)
+		assert.Equal(int64(8), summary.Scanned)
+		assert.Equal(3, progress)
+	}
+	// Test-3 : delete markers left without versions by the cleanup are purged too
+	removed = nil
+	_, err = cleanupBucketVersions(context.Background(), client, versionsCleanupOptions{BucketName: "bucket", Prefix: "logs/old", OlderThanDays: 30, PurgeDeleteMarkers: true}, now, nil)
+	if assert.NoError(err) {
+		assert.Equal([]string{"b1", "b2"}, removed)
+	}
+	// Test-4 : delete markers still hiding versions that failed are kept
+	removed = nil
+	minioRemoveObjectMock = func(ctx context.Context, bucketName, objectName string, opts minio.RemoveObjectOptions) error {
+		if opts.VersionID == "b1" {
+			return errors.New("Object is WORM protected and cannot be overwritten")
+		}
+		removed = append(removed, opts.VersionID)
+		return nil
+	}
+	summary, err = cleanupBucketVersions(context.Background(), client, versionsCleanupOptions{BucketName: "bucket", Prefix: "logs/old", OlderThanDays: 1, PurgeDeleteMarkers: true}, now, nil)
+	if assert.NoError(err) {
+		assert.Empty(removed)
+		assert.Equal(int64(1), summary.Failed)
+		assert.Equal("b1", summary.Items[0].VersionID)
+	}
+	// Test-5 : an action is required
+	_, err = cleanupBucketVersions(context.Background(), client, versionsCleanupOptions{BucketName: "bucket"}, now, nil)
+	assert.True(errors.Is(err, errInvalidVersionsCleanup))
+}
@@ -17,11 +17,21 @@
 package restapi
 
 import (
+	"bytes"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/swag"
 
 	"github.com/go-openapi/runtime/middleware"
@@ -31,6 +41,16 @@ import (
 	logsearchServer "github.com/minio/operator/logsearchapi/server"
 )
 
+// logSearchRequestTimeout bounds every request to the log search API
+const logSearchRequestTimeout = 30 * time.Second
+
+// logSearchBatchSize is the page size used to read whole result sets
+const logSearchBatchSize = 1000
+
+// logSearchMaxRows is the maximum number of rows exported or aggregated, and
+// read to build a page of the records filtered by Console
+const logSearchMaxRows = 100000
+
 func registerLogSearchHandlers(api *operations.ConsoleAPI) {
 	// log search
 	api.UserAPILogSearchHandler = user_api.LogSearchHandlerFunc(func(params user_api.LogSearchParams, session *models.Principal) middleware.Responder {
@@ -40,51 +60,598 @@ func registerLogSearchHandlers(api *operations.ConsoleAPI) {
 		}
 		return user_api.NewLogSearchOK().WithPayload(searchResp)
 	})
+	// export the log search results
+	api.UserAPILogSearchExportHandler = user_api.LogSearchExportHandlerFunc(func(params user_api.LogSearchExportParams, session *models.Principal) middleware.Responder {
+		data, err := getLogSearchExportResponse(params)
+		if err != nil {
+			return user_api.NewLogSearchExportDefault(int(err.Code)).WithPayload(err)
+		}
+		return logSearchExportResponder(*params.Format, data)
+	})
+}
+
+// logSearchQuery holds the parameters of a log search, the response time
+// bounds are in milliseconds and zero values are not used as filters
+type logSearchQuery struct {
+	Query           string
+	Fp              []string
+	Order           string
+	TimeStart       string
+	TimeEnd         string
+	Bucket          string
+	Object          string
+	APIName         string
+	StatusCode      int32
+	AccessKey       string
+	ResponseTimeMin int64
+	ResponseTimeMax int64
+	PageSize        int32
+	PageNo          int32
+}
+
+// filterParams returns the filter parameters of the log search API, the
+// ones of the request along with the bucket, object, API and status code
+func (q logSearchQuery) filterParams() []string {
+	fps := append([]string{}, q.Fp...)
+	if q.Bucket != "" {
+		fps = append(fps, "bucket:"+q.Bucket)
+	}
+	if q.Object != "" {
+		fps = append(fps, "object:"+q.Object)
+	}
+	if q.APIName != "" {
+		fps = append(fps, "api_name:"+q.APIName)
+	}
+	if q.StatusCode != 0 {
+		// the log search API filters on the status text MinIO records
+		fps = append(fps, "response_status:"+http.StatusText(int(q.StatusCode)))
+	}
+	return fps
+}
+
+// endpoint returns the log search API url of the query, the filters are sent
+// as filter parameters and the token as a query parameter since it's the only
+// way the log search API reads it
+func (q logSearchQuery) endpoint(baseURL, token string) string {
+	values := url.Values{}
+	values.Set("token", token)
+	values.Set("q", q.Query)
+	for _, fp := range q.filterParams() {
+		values.Add("fp", fp)
+	}
+	values.Set(q.Order, "ok")
+	if q.TimeStart != "" {
+		values.Set("timeStart", q.TimeStart)
+	}
+	if q.TimeEnd != "" {
+		values.Set("timeEnd", q.TimeEnd)
+	}
+	values.Set("pageSize", strconv.Itoa(int(q.PageSize)))
+	// the log search API documents pageNo but reads the page from pageStart
+	values.Set("pageNo", strconv.Itoa(int(q.PageNo)))
+	values.Set("pageStart", strconv.Itoa(int(q.PageNo)))
+	return fmt.Sprintf("%s/api/query?%s", baseURL, values.Encode())
+}
+
+// logSearchEntry holds the fields of a log record used by the filters and the stats
+type logSearchEntry struct {
+	time           time.Time
+	apiName        string
+	bucket         string
+	object         string
+	requestID      string
+	userAgent      string
+	accessKey      string
+	status         string
+	statusCode     int
+	timeToResponse time.Duration
+}
+
+// field returns the value of the entry for a key of the log search filter parameters
+func (e logSearchEntry) field(key string) string {
+	switch key {
+	case "bucket":
+		return e.bucket
+	case "object":
+		return e.object
+	case "api_name":
+		return e.apiName
+	case "request_id":
+		return e.requestID
+	case "user_agent":
+		return e.userAgent
+	case "response_status":
+		return e.status
+	}
+	return ""
+}
+
+func reqInfoEntry(row logsearchServer.ReqInfoRow) logSearchEntry {
+	return logSearchEntry{
+		time:           row.Time,
+		apiName:        row.APIName,
+		bucket:         row.Bucket,
+		object:         row.Object,
+		requestID:      row.RequestID,
+		userAgent:      row.UserAgent,
+		status:         row.ResponseStatus,
+		statusCode:     row.ResponseStatusCode,
+		timeToResponse: time.Duration(row.TimeToResponseNs),
+	}
+}
+
+func rawLogEntry(row logsearchServer.LogEventRow) logSearchEntry {
+	entry := logSearchEntry{time: row.EventTime}
+	entry.requestID, _ = row.Log["requestID"].(string)
+	entry.userAgent, _ = row.Log["userAgent"].(string)
+	if api, ok := row.Log["api"].(map[string]interface{}); ok {
+		entry.apiName, _ = api["name"].(string)
+		entry.bucket, _ = api["bucket"].(string)
+		entry.object, _ = api["object"].(string)
+		entry.status, _ = api["status"].(string)
+		if code, ok := api["statusCode"].(float64); ok {
+			entry.statusCode = int(code)
+		}
+		switch ttr := api["timeToResponse"].(type) {
+		case string:
+			entry.timeToResponse, _ = time.ParseDuration(ttr)
+		case float64:
+			entry.timeToResponse = time.Duration(ttr)
+		}
+	}
+	entry.accessKey, _ = row.Log["accessKey"].(string)
+	if claims, ok := row.Log["requestClaims"].(map[string]interface{}); ok && entry.accessKey == "" {
+		entry.accessKey, _ = claims["accessKey"].(string)
+	}
+	return entry
+}
+
+// logSearchPatternMatch matches a value against the patterns of the log search
+// filter parameters, where `.` matches a single character and `*` any text
+func logSearchPatternMatch(pattern, value string) bool {
+	if !strings.ContainsAny(pattern, ".*") {
+		return pattern == value
+	}
+	var sb strings.Builder
+	for _, r := range pattern {
+		switch r {
+		case '.':
+			sb.WriteString(".")
+		case '*':
+			sb.WriteString(".*")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	re, err := regexp.Compile("^" + sb.String() + "$")
+	return err == nil && re.MatchString(value)
+}
+
+// filteredLocally returns whether Console applies some of the filters, the log
+// search API has no access key nor response time filters and ignores the
+// filter parameters of the raw query type
+func (q logSearchQuery) filteredLocally() bool {
+	return q.AccessKey != "" || q.ResponseTimeMin > 0 || q.ResponseTimeMax > 0 ||
+		(q.Query == "raw" && len(q.filterParams()) > 0)
+}
+
+// matches applies the filters the log search API doesn't support to a record
+func (q logSearchQuery) matches(entry logSearchEntry, raw bool) bool {
+	if raw {
+		for _, fp := range q.filterParams() {
+			kv := strings.SplitN(fp, ":", 2)
+			if len(kv) == 2 && !logSearchPatternMatch(kv[1], entry.field(kv[0])) {
+				return false
+			}
+		}
+	}
+	if q.AccessKey != "" && entry.accessKey != q.AccessKey {
+		return false
+	}
+	if q.ResponseTimeMin > 0 && entry.timeToResponse < time.Duration(q.ResponseTimeMin)*time.Millisecond {
+		return false
+	}
+	if q.ResponseTimeMax > 0 && entry.timeToResponse > time.Duration(q.ResponseTimeMax)*time.Millisecond {
+		return false
+	}
+	return true
+}
+
+// filter returns the records of a page of the log search API that match the
+// filters applied by Console
+func (q logSearchQuery) filter(results interface{}) interface{} {
+	switch rows := results.(type) {
+	case []logsearchServer.ReqInfoRow:
+		filtered := []logsearchServer.ReqInfoRow{}
+		for _, row := range rows {
+			if q.matches(reqInfoEntry(row), false) {
+				filtered = append(filtered, row)
+			}
+		}
+		return filtered
+	case []logsearchServer.LogEventRow:
+		filtered := []logsearchServer.LogEventRow{}
+		for _, row := range rows {
+			if q.matches(rawLogEntry(row), true) {
+				filtered = append(filtered, row)
+			}
+		}
+		return filtered
+	}
+	return results
+}
+
+// validate checks the query can be answered, the access key is only part of
+// the raw records and the log search API knows only the standard status codes
+func (q logSearchQuery) validate() error {
+	if q.AccessKey != "" && q.Query == "reqinfo" {
+		return errAccessKeyFilterRequiresRaw
+	}
+	if q.StatusCode != 0 && http.StatusText(int(q.StatusCode)) == "" {
+		return errInvalidLogSearchStatusCode
+	}
+	return nil
+}
+
+// fetchLogSearchPage returns a page of records of the log search API along
+// with the number of records
+func fetchLogSearchPage(q logSearchQuery) (interface{}, int, *models.Error) {
+	endpoint := q.endpoint(getLogSearchURL(), getLogSearchAPIToken())
+	if q.Query == "raw" {
+		var rows []logsearchServer.LogEventRow
+		if err := fetchLogSearch(endpoint, &rows); err != nil {
+			return nil, 0, err
+		}
+		return rows, len(rows), nil
+	}
+	resp, err := logSearch(endpoint)
+	if err != nil {
+		return nil, 0, err
+	}
+	rows, _ := resp.Results.([]logsearchServer.ReqInfoRow)
+	return rows, len(rows), nil
+}
+
+// searchAllLogs reads the whole result set of a query in batches, calling fn
+// with the records of every batch that match the filters applied by Console
+// until it returns false, it returns whether the result set was larger than
+// logSearchMaxRows
+func searchAllLogs(q logSearchQuery, fn func(results interface{}) bool) (bool, *models.Error) {
+	q.PageSize = logSearchBatchSize
+	for read := 0; read < logSearchMaxRows; read += logSearchBatchSize {
+		q.PageNo = int32(read / logSearchBatchSize)
+		results, n, err := fetchLogSearchPage(q)
+		if err != nil {
+			return false, err
+		}
+		if !fn(q.filter(results)) || n < logSearchBatchSize {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// searchLogs returns a page of records, when Console applies some of the
+// filters the result set is read from the start to find the records of the
+// page, it returns whether logSearchMaxRows were read before filling the page
+func searchLogs(q logSearchQuery) (interface{}, bool, *models.Error) {
+	if !q.filteredLocally() {
+		results, _, err := fetchLogSearchPage(q)
+		return results, false, err
+	}
+	skip, size := int(q.PageNo)*int(q.PageSize), int(q.PageSize)
+	reqInfoRows := []logsearchServer.ReqInfoRow{}
+	rawRows := []logsearchServer.LogEventRow{}
+	truncated, err := searchAllLogs(q, func(results interface{}) bool {
+		switch rows := results.(type) {
+		case []logsearchServer.ReqInfoRow:
+			for _, row := range rows {
+				if skip > 0 {
+					skip--
+				} else if len(reqInfoRows) < size {
+					reqInfoRows = append(reqInfoRows, row)
+				}
+			}
+			return len(reqInfoRows) < size
+		case []logsearchServer.LogEventRow:
+			for _, row := range rows {
+				if skip > 0 {
+					skip--
+				} else if len(rawRows) < size {
+					rawRows = append(rawRows, row)
+				}
+			}
+			return len(rawRows) < size
+		}
+		return true
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if q.Query == "raw" {
+		return rawRows, truncated, nil
+	}
+	return reqInfoRows, truncated, nil
+}
+
+func logSearchQueryFromParams(params user_api.LogSearchParams) logSearchQuery {
+	return logSearchQuery{
+		Query:           *params.Q,
+		Fp:              params.Fp,
+		Order:           *params.Order,
+		TimeStart:       swag.StringValue(params.TimeStart),
+		TimeEnd:         swag.StringValue(params.TimeEnd),
+		Bucket:          swag.StringValue(params.Bucket),
+		Object:          swag.StringValue(params.Object),
+		APIName:         swag.StringValue(params.APIName),
+		StatusCode:      swag.Int32Value(params.StatusCode),
+		AccessKey:       swag.StringValue(params.AccessKey),
+		ResponseTimeMin: swag.Int64Value(params.ResponseTimeMin),
+		ResponseTimeMax: swag.Int64Value(params.ResponseTimeMax),
+		PageSize:        *params.PageSize,
+		PageNo:          *params.PageNo,
+	}
 }
 
 // getLogSearchResponse performs a query to Log Search if Enabled
 func getLogSearchResponse(params user_api.LogSearchParams) (*models.LogSearchResponse, *models.Error) {
-	token := getLogSearchAPIToken()
-	endpoint := fmt.Sprintf("%s/api/query?token=%s&q=reqinfo", getLogSearchURL(), token)
-	for _, fp := range params.Fp {
-		endpoint = fmt.Sprintf("%s&fp=%s", endpoint, fp)
+	q := logSearchQueryFromParams(params)
+	if q.Query == "statsinfo" {
+		return getLogSearchStats(q, time.Duration(*params.Interval)*time.Second)
+	}
+	if err := q.validate(); err != nil {
+		return nil, prepareError(err)
+	}
+	results, truncated, err := searchLogs(q)
+	if err != nil {
+		return nil, err
+	}
+	return &models.LogSearchResponse{Results: results, Truncated: truncated}, nil
+}
+
+// getLogSearchStats aggregates the requests per API per interval, the access
+// key filter is only available on the raw records so they are used instead
+func getLogSearchStats(q logSearchQuery, interval time.Duration) (*models.LogSearchResponse, *models.Error) {
+	if interval <= 0 {
+		return nil, prepareError(errInvalidLogSearchInterval)
+	}
+	q.Query = "reqinfo"
+	if q.AccessKey != "" {
+		q.Query = "raw"
+	}
+	if err := q.validate(); err != nil {
+		return nil, prepareError(err)
+	}
+	var entries []logSearchEntry
+	truncated, err := searchAllLogs(q, func(results interface{}) bool {
+		switch rows := results.(type) {
+		case []logsearchServer.ReqInfoRow:
+			for _, row := range rows {
+				entries = append(entries, reqInfoEntry(row))
+			}
+		case []logsearchServer.LogEventRow:
+			for _, row := range rows {
+				entries = append(entries, rawLogEntry(row))
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &models.LogSearchResponse{Stats: aggregateLogSearchStats(entries, interval), Truncated: truncated}, nil
+}
+
+// aggregateLogSearchStats counts the requests and errors per API per interval
+func aggregateLogSearchStats(entries []logSearchEntry, interval time.Duration) []*models.LogSearchStat {
+	type statKey struct {
+		time    time.Time
+		apiName string
+	}
+	type stat struct {
+		count          int64
+		errors         int64
+		timeToResponse time.Duration
+	}
+	stats := make(map[statKey]*stat)
+	for _, entry := range entries {
+		key := statKey{time: entry.time.UTC().Truncate(interval), apiName: entry.apiName}
+		s, ok := stats[key]
+		if !ok {
+			s = &stat{}
+			stats[key] = s
+		}
+		s.count++
+		if entry.statusCode >= 400 {
+			s.errors++
+		}
+		s.timeToResponse += entry.timeToResponse
+	}
+	keys := make([]statKey, 0, len(stats))
+	for key := range stats {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if !keys[i].time.Equal(keys[j].time) {
+			return keys[i].time.Before(keys[j].time)
+		}
+		return keys[i].apiName < keys[j].apiName
+	})
+	result := []*models.LogSearchStat{}
+	for _, key := range keys {
+		s := stats[key]
+		result = append(result, &models.LogSearchStat{
+			Time:            key.time.Format(time.RFC3339),
+			APIName:         key.apiName,
+			Count:           s.count,
+			Errors:          s.errors,
+			AvgResponseTime: float64(s.timeToResponse) / float64(s.count) / float64(time.Millisecond),
+		})
 	}
+	return result
+}
 
-	endpoint = fmt.Sprintf("%s&%s=ok", endpoint, *params.Order)
+// getLogSearchExportResponse returns the whole result set of a search as CSV or JSON
+func getLogSearchExportResponse(params user_api.LogSearchExportParams) ([]byte, *models.Error) {
+	// the pages are set while reading the whole result set
+	q := logSearchQueryFromParams(user_api.LogSearchParams{
+		Q:               params.Q,
+		Fp:              params.Fp,
+		Order:           params.Order,
+		TimeStart:       params.TimeStart,
+		TimeEnd:         params.TimeEnd,
+		Bucket:          params.Bucket,
+		Object:          params.Object,
+		APIName:         params.APIName,
+		StatusCode:      params.StatusCode,
+		AccessKey:       params.AccessKey,
+		ResponseTimeMin: params.ResponseTimeMin,
+		ResponseTimeMax: params.ResponseTimeMax,
+		PageSize:        swag.Int32(logSearchBatchSize),
+		PageNo:          swag.Int32(0),
+	})
+	if err := q.validate(); err != nil {
+		return nil, prepareError(err)
+	}
+	var reqInfoRows []logsearchServer.ReqInfoRow
+	var rawRows []logsearchServer.LogEventRow
+	_, err := searchAllLogs(q, func(results interface{}) bool {
+		switch rows := results.(type) {
+		case []logsearchServer.ReqInfoRow:
+			reqInfoRows = append(reqInfoRows, rows...)
+		case []logsearchServer.LogEventRow:
+			rawRows = append(rawRows, rows...)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	var data []byte
+	var err2 error
+	switch {
+	case *params.Format == "json" && q.Query == "raw":
+		data, err2 = json.Marshal(append([]logsearchServer.LogEventRow{}, rawRows...))
+	case *params.Format == "json":
+		data, err2 = json.Marshal(append([]logsearchServer.ReqInfoRow{}, reqInfoRows...))
+	case q.Query == "raw":
+		data, err2 = rawLogsToCSV(rawRows)
+	default:
+		data, err2 = reqInfoToCSV(reqInfoRows)
+	}
+	if err2 != nil {
+		return nil, prepareError(err2)
+	}
+	return data, nil
+}
 
-	// timeStart
-	if params.TimeStart != nil && *params.TimeStart != "" {
-		endpoint = fmt.Sprintf("%s&timeStart=%s", endpoint, *params.TimeStart)
+func optionalUint(v *uint64) string {
+	if v == nil {
+		return ""
 	}
-	// page size and page number
-	endpoint = fmt.Sprintf("%s&pageSize=%d", endpoint, *params.PageSize)
-	endpoint = fmt.Sprintf("%s&pageNo=%d", endpoint, *params.PageNo)
+	return strconv.FormatUint(*v, 10)
+}
 
-	return logSearch(endpoint)
+// reqInfoToCSV returns the request info records as CSV
+func reqInfoToCSV(rows []logsearchServer.ReqInfoRow) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write([]string{"time", "api_name", "bucket", "object", "time_to_response_ns", "remote_host", "request_id",
+		"user_agent", "response_status", "response_status_code", "request_content_length", "response_content_length"})
+	for _, row := range rows {
+		w.Write([]string{
+			row.Time.Format(time.RFC3339Nano),
+			row.APIName,
+			row.Bucket,
+			row.Object,
+			strconv.FormatUint(row.TimeToResponseNs, 10),
+			row.RemoteHost,
+			row.RequestID,
+			row.UserAgent,
+			row.ResponseStatus,
+			strconv.Itoa(row.ResponseStatusCode),
+			optionalUint(row.RequestContentLength),
+			optionalUint(row.ResponseContentLength),
+		})
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
 }
 
-func logSearch(endpoint string) (*models.LogSearchResponse, *models.Error) {
-	resp, err := http.Get(endpoint)
+// rawLogsToCSV returns the raw records as CSV, the audit log is kept as JSON
+func rawLogsToCSV(rows []logsearchServer.LogEventRow) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write([]string{"event_time", "api_name", "bucket", "object", "access_key", "status_code", "time_to_response_ns", "log"})
+	for _, row := range rows {
+		entry := rawLogEntry(row)
+		log, err := json.Marshal(row.Log)
+		if err != nil {
+			return nil, err
+		}
+		w.Write([]string{
+			row.EventTime.Format(time.RFC3339Nano),
+			entry.apiName,
+			entry.bucket,
+			entry.object,
+			entry.accessKey,
+			strconv.Itoa(entry.statusCode),
+			strconv.FormatInt(int64(entry.timeToResponse), 10),
+			string(log),
+		})
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+func logSearchExportResponder(format string, data []byte) middleware.Responder {
+	// Custom response writer to set the content-disposition header to tell the
+	// HTTP client the name and extension of the file we are returning
+	return middleware.ResponderFunc(func(w http.ResponseWriter, _ runtime.Producer) {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=logs.%s", format))
+		io.Copy(w, bytes.NewReader(data))
+	})
+}
+
+// fetchLogSearch performs a request to the log search API
+func fetchLogSearch(endpoint string, v interface{}) *models.Error {
+	req, err := http.NewRequest(http.MethodGet, endpoint, nil)
 	if err != nil {
-		return nil, prepareError(err)
+		return prepareError(err)
+	}
+	client := &http.Client{
+		Transport: GetConsoleSTSClient().Transport,
+		Timeout:   logSearchRequestTimeout,
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return prepareError(err)
 	}
 
 	body, err := ioutil.ReadAll(resp.Body)
 	resp.Body.Close()
 	if err != nil {
-		return nil, prepareError(err)
+		return prepareError(err)
 	}
 
 	if resp.StatusCode != 200 {
-		return nil, &models.Error{
+		return &models.Error{
 			Code:    int32(resp.StatusCode),
 			Message: swag.String(fmt.Sprintf("error retrieving logs: %s", http.StatusText(resp.StatusCode))),
 		}
 	}
 
+	if err = json.Unmarshal(body, v); err != nil {
+		return prepareError(err)
+	}
+	return nil
+}
+
+func logSearch(endpoint string) (*models.LogSearchResponse, *models.Error) {
 	var results []logsearchServer.ReqInfoRow
-	if err = json.Unmarshal(body, &results); err != nil {
-		return nil, prepareError(err)
+	if err := fetchLogSearch(endpoint, &results); err != nil {
+		return nil, err
 	}
 
 	response := models.LogSearchResponse{
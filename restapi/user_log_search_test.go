@@ -17,10 +17,14 @@
 package restapi
 
 import (
+	"bytes"
+	"encoding/csv"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"os"
 	"reflect"
 	"testing"
 	"time"
@@ -128,3 +132,162 @@ func TestLogSearch(t *testing.T) {
 		})
 	}
 }
+
+func TestLogSearchQueryEndpoint(t *testing.T) {
+	assert := asrt.New(t)
+	q := logSearchQuery{
+		Query:      "reqinfo",
+		Fp:         []string{"request_id:abc"},
+		Order:      "timeAsc",
+		TimeStart:  "2021-06-01T00:00:00Z",
+		TimeEnd:    "2021-06-02T00:00:00Z",
+		Bucket:     "photos-*",
+		APIName:    "PutObject",
+		StatusCode: 404,
+		PageSize:   50,
+		PageNo:     2,
+	}
+	u, err := url.Parse(q.endpoint("http://logsearch:8080", "secret"))
+	assert.NoError(err)
+	assert.Equal("/api/query", u.Path)
+	values := u.Query()
+	assert.Equal("reqinfo", values.Get("q"))
+	assert.Equal([]string{"request_id:abc", "bucket:photos-*", "api_name:PutObject", "response_status:Not Found"}, values["fp"])
+	assert.Equal("ok", values.Get("timeAsc"))
+	assert.Equal("2021-06-02T00:00:00Z", values.Get("timeEnd"))
+	assert.Equal("2", values.Get("pageStart"))
+	// the log search API only reads the token from the query parameters
+	assert.Equal("secret", values.Get("token"))
+}
+
+func TestSearchLogsFilters(t *testing.T) {
+	assert := asrt.New(t)
+	rows := []logsearchServer.ReqInfoRow{
+		{APIName: "GetObject", ResponseStatusCode: 200, TimeToResponseNs: uint64(5 * time.Millisecond)},
+		{APIName: "GetObject", ResponseStatusCode: 404, TimeToResponseNs: uint64(50 * time.Millisecond)},
+		{APIName: "PutObject", ResponseStatusCode: 200, TimeToResponseNs: uint64(500 * time.Millisecond)},
+	}
+	rawRows := []logsearchServer.LogEventRow{
+		{Log: map[string]interface{}{"accessKey": "alice", "api": map[string]interface{}{"name": "GetObject", "bucket": "photos-2021", "statusCode": 200, "timeToResponse": "1000000ns"}}},
+		{Log: map[string]interface{}{"requestClaims": map[string]interface{}{"accessKey": "bob"}, "api": map[string]interface{}{"name": "GetObject", "bucket": "docs", "statusCode": 200}}},
+	}
+	var query url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query()
+		if r.URL.Query().Get("q") == "raw" {
+			json.NewEncoder(w).Encode(rawRows)
+			return
+		}
+		json.NewEncoder(w).Encode(rows)
+	}))
+	defer server.Close()
+	os.Setenv(ConsoleLogQueryURL, server.URL)
+	os.Setenv(ConsoleLogQueryAuthToken, "secret")
+	defer os.Unsetenv(ConsoleLogQueryURL)
+	defer os.Unsetenv(ConsoleLogQueryAuthToken)
+
+	// Test-1 : the filters are sent to the log search API along with the token
+	results, n, err := fetchLogSearchPage(logSearchQuery{Query: "reqinfo", Order: "timeDesc", StatusCode: 200, PageSize: 10})
+	assert.Nil(err)
+	assert.Equal(3, n)
+	if page, ok := results.([]logsearchServer.ReqInfoRow); assert.True(ok) {
+		assert.Equal(3, len(page))
+	}
+	assert.Equal("secret", query.Get("token"))
+	assert.Equal([]string{"response_status:OK"}, query["fp"])
+	// Test-2 : raw records are returned as read
+	results, truncated, err := searchLogs(logSearchQuery{Query: "raw", Order: "timeDesc", PageSize: 10})
+	assert.Nil(err)
+	assert.False(truncated)
+	if page, ok := results.([]logsearchServer.LogEventRow); assert.True(ok) {
+		assert.Equal(2, len(page))
+	}
+	// Test-3 : the log search API ignores the filters of raw queries so Console applies them
+	results, _, err = searchLogs(logSearchQuery{Query: "raw", Order: "timeDesc", Bucket: "photos-*", PageSize: 10})
+	assert.Nil(err)
+	if page, ok := results.([]logsearchServer.LogEventRow); assert.True(ok) && assert.Equal(1, len(page)) {
+		assert.Equal("alice", page[0].Log["accessKey"])
+	}
+	// Test-4 : the access key is read from the request claims too
+	results, _, err = searchLogs(logSearchQuery{Query: "raw", Order: "timeDesc", AccessKey: "bob", PageSize: 10})
+	assert.Nil(err)
+	if page, ok := results.([]logsearchServer.LogEventRow); assert.True(ok) && assert.Equal(1, len(page)) {
+		assert.Equal("docs", page[0].Log["api"].(map[string]interface{})["bucket"])
+	}
+	// Test-5 : the pages of the records filtered by response time skip the records of the previous pages
+	results, _, err = searchLogs(logSearchQuery{Query: "reqinfo", Order: "timeDesc", ResponseTimeMin: 10, PageSize: 1, PageNo: 1})
+	assert.Nil(err)
+	if page, ok := results.([]logsearchServer.ReqInfoRow); assert.True(ok) && assert.Equal(1, len(page)) {
+		assert.Equal("PutObject", page[0].APIName)
+	}
+	results, _, err = searchLogs(logSearchQuery{Query: "reqinfo", Order: "timeDesc", ResponseTimeMax: 100, PageSize: 10})
+	assert.Nil(err)
+	if page, ok := results.([]logsearchServer.ReqInfoRow); assert.True(ok) {
+		assert.Equal(2, len(page))
+	}
+	// Test-6 : the access key is only part of the raw records
+	assert.Equal(errAccessKeyFilterRequiresRaw, logSearchQuery{Query: "reqinfo", AccessKey: "alice"}.validate())
+	assert.Equal(errInvalidLogSearchStatusCode, logSearchQuery{Query: "reqinfo", StatusCode: 999}.validate())
+	// Test-7 : stats are aggregated from the whole result set
+	resp, err := getLogSearchStats(logSearchQuery{Order: "timeDesc"}, time.Minute)
+	assert.Nil(err)
+	assert.False(resp.Truncated)
+	if assert.Equal(2, len(resp.Stats)) {
+		assert.Equal("GetObject", resp.Stats[0].APIName)
+		assert.Equal(int64(2), resp.Stats[0].Count)
+		assert.Equal(int64(1), resp.Stats[0].Errors)
+		assert.Equal(27.5, resp.Stats[0].AvgResponseTime)
+	}
+	// Test-8 : stats of an access key are aggregated from the raw records
+	resp, err = getLogSearchStats(logSearchQuery{Order: "timeDesc", AccessKey: "alice"}, time.Minute)
+	assert.Nil(err)
+	if assert.Equal(1, len(resp.Stats)) {
+		assert.Equal(int64(1), resp.Stats[0].Count)
+		assert.Equal(1.0, resp.Stats[0].AvgResponseTime)
+	}
+}
+
+func TestLogSearchPatternMatch(t *testing.T) {
+	assert := asrt.New(t)
+	assert.True(logSearchPatternMatch("photos", "photos"))
+	assert.False(logSearchPatternMatch("photos", "photos-2021"))
+	assert.True(logSearchPatternMatch("photos-*", "photos-2021"))
+	assert.True(logSearchPatternMatch("photos-202.", "photos-2021"))
+	assert.False(logSearchPatternMatch("photos-202.", "photos-20211"))
+	assert.True(logSearchPatternMatch("a+b*", "a+bc"))
+}
+
+func TestAggregateLogSearchStats(t *testing.T) {
+	assert := asrt.New(t)
+	start := time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC)
+	entries := []logSearchEntry{
+		{time: start.Add(10 * time.Second), apiName: "GetObject", statusCode: 200, timeToResponse: time.Millisecond},
+		{time: start.Add(70 * time.Second), apiName: "GetObject", statusCode: 500, timeToResponse: 3 * time.Millisecond},
+		{time: start.Add(80 * time.Second), apiName: "GetObject", statusCode: 200, timeToResponse: time.Millisecond},
+		{time: start.Add(20 * time.Second), apiName: "PutObject", statusCode: 200, timeToResponse: time.Millisecond},
+	}
+	stats := aggregateLogSearchStats(entries, time.Minute)
+	if assert.Equal(3, len(stats)) {
+		assert.Equal("2021-06-01T10:00:00Z", stats[0].Time)
+		assert.Equal("PutObject", stats[1].APIName)
+		assert.Equal("2021-06-01T10:01:00Z", stats[2].Time)
+		assert.Equal(int64(2), stats[2].Count)
+		assert.Equal(int64(1), stats[2].Errors)
+		assert.Equal(2.0, stats[2].AvgResponseTime)
+	}
+}
+
+func TestReqInfoToCSV(t *testing.T) {
+	assert := asrt.New(t)
+	length := uint64(10)
+	data, err := reqInfoToCSV([]logsearchServer.ReqInfoRow{
+		{Time: time.Date(2021, 6, 1, 10, 0, 0, 0, time.UTC), APIName: "PutObject", Bucket: "photos", Object: "a,b.jpg", ResponseStatusCode: 200, RequestContentLength: &length},
+	})
+	assert.NoError(err)
+	records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	assert.NoError(err)
+	if assert.Equal(2, len(records)) {
+		assert.Equal("time", records[0][0])
+		assert.Equal([]string{"2021-06-01T10:00:00Z", "PutObject", "photos", "a,b.jpg", "0", "", "", "", "", "200", "10", ""}, records[1])
+	}
+}
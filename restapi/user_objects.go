@@ -268,7 +268,7 @@ func getDeleteObjectResponse(session *models.Principal, params user_api.DeleteOb
 	// create a mc S3Client interface implementation
 	// defining the client to be used
 	mcClient := mcClient{client: s3Client}
-	var rec bool
+	var rec, bypass bool
 	var version string
 	if params.Recursive != nil {
 		rec = *params.Recursive
@@ -276,21 +276,28 @@ func getDeleteObjectResponse(session *models.Principal, params user_api.DeleteOb
 	if params.VersionID != nil {
 		version = *params.VersionID
 	}
-	err = deleteObjects(ctx, mcClient, params.BucketName, params.Path, version, rec)
+	if params.Bypass != nil {
+		bypass = *params.Bypass
+	}
+	if err := checkGovernanceBypass(session, bypass); err != nil {
+		return prepareError(err)
+	}
+	err = deleteObjects(ctx, mcClient, params.BucketName, params.Path, version, rec, bypass)
 	if err != nil {
 		return prepareError(err)
 	}
 	return nil
 }
 
-// deleteObjects deletes either a single object or multiple objects based on recursive flag
-func deleteObjects(ctx context.Context, client MCClient, bucket, path string, versionID string, recursive bool) error {
+// deleteObjects deletes either a single object or multiple objects based on recursive flag,
+// the governance retention of the objects is bypassed when requested
+func deleteObjects(ctx context.Context, client MCClient, bucket, path string, versionID string, recursive, bypass bool) error {
 	if recursive {
-		if err := deleteMultipleObjects(ctx, client, recursive); err != nil {
+		if err := deleteMultipleObjects(ctx, client, recursive, bypass); err != nil {
 			return err
 		}
 	} else {
-		if err := deleteSingleObject(ctx, client, bucket, path, versionID); err != nil {
+		if err := deleteSingleObject(ctx, client, bucket, path, versionID, bypass); err != nil {
 			return err
 		}
 	}
@@ -300,10 +307,9 @@ func deleteObjects(ctx context.Context, client MCClient, bucket, path string, ve
 // deleteMultipleObjects uses listing before removal, it can list recursively or not,
 //   Use cases:
 //      * Remove objects recursively
-func deleteMultipleObjects(ctx context.Context, client MCClient, recursive bool) error {
+func deleteMultipleObjects(ctx context.Context, client MCClient, recursive, isBypass bool) error {
 	isRemoveBucket := false
 	isIncomplete := false
-	isBypass := false
 	listOpts := mc.ListOptions{Recursive: recursive, Incomplete: isIncomplete, ShowDir: mc.DirNone}
 	// TODO: support older Versions
 	contentCh := make(chan *mc.ClientContent, 1)
@@ -357,7 +363,7 @@ OUTER_LOOP:
 
 }
 
-func deleteSingleObject(ctx context.Context, client MCClient, bucket, object string, versionID string) error {
+func deleteSingleObject(ctx context.Context, client MCClient, bucket, object string, versionID string, isBypass bool) error {
 	targetURL := fmt.Sprintf("%s/%s", bucket, object)
 	contentCh := make(chan *mc.ClientContent, 1)
 	contentCh <- &mc.ClientContent{URL: *newClientURL(targetURL), VersionID: versionID}
@@ -365,7 +371,6 @@ func deleteSingleObject(ctx context.Context, client MCClient, bucket, object str
 
 	isRemoveBucket := false
 	isIncomplete := false
-	isBypass := false
 
 	errorCh := client.remove(ctx, isIncomplete, isRemoveBucket, isBypass, contentCh)
 	for pErr := range errorCh {
@@ -544,6 +549,11 @@ func setObjectLegalHold(ctx context.Context, client MinioClient, bucketName, pre
 func getSetObjectRetentionResponse(session *models.Principal, params user_api.PutObjectRetentionParams) *models.Error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
 	defer cancel()
+	if params.Body != nil {
+		if err := checkGovernanceBypass(session, params.Body.GovernanceBypass); err != nil {
+			return prepareError(err)
+		}
+	}
 	mClient, err := newMinioClient(session)
 	if err != nil {
 		return prepareError(err)
@@ -588,6 +598,10 @@ func setObjectRetention(ctx context.Context, client MinioClient, bucketName, ver
 func deleteObjectRetentionResponse(session *models.Principal, params user_api.DeleteObjectRetentionParams) *models.Error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
 	defer cancel()
+	// clearing the retention always bypasses the governance mode
+	if err := checkGovernanceBypass(session, true); err != nil {
+		return prepareError(err)
+	}
 	mClient, err := newMinioClient(session)
 	if err != nil {
 		return prepareError(err)
@@ -0,0 +1,353 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/gorilla/websocket"
+	"github.com/minio/console/models"
+	"github.com/minio/console/restapi/operations"
+	"github.com/minio/console/restapi/operations/user_api"
+	"github.com/minio/minio-go/v7"
+	iampolicy "github.com/minio/pkg/iam/policy"
+)
+
+// lockedObjectsScanLimit is the maximum number of object versions checked by a locked objects report
+const lockedObjectsScanLimit = 10000
+
+func registerObjectLockHandlers(api *operations.ConsoleAPI) {
+	// apply retention or legal hold to the objects under a prefix
+	api.UserAPIBulkObjectLockHandler = user_api.BulkObjectLockHandlerFunc(func(params user_api.BulkObjectLockParams, session *models.Principal) middleware.Responder {
+		summary, err := getBulkObjectLockResponse(session, params)
+		if err != nil {
+			return user_api.NewBulkObjectLockDefault(int(err.Code)).WithPayload(err)
+		}
+		return user_api.NewBulkObjectLockOK().WithPayload(summary)
+	})
+	// list the objects under retention or legal hold
+	api.UserAPIListLockedObjectsHandler = user_api.ListLockedObjectsHandlerFunc(func(params user_api.ListLockedObjectsParams, session *models.Principal) middleware.Responder {
+		report, err := getListLockedObjectsResponse(session, params)
+		if err != nil {
+			return user_api.NewListLockedObjectsDefault(int(err.Code)).WithPayload(err)
+		}
+		return user_api.NewListLockedObjectsOK().WithPayload(report)
+	})
+}
+
+// sessionAllowsAction returns whether the policy of the session allows the action
+func sessionAllowsAction(session *models.Principal, action iampolicy.Action) bool {
+	if session == nil {
+		return false
+	}
+	actions := iampolicy.NewActionSet()
+	for _, a := range session.Actions {
+		actions.Add(iampolicy.Action(a))
+	}
+	return actions.Match(action)
+}
+
+// checkGovernanceBypass fails when the governance retention is bypassed by a
+// session whose policy doesn't allow it, MinIO would deny it anyway but the
+// request is stopped before touching any object
+func checkGovernanceBypass(session *models.Principal, bypass bool) error {
+	if bypass && !sessionAllowsAction(session, iampolicy.BypassGovernanceRetentionAction) {
+		return errGovernanceBypassNotAllowed
+	}
+	return nil
+}
+
+// bulkObjectLockOptions holds the retention and legal hold applied to the objects under a prefix
+type bulkObjectLockOptions struct {
+	BucketName       string
+	Prefix           string
+	Mode             *minio.RetentionMode
+	RetainUntilDate  *time.Time
+	LegalHold        *minio.LegalHoldStatus
+	GovernanceBypass bool
+	AllVersions      bool
+}
+
+// bulkObjectLockProgress is sent through the websocket connection after every
+// object, the summary is only set on the last message
+type bulkObjectLockProgress struct {
+	Processed int                           `json:"processed"`
+	Total     int                           `json:"total"`
+	Object    *models.BulkObjectLockResult  `json:"object,omitempty"`
+	Summary   *models.BulkObjectLockSummary `json:"summary,omitempty"`
+}
+
+// getBulkObjectLockOptions validates a bulk request, the retention needs both
+// the mode and the date and at least a retention or a legal hold is required
+func getBulkObjectLockOptions(bucketName string, req *models.BulkObjectLockRequest, now time.Time) (*bulkObjectLockOptions, error) {
+	if req == nil {
+		return nil, fmt.Errorf("%w: the request body is required", errInvalidBulkObjectLock)
+	}
+	opts := &bulkObjectLockOptions{
+		BucketName:       bucketName,
+		Prefix:           req.Prefix,
+		GovernanceBypass: req.GovernanceBypass,
+		AllVersions:      req.AllVersions,
+	}
+	if (req.Mode == "") != (req.Expires == "") {
+		return nil, fmt.Errorf("%w: the retention requires both the mode and the expiration date", errInvalidBulkObjectLock)
+	}
+	if req.Mode != "" {
+		mode := minio.Compliance
+		if req.Mode == models.ObjectRetentionModeGovernance {
+			mode = minio.Governance
+		}
+		retainUntilDate, err := time.Parse(time.RFC3339, req.Expires)
+		if err != nil {
+			return nil, fmt.Errorf("%w: the expiration date must be in RFC3339 format", errInvalidBulkObjectLock)
+		}
+		if !retainUntilDate.After(now) {
+			return nil, fmt.Errorf("%w: the expiration date must be in the future", errInvalidBulkObjectLock)
+		}
+		opts.Mode = &mode
+		opts.RetainUntilDate = &retainUntilDate
+	}
+	if req.LegalHold != "" {
+		status := minio.LegalHoldDisabled
+		if req.LegalHold == models.ObjectLegalHoldStatusEnabled {
+			status = minio.LegalHoldEnabled
+		}
+		opts.LegalHold = &status
+	}
+	if opts.Mode == nil && opts.LegalHold == nil {
+		return nil, fmt.Errorf("%w: a retention or a legal hold is required", errInvalidBulkObjectLock)
+	}
+	return opts, nil
+}
+
+// listBulkObjectLockTargets lists the objects under the prefix, every version
+// of them when requested. Delete markers can't be locked so they are skipped
+func listBulkObjectLockTargets(ctx context.Context, client MinioClient, opts bulkObjectLockOptions) ([]minio.ObjectInfo, error) {
+	var objects []minio.ObjectInfo
+	for info := range client.listObjects(ctx, opts.BucketName, minio.ListObjectsOptions{
+		Prefix:       opts.Prefix,
+		Recursive:    true,
+		WithVersions: opts.AllVersions,
+	}) {
+		if info.Err != nil {
+			return nil, info.Err
+		}
+		if info.IsDeleteMarker {
+			continue
+		}
+		objects = append(objects, info)
+	}
+	return objects, nil
+}
+
+// bulkObjectLock applies the retention and legal hold to every object under
+// the prefix, the objects that can't be updated are reported as failures
+// without stopping the rest
+func bulkObjectLock(ctx context.Context, client MinioClient, opts bulkObjectLockOptions, progress func(processed, total int, result *models.BulkObjectLockResult) error) (*models.BulkObjectLockSummary, error) {
+	objects, err := listBulkObjectLockTargets(ctx, client, opts)
+	if err != nil {
+		return nil, err
+	}
+	summary := &models.BulkObjectLockSummary{Failures: []*models.BulkObjectLockResult{}}
+	for i, object := range objects {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		var actionErr error
+		if opts.Mode != nil {
+			actionErr = client.putObjectRetention(ctx, opts.BucketName, object.Key, minio.PutObjectRetentionOptions{
+				GovernanceBypass: opts.GovernanceBypass,
+				Mode:             opts.Mode,
+				RetainUntilDate:  opts.RetainUntilDate,
+				VersionID:        object.VersionID,
+			})
+		}
+		if actionErr == nil && opts.LegalHold != nil {
+			actionErr = client.putObjectLegalHold(ctx, opts.BucketName, object.Key, minio.PutObjectLegalHoldOptions{
+				VersionID: object.VersionID,
+				Status:    opts.LegalHold,
+			})
+		}
+		result := &models.BulkObjectLockResult{Name: object.Key, VersionID: object.VersionID}
+		summary.Processed++
+		if actionErr != nil {
+			result.Error = actionErr.Error()
+			summary.Failed++
+			summary.Failures = append(summary.Failures, result)
+		} else {
+			summary.Updated++
+		}
+		if progress != nil {
+			if err := progress(i+1, len(objects), result); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return summary, nil
+}
+
+// getBulkObjectLockResponse applies retention or legal hold to the objects under a prefix
+func getBulkObjectLockResponse(session *models.Principal, params user_api.BulkObjectLockParams) (*models.BulkObjectLockSummary, *models.Error) {
+	opts, err := getBulkObjectLockOptions(params.BucketName, params.Body, time.Now())
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	if err := checkGovernanceBypass(session, opts.GovernanceBypass); err != nil {
+		return nil, prepareError(err)
+	}
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+	summary, err := bulkObjectLock(params.HTTPRequest.Context(), minioClient, *opts, nil)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return summary, nil
+}
+
+// getBulkObjectLockOptionsFromReq gets the bulk options from a request like
+// /object-lock/<bucket>?prefix=<prefix>&mode=governance&expires=<RFC3339 date>&legalHold=enabled&governanceBypass=true&allVersions=true
+func getBulkObjectLockOptionsFromReq(req *http.Request) (*bulkObjectLockOptions, error) {
+	re := regexp.MustCompile(`(/object-lock/)(.*?$)`)
+	matches := re.FindAllSubmatch([]byte(req.URL.Path), -1)
+	if len(matches) == 0 || len(matches[0]) < 3 || strings.TrimSpace(string(matches[0][2])) == "" {
+		return nil, fmt.Errorf("invalid url: %s", req.URL.Path)
+	}
+	return getBulkObjectLockOptions(strings.TrimSpace(string(matches[0][2])), &models.BulkObjectLockRequest{
+		Prefix:           req.FormValue("prefix"),
+		Mode:             models.ObjectRetentionMode(req.FormValue("mode")),
+		Expires:          req.FormValue("expires"),
+		LegalHold:        models.ObjectLegalHoldStatus(req.FormValue("legalHold")),
+		GovernanceBypass: req.FormValue("governanceBypass") == "true",
+		AllVersions:      req.FormValue("allVersions") == "true",
+	}, time.Now())
+}
+
+// startBulkObjectLock applies the bulk changes sending the progress after
+// every object and the summary once done
+func startBulkObjectLock(ctx context.Context, conn WSConn, client MinioClient, opts *bulkObjectLockOptions) error {
+	sendProgress := func(message bulkObjectLockProgress) error {
+		// Serialize message to be sent
+		bytes, err := json.Marshal(message)
+		if err != nil {
+			LogError("error on json.Marshal: %v", err)
+			return err
+		}
+		// Send Message through websocket connection
+		if err = conn.writeMessage(websocket.TextMessage, bytes); err != nil {
+			LogError("error writeMessage: %v", err)
+			return err
+		}
+		return nil
+	}
+	summary, err := bulkObjectLock(ctx, client, *opts, func(processed, total int, result *models.BulkObjectLockResult) error {
+		return sendProgress(bulkObjectLockProgress{Processed: processed, Total: total, Object: result})
+	})
+	if err != nil {
+		return err
+	}
+	processed := int(summary.Processed)
+	return sendProgress(bulkObjectLockProgress{Processed: processed, Total: processed, Summary: summary})
+}
+
+// isNoObjectLockError returns whether the error means the object has no retention or legal hold set
+func isNoObjectLockError(err error) bool {
+	return minio.ToErrorResponse(err).Code == "NoSuchObjectLockConfiguration"
+}
+
+// listLockedObjects reports the objects under the prefix with an active
+// retention or a legal hold, the retention of expired dates no longer locks
+// the objects so they are left out
+func listLockedObjects(ctx context.Context, client MinioClient, bucketName, prefix string, allVersions bool, now time.Time) (*models.LockedObjectsReport, error) {
+	report := &models.LockedObjectsReport{Objects: []*models.LockedObject{}}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	for info := range client.listObjects(ctx, bucketName, minio.ListObjectsOptions{
+		Prefix:       prefix,
+		Recursive:    true,
+		WithVersions: allVersions,
+	}) {
+		if info.Err != nil {
+			return nil, info.Err
+		}
+		if info.IsDeleteMarker {
+			continue
+		}
+		if report.Scanned >= lockedObjectsScanLimit {
+			report.Truncated = true
+			break
+		}
+		report.Scanned++
+		locked := &models.LockedObject{
+			Name:      info.Key,
+			VersionID: info.VersionID,
+			IsLatest:  info.IsLatest || !allVersions,
+			Size:      info.Size,
+		}
+		mode, retainUntilDate, err := client.getObjectRetention(ctx, bucketName, info.Key, info.VersionID)
+		if err != nil && !isNoObjectLockError(err) {
+			return nil, err
+		}
+		if err == nil && mode != nil && retainUntilDate != nil && retainUntilDate.After(now) {
+			locked.Mode = string(*mode)
+			locked.RetainUntilDate = retainUntilDate.Format(time.RFC3339)
+		}
+		legalHold, err := client.getObjectLegalHold(ctx, bucketName, info.Key, minio.GetObjectLegalHoldOptions{VersionID: info.VersionID})
+		if err != nil && !isNoObjectLockError(err) {
+			return nil, err
+		}
+		locked.LegalHold = err == nil && legalHold != nil && *legalHold == minio.LegalHoldEnabled
+		if locked.Mode != "" || locked.LegalHold {
+			report.Objects = append(report.Objects, locked)
+		}
+	}
+	return report, nil
+}
+
+// getListLockedObjectsResponse lists the locked objects of a bucket
+func getListLockedObjectsResponse(session *models.Principal, params user_api.ListLockedObjectsParams) (*models.LockedObjectsReport, *models.Error) {
+	ctx, cancel := context.WithTimeout(params.HTTPRequest.Context(), 5*time.Minute)
+	defer cancel()
+	mClient, err := newMinioClient(session)
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+	prefix := ""
+	if params.Prefix != nil {
+		prefix = *params.Prefix
+	}
+	allVersions := params.AllVersions != nil && *params.AllVersions
+	report, err := listLockedObjects(ctx, minioClient, params.BucketName, prefix, allVersions, time.Now())
+	if err != nil {
+		return nil, prepareError(err)
+	}
+	return report, nil
+}
@@ -0,0 +1,174 @@
+// This file is part of MinIO Console Server
+// Copyright (c) 2021 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/minio/console/models"
+	"github.com/minio/minio-go/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckGovernanceBypass(t *testing.T) {
+	assert := assert.New(t)
+	// Test-1 : no bypass requested
+	assert.NoError(checkGovernanceBypass(&models.Principal{}, false))
+	// Test-2 : the bypass requires the action or a wildcard matching it
+	assert.NoError(checkGovernanceBypass(&models.Principal{Actions: []string{"s3:BypassGovernanceRetention"}}, true))
+	assert.NoError(checkGovernanceBypass(&models.Principal{Actions: []string{"s3:*"}}, true))
+	assert.Equal(errGovernanceBypassNotAllowed, checkGovernanceBypass(&models.Principal{Actions: []string{"s3:GetObject", "s3:PutObjectRetention"}}, true))
+	assert.Equal(errGovernanceBypassNotAllowed, checkGovernanceBypass(nil, true))
+}
+
+func TestGetBulkObjectLockOptions(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC)
+	// Test-1 : retention and legal hold
+	opts, err := getBulkObjectLockOptions("bucket", &models.BulkObjectLockRequest{
+		Prefix:    "reports/",
+		Mode:      models.ObjectRetentionModeGovernance,
+		Expires:   "2022-01-01T00:00:00Z",
+		LegalHold: models.ObjectLegalHoldStatusEnabled,
+	}, now)
+	if assert.NoError(err) {
+		assert.Equal(minio.Governance, *opts.Mode)
+		assert.Equal(2022, opts.RetainUntilDate.Year())
+		assert.Equal(minio.LegalHoldEnabled, *opts.LegalHold)
+	}
+	// Test-2 : invalid requests
+	for _, req := range []*models.BulkObjectLockRequest{
+		nil,
+		{Prefix: "reports/"},
+		{Mode: models.ObjectRetentionModeCompliance},
+		{Mode: models.ObjectRetentionModeCompliance, Expires: "2022-01-01"},
+		{Mode: models.ObjectRetentionModeCompliance, Expires: "2021-08-01T00:00:00Z"},
+	} {
+		_, err := getBulkObjectLockOptions("bucket", req, now)
+		assert.True(errors.Is(err, errInvalidBulkObjectLock), req)
+	}
+}
+
+func TestBulkObjectLock(t *testing.T) {
+	assert := assert.New(t)
+	client := minioClientMock{}
+	minioListObjectsMock = mockReplicationListing([]minio.ObjectInfo{
+		{Key: "reports/2021.csv", VersionID: "v2", IsLatest: true},
+		{Key: "reports/2021.csv", VersionID: "v1"},
+		{Key: "reports/draft.csv", VersionID: "v3", IsLatest: true, IsDeleteMarker: true},
+		{Key: "reports/locked.csv", VersionID: "v4", IsLatest: true},
+		{Key: "logs/app.log", VersionID: "v5", IsLatest: true},
+	})
+	var retained, held []string
+	var bypass bool
+	minioPutObjectRetentionMock = func(ctx context.Context, bucketName, objectName string, opts minio.PutObjectRetentionOptions) error {
+		if objectName == "reports/locked.csv" {
+			return errors.New("Access Denied")
+		}
+		bypass = opts.GovernanceBypass
+		retained = append(retained, objectName+"@"+opts.VersionID)
+		return nil
+	}
+	minioPutObjectLegalHoldMock = func(ctx context.Context, bucketName, objectName string, opts minio.PutObjectLegalHoldOptions) error {
+		held = append(held, objectName+"@"+opts.VersionID)
+		return nil
+	}
+	mode := minio.Governance
+	until := time.Now().Add(24 * time.Hour)
+	status := minio.LegalHoldEnabled
+	var progress []int
+	summary, err := bulkObjectLock(context.Background(), client, bulkObjectLockOptions{
+		BucketName:       "bucket",
+		Prefix:           "reports/",
+		Mode:             &mode,
+		RetainUntilDate:  &until,
+		LegalHold:        &status,
+		GovernanceBypass: true,
+		AllVersions:      true,
+	}, func(processed, total int, result *models.BulkObjectLockResult) error {
+		progress = append(progress, processed*10+total)
+		return nil
+	})
+	if assert.NoError(err) {
+		// Test-1 : every version under the prefix except delete markers
+		assert.Equal(int64(3), summary.Processed)
+		assert.Equal(int64(2), summary.Updated)
+		assert.Equal([]string{"reports/2021.csv@v2", "reports/2021.csv@v1"}, retained)
+		assert.Equal(retained, held)
+		assert.True(bypass)
+		// Test-2 : failures are reported without stopping the rest
+		if assert.Equal(1, len(summary.Failures)) {
+			assert.Equal("reports/locked.csv", summary.Failures[0].Name)
+			assert.Equal("Access Denied", summary.Failures[0].Error)
+		}
+		assert.Equal([]int{13, 23, 33}, progress)
+	}
+}
+
+func TestListLockedObjects(t *testing.T) {
+	assert := assert.New(t)
+	client := minioClientMock{}
+	now := time.Date(2021, 9, 1, 0, 0, 0, 0, time.UTC)
+	minioListObjectsMock = mockReplicationListing([]minio.ObjectInfo{
+		{Key: "contracts/a.pdf", VersionID: "v1", IsLatest: true, Size: 10},
+		{Key: "contracts/b.pdf", VersionID: "v2", IsLatest: true, Size: 20},
+		{Key: "contracts/c.pdf", VersionID: "v3", IsLatest: true, Size: 30},
+		{Key: "contracts/d.pdf", VersionID: "v4", IsLatest: true, IsDeleteMarker: true},
+	})
+	minioGetObjectRetentionMock = func(ctx context.Context, bucketName, objectName, versionID string) (*minio.RetentionMode, *time.Time, error) {
+		compliance := minio.Compliance
+		switch objectName {
+		case "contracts/a.pdf":
+			until := now.Add(48 * time.Hour)
+			return &compliance, &until, nil
+		case "contracts/b.pdf":
+			expired := now.Add(-time.Hour)
+			return &compliance, &expired, nil
+		}
+		return nil, nil, minio.ErrorResponse{Code: "NoSuchObjectLockConfiguration"}
+	}
+	minioGetObjectLegalHoldMock = func(ctx context.Context, bucketName, objectName string, opts minio.GetObjectLegalHoldOptions) (*minio.LegalHoldStatus, error) {
+		if objectName == "contracts/c.pdf" {
+			status := minio.LegalHoldEnabled
+			return &status, nil
+		}
+		return nil, minio.ErrorResponse{Code: "NoSuchObjectLockConfiguration"}
+	}
+	// Test-1 : objects under active retention or legal hold
+	report, err := listLockedObjects(context.Background(), client, "bucket", "contracts/", false, now)
+	if assert.NoError(err) {
+		assert.Equal(int64(3), report.Scanned)
+		assert.False(report.Truncated)
+		if assert.Equal(2, len(report.Objects)) {
+			assert.Equal("COMPLIANCE", report.Objects[0].Mode)
+			assert.Equal("2021-09-03T00:00:00Z", report.Objects[0].RetainUntilDate)
+			assert.False(report.Objects[0].LegalHold)
+			assert.Equal("contracts/c.pdf", report.Objects[1].Name)
+			assert.True(report.Objects[1].LegalHold)
+			assert.Empty(report.Objects[1].Mode)
+		}
+	}
+	// Test-2 : other errors stop the report
+	minioGetObjectLegalHoldMock = func(ctx context.Context, bucketName, objectName string, opts minio.GetObjectLegalHoldOptions) (*minio.LegalHoldStatus, error) {
+		return nil, minio.ErrorResponse{Code: "AccessDenied"}
+	}
+	_, err = listLockedObjects(context.Background(), client, "bucket", "contracts/", false, now)
+	assert.Error(err)
+}
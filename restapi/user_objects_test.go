@@ -710,7 +710,7 @@ func Test_deleteObjects(t *testing.T) {
 		t.Run(tt.test, func(t *testing.T) {
 			mcListMock = tt.args.listFunc
 			mcRemoveMock = tt.args.removeFunc
-			err := deleteObjects(ctx, client, tt.args.bucket, tt.args.path, tt.args.versionID, tt.args.recursive)
+			err := deleteObjects(ctx, client, tt.args.bucket, tt.args.path, tt.args.versionID, tt.args.recursive, false)
 			if !reflect.DeepEqual(err, tt.wantError) {
 				t.Errorf("deleteObjects() error: %v, wantErr: %v", err, tt.wantError)
 				return
@@ -18,9 +18,12 @@ package restapi
 
 import (
 	"crypto/rand"
+	"encoding/json"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 )
@@ -105,6 +108,45 @@ func FileExists(filename string) bool {
 	return !info.IsDir()
 }
 
+// readDataFile decodes a JSON file of the Console data directory into v,
+// a missing file leaves v untouched
+func readDataFile(name string, v interface{}) error {
+	data, err := ioutil.ReadFile(filepath.Join(getConsoleDataDir(), name))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// writeDataFile stores v as JSON in the Console data directory, the file is
+// replaced atomically
+func writeDataFile(name string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(getConsoleDataDir(), name)
+	if err = os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
 func NewSessionCookieForConsole(token string) http.Cookie {
 	expiration := time.Now().Add(SessionDuration)
 
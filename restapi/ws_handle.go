@@ -66,6 +66,13 @@ type wsS3Client struct {
 	client MCClient
 }
 
+type wsMinioClient struct {
+	// websocket connection.
+	conn wsConn
+	// minioClient
+	client MinioClient
+}
+
 // WSConn interface with all functions to be implemented
 // by mock when testing, it should include all websocket.Conn
 // respective api calls that are used within this project.
@@ -193,6 +200,7 @@ func serveWS(w http.ResponseWriter, req *http.Request) {
 			closeWsConn(conn)
 			return
 		}
+		hOptions.RequestedBy = session.AccountAccessKey
 		wsAdminClient, err := newWebSocketAdminClient(conn, session)
 		if err != nil {
 			closeWsConn(conn)
@@ -212,6 +220,50 @@ func serveWS(w http.ResponseWriter, req *http.Request) {
 			return
 		}
 		go wsS3Client.watch(wOptions)
+	case strings.HasPrefix(wsPath, `/restore`):
+		rOptions, err := getRestoreOptionsFromReq(req)
+		if err != nil {
+			LogError("error getting restore options: %v", err)
+			closeWsConn(conn)
+			return
+		}
+		wsMinioClient, err := newWebSocketMinioClient(conn, session)
+		if err != nil {
+			closeWsConn(conn)
+			return
+		}
+		go wsMinioClient.restore(rOptions)
+	case strings.HasPrefix(wsPath, `/object-lock`):
+		lOptions, err := getBulkObjectLockOptionsFromReq(req)
+		if err != nil {
+			LogError("error getting object lock options: %v", err)
+			closeWsConn(conn)
+			return
+		}
+		if err := checkGovernanceBypass(session, lOptions.GovernanceBypass); err != nil {
+			LogError("error starting object lock: %v", err)
+			closeWsConn(conn)
+			return
+		}
+		wsMinioClient, err := newWebSocketMinioClient(conn, session)
+		if err != nil {
+			closeWsConn(conn)
+			return
+		}
+		go wsMinioClient.bulkObjectLock(lOptions)
+	case strings.HasPrefix(wsPath, `/versions-cleanup`):
+		vOptions, err := getVersionsCleanupOptionsFromReq(req)
+		if err != nil {
+			LogError("error getting versions cleanup options: %v", err)
+			closeWsConn(conn)
+			return
+		}
+		wsMinioClient, err := newWebSocketMinioClient(conn, session)
+		if err != nil {
+			closeWsConn(conn)
+			return
+		}
+		go wsMinioClient.versionsCleanup(vOptions)
 	default:
 		// path not found
 		closeWsConn(conn)
@@ -258,6 +310,24 @@ func newWebSocketS3Client(conn *websocket.Conn, claims *models.Principal, bucket
 	return wsS3Client, nil
 }
 
+// newWebSocketMinioClient returns a wsMinioClient authenticated as the session user
+func newWebSocketMinioClient(conn *websocket.Conn, claims *models.Principal) (*wsMinioClient, error) {
+	mClient, err := newMinioClient(claims)
+	if err != nil {
+		LogError("error creating minio client: %v", err)
+		return nil, err
+	}
+	// create a websocket connection interface implementation
+	// defining the connection to be used
+	wsConnection := wsConn{conn: conn}
+	// create a minioClient interface implementation
+	// defining the client to be used
+	minioClient := minioClient{client: mClient}
+	// create websocket client and handle request
+	wsMinioClient := &wsMinioClient{conn: wsConnection, client: minioClient}
+	return wsMinioClient, nil
+}
+
 // wsReadClientCtx reads the messages that come from the client
 // if the client sends a Close Message the context will be
 // canceled. If the connection is closed the goroutine inside
@@ -344,6 +414,51 @@ func (wsc *wsS3Client) watch(params *watchOptions) {
 	sendWsCloseMessage(wsc.conn, err)
 }
 
+func (wsc *wsMinioClient) restore(opts *restoreOptions) {
+	defer func() {
+		LogInfo("restore stopped")
+		// close connection after return
+		wsc.conn.close()
+	}()
+	LogInfo("restore started")
+
+	ctx := wsReadClientCtx(wsc.conn)
+
+	err := startRestore(ctx, wsc.conn, wsc.client, opts)
+
+	sendWsCloseMessage(wsc.conn, err)
+}
+
+func (wsc *wsMinioClient) bulkObjectLock(opts *bulkObjectLockOptions) {
+	defer func() {
+		LogInfo("object lock stopped")
+		// close connection after return
+		wsc.conn.close()
+	}()
+	LogInfo("object lock started")
+
+	ctx := wsReadClientCtx(wsc.conn)
+
+	err := startBulkObjectLock(ctx, wsc.conn, wsc.client, opts)
+
+	sendWsCloseMessage(wsc.conn, err)
+}
+
+func (wsc *wsMinioClient) versionsCleanup(opts *versionsCleanupOptions) {
+	defer func() {
+		LogInfo("versions cleanup stopped")
+		// close connection after return
+		wsc.conn.close()
+	}()
+	LogInfo("versions cleanup started")
+
+	ctx := wsReadClientCtx(wsc.conn)
+
+	err := startVersionsCleanup(ctx, wsc.conn, wsc.client, opts)
+
+	sendWsCloseMessage(wsc.conn, err)
+}
+
 func (wsc *wsAdminClient) heal(opts *healOptions) {
 	defer func() {
 		LogInfo("heal stopped")